@@ -0,0 +1,212 @@
+// Package main provides api-keys, a tool that manages admin API keys.
+//
+// Keys are stored hashed: the key value is printed only once, by create and rotate.
+// A -service key is a service account limited by its scopes alone; other keys act on behalf
+// of the -owner user they are bound to.
+// Rotation keeps the old key working for a grace period so clients can switch over:
+//
+//	api-keys create -name ops -scopes '*' -expires-days 90 -service
+//	api-keys create -name dashboard -scopes feedback.view -owner 123456789
+//	api-keys list
+//	api-keys rotate -id 3 -grace-days 1
+//	api-keys revoke -id 3
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"language-exchange-bot/internal/config"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/database"
+	"language-exchange-bot/internal/models"
+)
+
+const usage = `usage: api-keys <command> [flags]
+
+commands:
+  create  -name NAME -scopes SCOPES [-expires-days N] (-owner TELEGRAM_ID | -service)
+                                                        issue a key (SCOPES: comma-separated permissions or *;
+                                                        -owner: the user the key acts for;
+                                                        -service: service account limited by its scopes)
+  list                                                  list keys
+  rotate  -id ID [-grace-days N]                        issue a replacement, the old key works N more days
+  revoke  -id ID                                        revoke a key immediately
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err := run(os.Args[1], os.Args[2:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run выполняет команду с ее флагами.
+func run(command string, args []string) error {
+	switch command {
+	case "create", "list", "rotate", "revoke":
+	default:
+		fmt.Fprint(os.Stderr, usage)
+
+		return fmt.Errorf("unknown command %q", command)
+	}
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+
+	var (
+		name        = flags.String("name", "", "key name, e.g. the client that uses it")
+		scopes      = flags.String("scopes", "", "comma-separated permissions, or * for all")
+		expiresDays = flags.Int("expires-days", -1, "lifetime in days, 0 - never expires (default API_KEY_DEFAULT_LIFETIME_DAYS)")
+		keyID       = flags.Int("id", 0, "key ID")
+		graceDays   = flags.Int("grace-days", -1, "days the old key keeps working (default API_KEY_GRACE_PERIOD_DAYS)")
+		serviceKey  = flags.Bool("service", false, "service account key, limited by its scopes alone")
+		owner       = flags.Int64("owner", 0, "Telegram ID of the user the key acts for")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	service, closeDB, err := newService()
+	if err != nil {
+		return err
+	}
+
+	defer closeDB()
+
+	switch command {
+	case "create":
+		input := models.APIKeyInput{
+			Name: *name, Scopes: splitScopes(*scopes), ExpiresInDays: optionalDays(*expiresDays), ServiceAccount: *serviceKey,
+			OwnerTelegramID: *owner,
+		}
+
+		key, rawKey, err := service.CreateAPIKey(input, 0)
+		if err != nil {
+			return fmt.Errorf("failed to create api key: %w", err)
+		}
+
+		printIssuedKey(key, rawKey)
+	case "list":
+		keys, err := service.GetAPIKeys()
+		if err != nil {
+			return fmt.Errorf("failed to list api keys: %w", err)
+		}
+
+		printKeys(keys)
+	case "rotate":
+		key, rawKey, err := service.RotateAPIKey(*keyID, optionalDays(*graceDays), 0)
+		if err != nil {
+			return fmt.Errorf("failed to rotate api key %d: %w", *keyID, err)
+		}
+
+		printIssuedKey(key, rawKey)
+		fmt.Printf("Key %d keeps working until the grace period ends.\n", *keyID)
+	case "revoke":
+		if err := service.RevokeAPIKey(*keyID); err != nil {
+			return fmt.Errorf("failed to revoke api key %d: %w", *keyID, err)
+		}
+
+		fmt.Printf("Key %d revoked.\n", *keyID)
+	}
+
+	return nil
+}
+
+// newService подключается к базе из конфигурации окружения.
+func newService() (*core.BotService, func(), error) {
+	cfg := config.Load()
+
+	db, err := database.NewDB(cfg.DatabaseURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	closeDB := func() {
+		if err := db.Close(); err != nil {
+			log.Printf("Error closing database connection: %v", err)
+		}
+	}
+
+	return core.NewBotService(db, nil), closeDB, nil
+}
+
+// splitScopes разбирает список прав через запятую.
+func splitScopes(value string) []string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	return strings.Split(value, ",")
+}
+
+// formatOwner выводит внутренний ID владельца ключа или "-" для ключа без владельца.
+func formatOwner(userID int) string {
+	if userID == 0 {
+		return "-"
+	}
+
+	return strconv.Itoa(userID)
+}
+
+// optionalDays возвращает nil для отрицательного значения флага (значение по умолчанию из конфигурации).
+func optionalDays(days int) *int {
+	if days < 0 {
+		return nil
+	}
+
+	return &days
+}
+
+// printIssuedKey печатает выпущенный ключ вместе с его значением.
+func printIssuedKey(key *models.APIKey, rawKey string) {
+	fmt.Printf("Key %d %q issued, scopes: %s, expires: %s\n", key.ID, key.Name, strings.Join(key.Scopes, ","), formatTime(key.ExpiresAt))
+	fmt.Println("Store this key now, it will not be shown again:")
+	fmt.Println(rawKey)
+}
+
+// printKeys печатает ключи таблицей.
+func printKeys(keys []models.APIKey) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tNAME\tPREFIX\tSCOPES\tSERVICE\tOWNER\tEXPIRES\tLAST USED\tSTATUS")
+
+	now := time.Now()
+
+	for _, key := range keys {
+		status := "active"
+
+		switch {
+		case key.RevokedAt != nil:
+			status = "revoked"
+		case !key.IsUsable(now):
+			status = "expired"
+		}
+
+		fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%t\t%s\t%s\t%s\t%s\n",
+			key.ID, key.Name, key.KeyPrefix, strings.Join(key.Scopes, ","), key.ServiceAccount, formatOwner(key.OwnerUserID),
+			formatTime(key.ExpiresAt), formatTime(key.LastUsedAt), status)
+	}
+
+	if err := writer.Flush(); err != nil {
+		log.Printf("Failed to print api keys: %v", err)
+	}
+}
+
+// formatTime форматирует необязательное время.
+func formatTime(value *time.Time) string {
+	if value == nil {
+		return "-"
+	}
+
+	return value.Format(time.RFC3339)
+}
@@ -36,6 +36,7 @@ import (
 	"time"
 
 	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/adapters/discord"
 	"language-exchange-bot/internal/adapters/telegram"
 	"language-exchange-bot/internal/config"
 	"language-exchange-bot/internal/core"
@@ -79,12 +80,30 @@ func main() {
 		log.Fatalf("Failed to initialize service: %v", err)
 	}
 
+	// Фоновое удаление завершившихся периодов недоступности (отпусков)
+	go service.StartUnavailabilityCleanup(ctx)
+
+	// Периодический пересчет рекомендаций интересов по совместному выбору
+	go service.StartInterestCooccurrenceRefresh(ctx)
+
 	// Start admin API server с общим сервисом
 	adminServer := startAdminServerWithService(cfg, service, errorHandler)
 
 	// Start bots с общим сервисом
 	bots, wg, _ := startBotsWithService(ctx, cfg, service, errorHandler)
 
+	// Проверка сроков обработки отзывов; уведомления отправляет Telegram бот
+	go service.StartFeedbackSLAMonitor(ctx)
+
+	// Запуск запланированных опросов; диспетчер опросов задает Telegram бот
+	go service.StartSurveyScheduler(ctx)
+
+	// Ежедневное удаление данных с истекшим сроком хранения
+	go service.StartRetentionScheduler(ctx)
+
+	// Перевод персональных данных на активный ключ шифрования после ротации
+	go service.StartPersonalDataReencryption(ctx)
+
 	waitForShutdown(bots, wg, adminServer, ctx, cancel)
 }
 
@@ -104,6 +123,13 @@ func initializeService(
 		log.Printf("Redis cache initialized: %s", service.Cache.String())
 	}
 
+	// Назначаем роль admin зарегистрированным пользователям из ADMIN_CHAT_IDS и ADMIN_USERNAMES
+	if assigned, err := service.BootstrapAdminRoles(); err != nil {
+		log.Printf("Failed to bootstrap admin roles: %v", err)
+	} else if assigned > 0 {
+		log.Printf("Assigned admin role to %d configured users", assigned)
+	}
+
 	return service, nil
 }
 
@@ -129,6 +155,8 @@ func initializeTelegramBotWithService(
 	botService := telegramBot.GetService()
 	if botService != nil {
 		botService.SetFeedbackNotificationFunc(telegramBot.SendFeedbackNotification)
+		botService.SetFeedbackSLAAlertFunc(telegramBot.SendFeedbackSLAAlert)
+		botService.SetAccountDeletionNoticeFunc(telegramBot.SendAccountDeletionNotices)
 		log.Printf("Связал функцию уведомлений с сервисом отзывов")
 	}
 
@@ -144,9 +172,35 @@ func setupDatabase(cfg *config.Config) *database.DB {
 
 	log.Println("Connected to database successfully")
 
+	setupEncryption(cfg, db)
+
 	return db
 }
 
+// setupEncryption включает шифрование персональных данных, если заданы ключи.
+func setupEncryption(cfg *config.Config, db *database.DB) {
+	var provider database.KeyProvider
+
+	switch {
+	case cfg.EncryptionKeyFile != "":
+		provider = database.KeyFileProvider{Path: cfg.EncryptionKeyFile, ActiveID: cfg.EncryptionActiveKeyID}
+	case cfg.EncryptionKeyDir != "":
+		provider = database.SecretDirKeyProvider{Dir: cfg.EncryptionKeyDir, ActiveID: cfg.EncryptionActiveKeyID}
+	default:
+		log.Println("ENCRYPTION_KEY_FILE and ENCRYPTION_KEY_DIR are not set, personal data is stored unencrypted")
+
+		return
+	}
+
+	fieldCipher, err := database.NewFieldCipher(provider)
+	if err != nil {
+		log.Fatalf("Failed to load encryption keys: %v", err)
+	}
+
+	db.SetFieldCipher(fieldCipher)
+	log.Printf("Personal data encryption enabled, active key %s", fieldCipher.ActiveKeyID())
+}
+
 // setupErrorHandler создает систему обработки ошибок.
 func setupErrorHandler() *errors.ErrorHandler {
 	adminNotifier := errors.NewAdminNotifier([]int64{}, nil) // TODO: Добавить реальные Chat ID администраторов
@@ -178,8 +232,7 @@ func startAdminServerWithService(cfg *config.Config, service *core.BotService, e
 	telegramHandler := telegram.NewTelegramHandlerWithAdmins(
 		nil, // bot API не нужен для admin API
 		service,
-		[]int64{},  // пустой список admin chat IDs
-		[]string{}, // пустой список admin usernames
+		[]int64{}, // пустой список admin chat IDs
 		errorHandler,
 	)
 
@@ -272,8 +325,9 @@ func startBotsWithService(
 	errorHandler *errors.ErrorHandler,
 ) ([]adapters.BotAdapter, *sync.WaitGroup, *telegram.TelegramHandler) {
 	var (
-		wg   sync.WaitGroup
-		bots []adapters.BotAdapter
+		wg              sync.WaitGroup
+		bots            []adapters.BotAdapter
+		telegramHandler *telegram.TelegramHandler
 	)
 
 	// Telegram Bot
@@ -283,11 +337,10 @@ func startBotsWithService(
 			log.Printf("Failed to initialize Telegram bot, continuing with Admin API only: %v", err)
 		} else {
 			// Создаем handler для Telegram для admin server
-			telegramHandler := telegram.NewTelegramHandlerWithAdmins(
+			telegramHandler = telegram.NewTelegramHandlerWithAdmins(
 				telegramBot.GetBotAPI(),
 				service,
 				cfg.AdminChatIDs,
-				cfg.AdminUsernames,
 				errorHandler,
 			)
 
@@ -307,10 +360,32 @@ func startBotsWithService(
 			}()
 
 			bots = append(bots, telegramBot)
+		}
+	}
+
+	// Discord Bot: общий сервис, уведомления об отзывах уходят администраторам в Telegram
+	if cfg.EnableDiscord && cfg.DiscordToken != "" {
+		discordBot, err := discord.NewDiscordBot(cfg.DiscordToken, service, cfg.AdminChatIDs)
+		if err != nil {
+			log.Printf("Failed to initialize Discord bot: %v", err)
+		} else {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				log.Printf("Starting Discord bot...")
+
+				if err := discordBot.Start(ctx); err != nil {
+					log.Printf("Discord bot error: %v", err)
+				}
+
+				log.Printf("Discord bot stopped")
+			}()
 
-			return bots, &wg, telegramHandler
+			bots = append(bots, discordBot)
 		}
 	}
 
-	return bots, &wg, nil
+	return bots, &wg, telegramHandler
 }
@@ -0,0 +1,137 @@
+// Package main provides catalog-sync, a tool that keeps the interest catalog in the database
+// in sync with config/interests.json.
+//
+// By default it only prints the plan (dry run). Use -apply to apply it in one transaction.
+// Removing an interest that users have selected requires a migration target:
+//
+//	catalog-sync -apply -migrate old_key=new_key
+//
+// Interests approved from user suggestions or created through the admin API are not part of
+// the file and are never removed; adding such an interest to the file hands it over to the catalog.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"language-exchange-bot/internal/cache"
+	"language-exchange-bot/internal/config"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/database"
+	"language-exchange-bot/internal/localization"
+)
+
+// migrationFlags собирает повторяющийся флаг -migrate old_key=new_key.
+type migrationFlags map[string]string
+
+// String реализует flag.Value.
+func (m migrationFlags) String() string {
+	pairs := make([]string, 0, len(m))
+	for from, to := range m {
+		pairs = append(pairs, from+"="+to)
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// Set реализует flag.Value.
+func (m migrationFlags) Set(value string) error {
+	from, to, ok := strings.Cut(value, "=")
+	if !ok || from == "" || to == "" {
+		return fmt.Errorf("expected old_key=new_key, got %q", value)
+	}
+
+	if _, exists := m[from]; exists {
+		return fmt.Errorf("migration for %q is set twice", from)
+	}
+
+	m[from] = to
+
+	return nil
+}
+
+func main() {
+	configPath := flag.String("config", "config/interests.json", "path to interests.json with the interest catalog")
+	apply := flag.Bool("apply", false, "apply the plan (without it the tool only prints the plan)")
+	migrations := migrationFlags{}
+	flag.Var(migrations, "migrate", "move selections of a removed interest: old_key=new_key (repeatable)")
+	flag.Parse()
+
+	if err := run(*configPath, *apply, migrations); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run строит план синхронизации и, если задан apply, применяет его.
+func run(configPath string, apply bool, migrations map[string]string) error {
+	interestsConfig, err := config.LoadInterestsConfigFile(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to load interest catalog: %w", err)
+	}
+
+	cfg := config.Load()
+
+	db, err := database.NewDB(cfg.DatabaseURL)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.Printf("Error closing database connection: %v", err)
+		}
+	}()
+
+	current, err := db.GetInterestCatalog()
+	if err != nil {
+		return fmt.Errorf("failed to read interest catalog from database: %w", err)
+	}
+
+	for _, warning := range core.CheckInterestCatalogLocales(interestsConfig, localization.NewLocalizer(nil)) {
+		fmt.Printf("warning: %s\n", warning)
+	}
+
+	plan, err := core.PlanInterestCatalogSync(interestsConfig, current, migrations)
+	if err != nil {
+		return fmt.Errorf("cannot plan interest catalog sync: %w", err)
+	}
+
+	fmt.Print(core.FormatInterestCatalogPlan(plan))
+
+	if plan.IsEmpty() {
+		return nil
+	}
+
+	if !apply {
+		fmt.Println("Dry run: re-run with -apply to apply these changes.")
+
+		return nil
+	}
+
+	if err := db.ApplyInterestCatalogPlan(plan); err != nil {
+		return fmt.Errorf("failed to apply interest catalog plan, nothing was changed: %w", err)
+	}
+
+	fmt.Println("Interest catalog synced.")
+
+	invalidateStaticData(cfg)
+
+	return nil
+}
+
+// invalidateStaticData сбрасывает кэш интересов и переводов в Redis, чтобы бот увидел новый каталог.
+// Ошибка не критична: кэш истечет сам по TTL.
+func invalidateStaticData(cfg *config.Config) {
+	redisCache, err := cache.NewRedisCacheService(cfg.RedisURL, cfg.RedisPassword, cfg.RedisDB, cache.DefaultConfig())
+	if err != nil {
+		log.Printf("warning: could not connect to Redis to invalidate cached interests: %v", err)
+
+		return
+	}
+
+	defer redisCache.Stop()
+
+	cache.NewInvalidationService(redisCache).InvalidateStaticData()
+}
@@ -0,0 +1,297 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/adapters/screens"
+	"language-exchange-bot/internal/config"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/models"
+)
+
+// Slash-команды бота.
+const (
+	commandStart    = "start"
+	commandProfile  = "profile"
+	commandFeedback = "feedback"
+)
+
+// Идентификаторы компонентов, которых нет в общих экранах. После префикса передается шаг выбора
+// интересов (категория и страница), страница основных интересов или выбранные дни недели. Кнопки общих экранов используют действия пакета screens.
+const (
+	customIDInterestsPrefix   = "onboarding:interests:"
+	customIDSkipPrefix        = "onboarding:skip:"
+	customIDPrimaryPrefix     = "onboarding:primary:"
+	customIDPrimarySkipPrefix = "onboarding:primary-skip:"
+	customIDDays              = "onboarding:days"
+	customIDTimePrefix        = "onboarding:time:"
+	customIDFeedbackModal     = "feedback:submit"
+)
+
+// Префиксы действий выбора языка в общих экранах: lang_<назначение>_<код>.
+const (
+	actionInterfaceLanguage = screens.ActionLanguagePrefix + screens.LanguageInterface + "_"
+	actionNativeLanguage    = screens.ActionLanguagePrefix + screens.LanguageNative + "_"
+	actionTargetLanguage    = screens.ActionLanguagePrefix + screens.LanguageTarget + "_"
+)
+
+// supportedActions - действия общих экранов, которые обрабатывает handleAction.
+// Кнопки остальных действий (доступность, цели изучения и т.п.) в Discord не показываются.
+var supportedActions = map[string]bool{
+	screens.ActionMainMenu:          true,
+	screens.ActionViewProfile:       true,
+	screens.ActionProfileShow:       true,
+	screens.ActionProfileView:       true,
+	screens.ActionEditProfile:       true,
+	screens.ActionEditLanguages:     true,
+	screens.ActionStartProfileSetup: true,
+	screens.ActionProfileSetupInfo:  true,
+	screens.ActionEditInterests:     true,
+	screens.ActionChangeLanguage:    true,
+	screens.ActionFeedback:          true,
+}
+
+// supportedActionPrefixes - префиксы действий с параметром, которые обрабатывает handleAction.
+var supportedActionPrefixes = []string{
+	actionInterfaceLanguage,
+	actionNativeLanguage,
+	actionTargetLanguage,
+	screens.ActionLevelPrefix,
+}
+
+// InterestSelections - выбор интересов пользователя, который в Telegram ведет core.InterestService:
+// те же таблицы, проверка повторов и лимитов основных интересов.
+type InterestSelections interface {
+	GetUserInterestSelections(userID int) ([]models.InterestSelection, error)
+	AddUserInterestSelection(userID, interestID int, isPrimary bool) error
+	SetPrimaryInterest(userID, interestID int, isPrimary bool) error
+	BatchUpdateUserInterests(userID int, selections []models.InterestSelection) error
+	GetInterestLimitsConfig() (*config.InterestLimitsConfig, error)
+	// ValidateInterestSelection - проверка завершения выбора, как в Telegram: не меньше
+	// min_primary_interests основных интересов, иначе ErrMinPrimaryInterestsRequired.
+	ValidateInterestSelection(userID, totalInterests int) error
+}
+
+// Bot - Discord бот: онбординг, просмотр профиля и отзывы на компонентах Discord.
+// Пользователь определяется по ID с указанием платформы (discord:<ID пользователя>).
+type Bot struct {
+	gateway      Gateway
+	service      *core.BotService
+	interests    InterestSelections
+	screens      *screens.Builder
+	adminChatIDs []int64 // Telegram ID администраторов для уведомлений об отзывах
+}
+
+// NewBot создает бота поверх gateway. В тестах вместо Discord и базы передаются поддельные
+// gateway и выбор интересов.
+func NewBot(gateway Gateway, service *core.BotService, interests InterestSelections, adminChatIDs []int64) *Bot {
+	return &Bot{
+		gateway:      gateway,
+		service:      service,
+		interests:    interests,
+		screens:      screens.NewBuilder(service),
+		adminChatIDs: adminChatIDs,
+	}
+}
+
+// NewDiscordBot создает бота, подключенного к Discord с токеном бота.
+func NewDiscordBot(token string, service *core.BotService, adminChatIDs []int64) (*Bot, error) {
+	gateway, err := NewSessionGateway(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBot(gateway, service, core.NewInterestService(service.DB.GetConnection()), adminChatIDs), nil
+}
+
+// Start подключается к Discord и обрабатывает взаимодействия до отмены контекста.
+func (b *Bot) Start(ctx context.Context) error {
+	if err := b.gateway.Open(ctx, b.dispatch); err != nil {
+		return err
+	}
+
+	log.Printf("Discord bot started")
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// Stop отключается от Discord.
+func (b *Bot) Stop(_ context.Context) error {
+	return b.gateway.Close()
+}
+
+// GetPlatformName возвращает название платформы.
+func (b *Bot) GetPlatformName() string {
+	return models.PlatformDiscord
+}
+
+// dispatch обрабатывает взаимодействие и сообщает пользователю об ошибке.
+func (b *Bot) dispatch(interaction *Interaction) {
+	err := b.HandleInteraction(interaction)
+	if err == nil {
+		return
+	}
+
+	log.Printf("Failed to handle Discord interaction %s %q: %v", interaction.Type, interaction.CustomID+interaction.Command, err)
+
+	text := b.service.Localizer.Get(b.service.DetectLanguage(interaction.Locale), "discord_error")
+	if respondErr := b.gateway.Respond(interaction, &Response{Content: text, Ephemeral: true}); respondErr != nil {
+		log.Printf("Failed to report Discord error: %v", respondErr)
+	}
+}
+
+// HandleInteraction регистрирует пользователя и передает взаимодействие сценарию.
+func (b *Bot) HandleInteraction(interaction *Interaction) error {
+	firstName := interaction.GlobalName
+	if firstName == "" {
+		firstName = interaction.Username
+	}
+
+	user, err := b.service.HandlePlatformUserRegistration(
+		models.QualifiedUserID(models.PlatformDiscord, interaction.UserID),
+		interaction.Username,
+		firstName,
+		interaction.Locale,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register discord user: %w", err)
+	}
+
+	switch interaction.Type {
+	case InteractionCommand:
+		return b.handleCommand(interaction, user)
+	case InteractionComponent:
+		return b.handleComponent(interaction, user)
+	case InteractionModal:
+		if interaction.CustomID == customIDFeedbackModal {
+			return b.handleFeedbackSubmit(interaction, user)
+		}
+	}
+
+	return fmt.Errorf("unknown discord interaction %s %q", interaction.Type, interaction.CustomID)
+}
+
+// handleCommand обрабатывает slash-команды.
+func (b *Bot) handleCommand(interaction *Interaction, user *models.User) error {
+	switch interaction.Command {
+	case commandStart:
+		if b.isProfileComplete(user) {
+			return b.respondScreen(interaction, b.screens.MainMenu(user), false)
+		}
+
+		return b.respondScreen(interaction, b.screens.InterfaceLanguage(user), false)
+	case commandProfile:
+		return b.showProfile(interaction, user, false)
+	case commandFeedback:
+		return b.openFeedback(interaction, user)
+	}
+
+	return fmt.Errorf("unknown discord command %q", interaction.Command)
+}
+
+// handleComponent обрабатывает кнопки и меню выбора.
+func (b *Bot) handleComponent(interaction *Interaction, user *models.User) error {
+	switch id := interaction.CustomID; {
+	case id == customIDChoice:
+		if len(interaction.Values) == 0 {
+			return errNoSelection
+		}
+
+		return b.handleAction(interaction, user, interaction.Values[0])
+	case strings.HasPrefix(id, customIDInterestsPrefix):
+		return b.handleInterests(interaction, user, strings.TrimPrefix(id, customIDInterestsPrefix), true)
+	case strings.HasPrefix(id, customIDSkipPrefix):
+		return b.handleInterests(interaction, user, strings.TrimPrefix(id, customIDSkipPrefix), false)
+	case strings.HasPrefix(id, customIDPrimaryPrefix):
+		return b.handlePrimaryInterests(interaction, user, strings.TrimPrefix(id, customIDPrimaryPrefix), true)
+	case strings.HasPrefix(id, customIDPrimarySkipPrefix):
+		return b.handlePrimaryInterests(interaction, user, strings.TrimPrefix(id, customIDPrimarySkipPrefix), false)
+	case id == customIDDays:
+		return b.handleAvailabilityDays(interaction, user)
+	case strings.HasPrefix(id, customIDTimePrefix):
+		return b.handleAvailabilityTime(interaction, user, strings.TrimPrefix(id, customIDTimePrefix))
+	}
+
+	return b.handleAction(interaction, user, interaction.CustomID)
+}
+
+// handleAction обрабатывает действие кнопки общего экрана.
+func (b *Bot) handleAction(interaction *Interaction, user *models.User, action string) error {
+	switch {
+	case action == screens.ActionMainMenu:
+		return b.respondScreen(interaction, b.screens.MainMenu(user), true)
+	case action == screens.ActionViewProfile, action == screens.ActionProfileShow, action == screens.ActionProfileView:
+		return b.showProfile(interaction, user, true)
+	case action == screens.ActionEditProfile, action == screens.ActionEditLanguages,
+		action == screens.ActionStartProfileSetup, action == screens.ActionProfileSetupInfo:
+		return b.startLanguages(interaction, user)
+	case action == screens.ActionEditInterests:
+		return b.startInterests(interaction, user)
+	case action == screens.ActionChangeLanguage:
+		return b.respondScreen(interaction, b.screens.InterfaceLanguage(user), true)
+	case action == screens.ActionFeedback:
+		return b.openFeedback(interaction, user)
+	case strings.HasPrefix(action, actionInterfaceLanguage):
+		return b.handleInterfaceLanguage(interaction, user, strings.TrimPrefix(action, actionInterfaceLanguage))
+	case strings.HasPrefix(action, actionNativeLanguage):
+		return b.handleNativeLanguage(interaction, user, strings.TrimPrefix(action, actionNativeLanguage))
+	case strings.HasPrefix(action, actionTargetLanguage):
+		return b.saveTargetLanguage(interaction, user, strings.TrimPrefix(action, actionTargetLanguage))
+	case strings.HasPrefix(action, screens.ActionLevelPrefix):
+		return b.handleLanguageLevel(interaction, user, strings.TrimPrefix(action, screens.ActionLevelPrefix))
+	}
+
+	return fmt.Errorf("unknown discord component %q", action)
+}
+
+// supports сообщает, обрабатывает ли бот действие кнопки общего экрана.
+func (b *Bot) supports(action string) bool {
+	if supportedActions[action] {
+		return true
+	}
+
+	for _, prefix := range supportedActionPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// respondScreen отвечает общим экраном без кнопок, которые бот не обрабатывает.
+func (b *Bot) respondScreen(interaction *Interaction, screen adapters.Screen, update bool) error {
+	screen.Keyboard = screen.Keyboard.Filter(b.supports)
+
+	return b.respond(interaction, renderScreen(screen, update))
+}
+
+// respondPrivateScreen отвечает новым сообщением с общим экраном, которое видит только автор действия.
+func (b *Bot) respondPrivateScreen(interaction *Interaction, screen adapters.Screen) error {
+	screen.Keyboard = screen.Keyboard.Filter(b.supports)
+
+	response := renderScreen(screen, false)
+	response.Ephemeral = true
+
+	return b.respond(interaction, response)
+}
+
+// respond отправляет ответ через gateway.
+func (b *Bot) respond(interaction *Interaction, response *Response) error {
+	if err := b.gateway.Respond(interaction, response); err != nil {
+		return fmt.Errorf("failed to respond: %w", err)
+	}
+
+	return nil
+}
+
+// text возвращает локализованную строку на языке интерфейса пользователя.
+func (b *Bot) text(user *models.User, key string) string {
+	return b.service.Localizer.Get(user.InterfaceLanguageCode, key)
+}
@@ -0,0 +1,311 @@
+package discord_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"language-exchange-bot/internal/adapters/discord"
+	"language-exchange-bot/internal/adapters/discord/discordtest"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+	"language-exchange-bot/tests/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startBot запускает бота на поддельных gateway и выборе интересов до конца теста.
+func startBot(t *testing.T, db *mocks.DatabaseMock) (*discord.Bot, *discordtest.Gateway, *discordtest.Interests) {
+	t.Helper()
+
+	gateway, interests := discordtest.NewGateway(), discordtest.NewInterests()
+	bot := discord.NewBot(gateway, core.NewBotServiceWithInterface(db, localization.NewLocalizer(nil)), interests, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() { done <- bot.Start(ctx) }()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	return bot, gateway, interests
+}
+
+// selectCustomID возвращает идентификатор меню выбора в первой строке ответа.
+func selectCustomID(t *testing.T, response *discord.Response) string {
+	t.Helper()
+
+	require.NotNil(t, response)
+	require.NotEmpty(t, response.Components)
+	require.NotNil(t, response.Components[0].Select)
+
+	return response.Components[0].Select.CustomID
+}
+
+// buttonActions возвращает действия всех кнопок ответа.
+func buttonActions(t *testing.T, response *discord.Response) []string {
+	t.Helper()
+
+	require.NotNil(t, response)
+
+	var actions []string
+
+	for _, row := range response.Components {
+		for _, button := range row.Buttons {
+			actions = append(actions, button.CustomID)
+		}
+	}
+
+	return actions
+}
+
+// TestBot_onboarding тестирует онбординг на общих экранах: языки, уровень, интересы по категориям.
+func TestBot_onboarding(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+	categoryKey, interestKey := "hobbies", "chess"
+	_, err := db.CreateInterestCategory(models.InterestCategoryInput{KeyName: &categoryKey})
+	require.NoError(t, err)
+	interestID, err := db.CreateInterest(models.InterestInput{KeyName: &interestKey, CategoryKey: &categoryKey})
+	require.NoError(t, err)
+
+	bot, gateway, interests := startBot(t, db)
+	assert.Equal(t, models.PlatformDiscord, bot.GetPlatformName())
+
+	response := gateway.Send(discordtest.Command("42", "start"))
+	assert.Contains(t, buttonActions(t, response), "lang_interface_en")
+	assert.Len(t, response.Components, 5) // 4 языка и "Назад"
+
+	response = gateway.Send(discordtest.Click("42", "lang_interface_en"))
+	assert.True(t, response.Update)
+	assert.Contains(t, buttonActions(t, response), "lang_native_ru")
+
+	// Русский исключен, а "Назад" к предыдущему шагу в Discord не показывается
+	response = gateway.Send(discordtest.Click("42", "lang_native_ru"))
+	assert.ElementsMatch(t, []string{"lang_target_en", "lang_target_es", "lang_target_zh"}, buttonActions(t, response))
+
+	response = gateway.Send(discordtest.Click("42", "lang_target_es"))
+	assert.Equal(t, []string{"level_beginner", "level_elementary", "level_intermediate", "level_upper_intermediate"}, buttonActions(t, response))
+
+	response = gateway.Send(discordtest.Click("42", "level_intermediate"))
+	assert.Equal(t, "onboarding:interests:0:0", selectCustomID(t, response))
+	require.Len(t, response.Components, 2)
+	assert.Equal(t, "onboarding:skip:0:0", response.Components[1].Buttons[0].CustomID)
+
+	// Интерес не из категории шага не сохраняется
+	response = gateway.Send(discordtest.Select("42", "onboarding:interests:0:0", "999"))
+	assert.True(t, response.Ephemeral)
+
+	// Шаг без страницы из сообщений, отправленных до разбиения на страницы, - первая страница
+	response = gateway.Send(discordtest.Select("42", "onboarding:interests:0", strconv.Itoa(interestID)))
+	assert.Equal(t, "onboarding:primary:0", selectCustomID(t, response))
+	assert.Equal(t, strconv.Itoa(interestID), response.Components[0].Select.Options[0].Value)
+
+	response = gateway.Send(discordtest.Select("42", "onboarding:primary:0", strconv.Itoa(interestID)))
+	assert.Equal(t, "onboarding:days", selectCustomID(t, response))
+	assert.Len(t, response.Components[0].Select.Options, 7)
+
+	response = gateway.Send(discordtest.Select("42", "onboarding:days", "monday", "tuesday", "wednesday", "thursday", "friday"))
+	assert.Equal(t, "onboarding:time:monday,tuesday,wednesday,thursday,friday", selectCustomID(t, response))
+
+	response = gateway.Send(discordtest.Select("42", "onboarding:time:monday,tuesday,wednesday,thursday,friday", "evening"))
+	assert.Contains(t, buttonActions(t, response), "main_view_profile")
+
+	user, err := db.GetPlatformUser(models.PlatformDiscord, "42")
+	require.NoError(t, err)
+	assert.Equal(t, "en", user.InterfaceLanguageCode)
+	assert.Equal(t, "ru", user.NativeLanguageCode)
+	assert.Equal(t, "es", user.TargetLanguageCode)
+	assert.Equal(t, "intermediate", user.TargetLanguageLevel)
+	assert.Equal(t, []int{interestID}, interests.Selected(user.ID))
+	assert.Equal(t, []int{interestID}, interests.Primary(user.ID))
+
+	availability, err := db.GetTimeAvailability(user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "weekdays", availability.DayType)
+	assert.Equal(t, []string{"evening"}, availability.TimeSlots)
+	assert.Equal(t, models.StatusActive, user.Status)
+	assert.Equal(t, localization.ProfileCompletionLevelComplete, user.ProfileCompletionLevel)
+
+	// Заполненный профиль: /start открывает главное меню
+	response = gateway.Send(discordtest.Command("42", "start"))
+	assert.Contains(t, buttonActions(t, response), "main_view_profile")
+
+	// Смена языка интерфейса возвращает в главное меню
+	gateway.Send(discordtest.Click("42", "main_change_language"))
+	response = gateway.Send(discordtest.Click("42", "lang_interface_es"))
+	assert.Contains(t, buttonActions(t, response), "main_view_profile")
+
+	user, err = db.GetPlatformUser(models.PlatformDiscord, "42")
+	require.NoError(t, err)
+	assert.Equal(t, "es", user.InterfaceLanguageCode)
+}
+
+// TestBot_interestsPages тестирует категорию больше 25 интересов: она показывается по страницам.
+func TestBot_interestsPages(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+	categoryKey := "hobbies"
+	_, err := db.CreateInterestCategory(models.InterestCategoryInput{KeyName: &categoryKey})
+	require.NoError(t, err)
+
+	interestIDs := make([]int, 0, 30)
+
+	for i := range 30 {
+		key := fmt.Sprintf("hobby_%02d", i)
+		interestID, err := db.CreateInterest(models.InterestInput{KeyName: &key, CategoryKey: &categoryKey})
+		require.NoError(t, err)
+
+		interestIDs = append(interestIDs, interestID)
+	}
+
+	_, gateway, interests := startBot(t, db)
+
+	gateway.Send(discordtest.Command("42", "start"))
+	gateway.Send(discordtest.Click("42", "lang_interface_en"))
+	gateway.Send(discordtest.Click("42", "lang_native_en"))
+
+	response := gateway.Send(discordtest.Click("42", "level_beginner"))
+	assert.Equal(t, "onboarding:interests:0:0", selectCustomID(t, response))
+	assert.Len(t, response.Components[0].Select.Options, 25)
+	assert.Equal(t, 25, response.Components[0].Select.MaxValues)
+
+	response = gateway.Send(discordtest.Select("42", "onboarding:interests:0:0", strconv.Itoa(interestIDs[0])))
+	assert.Equal(t, "onboarding:interests:0:1", selectCustomID(t, response))
+
+	options := response.Components[0].Select.Options
+	require.Len(t, options, 5)
+	assert.Equal(t, strconv.Itoa(interestIDs[25]), options[0].Value)
+
+	gateway.Send(discordtest.Select("42", "onboarding:interests:0:1", strconv.Itoa(interestIDs[29])))
+
+	user, err := db.GetPlatformUser(models.PlatformDiscord, "42")
+	require.NoError(t, err)
+	assert.Equal(t, []int{interestIDs[0], interestIDs[29]}, interests.Selected(user.ID))
+}
+
+// TestBot_nativeLanguageNotRussian тестирует, что нерусскоязычные сразу выбирают уровень русского.
+func TestBot_nativeLanguageNotRussian(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+	_, gateway, _ := startBot(t, db)
+
+	gateway.Send(discordtest.Command("7", "start"))
+	gateway.Send(discordtest.Click("7", "lang_interface_en"))
+
+	response := gateway.Send(discordtest.Click("7", "lang_native_en"))
+	assert.Contains(t, buttonActions(t, response), "level_beginner")
+
+	// Категорий интересов нет: без интересов профиль не завершается, предлагается выбрать их заново
+	response = gateway.Send(discordtest.Click("7", "level_beginner"))
+	assert.Equal(t, []string{"isolated_edit_start"}, buttonActions(t, response))
+
+	user, err := db.GetPlatformUser(models.PlatformDiscord, "7")
+	require.NoError(t, err)
+	assert.Equal(t, "ru", user.TargetLanguageCode)
+	assert.NotEqual(t, localization.ProfileCompletionLevelComplete, user.ProfileCompletionLevel)
+}
+
+// TestBot_onboardingCompleteness тестирует проверку перед завершением онбординга: пропуск основных
+// интересов и лимит основных возвращают к выбору, недопустимая доступность - к выбору времени.
+func TestBot_onboardingCompleteness(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+	categoryKey := "hobbies"
+	_, err := db.CreateInterestCategory(models.InterestCategoryInput{KeyName: &categoryKey})
+	require.NoError(t, err)
+
+	values := make([]string, 0, 4)
+
+	for i := range 4 {
+		key := fmt.Sprintf("hobby_%d", i)
+		interestID, err := db.CreateInterest(models.InterestInput{KeyName: &key, CategoryKey: &categoryKey})
+		require.NoError(t, err)
+
+		values = append(values, strconv.Itoa(interestID))
+	}
+
+	_, gateway, interests := startBot(t, db)
+
+	gateway.Send(discordtest.Command("42", "start"))
+	gateway.Send(discordtest.Click("42", "lang_interface_en"))
+	gateway.Send(discordtest.Click("42", "lang_native_en"))
+	gateway.Send(discordtest.Click("42", "level_beginner"))
+
+	response := gateway.Send(discordtest.Select("42", "onboarding:interests:0:0", values...))
+	assert.Equal(t, "onboarding:primary:0", selectCustomID(t, response))
+	assert.Len(t, response.Components[0].Select.Options, 4)
+
+	// Без основных интересов пользователь возвращается к их выбору
+	response = gateway.Send(discordtest.Click("42", "onboarding:primary-skip:0"))
+	assert.Equal(t, "onboarding:primary:0", selectCustomID(t, response))
+	assert.Contains(t, response.Content, "choose_at_least_primary_interests")
+
+	// Сверх лимита основные не отмечаются, шаг показывается снова
+	response = gateway.Send(discordtest.Select("42", "onboarding:primary:0", values...))
+	assert.Equal(t, "onboarding:primary:0", selectCustomID(t, response))
+
+	user, err := db.GetPlatformUser(models.PlatformDiscord, "42")
+	require.NoError(t, err)
+	assert.Len(t, interests.Primary(user.ID), 3)
+
+	response = gateway.Send(discordtest.Click("42", "onboarding:primary-skip:0"))
+	assert.Equal(t, "onboarding:days", selectCustomID(t, response))
+
+	// Выходные без времени не проходят проверку доступности
+	gateway.Send(discordtest.Select("42", "onboarding:days", "saturday", "sunday"))
+	response = gateway.Send(discordtest.Select("42", "onboarding:time:saturday,sunday"))
+	assert.Equal(t, "onboarding:time:saturday,sunday", selectCustomID(t, response))
+
+	response = gateway.Send(discordtest.Select("42", "onboarding:time:saturday,sunday", "morning", "day"))
+	assert.Contains(t, buttonActions(t, response), "main_view_profile")
+
+	availability, err := db.GetTimeAvailability(user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "weekends", availability.DayType)
+
+	user, err = db.GetPlatformUser(models.PlatformDiscord, "42")
+	require.NoError(t, err)
+	assert.Equal(t, localization.ProfileCompletionLevelComplete, user.ProfileCompletionLevel)
+}
+
+// TestBot_profileAndFeedback тестирует профиль без заполнения и модальное окно отзыва.
+func TestBot_profileAndFeedback(t *testing.T) {
+	_, gateway, _ := startBot(t, mocks.NewDatabaseMock())
+
+	response := gateway.Send(discordtest.Command("42", "profile"))
+	assert.Equal(t, []string{"show_profile_setup_features"}, buttonActions(t, response))
+
+	response = gateway.Send(discordtest.Click("42", "show_profile_setup_features"))
+	assert.Contains(t, buttonActions(t, response), "lang_native_en")
+
+	response = gateway.Send(discordtest.Click("42", "main_feedback"))
+	require.NotNil(t, response)
+	require.NotNil(t, response.Modal)
+	assert.Equal(t, "feedback:submit", response.Modal.CustomID)
+	assert.Equal(t, localization.MinFeedbackLength, response.Modal.Inputs[0].MinLength)
+
+	// Пробелы по краям не засчитываются в длину отзыва
+	response = gateway.Send(discordtest.SubmitModal("42", "feedback:submit", map[string]string{"text": "  short     ", "contact": ""}))
+	require.NotNil(t, response)
+	assert.True(t, response.Ephemeral)
+	assert.Equal(t, "feedback_too_short", response.Content)
+}
+
+// TestBot_errors тестирует сообщение об ошибке на неизвестный компонент и закрытие gateway.
+func TestBot_errors(t *testing.T) {
+	bot, gateway, _ := startBot(t, mocks.NewDatabaseMock())
+
+	response := gateway.Send(discordtest.Click("42", "unknown:button"))
+	require.NotNil(t, response)
+	assert.True(t, response.Ephemeral)
+
+	response = gateway.Send(discordtest.Click("42", "level_fluent"))
+	require.NotNil(t, response)
+	assert.True(t, response.Ephemeral)
+
+	require.NoError(t, bot.Stop(context.Background()))
+	assert.True(t, gateway.Closed())
+}
@@ -0,0 +1,138 @@
+// Package discordtest provides a fake Discord gateway for testing bot flows without
+// a connection to Discord.
+package discordtest
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	"language-exchange-bot/internal/adapters/discord"
+)
+
+// Ошибки поддельного gateway: Discord принимает только один ответ на взаимодействие.
+var (
+	ErrNotPending      = errors.New("interaction is not pending")
+	ErrAlreadyAnswered = errors.New("interaction already answered")
+)
+
+// Gateway - поддельный discord.Gateway: взаимодействия доставляются синхронно,
+// ответы сохраняются для проверки в тестах.
+type Gateway struct {
+	mu        sync.Mutex
+	handler   func(*discord.Interaction)
+	opened    chan struct{}
+	pending   map[string]*discord.Response
+	responses []*discord.Response
+	nextID    int
+	closed    bool
+}
+
+// NewGateway создает поддельный gateway.
+func NewGateway() *Gateway {
+	return &Gateway{opened: make(chan struct{}), pending: map[string]*discord.Response{}}
+}
+
+// Open запоминает обработчик взаимодействий.
+func (g *Gateway) Open(_ context.Context, handler func(*discord.Interaction)) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.handler = handler
+	close(g.opened)
+
+	return nil
+}
+
+// Respond сохраняет ответ на взаимодействие, которое сейчас обрабатывается.
+func (g *Gateway) Respond(interaction *discord.Interaction, response *discord.Response) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	answer, ok := g.pending[interaction.ID]
+	if !ok {
+		return ErrNotPending
+	}
+
+	if answer != nil {
+		return ErrAlreadyAnswered
+	}
+
+	g.pending[interaction.ID] = response
+	g.responses = append(g.responses, response)
+
+	return nil
+}
+
+// Close отмечает gateway закрытым.
+func (g *Gateway) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.closed = true
+
+	return nil
+}
+
+// Closed сообщает, закрыл ли бот gateway.
+func (g *Gateway) Closed() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.closed
+}
+
+// Responses возвращает все отправленные ботом ответы по порядку.
+func (g *Gateway) Responses() []*discord.Response {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]*discord.Response(nil), g.responses...)
+}
+
+// Send доставляет взаимодействие боту, дождавшись подключения, и возвращает ответ бота
+// или nil, если бот не ответил. ID взаимодействия назначается автоматически.
+func (g *Gateway) Send(interaction *discord.Interaction) *discord.Response {
+	<-g.opened
+
+	g.mu.Lock()
+	g.nextID++
+	interaction.ID = strconv.Itoa(g.nextID)
+	g.pending[interaction.ID] = nil
+	handler := g.handler
+	g.mu.Unlock()
+
+	handler(interaction)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	response := g.pending[interaction.ID]
+	delete(g.pending, interaction.ID)
+
+	return response
+}
+
+// Command - slash-команда пользователя.
+func Command(userID, name string) *discord.Interaction {
+	return &discord.Interaction{Type: discord.InteractionCommand, UserID: userID, Username: "user" + userID, Command: name}
+}
+
+// Click - нажатие кнопки.
+func Click(userID, customID string) *discord.Interaction {
+	return &discord.Interaction{Type: discord.InteractionComponent, UserID: userID, Username: "user" + userID, CustomID: customID}
+}
+
+// Select - выбор значений в меню.
+func Select(userID, customID string, values ...string) *discord.Interaction {
+	interaction := Click(userID, customID)
+	interaction.Values = values
+
+	return interaction
+}
+
+// SubmitModal - отправка модального окна.
+func SubmitModal(userID, customID string, fields map[string]string) *discord.Interaction {
+	return &discord.Interaction{Type: discord.InteractionModal, UserID: userID, Username: "user" + userID, CustomID: customID, Fields: fields}
+}
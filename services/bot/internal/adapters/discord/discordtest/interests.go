@@ -0,0 +1,139 @@
+package discordtest
+
+import (
+	"sync"
+
+	"language-exchange-bot/internal/config"
+	"language-exchange-bot/internal/core"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// Interests - поддельный discord.InterestSelections: выборы интересов хранятся в памяти
+// и проверяются на повтор и лимиты основных интересов, как в core.InterestService.
+type Interests struct {
+	mu         sync.Mutex
+	selections map[int][]models.InterestSelection
+	Limits     config.InterestLimitsConfig
+}
+
+// NewInterests создает пустой выбор интересов с лимитами по умолчанию: от 1 до 3 основных.
+func NewInterests() *Interests {
+	return &Interests{
+		selections: map[int][]models.InterestSelection{},
+		Limits:     config.InterestLimitsConfig{MinPrimaryInterests: 1, MaxPrimaryInterests: 3},
+	}
+}
+
+// GetUserInterestSelections возвращает выборы пользователя по порядку выбора.
+func (i *Interests) GetUserInterestSelections(userID int) ([]models.InterestSelection, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return append([]models.InterestSelection(nil), i.selections[userID]...), nil
+}
+
+// AddUserInterestSelection добавляет интерес пользователю; повторный выбор - ErrInterestAlreadySelected.
+func (i *Interests) AddUserInterestSelection(userID, interestID int, isPrimary bool) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, selection := range i.selections[userID] {
+		if selection.InterestID == interestID {
+			return errorsPkg.ErrInterestAlreadySelected
+		}
+	}
+
+	i.selections[userID] = append(i.selections[userID], models.InterestSelection{
+		UserID: userID, InterestID: interestID, IsPrimary: isPrimary, SelectionOrder: len(i.selections[userID]) + 1,
+	})
+
+	return nil
+}
+
+// SetPrimaryInterest отмечает выбранный интерес основным; сверх MaxPrimaryInterests -
+// *core.PrimaryPolicyViolation.
+func (i *Interests) SetPrimaryInterest(userID, interestID int, isPrimary bool) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if isPrimary && i.primaryCount(userID) >= i.Limits.MaxPrimaryInterests {
+		return &core.PrimaryPolicyViolation{Rule: core.PrimaryPolicyRuleTotal, Limit: i.Limits.MaxPrimaryInterests}
+	}
+
+	for index, selection := range i.selections[userID] {
+		if selection.InterestID == interestID {
+			i.selections[userID][index].IsPrimary = isPrimary
+			return nil
+		}
+	}
+
+	return errorsPkg.ErrInterestNotFound
+}
+
+// GetInterestLimitsConfig возвращает Limits.
+func (i *Interests) GetInterestLimitsConfig() (*config.InterestLimitsConfig, error) {
+	limits := i.Limits
+	return &limits, nil
+}
+
+// ValidateInterestSelection требует не меньше MinPrimaryInterests основных интересов.
+func (i *Interests) ValidateInterestSelection(userID, _ int) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.primaryCount(userID) < i.Limits.MinPrimaryInterests {
+		return errorsPkg.ErrMinPrimaryInterestsRequired
+	}
+
+	return nil
+}
+
+// Primary возвращает ID основных интересов пользователя по порядку выбора.
+func (i *Interests) Primary(userID int) []int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var ids []int
+	for _, selection := range i.selections[userID] {
+		if selection.IsPrimary {
+			ids = append(ids, selection.InterestID)
+		}
+	}
+
+	return ids
+}
+
+func (i *Interests) primaryCount(userID int) int {
+	count := 0
+	for _, selection := range i.selections[userID] {
+		if selection.IsPrimary {
+			count++
+		}
+	}
+
+	return count
+}
+
+// BatchUpdateUserInterests заменяет выборы пользователя.
+func (i *Interests) BatchUpdateUserInterests(userID int, selections []models.InterestSelection) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.selections[userID] = append([]models.InterestSelection(nil), selections...)
+
+	return nil
+}
+
+// Selected возвращает ID выбранных пользователем интересов по порядку выбора.
+func (i *Interests) Selected(userID int) []int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	ids := make([]int, 0, len(i.selections[userID]))
+	for _, selection := range i.selections[userID] {
+		ids = append(ids, selection.InterestID)
+	}
+
+	return ids
+}
@@ -0,0 +1,66 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Поля модального окна отзыва.
+const (
+	fieldFeedbackText    = "text"
+	fieldFeedbackContact = "contact"
+)
+
+// maxContactLength - максимальная длина контакта в модальном окне отзыва.
+const maxContactLength = 200
+
+// openFeedback открывает модальное окно отзыва. Длину текста проверяет сам Discord.
+func (b *Bot) openFeedback(interaction *Interaction, user *models.User) error {
+	return b.respond(interaction, &Response{Modal: &Modal{
+		CustomID: customIDFeedbackModal,
+		Title:    b.text(user, "main_menu_feedback"),
+		Inputs: []TextInput{
+			{
+				CustomID:  fieldFeedbackText,
+				Label:     b.text(user, "main_menu_feedback"),
+				Paragraph: true,
+				Required:  true,
+				MinLength: localization.MinFeedbackLength,
+				MaxLength: localization.MaxFeedbackLength,
+			},
+			{
+				CustomID:  fieldFeedbackContact,
+				Label:     b.text(user, "feedback_contact_placeholder"),
+				MaxLength: maxContactLength,
+			},
+		},
+	}})
+}
+
+// handleFeedbackSubmit сохраняет отзыв из модального окна и уведомляет администраторов.
+func (b *Bot) handleFeedbackSubmit(interaction *Interaction, user *models.User) error {
+	var contact *string
+	if value := strings.TrimSpace(interaction.Fields[fieldFeedbackContact]); value != "" {
+		contact = &value
+	}
+
+	text := strings.TrimSpace(interaction.Fields[fieldFeedbackText])
+
+	// Длину проверяет и Discord, но пробелы по краям в нее входят
+	if err := b.service.ValidateFeedback(text); err != nil {
+		tooLong := errors.Is(err, errorsPkg.ErrFeedbackTooLong)
+
+		return b.respondPrivateScreen(interaction, b.screens.FeedbackRejected(user.InterfaceLanguageCode, len([]rune(text)), tooLong))
+	}
+
+	if err := b.service.SaveUserFeedback(user, text, contact, nil, b.adminChatIDs); err != nil {
+		return fmt.Errorf("failed to save feedback: %w", err)
+	}
+
+	return b.respondPrivateScreen(interaction, b.screens.FeedbackSaved(user.InterfaceLanguageCode))
+}
@@ -0,0 +1,93 @@
+// Package discord provides Discord integration: slash commands and message components
+// for onboarding, profile and feedback.
+package discord
+
+import "context"
+
+// Типы взаимодействий, которые обрабатывает бот.
+const (
+	InteractionCommand   = "command"   // Slash-команда
+	InteractionComponent = "component" // Нажатие кнопки или выбор в меню
+	InteractionModal     = "modal"     // Отправка модального окна
+)
+
+// Interaction - взаимодействие пользователя с ботом в виде, не зависящем от клиентской
+// библиотеки. Gateway переводит в него события Discord, поэтому сценарии бота можно
+// проверять без подключения к Discord.
+type Interaction struct {
+	ID         string
+	Type       string
+	UserID     string // ID пользователя в Discord
+	Username   string
+	GlobalName string // Отображаемое имя пользователя
+	Locale     string // Язык клиента пользователя, например en-US
+	ChannelID  string
+	Command    string            // Имя slash-команды без /
+	CustomID   string            // Идентификатор компонента или модального окна
+	Values     []string          // Выбранные значения меню
+	Fields     map[string]string // Поля модального окна: CustomID -> значение
+}
+
+// Response - ответ на взаимодействие.
+type Response struct {
+	Content    string
+	Components []ActionRow
+	Ephemeral  bool   // Сообщение видно только пользователю
+	Update     bool   // Заменить сообщение, к которому прикреплен компонент
+	Modal      *Modal // Открыть модальное окно вместо сообщения
+}
+
+// ActionRow - строка компонентов сообщения: кнопки или одно меню выбора.
+type ActionRow struct {
+	Buttons []Button
+	Select  *SelectMenu
+}
+
+// Button - кнопка сообщения.
+type Button struct {
+	Label    string
+	CustomID string
+	Primary  bool
+}
+
+// SelectMenu - меню выбора. MaxValues больше 1 разрешает выбрать несколько вариантов.
+type SelectMenu struct {
+	CustomID    string
+	Placeholder string
+	MinValues   int
+	MaxValues   int
+	Options     []SelectOption
+}
+
+// SelectOption - вариант меню выбора.
+type SelectOption struct {
+	Label string
+	Value string
+}
+
+// Modal - модальное окно с полями ввода.
+type Modal struct {
+	CustomID string
+	Title    string
+	Inputs   []TextInput
+}
+
+// TextInput - поле ввода модального окна.
+type TextInput struct {
+	CustomID  string
+	Label     string
+	Paragraph bool // Многострочное поле
+	Required  bool
+	MinLength int
+	MaxLength int
+}
+
+// Gateway связывает бота с Discord: доставляет взаимодействия и отправляет ответы.
+type Gateway interface {
+	// Open подключается к Discord и начинает передавать взаимодействия в handler.
+	Open(ctx context.Context, handler func(*Interaction)) error
+	// Respond отвечает на взаимодействие.
+	Respond(interaction *Interaction, response *Response) error
+	// Close отключается от Discord.
+	Close() error
+}
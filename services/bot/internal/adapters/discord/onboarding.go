@@ -0,0 +1,539 @@
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/adapters/screens"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// maxSelectOptions - ограничение Discord на число вариантов в одном меню выбора.
+const maxSelectOptions = 25
+
+// errNoSelection - в меню выбора не пришло ни одного значения.
+var errNoSelection = errors.New("no value selected")
+
+// handleInterfaceLanguage сохраняет язык интерфейса. Заполнивший профиль возвращается
+// в главное меню, новый пользователь переходит к выбору родного языка.
+func (b *Bot) handleInterfaceLanguage(interaction *Interaction, user *models.User, langCode string) error {
+	if err := b.service.DB.UpdateUserInterfaceLanguage(user.ID, langCode); err != nil {
+		return fmt.Errorf("failed to update interface language: %w", err)
+	}
+
+	user.InterfaceLanguageCode = langCode
+
+	if b.isProfileComplete(user) {
+		return b.respondScreen(interaction, b.screens.MainMenu(user), true)
+	}
+
+	return b.startLanguages(interaction, user)
+}
+
+// startLanguages начинает выбор языков заново с родного языка.
+func (b *Bot) startLanguages(interaction *Interaction, user *models.User) error {
+	if err := b.service.DB.UpdateUserState(user.ID, models.StateWaitingLanguage); err != nil {
+		return fmt.Errorf("failed to update user state: %w", err)
+	}
+
+	return b.respondScreen(interaction, b.screens.NativeLanguage(user), true)
+}
+
+// handleNativeLanguage сохраняет родной язык. Русскоязычные выбирают изучаемый язык,
+// остальные изучают русский, как и в Telegram.
+func (b *Bot) handleNativeLanguage(interaction *Interaction, user *models.User, langCode string) error {
+	if err := b.service.DB.UpdateUserNativeLanguage(user.ID, langCode); err != nil {
+		return fmt.Errorf("failed to update native language: %w", err)
+	}
+
+	user.NativeLanguageCode = langCode
+
+	if langCode == "ru" {
+		if err := b.service.DB.UpdateUserState(user.ID, models.StateWaitingTargetLanguage); err != nil {
+			return fmt.Errorf("failed to update user state: %w", err)
+		}
+
+		return b.respondScreen(interaction, b.screens.TargetLanguage(user), true)
+	}
+
+	return b.saveTargetLanguage(interaction, user, "ru")
+}
+
+// saveTargetLanguage сохраняет изучаемый язык и переходит к выбору уровня.
+func (b *Bot) saveTargetLanguage(interaction *Interaction, user *models.User, langCode string) error {
+	if err := b.service.DB.UpdateUserTargetLanguage(user.ID, langCode); err != nil {
+		return fmt.Errorf("failed to update target language: %w", err)
+	}
+
+	user.TargetLanguageCode = langCode
+
+	if err := b.service.DB.UpdateUserState(user.ID, models.StateWaitingLanguageLevel); err != nil {
+		return fmt.Errorf("failed to update user state: %w", err)
+	}
+
+	return b.respondScreen(interaction, b.screens.LanguageLevel(user), true)
+}
+
+// handleLanguageLevel сохраняет уровень и начинает выбор интересов заново.
+func (b *Bot) handleLanguageLevel(interaction *Interaction, user *models.User, level string) error {
+	if !slices.Contains(screens.LanguageLevels, level) {
+		return fmt.Errorf("unknown language level %q", level)
+	}
+
+	if err := b.service.DB.UpdateUserTargetLanguageLevel(user.ID, level); err != nil {
+		return fmt.Errorf("failed to update language level: %w", err)
+	}
+
+	user.TargetLanguageLevel = level
+
+	return b.startInterests(interaction, user)
+}
+
+// startInterests очищает интересы пользователя и предлагает выбрать их по категориям.
+func (b *Bot) startInterests(interaction *Interaction, user *models.User) error {
+	if err := b.interests.BatchUpdateUserInterests(user.ID, nil); err != nil {
+		return fmt.Errorf("failed to clear user interests: %w", err)
+	}
+
+	if err := b.service.DB.UpdateUserState(user.ID, models.StateWaitingInterests); err != nil {
+		return fmt.Errorf("failed to update user state: %w", err)
+	}
+
+	response, err := b.interestsStep(user, 0, 0)
+	if err != nil {
+		return err
+	}
+
+	return b.respond(interaction, response)
+}
+
+// interestsStep предлагает выбрать интересы категории с номером index, страница page; после последней
+// категории онбординг завершается. Пустые категории пропускаются. В меню Discord помещается не больше
+// 25 вариантов, поэтому большая категория показывается по страницам.
+func (b *Bot) interestsStep(user *models.User, index, page int) (*Response, error) {
+	categories, err := b.service.GetInterestCategoryItems()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest categories: %w", err)
+	}
+
+	for ; index < len(categories); index, page = index+1, 0 {
+		category := categories[index]
+
+		interests, err := b.service.GetInterestCatalogItems(category.KeyName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get interests: %w", err)
+		}
+
+		pages := (len(interests) + maxSelectOptions - 1) / maxSelectOptions
+		if page >= pages {
+			continue
+		}
+
+		pageInterests := interests[page*maxSelectOptions : min(len(interests), (page+1)*maxSelectOptions)]
+
+		options := make([]SelectOption, 0, len(pageInterests))
+		for _, interest := range pageInterests {
+			options = append(options, SelectOption{
+				Label: b.service.InterestName(user.InterfaceLanguageCode, interest.ID, interest.KeyName),
+				Value: strconv.Itoa(interest.ID),
+			})
+		}
+
+		placeholder := b.text(user, "choose_interests")
+		if pages > 1 {
+			placeholder += fmt.Sprintf(" (%d/%d)", page+1, pages)
+		}
+
+		step := interestsStepID(index, page)
+		title := b.service.Localizer.GetWithParams(user.InterfaceLanguageCode, "discord_interests_category", map[string]string{
+			"category": b.service.InterestCategoryName(user.InterfaceLanguageCode, category.KeyName),
+			"step":     strconv.Itoa(index + 1),
+			"total":    strconv.Itoa(len(categories)),
+		})
+
+		return &Response{
+			Content: title,
+			Update:  true,
+			Components: []ActionRow{
+				{Select: &SelectMenu{
+					CustomID:    customIDInterestsPrefix + step,
+					Placeholder: placeholder,
+					MinValues:   1,
+					MaxValues:   len(options),
+					Options:     options,
+				}},
+				{Buttons: []Button{{Label: b.text(user, "discord_skip_button"), CustomID: customIDSkipPrefix + step}}},
+			},
+		}, nil
+	}
+
+	return b.primaryStep(user, 0, "")
+}
+
+// interestsStepID кодирует категорию и страницу шага интересов для CustomID: <index>:<page>.
+func interestsStepID(index, page int) string {
+	return strconv.Itoa(index) + ":" + strconv.Itoa(page)
+}
+
+// parseInterestsStep разбирает шаг интересов из CustomID. Шаг без страницы (сообщения до разбиения
+// категорий на страницы) - первая страница.
+func parseInterestsStep(step string) (index, page int, err error) {
+	indexPart, pagePart, paged := strings.Cut(step, ":")
+
+	if index, err = strconv.Atoi(indexPart); err != nil {
+		return 0, 0, fmt.Errorf("invalid interests step %q: %w", step, err)
+	}
+
+	if paged {
+		if page, err = strconv.Atoi(pagePart); err != nil {
+			return 0, 0, fmt.Errorf("invalid interests step %q: %w", step, err)
+		}
+	}
+
+	return index, page, nil
+}
+
+// handleInterests сохраняет интересы шага, если они выбраны, и переходит к следующей странице
+// или категории.
+func (b *Bot) handleInterests(interaction *Interaction, user *models.User, step string, selected bool) error {
+	index, page, err := parseInterestsStep(step)
+	if err != nil {
+		return err
+	}
+
+	if selected {
+		if err := b.saveInterests(user, index, interaction.Values); err != nil {
+			return err
+		}
+	}
+
+	response, err := b.interestsStep(user, index, page+1)
+	if err != nil {
+		return err
+	}
+
+	return b.respond(interaction, response)
+}
+
+// saveInterests сохраняет интересы, выбранные на шаге категории index, через InterestSelections.
+// Принимаются только интересы этой категории; уже выбранный интерес не считается ошибкой.
+func (b *Bot) saveInterests(user *models.User, index int, values []string) error {
+	categories, err := b.service.GetInterestCategoryItems()
+	if err != nil {
+		return fmt.Errorf("failed to get interest categories: %w", err)
+	}
+
+	if index < 0 || index >= len(categories) {
+		return fmt.Errorf("invalid interests step %d", index)
+	}
+
+	interests, err := b.service.GetInterestCatalogItems(categories[index].KeyName)
+	if err != nil {
+		return fmt.Errorf("failed to get interests: %w", err)
+	}
+
+	for _, value := range values {
+		interestID, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid interest id %q: %w", value, err)
+		}
+
+		if !slices.ContainsFunc(interests, func(interest models.InterestCatalogItem) bool { return interest.ID == interestID }) {
+			return fmt.Errorf("interest %d is not in category %q", interestID, categories[index].KeyName)
+		}
+
+		err = b.interests.AddUserInterestSelection(user.ID, interestID, false)
+		if err != nil && !errors.Is(err, errorsPkg.ErrInterestAlreadySelected) {
+			return fmt.Errorf("failed to save interest: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// primaryStep предлагает отметить основные интересы среди выбранных, страница page. notice выводится
+// над текстом, когда пользователь возвращен к шагу. После последней страницы профиль проверяется.
+func (b *Bot) primaryStep(user *models.User, page int, notice string) (*Response, error) {
+	selections, err := b.interests.GetUserInterestSelections(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest selections: %w", err)
+	}
+
+	pages := (len(selections) + maxSelectOptions - 1) / maxSelectOptions
+	if page >= pages {
+		return b.finishOnboarding(user)
+	}
+
+	limits, err := b.interests.GetInterestLimitsConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest limits: %w", err)
+	}
+
+	catalog, err := b.service.GetInterestCatalogItems("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interests: %w", err)
+	}
+
+	keyNames := make(map[int]string, len(catalog))
+	for _, interest := range catalog {
+		keyNames[interest.ID] = interest.KeyName
+	}
+
+	pageSelections := selections[page*maxSelectOptions : min(len(selections), (page+1)*maxSelectOptions)]
+
+	options := make([]SelectOption, 0, len(pageSelections))
+	for _, selection := range pageSelections {
+		options = append(options, SelectOption{
+			Label: b.service.InterestName(user.InterfaceLanguageCode, selection.InterestID, keyNames[selection.InterestID]),
+			Value: strconv.Itoa(selection.InterestID),
+		})
+	}
+
+	text := b.service.Localizer.GetWithParams(user.InterfaceLanguageCode, "choose_primary_interests_dynamic", map[string]string{
+		"max": strconv.Itoa(limits.MaxPrimaryInterests),
+	})
+
+	return stepResponse(notice, text, &SelectMenu{
+		CustomID:    customIDPrimaryPrefix + strconv.Itoa(page),
+		Placeholder: b.text(user, "choose_interests"),
+		MinValues:   1,
+		MaxValues:   len(options),
+		Options:     options,
+	}, Button{Label: b.text(user, "discord_skip_button"), CustomID: customIDPrimarySkipPrefix + strconv.Itoa(page)}), nil
+}
+
+// handlePrimaryInterests отмечает выбранные интересы основными по политике лимитов и переходит
+// к следующей странице. Превышение лимита показывается на той же странице.
+func (b *Bot) handlePrimaryInterests(interaction *Interaction, user *models.User, step string, selected bool) error {
+	page, err := strconv.Atoi(step)
+	if err != nil {
+		return fmt.Errorf("invalid primary interests page %q: %w", step, err)
+	}
+
+	if selected {
+		notice, err := b.savePrimaryInterests(user, interaction.Values)
+		if err != nil {
+			return err
+		}
+
+		if notice != "" {
+			response, err := b.primaryStep(user, page, notice)
+			if err != nil {
+				return err
+			}
+
+			return b.respond(interaction, response)
+		}
+	}
+
+	response, err := b.primaryStep(user, page+1, "")
+	if err != nil {
+		return err
+	}
+
+	return b.respond(interaction, response)
+}
+
+// savePrimaryInterests отмечает основными выбранные пользователем интересы. Нарушение политики
+// лимитов возвращается объяснением для пользователя.
+func (b *Bot) savePrimaryInterests(user *models.User, values []string) (string, error) {
+	selections, err := b.interests.GetUserInterestSelections(user.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get interest selections: %w", err)
+	}
+
+	for _, value := range values {
+		interestID, err := strconv.Atoi(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid interest id %q: %w", value, err)
+		}
+
+		if !slices.ContainsFunc(selections, func(selection models.InterestSelection) bool { return selection.InterestID == interestID }) {
+			return "", fmt.Errorf("interest %d is not selected", interestID)
+		}
+
+		if err := b.interests.SetPrimaryInterest(user.ID, interestID, true); err != nil {
+			if message := b.service.PrimaryPolicyErrorMessage(err, user.InterfaceLanguageCode); message != "" {
+				return message, nil
+			}
+
+			return "", fmt.Errorf("failed to mark primary interest: %w", err)
+		}
+	}
+
+	return "", nil
+}
+
+// availabilityDaysStep предлагает выбрать дни недели, в которые пользователь может общаться.
+func (b *Bot) availabilityDaysStep(user *models.User, notice string) *Response {
+	options := make([]SelectOption, 0, len(screens.AvailabilityDays))
+	for _, day := range screens.AvailabilityDays {
+		options = append(options, SelectOption{Label: b.text(user, "day_"+day), Value: day})
+	}
+
+	text := b.text(user, "time_availability_intro") + "\n\n" + b.text(user, "select_specific_days")
+
+	return stepResponse(notice, text, &SelectMenu{
+		CustomID:  customIDDays,
+		MinValues: 1,
+		MaxValues: len(options),
+		Options:   options,
+	})
+}
+
+// handleAvailabilityDays переходит к выбору времени; выбранные дни передаются в CustomID шага.
+func (b *Bot) handleAvailabilityDays(interaction *Interaction, user *models.User) error {
+	if len(interaction.Values) == 0 {
+		return errNoSelection
+	}
+
+	for _, day := range interaction.Values {
+		if !slices.Contains(screens.AvailabilityDays, day) {
+			return fmt.Errorf("unknown day %q", day)
+		}
+	}
+
+	return b.respond(interaction, b.availabilityTimeStep(user, strings.Join(interaction.Values, ","), ""))
+}
+
+// availabilityTimeStep предлагает выбрать время суток для дней days (через запятую).
+func (b *Bot) availabilityTimeStep(user *models.User, days, notice string) *Response {
+	options := make([]SelectOption, 0, len(screens.AvailabilityTimeSlots))
+	for _, slot := range screens.AvailabilityTimeSlots {
+		options = append(options, SelectOption{Label: b.text(user, "time_"+slot), Value: slot})
+	}
+
+	return stepResponse(notice, b.text(user, "select_time_slot"), &SelectMenu{
+		CustomID:  customIDTimePrefix + days,
+		MinValues: 1,
+		MaxValues: len(options),
+		Options:   options,
+	})
+}
+
+// handleAvailabilityTime сохраняет доступность после той же проверки, что и в Telegram,
+// и завершает онбординг.
+func (b *Bot) handleAvailabilityTime(interaction *Interaction, user *models.User, days string) error {
+	availability := availabilityForDays(strings.Split(days, ","))
+	availability.TimeSlots = interaction.Values
+
+	if err := b.service.ValidateTimeAvailability(availability, user.InterfaceLanguageCode); err != nil {
+		return b.respond(interaction, b.availabilityTimeStep(user, days, err.Error()))
+	}
+
+	if err := b.service.SaveTimeAvailability(user.ID, availability); err != nil {
+		return fmt.Errorf("failed to save time availability: %w", err)
+	}
+
+	response, err := b.finishOnboarding(user)
+	if err != nil {
+		return err
+	}
+
+	return b.respond(interaction, response)
+}
+
+// availabilityForDays сворачивает выбранные дни в тип дней Telegram: все дни, будни, выходные
+// или отдельные дни.
+func availabilityForDays(days []string) *models.TimeAvailability {
+	weekdays, weekends := screens.AvailabilityDays[:5], screens.AvailabilityDays[5:]
+
+	sameDays := func(expected []string) bool {
+		return len(days) == len(expected) && !slices.ContainsFunc(days, func(day string) bool { return !slices.Contains(expected, day) })
+	}
+
+	switch {
+	case sameDays(screens.AvailabilityDays):
+		return &models.TimeAvailability{DayType: "any"}
+	case sameDays(weekdays):
+		return &models.TimeAvailability{DayType: "weekdays"}
+	case sameDays(weekends):
+		return &models.TimeAvailability{DayType: "weekends"}
+	}
+
+	return &models.TimeAvailability{DayType: "specific", SpecificDays: days}
+}
+
+// stepResponse - шаг онбординга: текст с предупреждением notice над ним, меню выбора и кнопки.
+func stepResponse(notice, text string, menu *SelectMenu, buttons ...Button) *Response {
+	if notice != "" {
+		text = notice + "\n\n" + text
+	}
+
+	response := &Response{Content: text, Update: true, Components: []ActionRow{{Select: menu}}}
+	if len(buttons) > 0 {
+		response.Components = append(response.Components, ActionRow{Buttons: buttons})
+	}
+
+	return response
+}
+
+// finishOnboarding проверяет профиль, как Telegram перед завершением: без интересов пользователь
+// начинает их выбор заново, без основных интересов и доступности возвращается к этим шагам.
+// Заполненный профиль отмечается завершенным, и показывается главное меню.
+func (b *Bot) finishOnboarding(user *models.User) (*Response, error) {
+	selections, err := b.interests.GetUserInterestSelections(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest selections: %w", err)
+	}
+
+	if len(selections) == 0 {
+		return renderScreen(adapters.Screen{
+			Text:     b.text(user, "choose_at_least_one_interest"),
+			Keyboard: adapters.Keyboard{adapters.Row(adapters.Button{Text: b.text(user, "choose_interests"), Action: screens.ActionEditInterests})},
+		}, true), nil
+	}
+
+	if err := b.interests.ValidateInterestSelection(user.ID, len(selections)); err != nil {
+		if !errors.Is(err, errorsPkg.ErrMinPrimaryInterestsRequired) {
+			return nil, fmt.Errorf("failed to validate interest selection: %w", err)
+		}
+
+		limits, err := b.interests.GetInterestLimitsConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get interest limits: %w", err)
+		}
+
+		notice := b.service.Localizer.GetWithParams(user.InterfaceLanguageCode, "choose_at_least_primary_interests", map[string]string{
+			"count": strconv.Itoa(limits.MinPrimaryInterests),
+		})
+
+		return b.primaryStep(user, 0, notice)
+	}
+
+	availability, err := b.service.GetTimeAvailability(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get time availability: %w", err)
+	}
+
+	if b.service.ValidateTimeAvailability(availability, user.InterfaceLanguageCode) != nil {
+		return b.availabilityDaysStep(user, ""), nil
+	}
+
+	if err := b.service.DB.UpdateUserState(user.ID, models.StateActive); err != nil {
+		return nil, fmt.Errorf("failed to update user state: %w", err)
+	}
+
+	if err := b.service.DB.UpdateUserStatus(user.ID, models.StatusActive); err != nil {
+		return nil, fmt.Errorf("failed to update user status: %w", err)
+	}
+
+	if err := b.service.DB.UpdateUserProfileCompletionLevel(user.ID, localization.ProfileCompletionLevelComplete); err != nil {
+		return nil, fmt.Errorf("failed to update profile completion: %w", err)
+	}
+
+	user.State, user.Status = models.StateActive, models.StatusActive
+	user.ProfileCompletionLevel = localization.ProfileCompletionLevelComplete
+
+	screen := b.screens.MainMenu(user)
+	screen.Text = b.text(user, "profile_completed") + "\n\n" + screen.Text
+	screen.Keyboard = screen.Keyboard.Filter(b.supports)
+
+	return renderScreen(screen, true), nil
+}
@@ -0,0 +1,22 @@
+package discord
+
+import "language-exchange-bot/internal/models"
+
+// isProfileComplete сообщает, заполнял ли пользователь профиль, как и главное меню Telegram.
+func (b *Bot) isProfileComplete(user *models.User) bool {
+	return user.ProfileCompletionLevel > 0
+}
+
+// showProfile показывает профиль пользователя, а без профиля предлагает его заполнить.
+func (b *Bot) showProfile(interaction *Interaction, user *models.User, update bool) error {
+	if !b.isProfileComplete(user) {
+		return b.respondScreen(interaction, b.screens.EmptyProfile(user.InterfaceLanguageCode), update)
+	}
+
+	screen, err := b.screens.Profile(user)
+	if err != nil {
+		return err
+	}
+
+	return b.respondScreen(interaction, screen, update)
+}
@@ -0,0 +1,66 @@
+package discord
+
+import "language-exchange-bot/internal/adapters"
+
+// Ограничения Discord на компоненты сообщения.
+const (
+	maxActionRows = 5
+	maxRowButtons = 5
+)
+
+// customIDChoice - меню выбора, в которое сворачиваются кнопки экрана, не поместившиеся
+// в строки компонентов. Выбранное значение - действие кнопки.
+const customIDChoice = "screen:choice"
+
+// renderScreen превращает экран в ответ Discord. Кнопки становятся строками кнопок
+// с действием в CustomID; если они не помещаются, экран показывается меню выбора.
+func renderScreen(screen adapters.Screen, update bool) *Response {
+	response := &Response{Content: screen.Text, Update: update}
+
+	if fitsButtons(screen.Keyboard) {
+		for _, row := range screen.Keyboard {
+			buttons := make([]Button, 0, len(row))
+			for _, button := range row {
+				buttons = append(buttons, Button{Label: button.Text, CustomID: button.Action})
+			}
+
+			response.Components = append(response.Components, ActionRow{Buttons: buttons})
+		}
+
+		return response
+	}
+
+	options := make([]SelectOption, 0, maxSelectOptions)
+
+	for _, row := range screen.Keyboard {
+		for _, button := range row {
+			if len(options) < maxSelectOptions {
+				options = append(options, SelectOption{Label: button.Text, Value: button.Action})
+			}
+		}
+	}
+
+	response.Components = []ActionRow{{Select: &SelectMenu{
+		CustomID:  customIDChoice,
+		MinValues: 1,
+		MaxValues: 1,
+		Options:   options,
+	}}}
+
+	return response
+}
+
+// fitsButtons сообщает, помещается ли клавиатура в строки кнопок Discord.
+func fitsButtons(keyboard adapters.Keyboard) bool {
+	if len(keyboard) > maxActionRows {
+		return false
+	}
+
+	for _, row := range keyboard {
+		if len(row) > maxRowButtons {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,50 @@
+package discord
+
+import (
+	"fmt"
+	"testing"
+
+	"language-exchange-bot/internal/adapters"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRenderScreen тестирует кнопки экрана и сворачивание длинной клавиатуры в меню выбора.
+func TestRenderScreen(t *testing.T) {
+	screen := adapters.Screen{Text: "menu", Keyboard: adapters.Keyboard{
+		adapters.Row(adapters.Button{Text: "A", Action: "a"}, adapters.Button{Text: "B", Action: "b"}),
+		adapters.Row(adapters.Button{Text: "C", Action: "c"}),
+	}}
+
+	response := renderScreen(screen, true)
+	assert.Equal(t, "menu", response.Content)
+	assert.True(t, response.Update)
+	require.Len(t, response.Components, 2)
+	assert.Equal(t, []Button{{Label: "A", CustomID: "a"}, {Label: "B", CustomID: "b"}}, response.Components[0].Buttons)
+
+	// 30 рядов не помещаются в 5 строк: меню выбора с первыми 25 действиями
+	long := adapters.Screen{}
+	for i := range 30 {
+		long.Keyboard = append(long.Keyboard, adapters.Row(adapters.Button{Text: fmt.Sprint(i), Action: fmt.Sprint("item_", i)}))
+	}
+
+	response = renderScreen(long, false)
+	require.Len(t, response.Components, 1)
+	require.NotNil(t, response.Components[0].Select)
+	assert.Equal(t, customIDChoice, response.Components[0].Select.CustomID)
+	assert.Len(t, response.Components[0].Select.Options, maxSelectOptions)
+	assert.Equal(t, "item_0", response.Components[0].Select.Options[0].Value)
+}
+
+// TestKeyboardFilter тестирует скрытие неподдерживаемых действий и пустых рядов.
+func TestKeyboardFilter(t *testing.T) {
+	bot := &Bot{}
+	keyboard := adapters.Keyboard{
+		adapters.Row(adapters.Button{Action: "edit_availability"}),
+		adapters.Row(adapters.Button{Action: "main_feedback"}, adapters.Button{Action: "profile_reset_ask"}),
+		adapters.Row(adapters.Button{Action: "level_beginner"}),
+	}
+
+	assert.Equal(t, []string{"main_feedback", "level_beginner"}, keyboard.Filter(bot.supports).Actions())
+}
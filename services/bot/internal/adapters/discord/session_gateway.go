@@ -0,0 +1,253 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// commands - slash-команды бота, регистрируемые при подключении.
+var commands = []*discordgo.ApplicationCommand{
+	{Name: commandStart, Description: "Start or continue profile setup"},
+	{Name: commandProfile, Description: "Show your language exchange profile"},
+	{Name: commandFeedback, Description: "Send feedback to the team"},
+}
+
+// SessionGateway - Gateway поверх WebSocket-сессии discordgo.
+type SessionGateway struct {
+	session *discordgo.Session
+
+	mu           sync.Mutex
+	interactions map[string]*discordgo.Interaction // Исходные взаимодействия для ответа по ID
+}
+
+// NewSessionGateway создает подключение к Discord с токеном бота.
+func NewSessionGateway(token string) (*SessionGateway, error) {
+	session, err := discordgo.New("Bot " + token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discord session: %w", err)
+	}
+
+	// Взаимодействия приходят без привилегированных intents
+	session.Identify.Intents = discordgo.IntentsNone
+
+	return &SessionGateway{session: session, interactions: map[string]*discordgo.Interaction{}}, nil
+}
+
+// Open подключается к Discord и регистрирует slash-команды.
+func (g *SessionGateway) Open(_ context.Context, handler func(*Interaction)) error {
+	g.session.AddHandler(func(session *discordgo.Session, ready *discordgo.Ready) {
+		appID := ready.User.ID
+		if ready.Application != nil {
+			appID = ready.Application.ID
+		}
+
+		if _, err := session.ApplicationCommandBulkOverwrite(appID, "", commands); err != nil {
+			log.Printf("Failed to register Discord commands: %v", err)
+		}
+	})
+
+	g.session.AddHandler(func(_ *discordgo.Session, event *discordgo.InteractionCreate) {
+		interaction := convertInteraction(event.Interaction)
+		if interaction == nil {
+			return
+		}
+
+		g.mu.Lock()
+		g.interactions[interaction.ID] = event.Interaction
+		g.mu.Unlock()
+
+		defer func() {
+			g.mu.Lock()
+			delete(g.interactions, interaction.ID)
+			g.mu.Unlock()
+		}()
+
+		handler(interaction)
+	})
+
+	if err := g.session.Open(); err != nil {
+		return fmt.Errorf("failed to open discord session: %w", err)
+	}
+
+	return nil
+}
+
+// Respond отвечает на взаимодействие, пока обработчик его не завершил.
+func (g *SessionGateway) Respond(interaction *Interaction, response *Response) error {
+	g.mu.Lock()
+	original, ok := g.interactions[interaction.ID]
+	g.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("interaction %s is not pending", interaction.ID)
+	}
+
+	if err := g.session.InteractionRespond(original, convertResponse(response)); err != nil {
+		return fmt.Errorf("failed to respond to discord interaction: %w", err)
+	}
+
+	return nil
+}
+
+// Close отключается от Discord.
+func (g *SessionGateway) Close() error {
+	if err := g.session.Close(); err != nil {
+		return fmt.Errorf("failed to close discord session: %w", err)
+	}
+
+	return nil
+}
+
+// convertInteraction переводит событие discordgo во взаимодействие бота.
+// Неподдерживаемые типы (автодополнение, ping) возвращают nil.
+func convertInteraction(source *discordgo.Interaction) *Interaction {
+	interaction := &Interaction{
+		ID:        source.ID,
+		Locale:    string(source.Locale),
+		ChannelID: source.ChannelID,
+	}
+
+	// В личных сообщениях заполнен User, на сервере - Member
+	user := source.User
+	if source.Member != nil {
+		user = source.Member.User
+	}
+
+	if user == nil {
+		return nil
+	}
+
+	interaction.UserID, interaction.Username, interaction.GlobalName = user.ID, user.Username, user.GlobalName
+
+	switch source.Type {
+	case discordgo.InteractionApplicationCommand:
+		interaction.Type = InteractionCommand
+		interaction.Command = source.ApplicationCommandData().Name
+	case discordgo.InteractionMessageComponent:
+		data := source.MessageComponentData()
+		interaction.Type = InteractionComponent
+		interaction.CustomID, interaction.Values = data.CustomID, data.Values
+	case discordgo.InteractionModalSubmit:
+		data := source.ModalSubmitData()
+		interaction.Type = InteractionModal
+		interaction.CustomID = data.CustomID
+		interaction.Fields = modalFields(data.Components)
+	default:
+		return nil
+	}
+
+	return interaction
+}
+
+// modalFields собирает значения полей модального окна.
+func modalFields(components []discordgo.MessageComponent) map[string]string {
+	fields := map[string]string{}
+
+	for _, component := range components {
+		row, ok := component.(*discordgo.ActionsRow)
+		if !ok {
+			continue
+		}
+
+		for _, rowComponent := range row.Components {
+			if input, ok := rowComponent.(*discordgo.TextInput); ok {
+				fields[input.CustomID] = input.Value
+			}
+		}
+	}
+
+	return fields
+}
+
+// convertResponse переводит ответ бота в ответ discordgo.
+func convertResponse(response *Response) *discordgo.InteractionResponse {
+	if response.Modal != nil {
+		rows := make([]discordgo.MessageComponent, 0, len(response.Modal.Inputs))
+
+		for _, input := range response.Modal.Inputs {
+			style := discordgo.TextInputShort
+			if input.Paragraph {
+				style = discordgo.TextInputParagraph
+			}
+
+			rows = append(rows, discordgo.ActionsRow{Components: []discordgo.MessageComponent{discordgo.TextInput{
+				CustomID:  input.CustomID,
+				Label:     input.Label,
+				Style:     style,
+				Required:  input.Required,
+				MinLength: input.MinLength,
+				MaxLength: input.MaxLength,
+			}}})
+		}
+
+		return &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseModal,
+			Data: &discordgo.InteractionResponseData{
+				CustomID:   response.Modal.CustomID,
+				Title:      response.Modal.Title,
+				Components: rows,
+			},
+		}
+	}
+
+	responseType := discordgo.InteractionResponseChannelMessageWithSource
+	if response.Update {
+		responseType = discordgo.InteractionResponseUpdateMessage
+	}
+
+	data := &discordgo.InteractionResponseData{
+		Content:    response.Content,
+		Components: convertRows(response.Components),
+	}
+
+	if response.Ephemeral {
+		data.Flags = discordgo.MessageFlagsEphemeral
+	}
+
+	return &discordgo.InteractionResponse{Type: responseType, Data: data}
+}
+
+// convertRows переводит строки компонентов в компоненты discordgo.
+func convertRows(rows []ActionRow) []discordgo.MessageComponent {
+	components := make([]discordgo.MessageComponent, 0, len(rows))
+
+	for _, row := range rows {
+		if row.Select != nil {
+			options := make([]discordgo.SelectMenuOption, 0, len(row.Select.Options))
+			for _, option := range row.Select.Options {
+				options = append(options, discordgo.SelectMenuOption{Label: option.Label, Value: option.Value})
+			}
+
+			minValues := row.Select.MinValues
+			components = append(components, discordgo.ActionsRow{Components: []discordgo.MessageComponent{discordgo.SelectMenu{
+				MenuType:    discordgo.StringSelectMenu,
+				CustomID:    row.Select.CustomID,
+				Placeholder: row.Select.Placeholder,
+				MinValues:   &minValues,
+				MaxValues:   row.Select.MaxValues,
+				Options:     options,
+			}}})
+
+			continue
+		}
+
+		buttons := make([]discordgo.MessageComponent, 0, len(row.Buttons))
+
+		for _, button := range row.Buttons {
+			style := discordgo.SecondaryButton
+			if button.Primary {
+				style = discordgo.PrimaryButton
+			}
+
+			buttons = append(buttons, discordgo.Button{Label: button.Label, CustomID: button.CustomID, Style: style})
+		}
+
+		components = append(components, discordgo.ActionsRow{Components: buttons})
+	}
+
+	return components
+}
@@ -0,0 +1,58 @@
+package adapters
+
+// Button - кнопка экрана. Action передается обратно боту при нажатии
+// (callback data в Telegram, custom ID компонента в Discord).
+type Button struct {
+	Text   string
+	Action string
+}
+
+// Keyboard - кнопки экрана по рядам.
+type Keyboard [][]Button
+
+// Screen - сообщение бота, не зависящее от платформы: текст и клавиатура.
+// Сценарии строят экраны, а адаптер каждой платформы превращает их в свои сообщения.
+type Screen struct {
+	Text     string
+	Keyboard Keyboard
+}
+
+// Row собирает ряд клавиатуры из кнопок.
+func Row(buttons ...Button) []Button {
+	return buttons
+}
+
+// Actions возвращает действия всех кнопок клавиатуры по порядку.
+func (k Keyboard) Actions() []string {
+	var actions []string
+
+	for _, row := range k {
+		for _, button := range row {
+			actions = append(actions, button.Action)
+		}
+	}
+
+	return actions
+}
+
+// Filter возвращает клавиатуру только с кнопками, действия которых поддерживает платформа.
+// Пустые ряды отбрасываются.
+func (k Keyboard) Filter(supported func(action string) bool) Keyboard {
+	filtered := make(Keyboard, 0, len(k))
+
+	for _, row := range k {
+		kept := make([]Button, 0, len(row))
+
+		for _, button := range row {
+			if supported(button.Action) {
+				kept = append(kept, button)
+			}
+		}
+
+		if len(kept) > 0 {
+			filtered = append(filtered, kept)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,157 @@
+package screens
+
+import (
+	"slices"
+	"strings"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+)
+
+// Действия шагов настройки доступности без параметра; переключатели дней, времени и способов
+// общения - localization.CallbackPrefixAvail* + значение.
+const (
+	ActionAvailabilityBackToDayType       = "availability_back_to_daytype"
+	ActionAvailabilityBackToDays          = "availability_back_to_days"
+	ActionAvailabilityBackToTime          = "availability_back_to_time"
+	ActionAvailabilityAllTimeSlots        = "availability_timeslot_select_all"
+	ActionAvailabilityAllCommunication    = "availability_communication_select_all"
+	ActionAvailabilityProceedToTime       = localization.CallbackAvailProceedToTime
+	ActionAvailabilityProceedToCommStyles = localization.CallbackAvailProceedToCommunication
+	ActionAvailabilityProceedToFrequency  = localization.CallbackAvailProceedToFrequency
+)
+
+// AvailabilityStart - начало настройки доступности: выбор типа дней.
+func (b *Builder) AvailabilityStart(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text: b.text(lang, "time_availability_intro") + "\n\n" + b.text(lang, "select_day_type"),
+		Keyboard: adapters.Keyboard{
+			adapters.Row(b.button(lang, localization.LocaleTimeWeekdays, ActionDayTypePrefix+"weekdays")),
+			adapters.Row(b.button(lang, localization.LocaleTimeWeekends, ActionDayTypePrefix+"weekends")),
+			adapters.Row(b.button(lang, localization.LocaleTimeAny, ActionDayTypePrefix+"any")),
+			adapters.Row(b.button(lang, "select_specific_days_button", ActionDayTypePrefix+"specific")),
+			adapters.Row(b.backButton(lang, ActionBackToInterests)),
+		},
+	}
+}
+
+// AvailabilitySetupKeyboard - переход к настройке доступности после заполнения профиля.
+func (b *Builder) AvailabilitySetupKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(adapters.Button{
+			Text:   "⏰ " + b.text(lang, "setup_availability_button"),
+			Action: ActionSetupAvailability,
+		}),
+		adapters.Row(
+			b.button(lang, "profile_show", ActionProfileShow),
+			b.button(lang, "profile_completed_main", ActionMainMenu),
+		),
+	}
+}
+
+// AvailabilityCompleted - сообщение о сохраненной доступности.
+func (b *Builder) AvailabilityCompleted(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text: b.text(lang, "availability_setup_complete") + "\n\n" + b.text(lang, "profile_completed"),
+		Keyboard: adapters.Keyboard{
+			adapters.Row(b.button(lang, "profile_show", ActionProfileView), b.mainMenuButton(lang)),
+		},
+	}
+}
+
+// AvailabilitySpecificDays - выбор конкретных дней недели по два в ряд с перечнем выбранных.
+func (b *Builder) AvailabilitySpecificDays(lang string, selected []string) adapters.Screen {
+	label := func(day string) string { return b.text(lang, "day_"+day) }
+
+	buttons := b.availabilityStepToggles(AvailabilityDays, selected, localization.CallbackPrefixAvailSpecificDay, label)
+
+	return adapters.Screen{
+		Text: b.text(lang, "select_specific_days") + "\n\n" + b.text(lang, "selected_days") + ": " +
+			b.availabilitySelection(lang, "no_days_selected", AvailabilityDays, selected, label),
+		Keyboard: append(pairs(buttons), b.availabilityStepNavigation(lang, ActionAvailabilityBackToDayType, ActionAvailabilityProceedToTime)),
+	}
+}
+
+// AvailabilityTimeSlotsStep - выбор времени суток по два в ряд с перечнем выбранных. errorKey,
+// если не пуст, - ключ ошибки под перечнем (например, время не выбрано).
+func (b *Builder) AvailabilityTimeSlotsStep(lang string, selected []string, errorKey string) adapters.Screen {
+	label := func(slot string) string { return b.text(lang, availabilityTimeSlotKeys[slot]) }
+
+	text := b.text(lang, "select_time_slot") + "\n\n" + b.text(lang, "selected_slots") + ": " +
+		b.availabilitySelection(lang, "none_selected", AvailabilityTimeSlots, selected, label)
+	if errorKey != "" {
+		text += "\n\n" + b.text(lang, errorKey)
+	}
+
+	buttons := b.availabilityStepToggles(AvailabilityTimeSlots, selected, localization.CallbackPrefixAvailTimeSlot, label)
+
+	keyboard := append(pairs(buttons),
+		adapters.Row(adapters.Button{Text: "✅ " + b.text(lang, "select_all"), Action: ActionAvailabilityAllTimeSlots}),
+		b.availabilityStepNavigation(lang, ActionAvailabilityBackToDays, ActionAvailabilityProceedToCommStyles),
+	)
+
+	return adapters.Screen{Text: text, Keyboard: keyboard}
+}
+
+// AvailabilityCommunicationStep - выбор способов общения по два в ряд; выбранные перечисляются
+// в порядке выбора.
+func (b *Builder) AvailabilityCommunicationStep(lang string, selected []string) adapters.Screen {
+	label := func(style string) string {
+		if key, ok := availabilityCommunicationKeys[style]; ok {
+			return b.text(lang, key)
+		}
+
+		return style
+	}
+
+	buttons := b.availabilityStepToggles(AvailabilityCommunicationStyles, selected, localization.CallbackPrefixAvailCommunication, label)
+
+	keyboard := append(pairs(buttons),
+		adapters.Row(adapters.Button{Text: "✅ " + b.text(lang, "select_all"), Action: ActionAvailabilityAllCommunication}),
+		b.availabilityStepNavigation(lang, ActionAvailabilityBackToTime, ActionAvailabilityProceedToFrequency),
+	)
+
+	return adapters.Screen{
+		Text: b.text(lang, "select_communication_style") + "\n\n" + b.text(lang, "selected_styles") + ": " +
+			b.availabilitySelection(lang, "none_selected", selected, selected, label),
+		Keyboard: keyboard,
+	}
+}
+
+// availabilityStepToggles создает переключатели шага настройки: действие - prefix + значение.
+func (b *Builder) availabilityStepToggles(values, selected []string, prefix string, label func(string) string) []adapters.Button {
+	buttons := make([]adapters.Button, 0, len(values))
+
+	for _, value := range values {
+		symbol := "☑"
+		if slices.Contains(selected, value) {
+			symbol = "✅"
+		}
+
+		buttons = append(buttons, adapters.Button{Text: symbol + " " + label(value), Action: prefix + value})
+	}
+
+	return buttons
+}
+
+// availabilitySelection перечисляет выбранные значения в порядке order или возвращает emptyKey.
+func (b *Builder) availabilitySelection(lang, emptyKey string, order, selected []string, label func(string) string) string {
+	names := make([]string, 0, len(selected))
+
+	for _, value := range order {
+		if slices.Contains(selected, value) {
+			names = append(names, label(value))
+		}
+	}
+
+	if len(names) == 0 {
+		return b.text(lang, emptyKey)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// availabilityStepNavigation - "Назад" и "Продолжить" шага настройки доступности.
+func (b *Builder) availabilityStepNavigation(lang, back, proceed string) []adapters.Button {
+	return adapters.Row(b.backButton(lang, back), b.button(lang, "continue_button", proceed))
+}
@@ -0,0 +1,213 @@
+package screens
+
+import (
+	"strconv"
+	"strings"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Значения, доступные в редакторе доступности, в порядке кнопок.
+var (
+	AvailabilityDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+	AvailabilityTimeSlots = []string{"morning", "day", "evening", "late"}
+
+	AvailabilityCommunicationStyles = []string{"text", "voice_msg", "audio_call", "video_call", "meet_person"}
+)
+
+// Подписи временных слотов и способов общения.
+var (
+	availabilityTimeSlotKeys = map[string]string{
+		"morning": localization.LocaleTimeMorning,
+		"day":     localization.LocaleTimeDay,
+		"evening": localization.LocaleTimeEvening,
+		"late":    localization.LocaleTimeLate,
+	}
+
+	availabilityCommunicationKeys = map[string]string{
+		"text":        localization.LocaleCommText,
+		"voice_msg":   localization.LocaleCommVoice,
+		"audio_call":  localization.LocaleCommAudio,
+		"video_call":  localization.LocaleCommVideo,
+		"meet_person": localization.LocaleCommMeet,
+	}
+)
+
+// AvailabilityEditorMenuKeyboard - главное меню редактора доступности; "Сохранить"
+// появляется только после первого изменения.
+func (b *Builder) AvailabilityEditorMenuKeyboard(lang string, hasChanges bool) adapters.Keyboard {
+	keyboard := adapters.Keyboard{
+		adapters.Row(adapters.Button{Text: b.textOr(lang, "edit_days", "📅 Edit days"), Action: localization.CallbackAvailEditDays}),
+		adapters.Row(adapters.Button{Text: b.textOr(lang, "edit_time", "🕐 Edit time"), Action: localization.CallbackAvailEditTime}),
+		adapters.Row(adapters.Button{
+			Text:   b.textOr(lang, "edit_communication", "💬 Edit communication"),
+			Action: localization.CallbackAvailEditCommunication,
+		}),
+		adapters.Row(adapters.Button{Text: b.textOr(lang, "edit_frequency", "📊 Edit frequency"), Action: localization.CallbackAvailEditFrequency}),
+		adapters.Row(adapters.Button{
+			Text:   b.textOr(lang, localization.LocaleVacationEditButton, "🏖 Vacation"),
+			Action: localization.CallbackAvailEditVacation,
+		}),
+	}
+
+	actions := adapters.Row()
+	if hasChanges {
+		actions = append(actions, adapters.Button{
+			Text:   "✅ " + b.text(lang, localization.LocaleSaveChanges),
+			Action: localization.CallbackAvailSaveChanges,
+		})
+	}
+
+	actions = append(actions, adapters.Button{
+		Text:   "❌ " + b.text(lang, localization.LocaleCancelEdit),
+		Action: localization.CallbackAvailCancelEdit,
+	})
+
+	return append(keyboard, actions)
+}
+
+// AvailabilityEditorDayTypeKeyboard - выбор типа дней в редакторе.
+func (b *Builder) AvailabilityEditorDayTypeKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(b.button(lang, localization.LocaleTimeWeekdays, localization.CallbackAvailEditDayTypeWeekdays)),
+		adapters.Row(b.button(lang, localization.LocaleTimeWeekends, localization.CallbackAvailEditDayTypeWeekends)),
+		adapters.Row(b.button(lang, localization.LocaleTimeAny, localization.CallbackAvailEditDayTypeAny)),
+		adapters.Row(b.button(lang, "select_specific_days_button", localization.CallbackAvailEditDayTypeSpecific)),
+		adapters.Row(b.availabilityEditorBackButton(lang)),
+	}
+}
+
+// AvailabilityEditorDaysKeyboard - дни недели по два в ряд с отметкой выбранных.
+func (b *Builder) AvailabilityEditorDaysKeyboard(lang string, selected []string) adapters.Keyboard {
+	buttons := b.availabilityToggles(AvailabilityDays, selected, localization.CallbackPrefixAvailEditDay, func(day string) string {
+		return b.text(lang, "day_"+day)
+	})
+
+	return append(pairs(buttons), b.availabilityEditorApplyRow(lang, localization.CallbackAvailApplyDays))
+}
+
+// AvailabilityEditorTimeSlotsKeyboard - временные слоты по одному в ряд с отметкой выбранных.
+func (b *Builder) AvailabilityEditorTimeSlotsKeyboard(lang string, selected []string) adapters.Keyboard {
+	buttons := b.availabilityToggles(AvailabilityTimeSlots, selected, localization.CallbackPrefixAvailEditTimeSlot, func(slot string) string {
+		return b.text(lang, availabilityTimeSlotKeys[slot])
+	})
+
+	return append(column(buttons), b.availabilityEditorApplyRow(lang, localization.CallbackAvailApplyTime))
+}
+
+// AvailabilityEditorCommunicationKeyboard - способы общения по одному в ряд с отметкой выбранных.
+func (b *Builder) AvailabilityEditorCommunicationKeyboard(lang string, selected []string) adapters.Keyboard {
+	buttons := b.availabilityToggles(AvailabilityCommunicationStyles, selected, localization.CallbackPrefixAvailEditCommStyle, func(style string) string {
+		return b.text(lang, availabilityCommunicationKeys[style])
+	})
+
+	return append(column(buttons), b.availabilityEditorApplyRow(lang, localization.CallbackAvailApplyCommunication))
+}
+
+// AvailabilityEditorFrequencyKeyboard - выбор частоты общения в редакторе.
+func (b *Builder) AvailabilityEditorFrequencyKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(b.button(lang, localization.LocaleFreqMultipleWeekly, localization.CallbackAvailEditFreqMultipleWeekly)),
+		adapters.Row(b.button(lang, localization.LocaleFreqWeekly, localization.CallbackAvailEditFreqWeekly)),
+		adapters.Row(b.button(lang, localization.LocaleFreqMultipleMonthly, localization.CallbackAvailEditFreqMultipleMonthly)),
+		adapters.Row(b.button(lang, localization.LocaleFreqFlexible, localization.CallbackAvailEditFreqFlexible)),
+		adapters.Row(b.availabilityEditorBackButton(lang)),
+	}
+}
+
+// AvailabilityEditorClosedKeyboard - переход к профилю после сохранения или отмены редактирования.
+func (b *Builder) AvailabilityEditorClosedKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{adapters.Row(b.button(lang, "profile_show", ActionProfileView))}
+}
+
+// AvailabilityVacation - список периодов недоступности с удалением каждого и добавлением
+// нового, пока не достигнут лимит. notice выводится над списком.
+func (b *Builder) AvailabilityVacation(lang, notice string, periods []models.UnavailabilityPeriod) adapters.Screen {
+	var text strings.Builder
+	if notice != "" {
+		text.WriteString(notice + "\n\n")
+	}
+
+	text.WriteString(b.text(lang, localization.LocaleVacationTitle) + "\n\n")
+	text.WriteString(b.text(lang, localization.LocaleVacationDescription) + "\n\n")
+
+	if len(periods) == 0 {
+		text.WriteString(b.text(lang, localization.LocaleVacationNone))
+	}
+
+	keyboard := make(adapters.Keyboard, 0, len(periods)+2)
+
+	for i, period := range periods {
+		formatted := core.FormatUnavailabilityPeriod(period)
+		if i > 0 {
+			text.WriteString("\n")
+		}
+
+		text.WriteString("• " + formatted)
+
+		keyboard = append(keyboard, adapters.Row(adapters.Button{
+			Text: b.service.Localizer.GetWithParams(lang, localization.LocaleVacationDeleteButton, map[string]string{
+				"period": formatted,
+			}),
+			Action: localization.CallbackPrefixAvailVacationDel + strconv.Itoa(period.ID),
+		}))
+	}
+
+	if len(periods) < localization.MaxUnavailabilityPeriods {
+		keyboard = append(keyboard, adapters.Row(b.button(lang, localization.LocaleVacationAddButton, localization.CallbackAvailVacationAdd)))
+	}
+
+	keyboard = append(keyboard, adapters.Row(b.button(lang, localization.LocaleBackToEditMenu, localization.CallbackAvailVacationBack)))
+
+	return adapters.Screen{Text: text.String(), Keyboard: keyboard}
+}
+
+// AvailabilityVacationInput - приглашение ввести даты отпуска с отменой ввода.
+func (b *Builder) AvailabilityVacationInput(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text: b.text(lang, localization.LocaleVacationEnterDates),
+		Keyboard: adapters.Keyboard{adapters.Row(adapters.Button{
+			Text:   "❌ " + b.text(lang, localization.LocaleCancelEdit),
+			Action: localization.CallbackAvailVacationCancelInput,
+		})},
+	}
+}
+
+// availabilityToggles создает переключатели значений с отметкой выбранных.
+// Действие кнопки: prefix + "_" + значение (формат, который разбирает роутер редактора).
+func (b *Builder) availabilityToggles(values, selected []string, prefix string, label func(string) string) []adapters.Button {
+	chosen := make(map[string]bool, len(selected))
+	for _, value := range selected {
+		chosen[value] = true
+	}
+
+	buttons := make([]adapters.Button, 0, len(values))
+
+	for _, value := range values {
+		symbol := "☑"
+		if chosen[value] {
+			symbol = "✅"
+		}
+
+		buttons = append(buttons, adapters.Button{Text: symbol + " " + label(value), Action: prefix + "_" + value})
+	}
+
+	return buttons
+}
+
+// availabilityEditorApplyRow - применение выбора и возврат в меню редактора.
+func (b *Builder) availabilityEditorApplyRow(lang, action string) []adapters.Button {
+	return adapters.Row(
+		adapters.Button{Text: "✅ " + b.text(lang, localization.LocaleSaveChanges), Action: action},
+		b.availabilityEditorBackButton(lang),
+	)
+}
+
+// availabilityEditorBackButton - возврат в меню редактора доступности.
+func (b *Builder) availabilityEditorBackButton(lang string) adapters.Button {
+	return b.button(lang, localization.LocaleBackToEditMenu, localization.CallbackAvailBackToEditMenu)
+}
@@ -0,0 +1,106 @@
+// Package screens builds platform-neutral screens for the conversation flows:
+// main menu, profile, language choice, interest and primary interest selection,
+// availability setup, feedback with its admin browser, and the profile editors
+// for languages, interests and availability.
+// Platform adapters render these screens into native messages.
+package screens
+
+import (
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/core"
+)
+
+// Действия кнопок, общие для всех платформ. В Telegram они же служат callback data.
+const (
+	ActionMainMenu          = "back_to_main_menu"
+	ActionPreviousStep      = "back_to_previous_step"
+	ActionViewProfile       = "main_view_profile"
+	ActionEditProfile       = "main_edit_profile"
+	ActionChangeLanguage    = "main_change_language"
+	ActionFeedback          = "main_feedback"
+	ActionFeedbackHelp      = "feedback_help"
+	ActionStartProfileSetup = "start_profile_setup"
+	ActionProfileSetupInfo  = "show_profile_setup_features"
+	ActionProfileShow       = "profile_show"
+	ActionProfileView       = "view_profile"
+	ActionProfileReset      = "profile_reset_ask"
+	ActionEditInterests     = "isolated_edit_start"
+	ActionEditLanguages     = "edit_languages"
+	ActionEditAvailability  = "edit_availability"
+	ActionSetupAvailability = "setup_availability"
+	ActionInterestsContinue = "interests_continue"
+	ActionBackToLevel       = "back_to_language_level"
+	ActionBackToInterests   = "back_to_primary_interests"
+	ActionLanguagePrefix    = "lang_"
+	ActionLevelPrefix       = "level_"
+	ActionCategoryPrefix    = "interest_category_"
+	ActionDayTypePrefix     = "availability_daytype_"
+)
+
+// Назначение клавиатуры выбора языка; входит в действие кнопки: lang_<назначение>_<код>.
+const (
+	LanguageInterface = "interface"
+	LanguageNative    = "native"
+	LanguageTarget    = "target"
+)
+
+// Builder строит экраны сценариев на языке интерфейса пользователя.
+type Builder struct {
+	service *core.BotService
+}
+
+// NewBuilder создает построитель экранов.
+func NewBuilder(service *core.BotService) *Builder {
+	return &Builder{service: service}
+}
+
+// text возвращает локализованную строку.
+func (b *Builder) text(lang, key string) string {
+	return b.service.Localizer.Get(lang, key)
+}
+
+// button создает кнопку с локализованной подписью.
+func (b *Builder) button(lang, key, action string) adapters.Button {
+	return adapters.Button{Text: b.text(lang, key), Action: action}
+}
+
+// textOr возвращает перевод ключа или fallback, если перевода нет ни в одном языке.
+func (b *Builder) textOr(lang, key, fallback string) string {
+	if text := b.text(lang, key); text != key {
+		return text
+	}
+
+	return fallback
+}
+
+// backButton создает кнопку "Назад".
+func (b *Builder) backButton(lang, action string) adapters.Button {
+	return b.button(lang, "back_button", action)
+}
+
+// mainMenuButton создает кнопку возврата в главное меню.
+func (b *Builder) mainMenuButton(lang string) adapters.Button {
+	return b.button(lang, "back_to_main", ActionMainMenu)
+}
+
+// pairs раскладывает кнопки по две в ряд.
+func pairs(buttons []adapters.Button) adapters.Keyboard {
+	keyboard := make(adapters.Keyboard, 0, (len(buttons)+1)/2)
+
+	for i := 0; i < len(buttons); i += 2 {
+		keyboard = append(keyboard, buttons[i:min(i+2, len(buttons))])
+	}
+
+	return keyboard
+}
+
+// column раскладывает кнопки по одной в ряд.
+func column(buttons []adapters.Button) adapters.Keyboard {
+	keyboard := make(adapters.Keyboard, 0, len(buttons))
+
+	for _, button := range buttons {
+		keyboard = append(keyboard, adapters.Row(button))
+	}
+
+	return keyboard
+}
@@ -0,0 +1,336 @@
+package screens_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"language-exchange-bot/internal/adapters/screens"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+	"language-exchange-bot/tests/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBuilder создает построитель экранов поверх мока БД.
+func newBuilder() *screens.Builder {
+	return screens.NewBuilder(core.NewBotServiceWithInterface(mocks.NewDatabaseMock(), localization.NewLocalizer(nil)))
+}
+
+// TestBuilder_MainMenu тестирует главное меню с профилем и без.
+func TestBuilder_MainMenu(t *testing.T) {
+	builder := newBuilder()
+
+	screen := builder.MainMenu(&models.User{InterfaceLanguageCode: "en"})
+	assert.Equal(t, []string{screens.ActionStartProfileSetup, screens.ActionChangeLanguage, screens.ActionFeedback}, screen.Keyboard.Actions())
+
+	screen = builder.MainMenu(&models.User{InterfaceLanguageCode: "en", ProfileCompletionLevel: localization.ProfileCompletionLevelComplete})
+	assert.Equal(t, []string{
+		screens.ActionViewProfile, screens.ActionEditProfile, screens.ActionChangeLanguage, screens.ActionFeedback,
+	}, screen.Keyboard.Actions())
+}
+
+// TestBuilder_LanguageKeyboard тестирует исключение языка и кнопку "Назад" по назначению клавиатуры.
+func TestBuilder_LanguageKeyboard(t *testing.T) {
+	builder := newBuilder()
+
+	target := builder.TargetLanguage(&models.User{InterfaceLanguageCode: "en"}).Keyboard.Actions()
+	assert.ElementsMatch(t, []string{"lang_target_en", "lang_target_es", "lang_target_zh", screens.ActionPreviousStep}, target)
+
+	native := builder.LanguageKeyboard("en", screens.LanguageNative, "", true).Actions()
+	assert.Len(t, native, 5)
+	assert.Equal(t, screens.ActionMainMenu, native[len(native)-1])
+
+	assert.Len(t, builder.LanguageKeyboard("en", screens.LanguageNative, "", false), 4)
+}
+
+// TestBuilder_LanguageLevel тестирует уровни с префиксом действия.
+func TestBuilder_LanguageLevel(t *testing.T) {
+	keyboard := newBuilder().LanguageLevelKeyboard("en", "isolated_level_", false)
+
+	assert.Equal(t, []string{
+		"isolated_level_beginner", "isolated_level_elementary", "isolated_level_intermediate", "isolated_level_upper_intermediate",
+	}, keyboard.Actions())
+}
+
+// TestBuilder_InterestCategories тестирует категории из справочника в порядке display_order
+// по две в ряд и предупреждение над подсказкой.
+func TestBuilder_InterestCategories(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+
+	for i, key := range []string{"entertainment", "education", "active", "creative", "social"} {
+		order := i + 1
+		if key == "social" {
+			order = 0
+		}
+
+		_, err := db.CreateInterestCategory(models.InterestCategoryInput{
+			KeyName: &key, DisplayOrder: &order, Translations: map[string]string{"en": key},
+		})
+		require.NoError(t, err)
+	}
+
+	builder := screens.NewBuilder(core.NewBotServiceWithInterface(db, localization.NewLocalizer(nil)))
+	screen := builder.InterestCategories(&models.User{InterfaceLanguageCode: "en"}, "warning")
+
+	assert.Equal(t, "warning\n\n"+localization.LocaleChooseInterests, screen.Text)
+	require.Len(t, screen.Keyboard, 4)
+	assert.Equal(t, []string{
+		screens.ActionCategoryPrefix + "social", screens.ActionCategoryPrefix + "entertainment",
+		screens.ActionCategoryPrefix + "education", screens.ActionCategoryPrefix + "active",
+		screens.ActionCategoryPrefix + "creative",
+	}, screen.Keyboard[:3].Actions())
+	assert.Len(t, screen.Keyboard[2], 1)
+	assert.Equal(t, []string{screens.ActionInterestsContinue, screens.ActionBackToLevel}, screen.Keyboard[3:].Actions())
+
+	// Без категорий в справочнике остаются только "Продолжить" и "Назад"
+	assert.Len(t, newBuilder().InterestCategoriesKeyboard("en"), 1)
+}
+
+// TestBuilder_FeedbackCardKeyboard тестирует навигацию в первом ряду и кнопки по типу списка.
+func TestBuilder_FeedbackCardKeyboard(t *testing.T) {
+	builder := newBuilder()
+
+	keyboard := builder.FeedbackCardKeyboard(screens.FeedbackCard{FeedbackID: 7, Index: 1, Total: 3, ListType: "active", Attachments: 2})
+	assert.Equal(t, []string{"nav_active_feedback_0", "nav_active_feedback_2"}, keyboard[:1].Actions())
+	assert.Equal(t, []string{
+		localization.CallbackPrefixFeedbackReply + "7", localization.CallbackPrefixFeedbackAttachment + "7",
+		localization.CallbackPrefixFeedbackTriageMenu + "active_7", screens.ActionArchiveFeedbackPrefix + "1",
+		"back_to_active_feedbacks", screens.ActionFeedbackStats,
+	}, keyboard[1:].Actions())
+
+	// Единственный отзыв в архиве: без навигации, с возвратом и удалением
+	keyboard = builder.FeedbackCardKeyboard(screens.FeedbackCard{FeedbackID: 7, Index: 0, Total: 1, ListType: "archive"})
+	assert.Len(t, keyboard[0], 1)
+	assert.Contains(t, keyboard.Actions(), screens.ActionDeleteAllArchiveFeedbacks)
+	assert.NotContains(t, keyboard.Actions(), screens.ActionArchiveFeedbackPrefix+"0")
+}
+
+// TestParseFeedbackExportOptions тестирует разбор параметров выгрузки из действия кнопки.
+func TestParseFeedbackExportOptions(t *testing.T) {
+	options, ok := screens.ParseFeedbackExportOptions("jsonl_30_active")
+	assert.True(t, ok)
+	assert.Equal(t, "jsonl_30_active", options.String())
+
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	query := options.Query(now)
+	assert.Equal(t, models.FeedbackStatusActive, query.Status)
+	assert.Equal(t, now.AddDate(0, 0, -30), *query.From)
+	assert.Nil(t, query.To)
+
+	query = screens.FeedbackExportOptions{
+		Format: models.FeedbackExportCSV, Period: screens.FeedbackExportPeriodAll, Status: screens.FeedbackExportStatusAll,
+	}.Query(now)
+	assert.Empty(t, query.Status)
+	assert.Nil(t, query.From)
+
+	for _, data := range []string{"xlsx_30_all", "csv_5_all", "csv_30_deleted", "csv_30"} {
+		_, ok := screens.ParseFeedbackExportOptions(data)
+		assert.False(t, ok, data)
+	}
+}
+
+// TestBuilder_InterestEditorCategoryKeyboard тестирует отметку выбранных интересов и кнопки категории.
+func TestBuilder_InterestEditorCategoryKeyboard(t *testing.T) {
+	interests := []models.Interest{
+		{ID: 2, KeyName: "anime", DisplayOrder: 2},
+		{ID: 1, KeyName: "music", DisplayOrder: 1},
+		{ID: 3, KeyName: "movies", DisplayOrder: 3},
+	}
+
+	keyboard := newBuilder().InterestEditorCategoryKeyboard("en", "entertainment", interests, map[int]bool{2: true})
+
+	require.Len(t, keyboard, 4)
+	assert.Equal(t, []string{
+		screens.ActionInterestEditorTogglePrefix + "1", screens.ActionInterestEditorTogglePrefix + "2",
+		screens.ActionInterestEditorTogglePrefix + "3",
+	}, keyboard[:2].Actions())
+	assert.True(t, strings.HasPrefix(keyboard[0][1].Text, localization.SymbolChecked))
+	assert.True(t, strings.HasPrefix(keyboard[0][0].Text, localization.SymbolUnchecked))
+	assert.Equal(t, []string{
+		screens.ActionInterestEditorSelectAllPrefix + "entertainment", screens.ActionInterestEditorClearAllPrefix + "entertainment",
+		screens.ActionInterestEditorCategories, screens.ActionInterestEditorMenu,
+	}, keyboard[2:].Actions())
+
+	// Пустая категория: только навигация
+	assert.Len(t, newBuilder().InterestEditorCategoryKeyboard("en", "entertainment", nil, nil), 1)
+}
+
+// TestBuilder_InterestEditorScreens тестирует тексты экранов редактора интересов: сводку сессии,
+// счетчик основных, предпросмотр и итоги с изменениями по группам.
+func TestBuilder_InterestEditorScreens(t *testing.T) {
+	builder := newBuilder()
+	interests := []models.Interest{{ID: 101, KeyName: "music"}, {ID: 102, KeyName: "anime"}}
+	changes := []screens.InterestEditorChange{
+		{Action: localization.ActionAdd, InterestID: 101, KeyName: "music"},
+		{Action: localization.ActionRemove, InterestID: 103, KeyName: "movies"},
+		{Action: localization.ActionSetPrimary, InterestID: 101, KeyName: "music"},
+	}
+	stats := screens.InterestEditorStats{Total: 2, Primary: 1, Changes: 3, Categories: map[string]int{"sports": 1, "entertainment": 1}}
+
+	screen := builder.InterestEditorMenu("en", stats)
+	assert.Equal(t, "edit_interests_breadcrumb\n\nedit_interests_main_menu\n\n"+
+		"📊 total_interests: 2 | ⭐ primary_interests_label: 1 | 🔄 changes_count: 3", screen.Text)
+	assert.Equal(t, builder.InterestEditorMenuKeyboard("en"), screen.Keyboard)
+
+	screen = builder.InterestEditorCategory("en", "entertainment", interests, nil)
+	assert.Equal(t, "edit_interests_breadcrumb_categories > entertainment\n\nedit_interests_in_category", screen.Text)
+
+	screen = builder.InterestEditorPrimary("en", interests, map[int]bool{102: true}, 3)
+	assert.Equal(t, "edit_interests_breadcrumb_primary\n\nedit_interests_primary_description (1 из 3 выбрано)", screen.Text)
+	assert.Equal(t, []string{
+		screens.ActionInterestEditorPrimaryPrefix + "101", screens.ActionInterestEditorPrimaryPrefix + "102",
+		screens.ActionInterestEditorMenu, screens.ActionInterestEditorCategories,
+	}, screen.Keyboard.Actions())
+
+	screen = builder.InterestEditorPrimaryRefusal("en", "too_many", interests, nil)
+	assert.Equal(t, "too_many\n\nedit_interests_primary_description", screen.Text)
+
+	screen = builder.InterestEditorPreview("en", changes)
+	assert.Equal(t, "edit_interests_changes_preview\n\n✅ added_interests:\n• music\n\n❌ removed_interests:\n• movies\n\n", screen.Text)
+	assert.Equal(t, builder.InterestEditorPreviewKeyboard("en"), screen.Keyboard)
+	assert.Equal(t, "edit_interests_changes_preview\n\nno_changes_made", builder.InterestEditorPreview("en", nil).Text)
+
+	screen = builder.InterestEditorSaved("en", changes)
+	assert.Equal(t, "✅ Изменения успешно сохранены!\n\n📊 Внесено изменений: 3\n\n📝 Детали изменений:"+
+		"\n\n➕ Добавлены:\n• music\n\n➖ Удалены:\n• movies\n\n⭐ Сделаны основными:\n• music", screen.Text)
+	assert.Equal(t, []string{screens.ActionProfileShow}, screen.Keyboard.Actions())
+	assert.Equal(t, "❌ Редактирование отменено!\n\n📊 Отменено изменений: 0", builder.InterestEditorCancelled("en", nil).Text)
+
+	screen = builder.InterestEditorStatistics("en", stats, 5*time.Minute)
+	assert.Equal(t, "edit_interests_detailed_statistics\n\n📊 total_interests: 2\n⭐ primary_interests_label: 1\n"+
+		"🔄 changes_count: 3\n⏱️ session_duration: 5m0s\n\ncategory_statistics:\n• entertainment: 1\n• sports: 1\n", screen.Text)
+
+	screen = builder.InterestSuggestionSent("en")
+	assert.Equal(t, localization.LocaleInterestSuggestSent, screen.Text)
+	assert.Equal(t, []string{localization.CallbackIsolatedSuggestCancel}, screen.Keyboard.Actions())
+}
+
+// TestBuilder_OnboardingInterests тестирует экраны выбора интересов при заполнении профиля:
+// интересы категории, основные интересы с подсказкой и итог выбора.
+func TestBuilder_OnboardingInterests(t *testing.T) {
+	builder := newBuilder()
+	interests := []models.Interest{
+		{ID: 102, KeyName: "anime", DisplayOrder: 2},
+		{ID: 101, KeyName: "music", DisplayOrder: 1},
+		{ID: 103, KeyName: "movies", DisplayOrder: 3},
+	}
+
+	screen := builder.CategoryInterests("en", "entertainment", interests, map[int]bool{102: true})
+	assert.Equal(t, "entertainment - choose_interests", screen.Text)
+	assert.Equal(t, []string{
+		screens.ActionInterestSelectPrefix + "101", screens.ActionInterestSelectPrefix + "102",
+		screens.ActionInterestSelectPrefix + "103", screens.ActionBackToCategories,
+	}, screen.Keyboard.Actions())
+	assert.Equal(t, localization.SymbolChecked+"anime", screen.Keyboard[0][1].Text)
+	assert.Equal(t, 102, interests[0].ID, "порядок переданных интересов не меняется")
+
+	// Основные интересы идут в порядке выбора; подсказка считает оставшиеся
+	screen = builder.PrimaryInterests("en", interests, map[int]bool{101: true}, 2, "")
+	assert.Equal(t, "choose_primary_interests_remaining", screen.Text)
+	assert.Equal(t, []string{
+		screens.ActionPrimaryInterestPrefix + "102", screens.ActionPrimaryInterestPrefix + "101",
+		screens.ActionPrimaryInterestPrefix + "103",
+		screens.ActionPrimaryInterestsContinue, screens.ActionBackToInterestSelection,
+	}, screen.Keyboard.Actions())
+	assert.Equal(t, localization.SymbolStar+"music", screen.Keyboard[0][1].Text)
+
+	assert.Equal(t, "choose_primary_interests_dynamic", builder.PrimaryInterests("en", interests, nil, 2, "").Text)
+	assert.Equal(t, localization.MessageMaxPrimaryInterestsFallback,
+		builder.PrimaryInterests("en", interests, map[int]bool{101: true, 103: true}, 2, "").Text)
+
+	warning := builder.PrimaryInterestsMinimum("en", 2)
+	assert.Contains(t, warning, "2")
+	assert.Equal(t, warning+"\n\nchoose_primary_interests", builder.PrimaryInterests("en", interests, nil, 2, warning).Text)
+
+	screen = builder.InterestsCompleted("en", &models.UserInterestSummary{
+		PrimaryInterests: []models.InterestWithCategory{{Interest: interests[1]}, {Interest: interests[0]}},
+	})
+	assert.Equal(t, "interests_selection_complete\n\nprimary_interests_label music, anime\n\ninterests_feedback_suggestion", screen.Text)
+	assert.Equal(t, []string{screens.ActionContinueToAvailability}, screen.Keyboard.Actions())
+
+	screen = builder.InterestCategoriesRequired(&models.User{InterfaceLanguageCode: "en"})
+	assert.True(t, strings.HasPrefix(screen.Text, "❗ "))
+	assert.Contains(t, screen.Keyboard.Actions(), screens.ActionInterestsContinue)
+}
+
+// TestBuilder_ProfileInterests тестирует экраны редактирования интересов из профиля:
+// основные интересы идут первыми, итог показывает счетчики и меню профиля.
+func TestBuilder_ProfileInterests(t *testing.T) {
+	builder := newBuilder()
+	interests := []models.Interest{{ID: 102, KeyName: "anime"}, {ID: 101, KeyName: "music"}, {ID: 103, KeyName: "movies"}}
+
+	screen := builder.ProfileInterestCategories("en")
+	assert.Equal(t, "edit_interests_from_profile\n\nchoose_interest_category", screen.Text)
+	assert.Equal(t, []string{screens.ActionInterestsContinue, screens.ActionBackToProfile}, screen.Keyboard[len(screen.Keyboard)-1:].Actions())
+
+	screen = builder.ProfileCategoryInterests("en", "entertainment", interests, map[int]bool{101: true})
+	assert.Equal(t, "edit_interests_in_category entertainment", screen.Text)
+	assert.Equal(t, []string{screens.ActionProfileInterestCategories}, screen.Keyboard[2:].Actions())
+
+	screen = builder.ProfilePrimaryInterests("en", interests, map[int]bool{103: true})
+	assert.Equal(t, []string{
+		screens.ActionProfilePrimaryInterestPrefix + "103", screens.ActionProfilePrimaryInterestPrefix + "101",
+		screens.ActionProfilePrimaryInterestPrefix + "102",
+		screens.ActionBackToCategories, screens.ActionBackToProfile, screens.ActionProfileInterestsSave,
+	}, screen.Keyboard.Actions())
+	assert.Equal(t, localization.SymbolStar+"movies", screen.Keyboard[0][0].Text)
+
+	screen = builder.ProfileInterestsSaved("en", &models.UserInterestSummary{
+		TotalInterests:   3,
+		PrimaryInterests: []models.InterestWithCategory{{Interest: interests[2]}},
+	})
+	assert.Equal(t, "interests_updated_successfully\n\ntotal_interests: 3\nprimary_interests_label: 1\nadditional_interests_label: 0", screen.Text)
+	assert.Equal(t, builder.ProfileMenuKeyboard("en"), screen.Keyboard)
+}
+
+// TestBuilder_AvailabilityEditor тестирует кнопку сохранения по наличию изменений
+// и формат действий переключателей.
+func TestBuilder_AvailabilityEditor(t *testing.T) {
+	builder := newBuilder()
+
+	assert.NotContains(t, builder.AvailabilityEditorMenuKeyboard("en", false).Actions(), localization.CallbackAvailSaveChanges)
+	assert.Contains(t, builder.AvailabilityEditorMenuKeyboard("en", true).Actions(), localization.CallbackAvailSaveChanges)
+
+	keyboard := builder.AvailabilityEditorDaysKeyboard("en", []string{"friday"})
+	require.Len(t, keyboard, 5)
+	assert.Equal(t, localization.CallbackPrefixAvailEditDay+"_friday", keyboard[2][0].Action)
+	assert.True(t, strings.HasPrefix(keyboard[2][0].Text, "✅"))
+	assert.Equal(t, []string{localization.CallbackAvailApplyDays, localization.CallbackAvailBackToEditMenu}, keyboard[4:].Actions())
+
+	screen := builder.AvailabilityVacation("en", "", nil)
+	assert.Equal(t, []string{localization.CallbackAvailVacationAdd, localization.CallbackAvailVacationBack}, screen.Keyboard.Actions())
+}
+
+// TestBuilder_AvailabilitySteps тестирует шаги настройки доступности: переключатели по два в ряд,
+// перечень выбранных в порядке недели и ошибку под перечнем.
+func TestBuilder_AvailabilitySteps(t *testing.T) {
+	builder := newBuilder()
+
+	screen := builder.AvailabilitySpecificDays("en", []string{"friday", "monday"})
+	require.Len(t, screen.Keyboard, 5)
+	assert.Equal(t, localization.CallbackAvailSpecificDayMonday, screen.Keyboard[0][0].Action)
+	assert.True(t, strings.HasPrefix(screen.Keyboard[0][0].Text, "✅"))
+	assert.True(t, strings.HasPrefix(screen.Keyboard[0][1].Text, "☑"))
+	assert.Equal(t, "select_specific_days\n\nselected_days: day_monday, day_friday", screen.Text)
+	assert.Equal(t, []string{screens.ActionAvailabilityBackToDayType, screens.ActionAvailabilityProceedToTime}, screen.Keyboard[4:].Actions())
+
+	screen = builder.AvailabilityTimeSlotsStep("en", nil, "error_no_time_selected")
+	assert.Equal(t, "select_time_slot\n\nselected_slots: none_selected\n\nerror_no_time_selected", screen.Text)
+	assert.Equal(t, []string{
+		localization.CallbackAvailTimeSlotMorning, localization.CallbackAvailTimeSlotDay,
+		localization.CallbackAvailTimeSlotEvening, localization.CallbackAvailTimeSlotLate,
+		screens.ActionAvailabilityAllTimeSlots, screens.ActionAvailabilityBackToDays, screens.ActionAvailabilityProceedToCommStyles,
+	}, screen.Keyboard.Actions())
+
+	// Способы общения перечисляются в порядке выбора
+	screen = builder.AvailabilityCommunicationStep("en", []string{"video_call", "text"})
+	assert.Equal(t, "select_communication_style\n\nselected_styles: "+localization.LocaleCommVideo+", "+localization.LocaleCommText, screen.Text)
+	require.Len(t, screen.Keyboard, 5)
+	assert.Equal(t, localization.CallbackAvailCommunicationMeetPerson, screen.Keyboard[2][0].Action)
+	assert.Equal(t, []string{screens.ActionAvailabilityAllCommunication}, screen.Keyboard[3:4].Actions())
+	assert.Equal(t, []string{screens.ActionAvailabilityBackToTime, screens.ActionAvailabilityProceedToFrequency}, screen.Keyboard[4:].Actions())
+}
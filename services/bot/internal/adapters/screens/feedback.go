@@ -0,0 +1,81 @@
+package screens
+
+import (
+	"fmt"
+	"strconv"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+)
+
+// Feedback - приглашение написать отзыв.
+func (b *Builder) Feedback(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text:     b.text(lang, localization.LocaleFeedbackText),
+		Keyboard: b.feedbackKeyboard(lang),
+	}
+}
+
+// FeedbackHelp - подсказка, как написать полезный отзыв.
+func (b *Builder) FeedbackHelp(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text:     b.text(lang, localization.LocaleFeedbackHelpTitle) + "\n\n" + b.text(lang, localization.LocaleFeedbackHelpContent),
+		Keyboard: b.feedbackKeyboard(lang),
+	}
+}
+
+// feedbackKeyboard - возврат в главное меню и подсказка по отзывам.
+func (b *Builder) feedbackKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(b.mainMenuButton(lang)),
+		adapters.Row(b.button(lang, localization.LocaleFeedbackHelp, ActionFeedbackHelp)),
+	}
+}
+
+// FeedbackSaved - благодарность за отправленный отзыв.
+func (b *Builder) FeedbackSaved(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text:     b.text(lang, "feedback_saved"),
+		Keyboard: adapters.Keyboard{adapters.Row(b.mainMenuButton(lang))},
+	}
+}
+
+// FeedbackRejected - отзыв не прошел проверку длины; length - длина текста в символах.
+func (b *Builder) FeedbackRejected(lang string, length int, tooLong bool) adapters.Screen {
+	key := "feedback_too_short"
+	if tooLong {
+		key = "feedback_too_long"
+	}
+
+	return adapters.Screen{
+		Text: b.service.Localizer.GetWithParams(lang, key, map[string]string{"count": strconv.Itoa(length)}),
+	}
+}
+
+// FeedbackAttachmentAdded - вложение добавлено в черновик; отзыв можно отправить без текста.
+func (b *Builder) FeedbackAttachmentAdded(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text: b.text(lang, localization.LocaleFeedbackAttachmentAdded),
+		Keyboard: adapters.Keyboard{
+			adapters.Row(b.button(lang, localization.LocaleFeedbackSendButton, localization.CallbackFeedbackDraftSend)),
+		},
+	}
+}
+
+// FeedbackReplyDelivered - ответ администратора автору отзыва с кнопкой ответа в переписке.
+func (b *Builder) FeedbackReplyDelivered(lang string, feedbackID int, feedbackText, replyText string) adapters.Screen {
+	return adapters.Screen{
+		Text: b.service.Localizer.GetWithParams(lang, localization.LocaleFeedbackReplyHeader, map[string]string{
+			"feedback": feedbackText,
+			"text":     replyText,
+		}),
+		Keyboard: adapters.Keyboard{adapters.Row(
+			b.button(lang, localization.LocaleFeedbackAnswerButton, fmt.Sprintf("%s%d", localization.CallbackPrefixFeedbackAnswer, feedbackID)),
+		)},
+	}
+}
+
+// FeedbackCancelKeyboard - отмена ввода ответа в переписке по отзыву.
+func (b *Builder) FeedbackCancelKeyboard(lang, action string) adapters.Keyboard {
+	return adapters.Keyboard{adapters.Row(b.button(lang, localization.LocaleFeedbackCancelButton, action))}
+}
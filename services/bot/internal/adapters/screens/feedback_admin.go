@@ -0,0 +1,463 @@
+package screens
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Действия просмотра отзывов администратором.
+const (
+	ActionFeedbackStats              = "back_to_feedback_stats"
+	ActionShowActiveFeedbacks        = "show_active_feedbacks"
+	ActionShowArchiveFeedbacks       = "show_archive_feedbacks"
+	ActionShowAllFeedbacks           = "show_all_feedbacks"
+	ActionArchiveFeedbackPrefix      = "archive_feedback_"        // + индекс в списке
+	ActionUnarchiveFeedbackPrefix    = "unarchive_feedback_"      // + индекс в списке
+	ActionDeleteFeedbackPrefix       = "delete_current_feedback_" // + индекс в списке
+	ActionDeleteAllArchiveFeedbacks  = "delete_all_archive_feedbacks"
+	ActionConfirmDeleteAllArchive    = "confirm_delete_all_archive"
+	ActionBackToArchiveFeedbacksList = "back_to_archive_feedbacks"
+)
+
+// Параметры выгрузки отзывов.
+const (
+	FeedbackExportPeriodDefault = "30"
+	FeedbackExportPeriodAll     = "all"
+	FeedbackExportStatusAll     = "all"
+)
+
+// FeedbackExportPeriods - периоды выгрузки в днях в порядке вывода.
+var FeedbackExportPeriods = []string{"7", "30", "90", FeedbackExportPeriodAll}
+
+// FeedbackExportStatuses - статусы выгрузки в порядке вывода.
+var FeedbackExportStatuses = []string{FeedbackExportStatusAll, models.FeedbackStatusActive, models.FeedbackStatusProcessed}
+
+// feedbackCategoryLabels - подписи категорий отзывов в просмотре отзывов.
+var feedbackCategoryLabels = map[string]string{
+	models.FeedbackCategoryBug:       "🐞 Ошибка",
+	models.FeedbackCategoryFeature:   "💡 Предложение",
+	models.FeedbackCategoryComplaint: "😠 Жалоба",
+	models.FeedbackCategoryPraise:    "🙏 Благодарность",
+}
+
+// feedbackPriorityLabels - подписи приоритетов отзывов в просмотре отзывов.
+var feedbackPriorityLabels = map[string]string{
+	models.FeedbackPriorityLow:    "🟢 Низкий",
+	models.FeedbackPriorityNormal: "🟡 Обычный",
+	models.FeedbackPriorityHigh:   "🟠 Высокий",
+	models.FeedbackPriorityUrgent: "🔴 Срочный",
+}
+
+// FeedbackCategoryLabel возвращает подпись категории отзыва.
+func FeedbackCategoryLabel(category string) string {
+	if label, ok := feedbackCategoryLabels[category]; ok {
+		return label
+	}
+
+	return "❔ Не определена"
+}
+
+// FeedbackPriorityLabel возвращает подпись приоритета отзыва.
+func FeedbackPriorityLabel(priority string) string {
+	if label, ok := feedbackPriorityLabels[priority]; ok {
+		return label
+	}
+
+	return priority
+}
+
+// FeedbackCard - положение отзыва в просматриваемом списке.
+type FeedbackCard struct {
+	FeedbackID  int
+	Index       int
+	Total       int
+	ListType    string // active, archive, all или filtered
+	Attachments int
+}
+
+// FeedbackExportOptions - параметры выгрузки отзывов, выбранные в меню. Параметры передаются
+// в действиях кнопок целиком, поэтому меню выгрузки не хранит состояние.
+type FeedbackExportOptions struct {
+	Format string
+	Period string
+	Status string
+}
+
+// String кодирует параметры для действия кнопки: формат_период_статус.
+func (o FeedbackExportOptions) String() string {
+	return o.Format + "_" + o.Period + "_" + o.Status
+}
+
+// Query возвращает условия выгрузки на момент now.
+func (o FeedbackExportOptions) Query(now time.Time) models.FeedbackQuery {
+	var query models.FeedbackQuery
+
+	if o.Status != FeedbackExportStatusAll {
+		query.Status = o.Status
+	}
+
+	if days, err := strconv.Atoi(o.Period); err == nil {
+		from := now.AddDate(0, 0, -days)
+		query.From = &from
+	}
+
+	return query
+}
+
+// ParseFeedbackExportOptions разбирает параметры выгрузки из действия кнопки.
+func ParseFeedbackExportOptions(value string) (FeedbackExportOptions, bool) {
+	parts := strings.Split(value, "_")
+	if len(parts) != 3 {
+		return FeedbackExportOptions{}, false
+	}
+
+	options := FeedbackExportOptions{Format: parts[0], Period: parts[1], Status: parts[2]}
+
+	valid := (options.Format == models.FeedbackExportCSV || options.Format == models.FeedbackExportJSONL) &&
+		slices.Contains(FeedbackExportPeriods, options.Period) && slices.Contains(FeedbackExportStatuses, options.Status)
+
+	return options, valid
+}
+
+// FeedbackExportPeriodLabel возвращает подпись периода выгрузки.
+func FeedbackExportPeriodLabel(period string) string {
+	if period == FeedbackExportPeriodAll {
+		return "все время"
+	}
+
+	return period + " дн."
+}
+
+// FeedbackExportStatusLabel возвращает подпись статуса выгрузки.
+func FeedbackExportStatusLabel(status string) string {
+	switch status {
+	case models.FeedbackStatusActive:
+		return "активные"
+	case models.FeedbackStatusProcessed:
+		return "обработанные"
+	default:
+		return "все"
+	}
+}
+
+// FeedbackStats - статистика отзывов и меню просмотра.
+func (b *Builder) FeedbackStats(active, breached, archived, total int) adapters.Screen {
+	text := "📊 Статистика отзывов:\n\n"
+	text += fmt.Sprintf("🔥 Активные: %d\n", active)
+	text += fmt.Sprintf("⏰ Просрочены: %d\n", breached)
+	text += fmt.Sprintf("📦 Обработанные: %d\n", archived)
+	text += fmt.Sprintf("📈 Всего: %d", total)
+
+	return adapters.Screen{Text: text, Keyboard: b.FeedbackStatsKeyboard()}
+}
+
+// FeedbackStatsKeyboard - меню просмотра отзывов: списки, фильтр, поиск и выгрузка.
+func (b *Builder) FeedbackStatsKeyboard() adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(
+			adapters.Button{Text: "🔥 Активные", Action: ActionShowActiveFeedbacks},
+			adapters.Button{Text: "📦 Обработанные", Action: ActionShowArchiveFeedbacks},
+		),
+		adapters.Row(adapters.Button{Text: "📋 Все отзывы", Action: ActionShowAllFeedbacks}),
+		adapters.Row(
+			adapters.Button{Text: "🔎 Фильтр", Action: localization.CallbackFeedbackFilterMenu},
+			adapters.Button{Text: "🔍 Поиск", Action: localization.CallbackFeedbackSearchStart},
+		),
+		adapters.Row(adapters.Button{Text: "📤 Выгрузка", Action: localization.CallbackFeedbackExportMenu}),
+	}
+}
+
+// FeedbackNotice - сообщение в просмотре отзывов с возвратом к статистике.
+func (b *Builder) FeedbackNotice(text string) adapters.Screen {
+	return adapters.Screen{
+		Text:     text,
+		Keyboard: adapters.Keyboard{adapters.Row(feedbackStatsButton())},
+	}
+}
+
+// FeedbackList - список отзывов для выбора карточки. Заголовок в HTML.
+func (b *Builder) FeedbackList(listType string, feedbacks []map[string]interface{}) adapters.Screen {
+	titles := map[string]string{
+		localization.FeedbackTypeActiveLocal:  "🔥 <b>Активные отзывы (%d):</b>",
+		localization.FeedbackTypeArchiveLocal: "📦 <b>Обработанные отзывы (%d):</b>",
+		localization.FeedbackTypeAllLocal:     "📋 <b>Все отзывы (%d):</b>",
+	}
+
+	keyboard := make(adapters.Keyboard, 0, len(feedbacks)+1)
+
+	for i, feedback := range feedbacks {
+		// В общем списке значок показывает статус отзыва
+		status := "📝"
+		if listType == localization.FeedbackTypeAllLocal {
+			status = "🔥"
+			if processed, _ := feedback["is_processed"].(bool); processed {
+				status = "📦"
+			}
+		}
+
+		firstName, _ := feedback["first_name"].(string)
+		label := fmt.Sprintf("%s %s (ID: %d)", status, firstName, feedback["id"])
+
+		if username, ok := feedback["username"].(string); ok && username != "" {
+			label = fmt.Sprintf("%s %s (@%s) (ID: %d)", status, firstName, username, feedback["id"])
+		}
+
+		keyboard = append(keyboard, adapters.Row(adapters.Button{
+			Text:   label,
+			Action: feedbackNavigateAction(listType, i),
+		}))
+	}
+
+	keyboard = append(keyboard, adapters.Row(feedbackStatsButton()))
+
+	return adapters.Screen{
+		Text:     fmt.Sprintf(titles[listType], len(feedbacks)) + "\n\nВыберите отзыв для просмотра:",
+		Keyboard: keyboard,
+	}
+}
+
+// FeedbackCardKeyboard - действия с карточкой отзыва: навигация по списку, ответ, вложения,
+// разметка и смена статуса. Первый ряд - навигация, остальные кнопки по одной в ряд.
+func (b *Builder) FeedbackCardKeyboard(card FeedbackCard) adapters.Keyboard {
+	var buttons []adapters.Button
+
+	if card.Index > 0 {
+		buttons = append(buttons, adapters.Button{Text: "⬅️ Предыдущий", Action: feedbackNavigateAction(card.ListType, card.Index-1)})
+	}
+
+	if card.Index < card.Total-1 {
+		buttons = append(buttons, adapters.Button{Text: "➡️ Следующий", Action: feedbackNavigateAction(card.ListType, card.Index+1)})
+	}
+
+	// Ответ доставляется автору отзыва
+	buttons = append(buttons, adapters.Button{
+		Text:   "💬 Ответить",
+		Action: fmt.Sprintf("%s%d", localization.CallbackPrefixFeedbackReply, card.FeedbackID),
+	})
+
+	// Файлы отзыва присылаются отдельными сообщениями
+	if card.Attachments > 0 {
+		buttons = append(buttons, adapters.Button{
+			Text:   fmt.Sprintf("📎 Вложения (%d)", card.Attachments),
+			Action: fmt.Sprintf("%s%d", localization.CallbackPrefixFeedbackAttachment, card.FeedbackID),
+		})
+	}
+
+	buttons = append(buttons, adapters.Button{
+		Text:   "🏷 Разметка",
+		Action: fmt.Sprintf("%s%s_%d", localization.CallbackPrefixFeedbackTriageMenu, card.ListType, card.FeedbackID),
+	})
+
+	switch card.ListType {
+	case localization.FeedbackTypeActiveLocal:
+		buttons = append(buttons, adapters.Button{
+			Text: "📦 В обработанные", Action: ActionArchiveFeedbackPrefix + strconv.Itoa(card.Index),
+		})
+	case localization.FeedbackTypeArchiveLocal:
+		buttons = append(buttons,
+			adapters.Button{Text: "🔄 Вернуть в активные", Action: ActionUnarchiveFeedbackPrefix + strconv.Itoa(card.Index)},
+			adapters.Button{Text: "🗑️ Удалить текущий", Action: ActionDeleteFeedbackPrefix + strconv.Itoa(card.Index)},
+			adapters.Button{Text: "🗑️ Удалить все", Action: ActionDeleteAllArchiveFeedbacks},
+		)
+	}
+
+	buttons = append(buttons,
+		adapters.Button{Text: "📋 К списку", Action: fmt.Sprintf("back_to_%s_feedbacks", card.ListType)},
+		adapters.Button{Text: "📊 К статистике", Action: ActionFeedbackStats},
+	)
+
+	navigation := 0
+	if card.Index > 0 {
+		navigation++
+	}
+
+	if card.Index < card.Total-1 {
+		navigation++
+	}
+
+	keyboard := make(adapters.Keyboard, 0, len(buttons))
+	if navigation > 0 {
+		keyboard = append(keyboard, buttons[:navigation])
+	}
+
+	for _, button := range buttons[navigation:] {
+		keyboard = append(keyboard, adapters.Row(button))
+	}
+
+	return keyboard
+}
+
+// FeedbackDeleteAllConfirm - подтверждение удаления всех обработанных отзывов. Текст в HTML.
+func (b *Builder) FeedbackDeleteAllConfirm(count int) adapters.Screen {
+	return adapters.Screen{
+		Text: fmt.Sprintf("⚠️ <b>Подтверждение удаления</b>\n\nВы действительно хотите удалить <b>%d обработанных отзывов</b>?"+
+			"\n\n❗️ <b>Это действие нельзя отменить!</b>", count),
+		Keyboard: adapters.Keyboard{adapters.Row(
+			adapters.Button{Text: "✅ Да, удалить все", Action: ActionConfirmDeleteAllArchive},
+			adapters.Button{Text: "❌ Отмена", Action: ActionBackToArchiveFeedbacksList},
+		)},
+	}
+}
+
+// FeedbackTriageKeyboard - выбор категории, приоритета и ответственного отзыва из списка listType.
+func (b *Builder) FeedbackTriageKeyboard(listType string, feedbackID int, category, priority string, assignedToMe bool) adapters.Keyboard {
+	target := fmt.Sprintf("%s_%d", listType, feedbackID)
+	keyboard := feedbackOptionRows(category, priority,
+		localization.CallbackPrefixFeedbackTriageCat+target+"_", localization.CallbackPrefixFeedbackTriagePri+target+"_")
+
+	assign := "🙋 Взять себе"
+	if assignedToMe {
+		assign = "🚫 Снять с себя"
+	}
+
+	return append(keyboard,
+		adapters.Row(adapters.Button{Text: assign, Action: localization.CallbackPrefixFeedbackTriageMine + target}),
+		adapters.Row(adapters.Button{Text: "⬅️ К отзыву", Action: localization.CallbackPrefixFeedbackTriageBack + target}),
+	)
+}
+
+// FeedbackFilterKeyboard - настройка фильтра активных отзывов; matched - сколько отзывов подходит.
+func (b *Builder) FeedbackFilterKeyboard(filter models.FeedbackFilter, matched int) adapters.Keyboard {
+	keyboard := feedbackOptionRows(filter.Category, filter.Priority,
+		localization.CallbackPrefixFeedbackFilterCat, localization.CallbackPrefixFeedbackFilterPri)
+
+	return append(keyboard,
+		adapters.Row(
+			adapters.Button{Text: MarkSelected("🙋 Мои", filter.AssignedToMe), Action: localization.CallbackFeedbackFilterMine},
+			adapters.Button{Text: MarkSelected("⏰ Просроченные", filter.Breached), Action: localization.CallbackFeedbackFilterBreached},
+		),
+		adapters.Row(
+			adapters.Button{Text: fmt.Sprintf("👀 Показать (%d)", matched), Action: localization.CallbackFeedbackFilterShow},
+			adapters.Button{Text: "♻️ Сбросить", Action: localization.CallbackFeedbackFilterReset},
+		),
+		adapters.Row(feedbackStatsButton()),
+	)
+}
+
+// FeedbackSearchKeyboard - открытие найденных отзывов, новый поиск и возврат к статистике.
+func (b *Builder) FeedbackSearchKeyboard(records []models.FeedbackRecord) adapters.Keyboard {
+	var keyboard adapters.Keyboard
+
+	var row []adapters.Button
+
+	for _, record := range records {
+		row = append(row, adapters.Button{
+			Text:   fmt.Sprintf("#%d", record.ID),
+			Action: fmt.Sprintf("%s%d", localization.CallbackPrefixFeedbackSearchOpen, record.ID),
+		})
+
+		if len(row) == localization.ButtonsPerRow {
+			keyboard = append(keyboard, row)
+			row = nil
+		}
+	}
+
+	if len(row) > 0 {
+		keyboard = append(keyboard, row)
+	}
+
+	return append(keyboard, adapters.Row(
+		adapters.Button{Text: "🔍 Новый поиск", Action: localization.CallbackFeedbackSearchStart},
+		feedbackStatsButton(),
+	))
+}
+
+// FeedbackExport - выбранные параметры выгрузки отзывов и кнопки для их изменения.
+func (b *Builder) FeedbackExport(options FeedbackExportOptions) adapters.Screen {
+	text := "📤 Выгрузка отзывов:\n\n"
+	text += fmt.Sprintf("📄 Формат: %s\n", strings.ToUpper(options.Format))
+	text += fmt.Sprintf("📅 Период: %s\n", FeedbackExportPeriodLabel(options.Period))
+	text += fmt.Sprintf("📌 Статус: %s\n\n", FeedbackExportStatusLabel(options.Status))
+	text += "Файл придет отдельным сообщением."
+
+	button := func(label string, selected bool, next FeedbackExportOptions) adapters.Button {
+		return adapters.Button{Text: MarkSelected(label, selected), Action: localization.CallbackPrefixFeedbackExportSet + next.String()}
+	}
+
+	var formats, periods, statuses []adapters.Button
+
+	for _, format := range []string{models.FeedbackExportCSV, models.FeedbackExportJSONL} {
+		next := options
+		next.Format = format
+		formats = append(formats, button(strings.ToUpper(format), format == options.Format, next))
+	}
+
+	for _, period := range FeedbackExportPeriods {
+		next := options
+		next.Period = period
+		periods = append(periods, button(FeedbackExportPeriodLabel(period), period == options.Period, next))
+	}
+
+	for _, status := range FeedbackExportStatuses {
+		next := options
+		next.Status = status
+		statuses = append(statuses, button(FeedbackExportStatusLabel(status), status == options.Status, next))
+	}
+
+	return adapters.Screen{
+		Text: text,
+		Keyboard: adapters.Keyboard{
+			formats,
+			periods,
+			statuses,
+			adapters.Row(adapters.Button{Text: "📥 Получить файл", Action: localization.CallbackPrefixFeedbackExportGet + options.String()}),
+			adapters.Row(feedbackStatsButton()),
+		},
+	}
+}
+
+// MarkSelected отмечает выбранный вариант в подписи кнопки.
+func MarkSelected(label string, selected bool) string {
+	if selected {
+		return "✅ " + label
+	}
+
+	return label
+}
+
+// feedbackOptionRows - категории и затем приоритеты отзыва по две кнопки в ряд;
+// выбранные значения отмечены. Действие кнопки - префикс + значение.
+func feedbackOptionRows(category, priority, categoryPrefix, priorityPrefix string) adapters.Keyboard {
+	var keyboard adapters.Keyboard
+
+	var row []adapters.Button
+
+	add := func(button adapters.Button) {
+		row = append(row, button)
+		if len(row) == localization.ButtonsPerRow {
+			keyboard = append(keyboard, row)
+			row = nil
+		}
+	}
+
+	for _, option := range models.FeedbackCategories {
+		add(adapters.Button{Text: MarkSelected(FeedbackCategoryLabel(option), option == category), Action: categoryPrefix + option})
+	}
+
+	for _, option := range models.FeedbackPriorities {
+		add(adapters.Button{Text: MarkSelected(FeedbackPriorityLabel(option), option == priority), Action: priorityPrefix + option})
+	}
+
+	if len(row) > 0 {
+		keyboard = append(keyboard, row)
+	}
+
+	return keyboard
+}
+
+// feedbackNavigateAction - действие открытия карточки index в списке listType.
+func feedbackNavigateAction(listType string, index int) string {
+	return fmt.Sprintf("nav_%s_feedback_%d", listType, index)
+}
+
+// feedbackStatsButton - возврат к статистике отзывов.
+func feedbackStatsButton() adapters.Button {
+	return adapters.Button{Text: "📊 К статистике", Action: ActionFeedbackStats}
+}
@@ -0,0 +1,384 @@
+package screens
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Действия редактора интересов профиля.
+const (
+	ActionInterestEditorMenu            = "isolated_main_menu"
+	ActionInterestEditorCategories      = "isolated_edit_categories"
+	ActionInterestEditorPrimary         = "isolated_edit_primary"
+	ActionInterestEditorPreview         = "isolated_preview_changes"
+	ActionInterestEditorSave            = "isolated_save_changes"
+	ActionInterestEditorCancel          = "isolated_cancel_edit"
+	ActionInterestEditorUndo            = "isolated_undo_last"
+	ActionInterestEditorCategoryPrefix  = "isolated_edit_category_"   // + ключ категории
+	ActionInterestEditorTogglePrefix    = "isolated_toggle_interest_" // + ID интереса
+	ActionInterestEditorPrimaryPrefix   = "isolated_toggle_primary_"  // + ID интереса
+	ActionInterestEditorSelectAllPrefix = "isolated_select_all_"      // + ключ категории
+	ActionInterestEditorClearAllPrefix  = "isolated_clear_all_"       // + ключ категории
+)
+
+// InterestEditorChange - изменение интереса за сессию редактора.
+type InterestEditorChange struct {
+	Action     string // localization.ActionAdd, ActionRemove, ActionSetPrimary или ActionUnsetPrimary
+	InterestID int
+	KeyName    string
+}
+
+// InterestEditorStats - состояние сессии редактора: выбранные интересы, основные, изменения
+// и число выбранных по ключу категории.
+type InterestEditorStats struct {
+	Total      int
+	Primary    int
+	Changes    int
+	Categories map[string]int
+}
+
+// InterestEditorMenu - главное меню редактора с хлебными крошками и сводкой сессии.
+func (b *Builder) InterestEditorMenu(lang string, stats InterestEditorStats) adapters.Screen {
+	summary := fmt.Sprintf("📊 %s: %d | ⭐ %s: %d | 🔄 %s: %d",
+		b.text(lang, "total_interests"), stats.Total,
+		b.text(lang, "primary_interests_label"), stats.Primary,
+		b.text(lang, "changes_count"), stats.Changes)
+
+	return adapters.Screen{
+		Text:     b.text(lang, "edit_interests_breadcrumb") + "\n\n" + b.text(lang, "edit_interests_main_menu") + "\n\n" + summary,
+		Keyboard: b.InterestEditorMenuKeyboard(lang),
+	}
+}
+
+// InterestEditorCategories - выбор категории; при рекомендациях текст упоминает их ряд.
+func (b *Builder) InterestEditorCategories(
+	lang string,
+	categories []models.InterestCategory,
+	progress map[string]string,
+	suggestions []models.InterestRecommendation,
+) adapters.Screen {
+	text := b.text(lang, "edit_interests_breadcrumb_categories") + "\n\n" + b.text(lang, "edit_interests_choose_category")
+	if len(suggestions) > 0 {
+		text += "\n\n" + b.text(lang, localization.LocaleInterestSuggestedForYou)
+	}
+
+	return adapters.Screen{Text: text, Keyboard: b.InterestEditorCategoriesKeyboard(lang, categories, progress, suggestions)}
+}
+
+// InterestEditorCategory - интересы категории с хлебными крошками до нее.
+func (b *Builder) InterestEditorCategory(lang, categoryKey string, interests []models.Interest, selected map[int]bool) adapters.Screen {
+	return adapters.Screen{
+		Text: b.text(lang, "edit_interests_breadcrumb_categories") + " > " + b.service.InterestCategoryName(lang, categoryKey) +
+			"\n\n" + b.text(lang, "edit_interests_in_category"),
+		Keyboard: b.InterestEditorCategoryKeyboard(lang, categoryKey, interests, selected),
+	}
+}
+
+// InterestEditorPrimary - выбор основных интересов; при recommended > 0 под описанием
+// показывается, сколько основных выбрано из рекомендуемых.
+func (b *Builder) InterestEditorPrimary(lang string, interests []models.Interest, primary map[int]bool, recommended int) adapters.Screen {
+	text := b.text(lang, "edit_interests_breadcrumb_primary") + "\n\n" + b.text(lang, "edit_interests_primary_description")
+	if recommended > 0 {
+		selected := 0
+
+		for _, interest := range interests {
+			if primary[interest.ID] {
+				selected++
+			}
+		}
+
+		text += fmt.Sprintf(" (%d из %d выбрано)", selected, recommended)
+	}
+
+	return adapters.Screen{Text: text, Keyboard: b.InterestEditorPrimaryKeyboard(lang, interests, primary)}
+}
+
+// InterestEditorPrimaryRefusal - выбор основных интересов с объяснением, почему отметка не поставлена.
+func (b *Builder) InterestEditorPrimaryRefusal(lang, reason string, interests []models.Interest, primary map[int]bool) adapters.Screen {
+	return adapters.Screen{
+		Text:     reason + "\n\n" + b.text(lang, "edit_interests_primary_description"),
+		Keyboard: b.InterestEditorPrimaryKeyboard(lang, interests, primary),
+	}
+}
+
+// InterestEditorPreview - добавленные и удаленные за сессию интересы перед сохранением.
+func (b *Builder) InterestEditorPreview(lang string, changes []InterestEditorChange) adapters.Screen {
+	text := b.text(lang, "edit_interests_changes_preview") + "\n\n"
+
+	if len(changes) == 0 {
+		text += b.text(lang, "no_changes_made")
+	} else {
+		for _, group := range []struct{ action, title string }{
+			{localization.ActionAdd, "✅ " + b.text(lang, "added_interests")},
+			{localization.ActionRemove, "❌ " + b.text(lang, "removed_interests")},
+		} {
+			if names := b.changedInterests(lang, changes, group.action); len(names) > 0 {
+				text += group.title + ":\n• " + strings.Join(names, "\n• ") + "\n\n"
+			}
+		}
+	}
+
+	return adapters.Screen{Text: text, Keyboard: b.InterestEditorPreviewKeyboard(lang)}
+}
+
+// InterestEditorSaved - итог сохранения с перечнем изменений и переходом к профилю.
+func (b *Builder) InterestEditorSaved(lang string, changes []InterestEditorChange) adapters.Screen {
+	text := fmt.Sprintf("✅ Изменения успешно сохранены!\n\n📊 Внесено изменений: %d", len(changes))
+	if len(changes) > 0 {
+		text += "\n\n📝 Детали изменений:" + b.interestChangesSummary(lang, changes)
+	}
+
+	return b.interestEditorClosed(lang, text)
+}
+
+// InterestEditorCancelled - итог отмены с перечнем отброшенных изменений и переходом к профилю.
+func (b *Builder) InterestEditorCancelled(lang string, changes []InterestEditorChange) adapters.Screen {
+	text := fmt.Sprintf("❌ Редактирование отменено!\n\n📊 Отменено изменений: %d", len(changes))
+	if len(changes) > 0 {
+		text += "\n\n📝 Отмененные изменения:" + b.interestChangesSummary(lang, changes)
+	}
+
+	return b.interestEditorClosed(lang, text)
+}
+
+// InterestEditorStatistics - подробная статистика сессии; категории идут по ключу.
+func (b *Builder) InterestEditorStatistics(lang string, stats InterestEditorStats, duration time.Duration) adapters.Screen {
+	text := b.text(lang, "edit_interests_detailed_statistics") + "\n\n" +
+		fmt.Sprintf("📊 %s: %d\n", b.text(lang, "total_interests"), stats.Total) +
+		fmt.Sprintf("⭐ %s: %d\n", b.text(lang, "primary_interests_label"), stats.Primary) +
+		fmt.Sprintf("🔄 %s: %d\n", b.text(lang, "changes_count"), stats.Changes) +
+		fmt.Sprintf("⏱️ %s: %s\n\n", b.text(lang, "session_duration"), duration.Round(time.Minute))
+
+	if len(stats.Categories) > 0 {
+		text += b.text(lang, "category_statistics") + ":\n"
+
+		keys := make([]string, 0, len(stats.Categories))
+		for key := range stats.Categories {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			text += fmt.Sprintf("• %s: %d\n", b.service.InterestCategoryName(lang, key), stats.Categories[key])
+		}
+	}
+
+	return adapters.Screen{Text: text, Keyboard: b.InterestEditorStatisticsKeyboard(lang)}
+}
+
+// InterestSuggestionPrompt - приглашение ввести свой интерес с ограничением длины.
+func (b *Builder) InterestSuggestionPrompt(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text: b.service.Localizer.GetWithParams(lang, localization.LocaleInterestSuggestPrompt, map[string]string{
+			"max": strconv.Itoa(localization.MaxInterestSuggestionLength),
+		}),
+		Keyboard: b.InterestSuggestionCancelKeyboard(lang),
+	}
+}
+
+// InterestSuggestionSent - подтверждение отправки предложения.
+func (b *Builder) InterestSuggestionSent(lang string) adapters.Screen {
+	return b.InterestSuggestionClosed(lang, b.text(lang, localization.LocaleInterestSuggestSent))
+}
+
+// InterestSuggestionClosed - сообщение, после которого ввод предложения закончен, с возвратом в редактор.
+func (b *Builder) InterestSuggestionClosed(lang, text string) adapters.Screen {
+	return adapters.Screen{Text: text, Keyboard: b.InterestSuggestionDoneKeyboard(lang)}
+}
+
+// InterestEditorMenuKeyboard - главное меню редактора интересов.
+func (b *Builder) InterestEditorMenuKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(b.interestEditorCategoriesButton(lang), b.interestEditorPrimaryButton(lang)),
+		// Свой интерес, если подходящего нет в каталоге
+		adapters.Row(adapters.Button{
+			Text:   "💡 " + b.text(lang, localization.LocaleInterestSuggestButton),
+			Action: localization.CallbackIsolatedSuggestInterest,
+		}),
+		adapters.Row(b.interestEditorSaveButton(lang), b.interestEditorCancelButton(lang)),
+	}
+}
+
+// InterestEditorCategoriesKeyboard - категории в порядке display_order по две в ряд с индикатором
+// выбранных интересов (progress по ключу категории), затем рекомендованные интересы.
+func (b *Builder) InterestEditorCategoriesKeyboard(
+	lang string,
+	categories []models.InterestCategory,
+	progress map[string]string,
+	suggestions []models.InterestRecommendation,
+) adapters.Keyboard {
+	sort.SliceStable(categories, func(i, j int) bool {
+		return categories[i].DisplayOrder < categories[j].DisplayOrder
+	})
+
+	buttons := make([]adapters.Button, 0, len(categories))
+	for _, category := range categories {
+		buttons = append(buttons, adapters.Button{
+			Text:   b.service.InterestCategoryName(lang, category.KeyName) + " " + progress[category.KeyName],
+			Action: ActionInterestEditorCategoryPrefix + category.KeyName,
+		})
+	}
+
+	keyboard := pairs(buttons)
+
+	// Интересы, которые часто выбирают вместе с уже выбранными
+	if len(suggestions) > 0 {
+		suggested := make([]adapters.Button, 0, len(suggestions))
+		for _, suggestion := range suggestions {
+			suggested = append(suggested, adapters.Button{
+				Text:   localization.SymbolSuggested + b.service.InterestName(lang, suggestion.InterestID, suggestion.KeyName),
+				Action: localization.CallbackIsolatedToggleSuggestedPrefix + strconv.Itoa(suggestion.InterestID),
+			})
+		}
+
+		keyboard = append(keyboard, suggested)
+	}
+
+	return append(keyboard, adapters.Row(b.interestEditorMenuButton(lang), b.interestEditorPrimaryButton(lang)))
+}
+
+// InterestEditorCategoryKeyboard - интересы категории по два в ряд с отметкой выбранных
+// и кнопками выбора и сброса всей категории.
+func (b *Builder) InterestEditorCategoryKeyboard(lang, categoryKey string, interests []models.Interest, selected map[int]bool) adapters.Keyboard {
+	keyboard := b.interestToggles(lang, interests, selected, ActionInterestEditorTogglePrefix)
+
+	if len(interests) > 0 {
+		keyboard = append(keyboard, adapters.Row(
+			adapters.Button{
+				Text:   localization.SymbolChecked + b.text(lang, "select_all_in_category"),
+				Action: ActionInterestEditorSelectAllPrefix + categoryKey,
+			},
+			adapters.Button{
+				Text:   "❌ " + b.text(lang, "clear_all_in_category"),
+				Action: ActionInterestEditorClearAllPrefix + categoryKey,
+			},
+		))
+	}
+
+	return append(keyboard, adapters.Row(
+		adapters.Button{Text: "⬅️ " + b.text(lang, "back_to_categories"), Action: ActionInterestEditorCategories},
+		b.interestEditorMenuButton(lang),
+	))
+}
+
+// InterestEditorPrimaryKeyboard - выбранные интересы по два в ряд; основные отмечены звездой.
+func (b *Builder) InterestEditorPrimaryKeyboard(lang string, interests []models.Interest, primary map[int]bool) adapters.Keyboard {
+	return append(
+		b.primaryToggles(lang, interests, primary, ActionInterestEditorPrimaryPrefix),
+		adapters.Row(b.interestEditorMenuButton(lang), b.interestEditorCategoriesButton(lang)),
+	)
+}
+
+// InterestEditorPreviewKeyboard - сохранение или откат изменений в предпросмотре.
+func (b *Builder) InterestEditorPreviewKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(
+			b.interestEditorSaveButton(lang),
+			adapters.Button{Text: "↩️ " + b.text(lang, "undo_last_change"), Action: ActionInterestEditorUndo},
+		),
+		adapters.Row(b.interestEditorMenuButton(lang), b.interestEditorCancelButton(lang)),
+	}
+}
+
+// InterestEditorStatisticsKeyboard - возврат из статистики редактирования.
+func (b *Builder) InterestEditorStatisticsKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{adapters.Row(
+		adapters.Button{Text: "🏠 " + b.text(lang, "back_to_main_menu"), Action: ActionInterestEditorMenu},
+		adapters.Button{Text: "👁️ " + b.text(lang, "preview_changes"), Action: ActionInterestEditorPreview},
+	)}
+}
+
+// interestEditorClosed - итог сохранения или отмены редактирования с переходом к профилю.
+func (b *Builder) interestEditorClosed(lang, text string) adapters.Screen {
+	return adapters.Screen{
+		Text:     text,
+		Keyboard: adapters.Keyboard{adapters.Row(b.button(lang, "profile_show", ActionProfileShow))},
+	}
+}
+
+// InterestSuggestionCancelKeyboard - отмена ввода предлагаемого интереса.
+func (b *Builder) InterestSuggestionCancelKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{adapters.Row(adapters.Button{
+		Text:   "❌ " + b.text(lang, "cancel_edit"),
+		Action: localization.CallbackIsolatedSuggestCancel,
+	})}
+}
+
+// InterestSuggestionDoneKeyboard - возврат в редактор после отправки предложения.
+func (b *Builder) InterestSuggestionDoneKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{adapters.Row(
+		b.button(lang, localization.LocaleInterestSuggestBackToEditor, localization.CallbackIsolatedSuggestCancel),
+	)}
+}
+
+// interestEditorMenuButton - возврат в меню редактора.
+func (b *Builder) interestEditorMenuButton(lang string) adapters.Button {
+	return adapters.Button{Text: "🏠 " + b.text(lang, "back_to_edit_menu"), Action: ActionInterestEditorMenu}
+}
+
+// interestEditorCategoriesButton - переход к категориям редактора.
+func (b *Builder) interestEditorCategoriesButton(lang string) adapters.Button {
+	return adapters.Button{Text: "🎯 " + b.text(lang, "edit_interests_by_category"), Action: ActionInterestEditorCategories}
+}
+
+// interestEditorPrimaryButton - переход к выбору основных интересов.
+func (b *Builder) interestEditorPrimaryButton(lang string) adapters.Button {
+	return adapters.Button{Text: localization.SymbolStar + b.text(lang, "edit_primary_interests"), Action: ActionInterestEditorPrimary}
+}
+
+// interestEditorSaveButton - сохранение изменений редактора.
+func (b *Builder) interestEditorSaveButton(lang string) adapters.Button {
+	return adapters.Button{Text: "💾 " + b.text(lang, "save_changes"), Action: ActionInterestEditorSave}
+}
+
+// interestEditorCancelButton - отмена редактирования.
+func (b *Builder) interestEditorCancelButton(lang string) adapters.Button {
+	return adapters.Button{Text: "❌ " + b.text(lang, "cancel_edit"), Action: ActionInterestEditorCancel}
+}
+
+// changedInterests - названия интересов с данным действием в порядке изменений.
+func (b *Builder) changedInterests(lang string, changes []InterestEditorChange, action string) []string {
+	var names []string
+
+	for _, change := range changes {
+		if change.Action == action {
+			names = append(names, b.service.InterestName(lang, change.InterestID, change.KeyName))
+		}
+	}
+
+	return names
+}
+
+// interestChangesSummary - изменения сессии, сгруппированные по действию.
+func (b *Builder) interestChangesSummary(lang string, changes []InterestEditorChange) string {
+	var summary strings.Builder
+
+	for _, group := range []struct{ action, title string }{
+		{localization.ActionAdd, "➕ Добавлены:"},
+		{localization.ActionRemove, "➖ Удалены:"},
+		{localization.ActionSetPrimary, "⭐ Сделаны основными:"},
+		{localization.ActionUnsetPrimary, "☐ Убраны из основных:"},
+	} {
+		names := b.changedInterests(lang, changes, group.action)
+		if len(names) == 0 {
+			continue
+		}
+
+		summary.WriteString("\n\n" + group.title)
+
+		for _, name := range names {
+			summary.WriteString("\n• " + name)
+		}
+	}
+
+	return summary.String()
+}
@@ -0,0 +1,205 @@
+package screens
+
+import (
+	"log"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Действия выбора интересов при заполнении профиля.
+const (
+	ActionInterestSelectPrefix     = "edit_interest_select_" // + ID интереса
+	ActionPrimaryInterestPrefix    = "primary_interest_"     // + ID интереса
+	ActionBackToCategories         = "back_to_categories"
+	ActionBackToInterestSelection  = "back_to_interests"
+	ActionPrimaryInterestsContinue = "primary_interests_continue"
+	ActionContinueToAvailability   = "continue_to_availability"
+)
+
+// InterestCategories - выбор категории интересов. warning, если не пуст, выводится над подсказкой.
+func (b *Builder) InterestCategories(user *models.User, warning string) adapters.Screen {
+	lang := user.InterfaceLanguageCode
+
+	text := b.text(lang, localization.LocaleChooseInterests)
+	if warning != "" {
+		text = warning + "\n\n" + text
+	}
+
+	return adapters.Screen{Text: text, Keyboard: b.InterestCategoriesKeyboard(lang)}
+}
+
+// InterestCategoriesKeyboard - категории из справочника в порядке display_order по две в ряд,
+// затем "Продолжить" и "Назад" к уровню языка.
+func (b *Builder) InterestCategoriesKeyboard(lang string) adapters.Keyboard {
+	categories, err := b.service.GetCachedInterestCategories(lang)
+	if err != nil {
+		log.Printf("Failed to load interest categories: %v", err)
+	}
+
+	buttons := make([]adapters.Button, 0, len(categories))
+	for _, category := range categories {
+		buttons = append(buttons, categoryButton(category))
+	}
+
+	return append(pairs(buttons), adapters.Row(
+		b.button(lang, "continue_button", ActionInterestsContinue),
+		b.backButton(lang, ActionBackToLevel),
+	))
+}
+
+// categoryButton создает кнопку категории интересов.
+func categoryButton(category *models.InterestCategory) adapters.Button {
+	return adapters.Button{Text: category.Name, Action: ActionCategoryPrefix + category.KeyName}
+}
+
+// InterestCategoriesRequired - возврат к категориям, когда не выбрано ни одного интереса.
+func (b *Builder) InterestCategoriesRequired(user *models.User) adapters.Screen {
+	warning := "❗ " + b.textOr(user.InterfaceLanguageCode, localization.MessageChooseAtLeastOneInterest, "Пожалуйста, выберите хотя бы один интерес")
+
+	return b.InterestCategories(user, warning)
+}
+
+// CategoryInterests - интересы категории с отметкой выбранных.
+func (b *Builder) CategoryInterests(lang, categoryKey string, interests []models.Interest, selected map[int]bool) adapters.Screen {
+	return adapters.Screen{
+		Text:     b.service.InterestCategoryName(lang, categoryKey) + " - " + b.text(lang, localization.LocaleChooseInterests),
+		Keyboard: b.CategoryInterestsKeyboard(lang, interests, selected),
+	}
+}
+
+// CategoryInterestsKeyboard - интересы категории в порядке display_order по два в ряд
+// и возврат к категориям.
+func (b *Builder) CategoryInterestsKeyboard(lang string, interests []models.Interest, selected map[int]bool) adapters.Keyboard {
+	return append(
+		b.interestToggles(lang, interests, selected, ActionInterestSelectPrefix),
+		adapters.Row(b.button(lang, "to_categories_button", ActionBackToCategories)),
+	)
+}
+
+// PrimaryInterests - выбор основных среди выбранных интересов. Подсказка говорит, сколько основных
+// еще можно отметить из рекомендованных recommended; warning, если не пуст, заменяет подсказку.
+func (b *Builder) PrimaryInterests(lang string, interests []models.Interest, primary map[int]bool, recommended int, warning string) adapters.Screen {
+	text := b.primaryInterestsHint(lang, interests, primary, recommended)
+	if warning != "" {
+		text = warning + "\n\n" + b.text(lang, localization.LocaleChoosePrimaryInterests)
+	}
+
+	return adapters.Screen{Text: text, Keyboard: b.PrimaryInterestsKeyboard(lang, interests, primary)}
+}
+
+// PrimaryInterestsKeyboard - выбранные интересы по два в ряд, основные отмечены звездой,
+// затем "Продолжить" и "Назад" к выбору интересов.
+func (b *Builder) PrimaryInterestsKeyboard(lang string, interests []models.Interest, primary map[int]bool) adapters.Keyboard {
+	return append(b.primaryToggles(lang, interests, primary, ActionPrimaryInterestPrefix), adapters.Row(
+		b.button(lang, "continue_button", ActionPrimaryInterestsContinue),
+		b.backButton(lang, ActionBackToInterestSelection),
+	))
+}
+
+// PrimaryInterestsMinimum - предупреждение, что основных интересов меньше минимума.
+func (b *Builder) PrimaryInterestsMinimum(lang string, minimum int) string {
+	count := strconv.Itoa(minimum)
+
+	text := b.service.Localizer.GetWithParams(lang, "choose_at_least_primary_interests", map[string]string{"count": count})
+	if text == "choose_at_least_primary_interests" {
+		return "❗ Пожалуйста, выберите минимум " + count + " основных интереса"
+	}
+
+	return text
+}
+
+// InterestsCompleted - итог выбора интересов с переходом к настройке доступности.
+func (b *Builder) InterestsCompleted(lang string, summary *models.UserInterestSummary) adapters.Screen {
+	text := b.text(lang, "interests_selection_complete") + "\n\n" +
+		b.interestList(lang, localization.LocalePrimaryInterestsLabel, summary.PrimaryInterests) +
+		b.interestList(lang, localization.LocaleAdditionalInterestsLabel, summary.AdditionalInterests) +
+		"\n" + b.text(lang, "interests_feedback_suggestion")
+
+	return adapters.Screen{
+		Text:     text,
+		Keyboard: adapters.Keyboard{adapters.Row(b.button(lang, "continue_button", ActionContinueToAvailability))},
+	}
+}
+
+// primaryInterestsHint - подсказка, сколько основных интересов осталось отметить.
+func (b *Builder) primaryInterestsHint(lang string, interests []models.Interest, primary map[int]bool, recommended int) string {
+	count := 0
+
+	for _, interest := range interests {
+		if primary[interest.ID] {
+			count++
+		}
+	}
+
+	params := map[string]string{"max": strconv.Itoa(recommended), "remaining": strconv.Itoa(recommended - count)}
+
+	switch {
+	case count == 0:
+		return b.service.Localizer.GetWithParams(lang, "choose_primary_interests_dynamic", params)
+	case count < recommended:
+		return b.service.Localizer.GetWithParams(lang, "choose_primary_interests_remaining", params)
+	default:
+		return b.textOr(lang, localization.MessageMaxPrimaryInterestsReached, localization.MessageMaxPrimaryInterestsFallback)
+	}
+}
+
+// interestToggles - интересы в порядке display_order по два в ряд с отметкой выбранных.
+func (b *Builder) interestToggles(lang string, interests []models.Interest, selected map[int]bool, prefix string) adapters.Keyboard {
+	sorted := slices.Clone(interests)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DisplayOrder < sorted[j].DisplayOrder
+	})
+
+	buttons := make([]adapters.Button, 0, len(sorted))
+	for _, interest := range sorted {
+		mark := localization.SymbolUnchecked
+		if selected[interest.ID] {
+			mark = localization.SymbolChecked
+		}
+
+		buttons = append(buttons, adapters.Button{
+			Text:   mark + b.service.InterestName(lang, interest.ID, interest.KeyName),
+			Action: prefix + strconv.Itoa(interest.ID),
+		})
+	}
+
+	return pairs(buttons)
+}
+
+// primaryToggles - интересы в переданном порядке по два в ряд, основные отмечены звездой.
+func (b *Builder) primaryToggles(lang string, interests []models.Interest, primary map[int]bool, prefix string) adapters.Keyboard {
+	buttons := make([]adapters.Button, 0, len(interests))
+	for _, interest := range interests {
+		mark := localization.SymbolUnchecked
+		if primary[interest.ID] {
+			mark = localization.SymbolStar
+		}
+
+		buttons = append(buttons, adapters.Button{
+			Text:   mark + b.service.InterestName(lang, interest.ID, interest.KeyName),
+			Action: prefix + strconv.Itoa(interest.ID),
+		})
+	}
+
+	return pairs(buttons)
+}
+
+// interestList - строка "подпись: интерес, интерес" или пустая строка без интересов.
+func (b *Builder) interestList(lang, labelKey string, interests []models.InterestWithCategory) string {
+	if len(interests) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(interests))
+	for _, interest := range interests {
+		names = append(names, b.service.InterestName(lang, interest.ID, interest.KeyName))
+	}
+
+	return b.text(lang, labelKey) + " " + strings.Join(names, ", ") + "\n"
+}
@@ -0,0 +1,127 @@
+package screens
+
+import (
+	"fmt"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// LanguageLevels - уровни владения изучаемым языком в порядке выбора.
+var LanguageLevels = []string{"beginner", "elementary", "intermediate", "upper_intermediate"}
+
+// fallbackLanguages - справочник языков на случай недоступности БД.
+var fallbackLanguages = []*models.Language{
+	{ID: localization.LanguageIDEnglish, Code: "en", NameNative: "English", NameEn: "English"},
+	{ID: localization.LanguageIDRussian, Code: "ru", NameNative: "Русский", NameEn: "Russian"},
+	{ID: localization.LanguageIDSpanish, Code: "es", NameNative: "Español", NameEn: "Spanish"},
+	{ID: localization.LanguageIDChinese, Code: "zh", NameNative: "中文", NameEn: "Chinese"},
+}
+
+// InterfaceLanguage - выбор языка интерфейса.
+func (b *Builder) InterfaceLanguage(user *models.User) adapters.Screen {
+	lang := user.InterfaceLanguageCode
+
+	return adapters.Screen{
+		Text:     b.text(lang, localization.LocaleChooseInterfaceLanguage),
+		Keyboard: b.LanguageKeyboard(lang, LanguageInterface, "", true),
+	}
+}
+
+// NativeLanguage - выбор родного языка.
+func (b *Builder) NativeLanguage(user *models.User) adapters.Screen {
+	lang := user.InterfaceLanguageCode
+
+	return adapters.Screen{
+		Text:     b.text(lang, "choose_native_language"),
+		Keyboard: b.LanguageKeyboard(lang, LanguageNative, "", true),
+	}
+}
+
+// TargetLanguage - выбор изучаемого языка для русскоязычных; русский исключен.
+func (b *Builder) TargetLanguage(user *models.User) adapters.Screen {
+	lang := user.InterfaceLanguageCode
+
+	return adapters.Screen{
+		Text:     b.text(lang, "choose_target_language"),
+		Keyboard: b.LanguageKeyboard(lang, LanguageTarget, "ru", true),
+	}
+}
+
+// LanguageLevel - выбор уровня владения изучаемым языком пользователя.
+func (b *Builder) LanguageLevel(user *models.User) adapters.Screen {
+	lang := user.InterfaceLanguageCode
+
+	return adapters.Screen{
+		Text: b.service.Localizer.GetWithParams(lang, "choose_level_title", map[string]string{
+			"language": b.service.Localizer.GetLanguageName(user.TargetLanguageCode, lang),
+		}),
+		Keyboard: b.LanguageLevelKeyboard(lang, ActionLevelPrefix, true),
+	}
+}
+
+// LanguageKeyboard - выбор языка из справочника, кроме exclude. Действие кнопки:
+// lang_<purpose>_<код>. Назад из выбора интерфейса и родного языка ведет в главное меню.
+func (b *Builder) LanguageKeyboard(lang, purpose, exclude string, showBackButton bool) adapters.Keyboard {
+	languages, err := b.service.GetCachedLanguages(lang)
+	if err != nil {
+		languages = fallbackLanguages
+	}
+
+	keyboard := make(adapters.Keyboard, 0, len(languages)+1)
+	seen := make(map[string]bool, len(languages))
+
+	for _, language := range languages {
+		action := fmt.Sprintf("%s%s_%s", ActionLanguagePrefix, purpose, language.Code)
+		if language.Code == exclude || seen[action] {
+			continue
+		}
+
+		seen[action] = true
+		label := languageFlag(language.Code) + " " + b.service.Localizer.GetLanguageName(language.Code, lang)
+		keyboard = append(keyboard, adapters.Row(adapters.Button{Text: label, Action: action}))
+	}
+
+	if showBackButton {
+		back := ActionPreviousStep
+		if purpose == LanguageInterface || purpose == LanguageNative {
+			back = ActionMainMenu
+		}
+
+		keyboard = append(keyboard, adapters.Row(b.backButton(lang, back)))
+	}
+
+	return keyboard
+}
+
+// LanguageLevelKeyboard - уровни владения языком; действие кнопки: prefix + уровень.
+func (b *Builder) LanguageLevelKeyboard(lang, prefix string, showBackButton bool) adapters.Keyboard {
+	keyboard := make(adapters.Keyboard, 0, len(LanguageLevels)+1)
+
+	for _, level := range LanguageLevels {
+		keyboard = append(keyboard, adapters.Row(b.button(lang, "choose_level_"+level, prefix+level)))
+	}
+
+	if showBackButton {
+		keyboard = append(keyboard, adapters.Row(b.backButton(lang, ActionPreviousStep)))
+	}
+
+	return keyboard
+}
+
+// languageFlag возвращает флаг для языка.
+func languageFlag(langCode string) string {
+	switch langCode {
+	case "ru":
+		return "🇷🇺"
+	case "en":
+		return "🇺🇸"
+	case "es":
+		return "🇪🇸"
+	case "zh":
+		return "🇨🇳"
+	default:
+		return "🌍"
+	}
+}
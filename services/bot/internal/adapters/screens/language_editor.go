@@ -0,0 +1,75 @@
+package screens
+
+import "language-exchange-bot/internal/adapters"
+
+// Действия редактора языков профиля.
+const (
+	ActionLanguageEditorMenu        = "isolated_lang_back_to_menu"
+	ActionLanguageEditorNative      = "isolated_lang_edit_native"
+	ActionLanguageEditorTarget      = "isolated_lang_edit_target"
+	ActionLanguageEditorLevel       = "isolated_lang_edit_level"
+	ActionLanguageEditorPreview     = "isolated_lang_preview"
+	ActionLanguageEditorSave        = "isolated_lang_save"
+	ActionLanguageEditorCancel      = "isolated_lang_cancel"
+	ActionLanguageEditorUndo        = "isolated_lang_undo_last"
+	ActionLanguageEditorLevelPrefix = "isolated_level_" // + уровень
+)
+
+// Назначения выбора языка в редакторе: действие кнопки lang_<назначение>_<код>.
+const (
+	LanguageEditorNative = "isolated_native"
+	LanguageEditorTarget = "isolated_target"
+)
+
+// LanguageEditorMenuKeyboard - главное меню редактора языков; предпросмотр появляется
+// только после первого изменения.
+func (b *Builder) LanguageEditorMenuKeyboard(lang string, hasChanges bool) adapters.Keyboard {
+	keyboard := adapters.Keyboard{
+		adapters.Row(adapters.Button{Text: "🏠 " + b.text(lang, "edit_native_language"), Action: ActionLanguageEditorNative}),
+		adapters.Row(adapters.Button{Text: "📚 " + b.text(lang, "edit_target_language"), Action: ActionLanguageEditorTarget}),
+		adapters.Row(adapters.Button{Text: "📊 " + b.text(lang, "edit_language_level"), Action: ActionLanguageEditorLevel}),
+	}
+
+	if hasChanges {
+		keyboard = append(keyboard, adapters.Row(
+			adapters.Button{Text: "👁️ " + b.text(lang, "preview_changes"), Action: ActionLanguageEditorPreview},
+		))
+	}
+
+	return append(keyboard, adapters.Row(
+		adapters.Button{Text: "💾 " + b.text(lang, "save_changes"), Action: ActionLanguageEditorSave},
+		b.languageEditorCancelButton(lang),
+	))
+}
+
+// LanguageEditorChoiceKeyboard - выбор родного или изучаемого языка (purpose) без exclude
+// с возвратом в меню редактора.
+func (b *Builder) LanguageEditorChoiceKeyboard(lang, purpose, exclude string) adapters.Keyboard {
+	return append(b.LanguageKeyboard(lang, purpose, exclude, false), b.LanguageEditorBackKeyboard(lang)...)
+}
+
+// LanguageEditorLevelKeyboard - выбор уровня владения с возвратом в меню редактора.
+func (b *Builder) LanguageEditorLevelKeyboard(lang string) adapters.Keyboard {
+	return append(b.LanguageLevelKeyboard(lang, ActionLanguageEditorLevelPrefix, false), b.LanguageEditorBackKeyboard(lang)...)
+}
+
+// LanguageEditorPreviewKeyboard - сохранение или откат изменений в предпросмотре.
+func (b *Builder) LanguageEditorPreviewKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(
+			adapters.Button{Text: "💾 " + b.text(lang, "save_changes"), Action: ActionLanguageEditorSave},
+			b.button(lang, "undo_last_change", ActionLanguageEditorUndo),
+		),
+		adapters.Row(b.backButton(lang, ActionLanguageEditorMenu), b.languageEditorCancelButton(lang)),
+	}
+}
+
+// LanguageEditorBackKeyboard - единственная кнопка возврата в меню редактора.
+func (b *Builder) LanguageEditorBackKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{adapters.Row(b.backButton(lang, ActionLanguageEditorMenu))}
+}
+
+// languageEditorCancelButton - отмена редактирования языков.
+func (b *Builder) languageEditorCancelButton(lang string) adapters.Button {
+	return adapters.Button{Text: "❌ " + b.text(lang, "cancel_edit"), Action: ActionLanguageEditorCancel}
+}
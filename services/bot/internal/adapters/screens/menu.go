@@ -0,0 +1,41 @@
+package screens
+
+import (
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// MainMenu - приветствие и главное меню пользователя.
+func (b *Builder) MainMenu(user *models.User) adapters.Screen {
+	lang := user.InterfaceLanguageCode
+
+	return adapters.Screen{
+		Text:     b.service.GetWelcomeMessage(user) + "\n\n" + b.text(lang, localization.LocaleMainMenuTitle),
+		Keyboard: b.MainMenuKeyboard(lang, user.ProfileCompletionLevel > 0),
+	}
+}
+
+// MainMenuKeyboard - кнопки главного меню. Без профиля вместо просмотра и
+// редактирования показывается одна кнопка "Создать профиль".
+func (b *Builder) MainMenuKeyboard(lang string, hasProfile bool) adapters.Keyboard {
+	settings := adapters.Row(
+		b.button(lang, "main_menu_change_lang", ActionChangeLanguage),
+		b.button(lang, "main_menu_feedback", ActionFeedback),
+	)
+
+	if !hasProfile {
+		return adapters.Keyboard{
+			adapters.Row(b.button(lang, "main_menu_create_profile", ActionStartProfileSetup)),
+			settings,
+		}
+	}
+
+	return adapters.Keyboard{
+		adapters.Row(
+			b.button(lang, "main_menu_view_profile", ActionViewProfile),
+			b.button(lang, "main_menu_edit_profile", ActionEditProfile),
+		),
+		settings,
+	}
+}
@@ -0,0 +1,74 @@
+package screens
+
+import (
+	"fmt"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Profile - сводка профиля с меню действий.
+func (b *Builder) Profile(user *models.User) (adapters.Screen, error) {
+	summary, err := b.service.BuildProfileSummary(user)
+	if err != nil {
+		return adapters.Screen{}, fmt.Errorf("failed to build profile summary: %w", err)
+	}
+
+	lang := user.InterfaceLanguageCode
+
+	return adapters.Screen{
+		Text:     summary + "\n\n" + b.text(lang, "profile_actions"),
+		Keyboard: b.ProfileMenuKeyboard(lang),
+	}, nil
+}
+
+// EmptyProfile - сообщение для пользователя без профиля с кнопкой настройки.
+func (b *Builder) EmptyProfile(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text: b.text(lang, localization.LocaleEmptyProfileMessage),
+		Keyboard: adapters.Keyboard{
+			adapters.Row(b.button(lang, localization.LocaleSetupProfileButton, ActionProfileSetupInfo)),
+		},
+	}
+}
+
+// ProfileMenuKeyboard - действия с профилем.
+func (b *Builder) ProfileMenuKeyboard(lang string) adapters.Keyboard {
+	// Ряд 1: интересы и доступность, ряд 2: языки и интерфейс,
+	// затем цели изучения, сброс профиля и главное меню
+	return adapters.Keyboard{
+		adapters.Row(
+			b.button(lang, "profile_edit_interests_isolated", ActionEditInterests),
+			adapters.Button{Text: "⏰ " + b.text(lang, "edit_availability"), Action: ActionEditAvailability},
+		),
+		adapters.Row(
+			b.button(lang, "profile_edit_languages", ActionEditLanguages),
+			b.button(lang, "main_menu_change_lang", ActionChangeLanguage),
+		),
+		adapters.Row(adapters.Button{
+			Text:   "🎯 " + b.text(lang, localization.LocaleLearningGoalsEditButton),
+			Action: localization.CallbackProfileLearningGoals,
+		}),
+		adapters.Row(b.button(lang, "profile_reconfigure", ActionProfileReset)),
+		adapters.Row(b.mainMenuButton(lang)),
+	}
+}
+
+// ProfileCompleted - сообщение о заполненном профиле.
+func (b *Builder) ProfileCompleted(lang string) adapters.Screen {
+	return adapters.Screen{
+		Text:     b.text(lang, "profile_completed"),
+		Keyboard: b.ProfileCompletedKeyboard(lang),
+	}
+}
+
+// ProfileCompletedKeyboard - просмотр профиля и главное меню после заполнения профиля.
+func (b *Builder) ProfileCompletedKeyboard(lang string) adapters.Keyboard {
+	return adapters.Keyboard{
+		adapters.Row(
+			b.button(lang, "profile_completed_view", ActionProfileShow),
+			b.button(lang, "profile_completed_main", ActionMainMenu),
+		),
+	}
+}
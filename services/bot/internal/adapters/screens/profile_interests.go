@@ -0,0 +1,91 @@
+package screens
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Действия редактирования интересов из профиля. Остались для сессий, начатых до редактора интересов.
+const (
+	ActionProfileInterestCategoryPrefix = "edit_interest_category_" // + ключ категории
+	ActionProfilePrimaryInterestPrefix  = "edit_primary_interest_"  // + ID интереса
+	ActionProfileInterestCategories     = "back_to_edit_categories"
+	ActionProfileInterestsSave          = "save_interest_edits"
+	ActionBackToProfile                 = "back_to_profile"
+)
+
+// ProfileInterestCategories - выбор категории для редактирования интересов из профиля.
+func (b *Builder) ProfileInterestCategories(lang string) adapters.Screen {
+	categories, err := b.service.GetCachedInterestCategories(lang)
+	if err != nil {
+		log.Printf("Failed to load interest categories: %v", err)
+	}
+
+	buttons := make([]adapters.Button, 0, len(categories))
+	for _, category := range categories {
+		buttons = append(buttons, adapters.Button{Text: category.Name, Action: ActionProfileInterestCategoryPrefix + category.KeyName})
+	}
+
+	return adapters.Screen{
+		Text: b.text(lang, localization.LocaleEditInterestsFromProfile) + "\n\n" + b.text(lang, localization.LocaleChooseInterestCategory),
+		Keyboard: append(pairs(buttons), adapters.Row(
+			b.button(lang, "continue_button", ActionInterestsContinue),
+			b.button(lang, "cancel_button", ActionBackToProfile),
+		)),
+	}
+}
+
+// ProfileCategoryInterests - интересы категории с отметкой выбранных и возвратом к категориям.
+func (b *Builder) ProfileCategoryInterests(lang, categoryKey string, interests []models.Interest, selected map[int]bool) adapters.Screen {
+	return adapters.Screen{
+		Text: b.text(lang, localization.LocaleEditInterestsInCategory) + " " + b.service.InterestCategoryName(lang, categoryKey),
+		Keyboard: append(
+			b.interestToggles(lang, interests, selected, ActionInterestSelectPrefix),
+			adapters.Row(b.backButton(lang, ActionProfileInterestCategories)),
+		),
+	}
+}
+
+// ProfilePrimaryInterests - выбор основных интересов из профиля.
+func (b *Builder) ProfilePrimaryInterests(lang string, interests []models.Interest, primary map[int]bool) adapters.Screen {
+	return adapters.Screen{
+		Text:     b.text(lang, localization.LocaleEditPrimaryInterests) + "\n\n" + b.text(lang, localization.LocaleChoosePrimaryInterests),
+		Keyboard: b.ProfilePrimaryInterestsKeyboard(lang, interests, primary),
+	}
+}
+
+// ProfilePrimaryInterestsKeyboard - выбранные интересы по два в ряд: сначала основные, затем по ID;
+// затем возврат к категориям, отмена и сохранение.
+func (b *Builder) ProfilePrimaryInterestsKeyboard(lang string, interests []models.Interest, primary map[int]bool) adapters.Keyboard {
+	sorted := slices.Clone(interests)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if primary[sorted[i].ID] != primary[sorted[j].ID] {
+			return primary[sorted[i].ID]
+		}
+
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	return append(b.primaryToggles(lang, sorted, primary, ActionProfilePrimaryInterestPrefix), adapters.Row(
+		b.button(lang, "to_categories_button", ActionBackToCategories),
+		b.button(lang, "cancel_button", ActionBackToProfile),
+		b.button(lang, "save_button", ActionProfileInterestsSave),
+	))
+}
+
+// ProfileInterestsSaved - итог редактирования: сколько интересов всего, основных и дополнительных.
+func (b *Builder) ProfileInterestsSaved(lang string, summary *models.UserInterestSummary) adapters.Screen {
+	text := b.text(lang, localization.LocaleInterestsUpdatedSuccessfully) + "\n\n" +
+		fmt.Sprintf("%s: %d\n%s: %d\n%s: %d",
+			b.text(lang, localization.LocaleTotalInterests), summary.TotalInterests,
+			b.text(lang, localization.LocalePrimaryInterestsLabel), len(summary.PrimaryInterests),
+			b.text(lang, localization.LocaleAdditionalInterestsLabel), len(summary.AdditionalInterests))
+
+	return adapters.Screen{Text: text, Keyboard: b.ProfileMenuKeyboard(lang)}
+}
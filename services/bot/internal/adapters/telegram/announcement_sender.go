@@ -0,0 +1,51 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"language-exchange-bot/internal/adapters/telegram/handlers/base"
+	"language-exchange-bot/internal/core"
+	errorsPkg "language-exchange-bot/internal/errors"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// AnnouncementSender отправляет анонсы через Telegram Bot API (реализует core.AnnouncementSender).
+type AnnouncementSender struct {
+	bot *tgbotapi.BotAPI
+}
+
+// NewAnnouncementSender создает отправителя анонсов.
+func NewAnnouncementSender(bot *tgbotapi.BotAPI) *AnnouncementSender {
+	return &AnnouncementSender{bot: bot}
+}
+
+// SendAnnouncement отправляет анонс простым текстом, чтобы разметка администратора не ломала сообщение.
+// Ответ 403 означает, что пользователь заблокировал бота или удалил аккаунт, 429 - превышение лимита.
+func (s *AnnouncementSender) SendAnnouncement(chatID int64, text string) error {
+	if err := base.CheckRecipientChat(chatID); err != nil {
+		return err
+	}
+
+	_, err := s.bot.Send(tgbotapi.NewMessage(chatID, text))
+
+	return deliveryError(err)
+}
+
+// deliveryError переводит ошибки Telegram Bot API в ошибки рассылки ядра.
+func deliveryError(err error) error {
+	var apiErr *tgbotapi.Error
+	if errors.As(err, &apiErr) {
+		switch apiErr.Code {
+		case http.StatusForbidden:
+			return fmt.Errorf("%w: %s", errorsPkg.ErrRecipientBlocked, apiErr.Message)
+		case http.StatusTooManyRequests:
+			return &core.RetryAfterError{Delay: time.Duration(apiErr.RetryAfter) * time.Second}
+		}
+	}
+
+	return err
+}
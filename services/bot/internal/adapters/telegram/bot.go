@@ -6,11 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
+	"language-exchange-bot/internal/adapters/screens"
+	"language-exchange-bot/internal/adapters/telegram/handlers/base"
+	"language-exchange-bot/internal/adapters/telegram/handlers/feedback"
 	"language-exchange-bot/internal/core"
 	"language-exchange-bot/internal/database"
 	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
@@ -108,18 +114,27 @@ func (tb *TelegramBot) SendFeedbackNotification(feedbackData map[string]interfac
 	log.Printf("Отправляем уведомление о новом отзыве администраторам...")
 	log.Printf("Администраторы по ID: %v", tb.adminChatIDs)
 	log.Printf("Администраторы по username: %v", tb.adminUsernames)
+	// Ответ автора на ответ администратора приходит тем же каналом, но с ID отзыва
+	title, label := "📝 Новый отзыв от пользователя:", "📝 Отзыв:"
+
+	replyTo, isReply := feedbackData["reply_to"].(int)
+	if isReply {
+		title, label = fmt.Sprintf("💬 Ответ пользователя на отзыв #%d:", replyTo), "💬 Ответ:"
+	}
+
 	// Формируем сообщение для администраторов
 	adminMsg := fmt.Sprintf(`
-📝 Новый отзыв от пользователя:
+%s
 
 👤 Имя: %s
 📱 Telegram ID: %d
 
 %s
 
-📝 Отзыв:
+%s
 %s
 `,
+		title,
 		feedbackData["first_name"].(string),
 		feedbackData["telegram_id"].(int64),
 		func() string {
@@ -129,6 +144,7 @@ func (tb *TelegramBot) SendFeedbackNotification(feedbackData map[string]interfac
 
 			return "👤 Username: отсутствует"
 		}(),
+		label,
 		feedbackData["feedback_text"].(string),
 	)
 
@@ -137,16 +153,30 @@ func (tb *TelegramBot) SendFeedbackNotification(feedbackData map[string]interfac
 		adminMsg += "\n📞 Контакты: " + *contactInfo
 	}
 
+	// Добавляем разметку, предложенную по ключевым словам
+	if priority, ok := feedbackData["priority"].(string); ok {
+		category, _ := feedbackData["category"].(string)
+		adminMsg += fmt.Sprintf("\n🏷 Категория: %s · ⚡ Приоритет: %s", screens.FeedbackCategoryLabel(category), screens.FeedbackPriorityLabel(priority))
+	}
+
 	// Отправляем сообщение всем администраторам по ID
 	log.Printf("Отправляем уведомления %d администраторам по ID", len(tb.adminChatIDs))
 
 	for _, adminID := range tb.adminChatIDs {
 		msg := tgbotapi.NewMessage(adminID, adminMsg)
+		if isReply {
+			msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData("💬 Ответить", fmt.Sprintf("%s%d", localization.CallbackPrefixFeedbackReply, replyTo)),
+			))
+		}
+
 		if _, err := tb.api.Send(msg); err != nil {
 			log.Printf("Ошибка отправки уведомления администратору %d: %v", adminID, err)
 		} else {
 			log.Printf("Уведомление отправлено администратору %d", adminID)
 		}
+
+		tb.sendFeedbackAttachments(adminID, feedbackData)
 	}
 
 	// Username администраторы используются только для проверки прав, не для уведомлений
@@ -158,6 +188,87 @@ func (tb *TelegramBot) SendFeedbackNotification(feedbackData map[string]interfac
 	return nil
 }
 
+// sendFeedbackAttachments пересылает администратору вложения нового отзыва по их file_id.
+func (tb *TelegramBot) sendFeedbackAttachments(adminID int64, feedbackData map[string]interface{}) {
+	attachments, _ := feedbackData["attachments"].([]models.FeedbackAttachment)
+	feedbackID, _ := feedbackData["feedback_id"].(int)
+
+	for _, attachment := range attachments {
+		if _, err := tb.api.Send(feedback.AttachmentMessage(adminID, attachment, feedback.AttachmentCaption(feedbackID))); err != nil {
+			log.Printf("Ошибка отправки вложения отзыва #%d администратору %d: %v", feedbackID, adminID, err)
+		}
+	}
+}
+
+// SendFeedbackSLAAlert уведомляет администраторов о необработанных отзывах с истекшим сроком,
+// а ответственных - об их отзывах. Ошибка возвращается, только если не удалось доставить ни одного
+// уведомления: тогда отзывы попадут в следующую проверку.
+func (tb *TelegramBot) SendFeedbackSLAAlert(breaches []models.FeedbackSLABreach) error {
+	recipients := make(map[int64][]models.FeedbackSLABreach)
+
+	for _, adminID := range tb.adminChatIDs {
+		recipients[adminID] = breaches
+	}
+
+	for _, breach := range breaches {
+		if base.CheckRecipientChat(breach.AssigneeTelegramID) != nil || slices.Contains(tb.adminChatIDs, breach.AssigneeTelegramID) {
+			continue
+		}
+
+		recipients[breach.AssigneeTelegramID] = append(recipients[breach.AssigneeTelegramID], breach)
+	}
+
+	delivered := 0
+
+	for chatID, items := range recipients {
+		if _, err := tb.api.Send(tgbotapi.NewMessage(chatID, feedback.FormatSLABreaches(items))); err != nil {
+			log.Printf("Ошибка отправки уведомления о сроках отзывов в чат %d: %v", chatID, err)
+
+			continue
+		}
+
+		delivered++
+	}
+
+	if delivered == 0 && len(recipients) > 0 {
+		return errors.New("feedback SLA alert was not delivered")
+	}
+
+	return nil
+}
+
+// SendAccountDeletionNotices сообщает собеседникам удаленного аккаунта, что партнер больше недоступен.
+// Собеседникам с других платформ уведомление не доставить: они пропускаются с записью в лог.
+// Ошибка возвращается, только если не удалось доставить ни одного уведомления.
+func (tb *TelegramBot) SendAccountDeletionNotices(partners []models.MatchPartner) error {
+	delivered, recipients := 0, 0
+
+	for _, partner := range partners {
+		if base.CheckRecipientChat(partner.TelegramID) != nil {
+			log.Printf("Partner %d is on %s, account deletion notice is not delivered", partner.UserID, partner.Platform)
+
+			continue
+		}
+
+		recipients++
+
+		notice := tb.service.Localizer.Get(partner.InterfaceLanguageCode, localization.LocaleDeleteMePartnerNotice)
+		if _, err := tb.api.Send(tgbotapi.NewMessage(partner.TelegramID, notice)); err != nil {
+			log.Printf("Failed to notify partner %d about account deletion: %v", partner.UserID, err)
+
+			continue
+		}
+
+		delivered++
+	}
+
+	if delivered == 0 && recipients > 0 {
+		return errors.New("account deletion notice was not delivered")
+	}
+
+	return nil
+}
+
 // GetService возвращает сервис бота для внешнего доступа.
 func (tb *TelegramBot) GetService() *core.BotService {
 	return tb.service
@@ -172,7 +283,7 @@ func (tb *TelegramBot) Start(ctx context.Context) error {
 
 	updates := tb.api.GetUpdatesChan(u)
 	// Передаем usernames администраторов в обработчик
-	tb.handler = NewTelegramHandlerWithAdmins(tb.api, tb.service, tb.adminChatIDs, tb.adminUsernames, tb.errorHandler)
+	tb.handler = NewTelegramHandlerWithAdmins(tb.api, tb.service, tb.adminChatIDs, tb.errorHandler)
 	handler := tb.handler
 
 	for {
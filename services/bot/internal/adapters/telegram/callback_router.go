@@ -126,6 +126,14 @@ func (r *CallbackRouter) SetupIsolatedRoutes(handler *TelegramHandler) error {
 		return handler.HandleIsolatedShowStats(callback, user)
 	})
 
+	r.RegisterSimple("isolated_suggest_interest", func(callback *tgbotapi.CallbackQuery, user *models.User, params map[string]string) error {
+		return handler.HandleIsolatedSuggestInterest(callback, user)
+	})
+
+	r.RegisterSimple("isolated_suggest_cancel", func(callback *tgbotapi.CallbackQuery, user *models.User, params map[string]string) error {
+		return handler.HandleIsolatedSuggestCancel(callback, user)
+	})
+
 	// Префиксные маршруты с параметрами
 	r.RegisterPrefix("isolated_edit_category_", func(callback *tgbotapi.CallbackQuery, user *models.User, params map[string]string) error {
 		categoryKey := params["param"]
@@ -139,6 +147,12 @@ func (r *CallbackRouter) SetupIsolatedRoutes(handler *TelegramHandler) error {
 		return handler.HandleIsolatedToggleInterest(callback, user, interestIDStr)
 	})
 
+	r.RegisterPrefix("isolated_toggle_suggested_", func(callback *tgbotapi.CallbackQuery, user *models.User, params map[string]string) error {
+		interestIDStr := params["param"]
+
+		return handler.HandleIsolatedToggleSuggested(callback, user, interestIDStr)
+	})
+
 	r.RegisterPrefix("isolated_toggle_primary_", func(callback *tgbotapi.CallbackQuery, user *models.User, params map[string]string) error {
 		interestIDStr := params["param"]
 
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"language-exchange-bot/internal/adapters/telegram/handlers/admin"
+	"language-exchange-bot/internal/adapters/telegram/handlers/admin_panel"
 	"language-exchange-bot/internal/adapters/telegram/handlers/availability"
 	"language-exchange-bot/internal/adapters/telegram/handlers/base"
 	"language-exchange-bot/internal/adapters/telegram/handlers/feedback"
@@ -33,8 +34,7 @@ import (
 type TelegramHandler struct {
 	bot                    *tgbotapi.BotAPI
 	service                *core.BotService
-	adminChatIDs           []int64  // Chat ID администраторов
-	adminUsernames         []string // Usernames администраторов для проверки доступа
+	adminChatIDs           []int64 // Chat ID администраторов для уведомлений; доступ проверяется по роли
 	keyboardBuilder        *base.KeyboardBuilder
 	menuHandler            *menu.MenuHandler
 	profileHandler         *profile.ProfileHandlerImpl
@@ -47,11 +47,14 @@ type TelegramHandler struct {
 	availabilityHandler    *availability.AvailabilityHandlerImpl
 	availabilityEditor     *availability.IsolatedAvailabilityEditor
 	adminHandler           *admin.AdminHandlerImpl
+	adminPanelHandler      *admin_panel.AdminPanelHandler
 	utilityHandler         *utility.UtilityHandlerImpl
 	errorHandler           *errorsPkg.ErrorHandler
 	isolatedRouter         *CallbackRouter // Роутер для изолированных callback'ов
 	rateLimiter            *RateLimiter    // Rate limiter для защиты от спама
 	messageFactory         *base.MessageFactory
+	announcementDispatcher *core.AnnouncementDispatcher // nil без BotAPI (в тестах)
+	surveyDispatcher       *core.SurveyDispatcher       // nil без BotAPI (в тестах)
 }
 
 // NewTelegramHandler создает новый экземпляр TelegramHandler с базовой конфигурацией.
@@ -75,11 +78,7 @@ func NewTelegramHandler(
 
 	menuHandler := menu.NewMenuHandler(baseHandler)
 	profileHandler := profile.NewProfileHandler(baseHandler)
-	feedbackHandler := feedback.NewFeedbackHandler(
-		baseHandler,
-		adminChatIDs,
-		make([]string, 0),
-	)
+	feedbackHandler := feedback.NewFeedbackHandler(baseHandler, adminChatIDs)
 	languageHandler := language.NewLanguageHandler(baseHandler)
 
 	var interestService *core.InterestService
@@ -108,7 +107,8 @@ func NewTelegramHandler(
 	isolatedLanguageEditor := language.NewIsolatedLanguageEditor(baseHandler)
 	availabilityHandler := availability.NewAvailabilityHandler(baseHandler)
 	availabilityEditor := availability.NewIsolatedAvailabilityEditor(baseHandler)
-	adminHandler := admin.NewAdminHandler(baseHandler, adminChatIDs, make([]string, 0))
+	adminHandler := admin.NewAdminHandler(baseHandler)
+	adminPanelHandler := admin_panel.NewAdminPanelHandler(baseHandler)
 	utilityHandler := utility.NewUtilityHandler(baseHandler)
 
 	// Создаем rate limiter для защиты от спама
@@ -120,7 +120,6 @@ func NewTelegramHandler(
 		bot:                    bot,
 		service:                service,
 		adminChatIDs:           adminChatIDs,
-		adminUsernames:         make([]string, 0),
 		keyboardBuilder:        keyboardBuilder,
 		menuHandler:            menuHandler,
 		profileHandler:         profileHandler,
@@ -133,6 +132,7 @@ func NewTelegramHandler(
 		availabilityHandler:    availabilityHandler,
 		availabilityEditor:     availabilityEditor,
 		adminHandler:           adminHandler,
+		adminPanelHandler:      adminPanelHandler,
 		utilityHandler:         utilityHandler,
 		errorHandler:           errorHandler,
 		isolatedRouter:         isolatedRouter,
@@ -145,15 +145,17 @@ func NewTelegramHandler(
 		panic(fmt.Sprintf("failed to setup isolated routes: %v", err))
 	}
 
+	handler.setupAnnouncementDispatcher()
+
 	return handler
 }
 
-// NewTelegramHandlerWithAdmins создает новый экземпляр TelegramHandler с полной конфигурацией администраторов.
+// NewTelegramHandlerWithAdmins создает новый экземпляр TelegramHandler с Chat ID администраторов для уведомлений.
+// Права администраторов определяются ролью пользователя (см. core.BotService.BootstrapAdminRoles).
 func NewTelegramHandlerWithAdmins(
 	bot *tgbotapi.BotAPI,
 	service *core.BotService,
 	adminChatIDs []int64,
-	adminUsernames []string,
 	errorHandler *errorsPkg.ErrorHandler,
 ) *TelegramHandler {
 	keyboardBuilder := base.NewKeyboardBuilder(service)
@@ -170,11 +172,7 @@ func NewTelegramHandlerWithAdmins(
 
 	menuHandler := menu.NewMenuHandler(baseHandler)
 	profileHandler := profile.NewProfileHandler(baseHandler)
-	feedbackHandler := feedback.NewFeedbackHandler(
-		baseHandler,
-		adminChatIDs,
-		adminUsernames,
-	)
+	feedbackHandler := feedback.NewFeedbackHandler(baseHandler, adminChatIDs)
 	languageHandler := language.NewLanguageHandler(baseHandler)
 	interestService := core.NewInterestService(service.DB.GetConnection())
 	interestHandler := interests.NewNewInterestHandler(baseHandler, interestService)
@@ -196,7 +194,8 @@ func NewTelegramHandlerWithAdmins(
 	isolatedLanguageEditor := language.NewIsolatedLanguageEditor(baseHandler)
 	availabilityHandler := availability.NewAvailabilityHandler(baseHandler)
 	availabilityEditor := availability.NewIsolatedAvailabilityEditor(baseHandler)
-	adminHandler := admin.NewAdminHandler(baseHandler, adminChatIDs, adminUsernames)
+	adminHandler := admin.NewAdminHandler(baseHandler)
+	adminPanelHandler := admin_panel.NewAdminPanelHandler(baseHandler)
 	utilityHandler := utility.NewUtilityHandler(baseHandler)
 
 	// Создаем rate limiter для защиты от спама
@@ -208,7 +207,6 @@ func NewTelegramHandlerWithAdmins(
 		bot:                    bot,
 		service:                service,
 		adminChatIDs:           adminChatIDs,
-		adminUsernames:         adminUsernames,
 		keyboardBuilder:        keyboardBuilder,
 		menuHandler:            menuHandler,
 		profileHandler:         profileHandler,
@@ -221,6 +219,7 @@ func NewTelegramHandlerWithAdmins(
 		availabilityHandler:    availabilityHandler,
 		availabilityEditor:     availabilityEditor,
 		adminHandler:           adminHandler,
+		adminPanelHandler:      adminPanelHandler,
 		utilityHandler:         utilityHandler,
 		errorHandler:           errorHandler,
 		isolatedRouter:         isolatedRouter,
@@ -233,6 +232,8 @@ func NewTelegramHandlerWithAdmins(
 		panic(fmt.Sprintf("failed to setup isolated routes: %v", err))
 	}
 
+	handler.setupAnnouncementDispatcher()
+
 	return handler
 }
 
@@ -325,6 +326,10 @@ func (h *TelegramHandler) handleCommand(message *tgbotapi.Message, user *models.
 		return h.menuHandler.HandleLanguageCommand(message, user)
 	case "profile":
 		return h.profileHandler.HandleProfileCommand(message, user)
+	case "mydata":
+		return h.profileHandler.HandleMyDataCommand(message, user)
+	case "deleteme":
+		return h.profileHandler.HandleDeleteMeCommand(message, user)
 	case "feedback":
 		return h.feedbackHandler.HandleFeedbackCommand(
 			message,
@@ -335,6 +340,8 @@ func (h *TelegramHandler) handleCommand(message *tgbotapi.Message, user *models.
 			message,
 			user,
 		)
+	case "admin":
+		return h.adminPanelHandler.HandleAdminCommand(message, user)
 	default:
 		log.Printf("Unknown command: %s", message.Command())
 
@@ -364,6 +371,20 @@ func (h *TelegramHandler) handleState(message *tgbotapi.Message, user *models.Us
 		return h.feedbackHandler.HandleFeedbackMessage(message, user)
 	case models.StateWaitingFeedbackContact:
 		return h.feedbackHandler.HandleFeedbackContactMessage(message, user)
+	case models.StateWaitingUnavailabilityDates:
+		return h.availabilityEditor.HandleVacationDatesMessage(message, user)
+	case models.StateWaitingInterestSuggestion:
+		return h.isolatedInterestEditor.HandleSuggestionMessage(message, user)
+	case models.StateWaitingAdminUserSearch:
+		return h.adminPanelHandler.HandleSearchMessage(message, user)
+	case models.StateWaitingAdminMessage:
+		return h.adminPanelHandler.HandleDirectMessage(message, user)
+	case models.StateWaitingFeedbackReply:
+		return h.feedbackHandler.HandleFeedbackReplyMessage(message, user)
+	case models.StateWaitingFeedbackAnswer:
+		return h.feedbackHandler.HandleFeedbackAnswerMessage(message, user)
+	case models.StateWaitingFeedbackSearch:
+		return h.feedbackHandler.HandleFeedbackSearchMessage(message, user)
 	default:
 		// Игнорируем текстовые сообщения, если пользователь не в специальном состоянии
 		// Пользователь должен использовать кнопки меню
@@ -451,37 +472,45 @@ func (h *TelegramHandler) handleCallbackQuery(callback *tgbotapi.CallbackQuery)
 		return err
 	}
 
+	if err := h.handleSurveyCallbacks(callback, user, data); err != nil {
+		log.Printf("DEBUG: handleSurveyCallbacks returned error: %v", err)
+
+		return err
+	}
+
 	if err := h.handleFeedbackCallbacks(callback, user, data); err != nil {
 		log.Printf("DEBUG: handleFeedbackCallbacks returned error: %v", err)
 
 		return err
 	}
 
+	if err := h.handleAdminPanelCallbacks(callback, user, data); err != nil {
+		log.Printf("DEBUG: handleAdminPanelCallbacks returned error: %v", err)
+
+		return err
+	}
+
 	// Если callback не был обработан ни одним обработчиком, просто игнорируем
 	log.Printf("DEBUG: No handler processed callback data: '%s'", data)
 
 	return nil
 }
 
-// isAdmin проверяет, является ли пользователь администратором.
-func (h *TelegramHandler) isAdmin(userID int64, username string) bool {
-	// Проверяем по Chat ID
-	for _, adminID := range h.adminChatIDs {
-		if userID == adminID {
-			return true
-		}
-	}
+// feedbackManagePrefixes - callback'и, которые меняют или удаляют отзывы.
+var feedbackManagePrefixes = []string{
+	"fb_process_", "fb_unprocess_", "fb_delete_", "archive_feedback_", "delete_current_feedback_",
+	localization.CallbackPrefixFeedbackReply, localization.CallbackPrefixFeedbackTriage,
+}
 
-	// Проверяем по username
-	if username != "" {
-		for _, adminUsername := range h.adminUsernames {
-			if username == adminUsername {
-				return true
-			}
+// feedbackCallbackPermission возвращает право, нужное для callback'а отзывов.
+func feedbackCallbackPermission(data string) core.Permission {
+	for _, prefix := range feedbackManagePrefixes {
+		if strings.HasPrefix(data, prefix) {
+			return core.PermissionManageFeedback
 		}
 	}
 
-	return false
+	return core.PermissionViewFeedback
 }
 
 // === ОБРАБОТЧИКИ ВИДОВ ОТЗЫВОВ ===
@@ -625,6 +654,14 @@ func (h *TelegramHandler) handleProfileCallbacks(callback *tgbotapi.CallbackQuer
 		)
 	}
 
+	// Подтверждение удаления аккаунта (/deleteme)
+	switch data {
+	case localization.CallbackDeleteAccountConfirm:
+		return h.profileHandler.HandleDeleteMeConfirm(callback, user)
+	case localization.CallbackDeleteAccountCancel:
+		return h.profileHandler.HandleDeleteMeCancel(callback, user)
+	}
+
 	// Обработка команд профиля
 	if strings.HasPrefix(data, "profile_") ||
 		strings.HasPrefix(data, "edit_") ||
@@ -828,6 +865,28 @@ func (h *TelegramHandler) HandleIsolatedShowStats(callback *tgbotapi.CallbackQue
 	return h.isolatedInterestEditor.ShowEditStatistics(callback, user, session)
 }
 
+// HandleIsolatedToggleSuggested переключает интерес из ряда «Рекомендуем вам».
+func (h *TelegramHandler) HandleIsolatedToggleSuggested(callback *tgbotapi.CallbackQuery, user *models.User, interestIDStr string) error {
+	interestID, err := strconv.Atoi(interestIDStr)
+	if err != nil {
+		return h.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "ParseInterestID")
+	}
+
+	return h.isolatedInterestEditor.ToggleSuggestedInterest(callback, user, interestID)
+}
+
+// HandleIsolatedSuggestInterest начинает ввод предложения нового интереса.
+func (h *TelegramHandler) HandleIsolatedSuggestInterest(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	log.Printf("Starting interest suggestion for user %d", user.ID)
+
+	return h.isolatedInterestEditor.StartSuggestInterest(callback, user)
+}
+
+// HandleIsolatedSuggestCancel возвращает из ввода предложения в редактор интересов.
+func (h *TelegramHandler) HandleIsolatedSuggestCancel(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	return h.isolatedInterestEditor.CancelSuggestInterest(callback, user)
+}
+
 // =============================================================================
 // ISOLATED LANGUAGE EDITOR HANDLERS
 // =============================================================================
@@ -907,6 +966,12 @@ func (h *TelegramHandler) handleIsolatedCallbacks(callback *tgbotapi.CallbackQue
 func (h *TelegramHandler) handleProfileCommands(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
 	log.Printf("DEBUG: handleProfileCommands called with data: '%s' for user %d", data, user.ID)
 
+	if strings.HasPrefix(data, localization.CallbackPrefixProfileLearningGoal) {
+		goal := strings.TrimPrefix(data, localization.CallbackPrefixProfileLearningGoal)
+
+		return h.profileHandler.HandleToggleLearningGoal(callback, user, goal)
+	}
+
 	switch data {
 	case "profile_show":
 		log.Printf("DEBUG: Handling profile_show for user %d", user.ID)
@@ -936,6 +1001,10 @@ func (h *TelegramHandler) handleProfileCommands(callback *tgbotapi.CallbackQuery
 		log.Printf("DEBUG: Handling edit_availability for user %d", user.ID)
 
 		return h.availabilityEditor.StartEditSession(callback, user)
+	case localization.CallbackProfileLearningGoals:
+		log.Printf("DEBUG: Handling profile_goals for user %d", user.ID)
+
+		return h.profileHandler.HandleEditLearningGoals(callback, user)
 		// Removed deprecated language edit callbacks (edit_native_lang, edit_target_lang, edit_level)
 		// Use isolated language editor via "edit_languages" callback instead
 	}
@@ -1030,6 +1099,38 @@ func (h *TelegramHandler) handleAvailabilityCallbacks(callback *tgbotapi.Callbac
 				return err
 			}
 			return nil
+		case data == localization.CallbackAvailEditVacation:
+			if err := h.availabilityEditor.EditVacation(callback, user); err != nil {
+				h.service.LoggingService.Telegram().ErrorWithContext("Error in EditVacation", "", int64(user.ID), callback.Message.Chat.ID, "AvailabilityCallback", map[string]interface{}{
+					"user_id": user.ID,
+					"error":   err.Error(),
+				})
+				return err
+			}
+			return nil
+		case data == localization.CallbackAvailVacationAdd:
+			if err := h.availabilityEditor.StartVacationInput(callback, user); err != nil {
+				h.service.LoggingService.Telegram().ErrorWithContext("Error in StartVacationInput", "", int64(user.ID), callback.Message.Chat.ID, "AvailabilityCallback", map[string]interface{}{
+					"user_id": user.ID,
+					"error":   err.Error(),
+				})
+				return err
+			}
+			return nil
+		case data == localization.CallbackAvailVacationCancelInput:
+			return h.availabilityEditor.CancelVacationInput(callback, user)
+		case data == localization.CallbackAvailVacationBack:
+			return h.availabilityEditor.BackFromVacation(callback, user)
+		case strings.HasPrefix(data, localization.CallbackPrefixAvailVacationDel):
+			periodID := strings.TrimPrefix(data, localization.CallbackPrefixAvailVacationDel)
+			if err := h.availabilityEditor.DeleteVacation(callback, user, periodID); err != nil {
+				h.service.LoggingService.Telegram().ErrorWithContext("Error in DeleteVacation", "", int64(user.ID), callback.Message.Chat.ID, "AvailabilityCallback", map[string]interface{}{
+					"user_id": user.ID,
+					"error":   err.Error(),
+				})
+				return err
+			}
+			return nil
 		case data == "avail_save_changes":
 			if err := h.availabilityEditor.SaveChanges(callback, user); err != nil {
 				h.service.LoggingService.Telegram().ErrorWithContext("Error in SaveChanges", "", int64(user.ID), callback.Message.Chat.ID, "AvailabilityCallback", map[string]interface{}{
@@ -1325,11 +1426,30 @@ func (h *TelegramHandler) handleMenuCallbacks(callback *tgbotapi.CallbackQuery,
 	return nil
 }
 
+// handleAdminPanelCallbacks обрабатывает callback'и админ-панели управления пользователями.
+func (h *TelegramHandler) handleAdminPanelCallbacks(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
+	if !strings.HasPrefix(data, localization.CallbackPrefixAdminPanel) {
+		return nil
+	}
+
+	return h.adminPanelHandler.HandleCallback(callback, user, data)
+}
+
 // handleFeedbackCallbacks обрабатывает callback'и связанные с отзывами.
 func (h *TelegramHandler) handleFeedbackCallbacks(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
-	// Проверяем права администратора для доступа к отзывам
-	if !h.isAdmin(callback.From.ID, callback.From.UserName) {
-		// Если это не администратор, игнорируем callback
+	// Ответ на ответ администратора и отправка отзыва с вложениями доступны автору без прав на отзывы
+	switch {
+	case data == localization.CallbackFeedbackDraftSend:
+		return h.feedbackHandler.HandleFeedbackDraftSend(callback, user)
+	case data == localization.CallbackFeedbackAnswerCancel:
+		return h.feedbackHandler.HandleFeedbackAnswerCancel(callback, user)
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackAnswer):
+		return h.feedbackHandler.HandleFeedbackAnswerStart(callback, user, strings.TrimPrefix(data, localization.CallbackPrefixFeedbackAnswer))
+	}
+
+	// Проверяем права на просмотр или изменение отзывов по роли пользователя
+	if !h.service.UserHasPermission(user, feedbackCallbackPermission(data)) {
+		// Если прав нет, игнорируем callback
 		return nil
 	}
 
@@ -1346,6 +1466,20 @@ func (h *TelegramHandler) handleFeedbackCallbacks(callback *tgbotapi.CallbackQue
 		feedbackIDStr := strings.TrimPrefix(data, "fb_delete_")
 
 		return h.feedbackHandler.HandleFeedbackDelete(callback, user, feedbackIDStr)
+	case data == localization.CallbackFeedbackReplyCancel:
+		return h.feedbackHandler.HandleFeedbackReplyCancel(callback, user)
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackReply):
+		return h.feedbackHandler.HandleFeedbackReplyStart(callback, user, strings.TrimPrefix(data, localization.CallbackPrefixFeedbackReply))
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackTriage):
+		return h.feedbackHandler.HandleFeedbackTriageCallback(callback, user, data)
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackFilter):
+		return h.feedbackHandler.HandleFeedbackFilterCallback(callback, user, data)
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackSearch):
+		return h.feedbackHandler.HandleFeedbackSearchCallback(callback, user, data)
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackExport):
+		return h.feedbackHandler.HandleFeedbackExportCallback(callback, user, data)
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackAttachment):
+		return h.feedbackHandler.HandleFeedbackAttachmentsCallback(callback, user, strings.TrimPrefix(data, localization.CallbackPrefixFeedbackAttachment))
 	case strings.HasPrefix(data, "browse_active_feedbacks_"):
 		indexStr := strings.TrimPrefix(data, "browse_active_feedbacks_")
 
@@ -1403,7 +1537,8 @@ func (h *TelegramHandler) handleFeedbackCallbacks(callback *tgbotapi.CallbackQue
 		return h.feedbackHandler.HandleArchiveFeedback(callback, user, indexStr)
 	case strings.HasPrefix(data, "back_to_active_feedbacks") ||
 		strings.HasPrefix(data, "back_to_archive_feedbacks") ||
-		strings.HasPrefix(data, "back_to_all_feedbacks"):
+		strings.HasPrefix(data, "back_to_all_feedbacks") ||
+		strings.HasPrefix(data, "back_to_filtered_feedbacks"):
 		// Обработка возврата к списку отзывов: back_to_active_feedbacks, back_to_archive_feedbacks, etc.
 		parts := strings.Split(data, "_")
 		if len(parts) >= localization.MinPartsForNav {
@@ -1467,6 +1602,37 @@ func (h *TelegramHandler) SetService(service *core.BotService) {
 // SetBotAPI устанавливает BotAPI для handler'а.
 func (h *TelegramHandler) SetBotAPI(bot *tgbotapi.BotAPI) {
 	h.bot = bot
+	h.setupAnnouncementDispatcher()
+}
+
+// AnnouncementDispatcher возвращает диспетчер рассылки анонсов (nil без BotAPI).
+func (h *TelegramHandler) AnnouncementDispatcher() *core.AnnouncementDispatcher {
+	return h.announcementDispatcher
+}
+
+// SurveyDispatcher возвращает диспетчер рассылки опросов (nil без BotAPI).
+func (h *TelegramHandler) SurveyDispatcher() *core.SurveyDispatcher {
+	return h.surveyDispatcher
+}
+
+// setupAnnouncementDispatcher создает диспетчеры анонсов и опросов, отправляющие сообщения через текущий BotAPI.
+// Диспетчер опросов делит с анонсами лимиты отправки и передается сервису для запуска запланированных опросов.
+func (h *TelegramHandler) setupAnnouncementDispatcher() {
+	if h.bot == nil {
+		h.announcementDispatcher = nil
+		h.surveyDispatcher = nil
+
+		return
+	}
+
+	h.announcementDispatcher = core.NewAnnouncementDispatcher(h.service, NewAnnouncementSender(h.bot))
+
+	if h.service == nil {
+		return
+	}
+
+	h.surveyDispatcher = core.NewSurveyDispatcher(h.announcementDispatcher, NewSurveySender(h.bot, h.service.Localizer))
+	h.service.SetSurveyDispatcher(h.surveyDispatcher)
 }
 
 // GetService возвращает сервис handler'а.
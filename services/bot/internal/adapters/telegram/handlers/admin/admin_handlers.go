@@ -3,8 +3,8 @@ package admin
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
+	"language-exchange-bot/internal/core"
 	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/models"
 
@@ -26,55 +26,30 @@ type AdminHandler interface {
 		totalCount int,
 		feedbackType string,
 	) error
-	IsAdmin(chatID int64, username string) bool
+	HasPermission(user *models.User, permission core.Permission) bool
 }
 
 // AdminHandlerImpl реализация административного обработчика.
 type AdminHandlerImpl struct {
-	base           *base.BaseHandler
-	adminChatIDs   []int64
-	adminUsernames []string
+	base *base.BaseHandler
 }
 
 // NewAdminHandler создает новый административный обработчик.
-func NewAdminHandler(
-	base *base.BaseHandler,
-	adminChatIDs []int64,
-	adminUsernames []string,
-) *AdminHandlerImpl {
+func NewAdminHandler(base *base.BaseHandler) *AdminHandlerImpl {
 	return &AdminHandlerImpl{
-		base:           base,
-		adminChatIDs:   adminChatIDs,
-		adminUsernames: adminUsernames,
+		base: base,
 	}
 }
 
-// IsAdmin проверяет права администратора.
-func (h *AdminHandlerImpl) IsAdmin(chatID int64, username string) bool {
-	// Проверяем по Chat ID
-	for _, adminID := range h.adminChatIDs {
-		if chatID == adminID {
-			return true
-		}
-	}
-
-	// Проверяем по username
-	if username != "" {
-		for _, adminUsername := range h.adminUsernames {
-			cleanUsername := strings.TrimPrefix(adminUsername, "@")
-			if username == cleanUsername {
-				return true
-			}
-		}
-	}
-
-	return false
+// HasPermission проверяет право пользователя по его роли.
+func (h *AdminHandlerImpl) HasPermission(user *models.User, permission core.Permission) bool {
+	return h.base.Service.UserHasPermission(user, permission)
 }
 
 // ShowFeedbackStatisticsEdit показывает статистику отзывов с редактированием текущего сообщения.
 func (h *AdminHandlerImpl) ShowFeedbackStatisticsEdit(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	// Проверяем права администратора
-	if !h.IsAdmin(callback.Message.Chat.ID, user.Username) {
+	// Проверяем права на просмотр отзывов
+	if !h.HasPermission(user, core.PermissionViewFeedback) {
 		// Используем MessageFactory для отправки сообщения об отказе доступа
 		return h.base.MessageFactory.SendText(callback.Message.Chat.ID, "❌ Данная команда доступна только администраторам бота.")
 	}
@@ -116,7 +91,7 @@ func (h *AdminHandlerImpl) ShowFeedbackStatisticsEdit(callback *tgbotapi.Callbac
 		totalCount, processedCount, pendingCount)
 
 	// Создаем клавиатуру для управления отзывами
-	keyboard := h.base.KeyboardBuilder.CreateFeedbackAdminKeyboard(user.InterfaceLanguageCode)
+	keyboard := base.RenderKeyboard(h.base.Screens.FeedbackStatsKeyboard())
 
 	err = h.base.MessageFactory.EditWithKeyboard(
 		callback.Message.Chat.ID,
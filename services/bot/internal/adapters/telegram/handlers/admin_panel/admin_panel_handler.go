@@ -0,0 +1,630 @@
+// Package admin_panel реализует админ-панель управления пользователями в Telegram.
+package admin_panel
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"language-exchange-bot/internal/adapters/telegram/handlers/base"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// feedbackPreviewLength - сколько символов отзыва показывать в карточке пользователя.
+const feedbackPreviewLength = 80
+
+// AdminPanelHandler обрабатывает поиск пользователей и действия над ними в админ-панели.
+type AdminPanelHandler struct {
+	base *base.BaseHandler
+}
+
+// NewAdminPanelHandler создает обработчик админ-панели.
+func NewAdminPanelHandler(base *base.BaseHandler) *AdminPanelHandler {
+	return &AdminPanelHandler{base: base}
+}
+
+// HandleAdminCommand открывает админ-панель по команде /admin.
+func (h *AdminPanelHandler) HandleAdminCommand(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+
+	if !h.base.Service.UserHasPermission(user, core.PermissionViewUsers) {
+		return h.base.MessageFactory.SendText(message.Chat.ID, h.text(lang, localization.LocaleAdminPanelAccessDenied))
+	}
+
+	return h.base.MessageFactory.SendWithKeyboard(message.Chat.ID, h.panelText(lang), h.panelKeyboard(lang))
+}
+
+// HandleCallback обрабатывает callback'и с префиксом adm_. Права проверяет core,
+// здесь же отсекаются пользователи без доступа к админ-панели вообще.
+func (h *AdminPanelHandler) HandleCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
+	if !h.base.Service.UserHasPermission(user, core.PermissionViewUsers) {
+		return nil
+	}
+
+	switch {
+	case data == localization.CallbackAdminPanel:
+		return h.showPanel(callback, user)
+	case data == localization.CallbackAdminSearch:
+		return h.startSearch(callback, user)
+	case data == localization.CallbackAdminCancel:
+		return h.cancelInput(callback, user)
+	case strings.HasPrefix(data, localization.CallbackPrefixAdminUser):
+		return h.withUserID(callback, user, data, localization.CallbackPrefixAdminUser, h.showUserCard)
+	case strings.HasPrefix(data, localization.CallbackPrefixAdminResetConfirm):
+		return h.withUserID(callback, user, data, localization.CallbackPrefixAdminResetConfirm, h.resetProfile)
+	case strings.HasPrefix(data, localization.CallbackPrefixAdminReset):
+		return h.withUserID(callback, user, data, localization.CallbackPrefixAdminReset, h.confirmReset)
+	case strings.HasPrefix(data, localization.CallbackPrefixAdminSetStatus):
+		return h.applyChoice(callback, user, strings.TrimPrefix(data, localization.CallbackPrefixAdminSetStatus),
+			h.base.Service.AdminChangeUserStatus)
+	case strings.HasPrefix(data, localization.CallbackPrefixAdminStatus):
+		return h.withUserID(callback, user, data, localization.CallbackPrefixAdminStatus, h.showStatusOptions)
+	case strings.HasPrefix(data, localization.CallbackPrefixAdminSetState):
+		return h.applyChoice(callback, user, strings.TrimPrefix(data, localization.CallbackPrefixAdminSetState),
+			h.base.Service.AdminForceUserState)
+	case strings.HasPrefix(data, localization.CallbackPrefixAdminState):
+		return h.withUserID(callback, user, data, localization.CallbackPrefixAdminState, h.showStateOptions)
+	case strings.HasPrefix(data, localization.CallbackPrefixAdminMessage):
+		return h.withUserID(callback, user, data, localization.CallbackPrefixAdminMessage, h.startMessage)
+	}
+
+	return nil
+}
+
+// HandleSearchMessage обрабатывает поисковый запрос администратора.
+func (h *AdminPanelHandler) HandleSearchMessage(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+
+	users, err := h.base.Service.SearchUsersForAdmin(user, message.Text)
+	if stdErrors.Is(err, errors.ErrInvalidUserInput) {
+		// Пустой запрос: администратор остается в режиме поиска
+		return h.base.MessageFactory.SendText(message.Chat.ID, h.text(lang, localization.LocaleAdminPanelSearchPrompt))
+	}
+
+	if err := h.base.Service.UpdateUserState(user.ID, models.StateActive); err != nil {
+		return h.base.ErrorHandler.HandleTelegramError(err, message.Chat.ID, int64(user.ID), "UpdateUserState")
+	}
+
+	if err != nil {
+		return h.sendError(message.Chat.ID, user, err, "SearchUsersForAdmin")
+	}
+
+	if len(users) == 0 {
+		text := h.base.Service.Localizer.GetWithParams(lang, localization.LocaleAdminPanelNoResults, map[string]string{
+			"query": strings.TrimSpace(message.Text),
+		})
+
+		return h.base.MessageFactory.SendWithKeyboard(message.Chat.ID, text, h.panelKeyboard(lang))
+	}
+
+	text := h.base.Service.Localizer.GetWithParams(lang, localization.LocaleAdminPanelResults, map[string]string{
+		"count": strconv.Itoa(len(users)),
+	})
+
+	return h.base.MessageFactory.SendWithKeyboard(message.Chat.ID, text, h.resultsKeyboard(lang, users))
+}
+
+// HandleDirectMessage отправляет пользователю сообщение, введенное администратором.
+func (h *AdminPanelHandler) HandleDirectMessage(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+	chatID := message.Chat.ID
+
+	targetID := h.messageTarget(user)
+	if targetID == 0 {
+		_ = h.base.Service.UpdateUserState(user.ID, models.StateActive)
+
+		return h.base.MessageFactory.SendWithKeyboard(chatID, h.text(lang, localization.LocaleAdminPanelSessionExpired), h.panelKeyboard(lang))
+	}
+
+	target, text, err := h.base.Service.PrepareAdminMessage(user, targetID, message.Text)
+	if stdErrors.Is(err, errors.ErrInvalidUserInput) {
+		// Администратор остается в режиме ввода и может повторить попытку
+		return h.base.MessageFactory.SendText(chatID, h.base.Service.Localizer.GetWithParams(
+			lang, localization.LocaleAdminPanelMessageInvalid, map[string]string{
+				"max": strconv.Itoa(localization.MaxAdminMessageLength),
+			}))
+	}
+
+	h.clearMessageTarget(user)
+
+	if err := h.base.Service.UpdateUserState(user.ID, models.StateActive); err != nil {
+		return h.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+	}
+
+	if err != nil {
+		return h.sendError(chatID, user, err, "PrepareAdminMessage")
+	}
+
+	delivered := h.base.Service.Localizer.GetWithParams(
+		target.InterfaceLanguageCode, localization.LocaleAdminPanelMessageHeader, map[string]string{"text": text},
+	)
+	if err := h.base.MessageFactory.SendText(target.TelegramID, delivered); err != nil {
+		return h.base.MessageFactory.SendWithKeyboard(
+			chatID, h.text(lang, localization.LocaleAdminPanelMessageFailed), h.backToUserKeyboard(lang, target.ID),
+		)
+	}
+
+	if err := h.base.Service.LogAdminMessage(user, target.ID, text); err != nil {
+		return h.sendError(chatID, user, err, "LogAdminMessage")
+	}
+
+	sent := h.base.Service.Localizer.GetWithParams(lang, localization.LocaleAdminPanelMessageSent, map[string]string{
+		"id": strconv.Itoa(target.ID),
+	})
+
+	return h.base.MessageFactory.SendWithKeyboard(chatID, sent, h.backToUserKeyboard(lang, target.ID))
+}
+
+// showPanel показывает главное окно админ-панели.
+func (h *AdminPanelHandler) showPanel(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+	keyboard := h.panelKeyboard(lang)
+
+	return h.base.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID, callback.Message.MessageID, h.panelText(lang), &keyboard,
+	)
+}
+
+// panelText возвращает текст главного окна админ-панели с обезличенной статистикой удалений аккаунтов.
+func (h *AdminPanelHandler) panelText(lang string) string {
+	text := h.text(lang, localization.LocaleAdminPanelTitle)
+
+	stats, err := h.base.Service.GetAccountDeletionStats()
+	if err != nil {
+		log.Printf("Failed to get account deletion stats: %v", err)
+
+		return text
+	}
+
+	return text + "\n\n" + h.base.Service.Localizer.GetWithParams(lang, localization.LocaleAdminPanelDeletions, map[string]string{
+		"total":  strconv.Itoa(stats.Total),
+		"recent": strconv.Itoa(stats.Recent),
+		"days":   strconv.Itoa(stats.RecentDays),
+	})
+}
+
+// startSearch переводит администратора в режим ввода поискового запроса.
+func (h *AdminPanelHandler) startSearch(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+
+	if err := h.base.Service.UpdateUserState(user.ID, models.StateWaitingAdminUserSearch); err != nil {
+		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "UpdateUserState")
+	}
+
+	keyboard := h.cancelKeyboard(lang)
+
+	return h.base.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID, callback.Message.MessageID, h.text(lang, localization.LocaleAdminPanelSearchPrompt), &keyboard,
+	)
+}
+
+// cancelInput отменяет ввод поискового запроса или сообщения и возвращает в админ-панель.
+func (h *AdminPanelHandler) cancelInput(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	if user.State == models.StateWaitingAdminUserSearch || user.State == models.StateWaitingAdminMessage {
+		if err := h.base.Service.UpdateUserState(user.ID, models.StateActive); err != nil {
+			return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "UpdateUserState")
+		}
+	}
+
+	h.clearMessageTarget(user)
+
+	return h.showPanel(callback, user)
+}
+
+// showUserCard показывает карточку пользователя с действиями, доступными роли администратора.
+func (h *AdminPanelHandler) showUserCard(callback *tgbotapi.CallbackQuery, user *models.User, userID int) error {
+	card, err := h.base.Service.GetAdminUserCard(user, userID)
+	if err != nil {
+		return h.editError(callback, user, err, "GetAdminUserCard")
+	}
+
+	keyboard := h.cardKeyboard(user, card.User.ID)
+
+	return h.base.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID, callback.Message.MessageID, h.formatUserCard(user.InterfaceLanguageCode, card), &keyboard,
+	)
+}
+
+// confirmReset запрашивает подтверждение сброса профиля.
+func (h *AdminPanelHandler) confirmReset(callback *tgbotapi.CallbackQuery, user *models.User, userID int) error {
+	lang := user.InterfaceLanguageCode
+
+	if !h.base.Service.UserHasPermission(user, core.PermissionManageUsers) {
+		return h.editError(callback, user, errors.ErrPermissionDenied, "confirmReset")
+	}
+
+	text := h.base.Service.Localizer.GetWithParams(lang, localization.LocaleAdminPanelResetConfirm, map[string]string{
+		"id": strconv.Itoa(userID),
+	})
+	keyboard := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				h.text(lang, localization.LocaleAdminPanelConfirmButton),
+				localization.CallbackPrefixAdminResetConfirm+strconv.Itoa(userID),
+			),
+			tgbotapi.NewInlineKeyboardButtonData(
+				h.text(lang, localization.LocaleAdminPanelCancelButton),
+				localization.CallbackPrefixAdminUser+strconv.Itoa(userID),
+			),
+		),
+	)
+
+	return h.base.MessageFactory.EditWithKeyboard(callback.Message.Chat.ID, callback.Message.MessageID, text, &keyboard)
+}
+
+// resetProfile сбрасывает профиль после подтверждения.
+func (h *AdminPanelHandler) resetProfile(callback *tgbotapi.CallbackQuery, user *models.User, userID int) error {
+	if _, err := h.base.Service.AdminResetUserProfile(user, userID); err != nil {
+		return h.editError(callback, user, err, "AdminResetUserProfile")
+	}
+
+	return h.showActionDone(callback, user, userID)
+}
+
+// showStatusOptions показывает статусы, которые можно назначить пользователю.
+func (h *AdminPanelHandler) showStatusOptions(callback *tgbotapi.CallbackQuery, user *models.User, userID int) error {
+	return h.showOptions(callback, user, userID, localization.LocaleAdminPanelChooseStatus,
+		localization.CallbackPrefixAdminSetStatus, core.AdminAssignableStatuses)
+}
+
+// showStateOptions показывает состояния, в которые можно перевести пользователя.
+func (h *AdminPanelHandler) showStateOptions(callback *tgbotapi.CallbackQuery, user *models.User, userID int) error {
+	return h.showOptions(callback, user, userID, localization.LocaleAdminPanelChooseState,
+		localization.CallbackPrefixAdminSetState, core.AdminForcibleStates)
+}
+
+// showOptions показывает список значений (статусов или состояний) по одному в строке.
+func (h *AdminPanelHandler) showOptions(
+	callback *tgbotapi.CallbackQuery,
+	user *models.User,
+	userID int,
+	promptKey string,
+	callbackPrefix string,
+	options []string,
+) error {
+	lang := user.InterfaceLanguageCode
+
+	if !h.base.Service.UserHasPermission(user, core.PermissionManageUsers) {
+		return h.editError(callback, user, errors.ErrPermissionDenied, "showOptions")
+	}
+
+	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(options)+1)
+	for _, option := range options {
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(option, fmt.Sprintf("%s%d_%s", callbackPrefix, userID, option)),
+		))
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(
+			h.text(lang, localization.LocaleAdminPanelBackToUser),
+			localization.CallbackPrefixAdminUser+strconv.Itoa(userID),
+		),
+	))
+
+	text := h.base.Service.Localizer.GetWithParams(lang, promptKey, map[string]string{"id": strconv.Itoa(userID)})
+	keyboard := tgbotapi.NewInlineKeyboardMarkup(rows...)
+
+	return h.base.MessageFactory.EditWithKeyboard(callback.Message.Chat.ID, callback.Message.MessageID, text, &keyboard)
+}
+
+// applyChoice разбирает "<ID>_<значение>" и применяет выбранный статус или состояние.
+func (h *AdminPanelHandler) applyChoice(
+	callback *tgbotapi.CallbackQuery,
+	user *models.User,
+	payload string,
+	apply func(admin *models.User, userID int, value string) (*models.User, error),
+) error {
+	idStr, value, found := strings.Cut(payload, "_")
+
+	userID, err := strconv.Atoi(idStr)
+	if !found || err != nil {
+		return nil
+	}
+
+	if _, err := apply(user, userID, value); err != nil {
+		return h.editError(callback, user, err, "applyChoice")
+	}
+
+	return h.showActionDone(callback, user, userID)
+}
+
+// startMessage переводит администратора в режим ввода сообщения пользователю.
+func (h *AdminPanelHandler) startMessage(callback *tgbotapi.CallbackQuery, user *models.User, userID int) error {
+	lang := user.InterfaceLanguageCode
+	chatID := callback.Message.Chat.ID
+
+	if !h.base.Service.UserHasPermission(user, core.PermissionMessageUsers) {
+		return h.editError(callback, user, errors.ErrPermissionDenied, "startMessage")
+	}
+
+	if h.base.Service.Cache == nil {
+		return h.base.ErrorHandler.HandleTelegramError(
+			stdErrors.New("cache is not configured"), chatID, int64(user.ID), "startMessage",
+		)
+	}
+
+	err := h.base.Service.Cache.Set(context.Background(), h.messageTargetKey(user), userID, localization.AdminPanelInputTTL)
+	if err != nil {
+		return h.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "startMessage")
+	}
+
+	if err := h.base.Service.UpdateUserState(user.ID, models.StateWaitingAdminMessage); err != nil {
+		return h.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+	}
+
+	text := h.base.Service.Localizer.GetWithParams(lang, localization.LocaleAdminPanelMessagePrompt, map[string]string{
+		"id":  strconv.Itoa(userID),
+		"max": strconv.Itoa(localization.MaxAdminMessageLength),
+	})
+	keyboard := h.cancelKeyboard(lang)
+
+	return h.base.MessageFactory.EditWithKeyboard(chatID, callback.Message.MessageID, text, &keyboard)
+}
+
+// showActionDone сообщает о выполненном действии и предлагает вернуться к карточке.
+func (h *AdminPanelHandler) showActionDone(callback *tgbotapi.CallbackQuery, user *models.User, userID int) error {
+	lang := user.InterfaceLanguageCode
+	keyboard := h.backToUserKeyboard(lang, userID)
+
+	return h.base.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID, callback.Message.MessageID, h.text(lang, localization.LocaleAdminPanelActionDone), &keyboard,
+	)
+}
+
+// withUserID извлекает ID пользователя из callback'а и вызывает обработчик.
+func (h *AdminPanelHandler) withUserID(
+	callback *tgbotapi.CallbackQuery,
+	user *models.User,
+	data string,
+	prefix string,
+	handle func(callback *tgbotapi.CallbackQuery, user *models.User, userID int) error,
+) error {
+	userID, err := strconv.Atoi(strings.TrimPrefix(data, prefix))
+	if err != nil {
+		return nil
+	}
+
+	return handle(callback, user, userID)
+}
+
+// formatUserCard формирует текст карточки пользователя.
+func (h *AdminPanelHandler) formatUserCard(lang string, card *models.AdminUserCard) string {
+	target := card.User
+
+	var text strings.Builder
+
+	text.WriteString(h.base.Service.Localizer.GetWithParams(lang, localization.LocaleAdminPanelUserCard, map[string]string{
+		"id":          strconv.Itoa(target.ID),
+		"telegram_id": strconv.FormatInt(target.TelegramID, 10),
+		"username":    orDash(prefixed("@", target.Username)),
+		"first_name":  orDash(target.FirstName),
+		"role":        target.Role,
+		"status":      target.Status,
+		"state":       target.State,
+		"native":      orDash(target.NativeLanguageCode),
+		"target":      orDash(target.TargetLanguageCode),
+		"level":       orDash(target.TargetLanguageLevel),
+		"completion":  strconv.Itoa(target.ProfileCompletionLevel),
+		"created":     target.CreatedAt.Format("02.01.2006"),
+	}))
+
+	text.WriteString("\n\n")
+
+	if len(card.Feedback) == 0 {
+		text.WriteString(h.text(lang, localization.LocaleAdminPanelFeedbackNone))
+	} else {
+		text.WriteString(h.text(lang, localization.LocaleAdminPanelFeedbackHeader))
+
+		for _, fb := range card.Feedback {
+			text.WriteString("\n" + formatFeedbackLine(fb))
+		}
+	}
+
+	if len(card.Actions) > 0 {
+		text.WriteString("\n\n" + h.text(lang, localization.LocaleAdminPanelActionsHeader))
+
+		for _, action := range card.Actions {
+			text.WriteString(fmt.Sprintf("\n• %s %s (admin #%d)", action.CreatedAt.Format("02.01.2006 15:04"), action.Action, action.AdminID))
+		}
+	}
+
+	return text.String()
+}
+
+// formatFeedbackLine форматирует отзыв одной строкой: статус, дата и начало текста.
+func formatFeedbackLine(fb map[string]interface{}) string {
+	mark := "⏳"
+	if processed, _ := fb["is_processed"].(bool); processed {
+		mark = "✅"
+	}
+
+	date := ""
+	if createdAt, ok := fb["created_at"].(time.Time); ok {
+		date = createdAt.Format("02.01.2006") + " "
+	}
+
+	feedbackText, _ := fb["feedback_text"].(string)
+	if utf8.RuneCountInString(feedbackText) > feedbackPreviewLength {
+		feedbackText = string([]rune(feedbackText)[:feedbackPreviewLength]) + "…"
+	}
+
+	return fmt.Sprintf("%s %s%s", mark, date, feedbackText)
+}
+
+// panelKeyboard - клавиатура главного окна админ-панели.
+func (h *AdminPanelHandler) panelKeyboard(lang string) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(h.text(lang, localization.LocaleAdminPanelSearchButton), localization.CallbackAdminSearch),
+		),
+	)
+}
+
+// cancelKeyboard - клавиатура отмены ввода.
+func (h *AdminPanelHandler) cancelKeyboard(lang string) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(h.text(lang, localization.LocaleAdminPanelCancelButton), localization.CallbackAdminCancel),
+		),
+	)
+}
+
+// backToUserKeyboard - клавиатура возврата к карточке пользователя.
+func (h *AdminPanelHandler) backToUserKeyboard(lang string, userID int) tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				h.text(lang, localization.LocaleAdminPanelBackToUser),
+				localization.CallbackPrefixAdminUser+strconv.Itoa(userID),
+			),
+		),
+	)
+}
+
+// resultsKeyboard - найденные пользователи по одному в строке и кнопки нового поиска.
+func (h *AdminPanelHandler) resultsKeyboard(lang string, users []*models.User) tgbotapi.InlineKeyboardMarkup {
+	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(users)+1)
+
+	for _, found := range users {
+		label := fmt.Sprintf("#%d %s %s", found.ID, found.FirstName, prefixed("@", found.Username))
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(strings.TrimSpace(label), localization.CallbackPrefixAdminUser+strconv.Itoa(found.ID)),
+		))
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(h.text(lang, localization.LocaleAdminPanelSearchButton), localization.CallbackAdminSearch),
+	))
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+// cardKeyboard - действия в карточке пользователя; кнопки без права у роли не показываются.
+func (h *AdminPanelHandler) cardKeyboard(admin *models.User, userID int) tgbotapi.InlineKeyboardMarkup {
+	lang := admin.InterfaceLanguageCode
+	id := strconv.Itoa(userID)
+
+	var rows [][]tgbotapi.InlineKeyboardButton
+
+	if h.base.Service.UserHasPermission(admin, core.PermissionManageUsers) {
+		rows = append(rows,
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData(h.text(lang, localization.LocaleAdminPanelStatusButton), localization.CallbackPrefixAdminStatus+id),
+				tgbotapi.NewInlineKeyboardButtonData(h.text(lang, localization.LocaleAdminPanelStateButton), localization.CallbackPrefixAdminState+id),
+			),
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData(h.text(lang, localization.LocaleAdminPanelResetButton), localization.CallbackPrefixAdminReset+id),
+			),
+		)
+	}
+
+	if h.base.Service.UserHasPermission(admin, core.PermissionMessageUsers) {
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(h.text(lang, localization.LocaleAdminPanelMessageButton), localization.CallbackPrefixAdminMessage+id),
+		))
+	}
+
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(h.text(lang, localization.LocaleAdminPanelBackButton), localization.CallbackAdminPanel),
+	))
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+// editError показывает ошибку действия в текущем сообщении.
+func (h *AdminPanelHandler) editError(callback *tgbotapi.CallbackQuery, user *models.User, err error, operation string) error {
+	key, ok := errorLocaleKey(err)
+	if !ok {
+		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), operation)
+	}
+
+	keyboard := h.panelKeyboard(user.InterfaceLanguageCode)
+
+	return h.base.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID, callback.Message.MessageID, h.text(user.InterfaceLanguageCode, key), &keyboard,
+	)
+}
+
+// sendError отправляет ошибку действия новым сообщением.
+func (h *AdminPanelHandler) sendError(chatID int64, user *models.User, err error, operation string) error {
+	key, ok := errorLocaleKey(err)
+	if !ok {
+		return h.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), operation)
+	}
+
+	return h.base.MessageFactory.SendWithKeyboard(chatID, h.text(user.InterfaceLanguageCode, key), h.panelKeyboard(user.InterfaceLanguageCode))
+}
+
+// errorLocaleKey возвращает ключ локализации для ожидаемых ошибок админ-панели.
+func errorLocaleKey(err error) (string, bool) {
+	switch {
+	case stdErrors.Is(err, errors.ErrPermissionDenied), stdErrors.Is(err, errors.ErrInvalidAdminAction):
+		return localization.LocaleAdminPanelAccessDenied, true
+	case stdErrors.Is(err, errors.ErrUserNotFound):
+		return localization.LocaleAdminPanelUserNotFound, true
+	case stdErrors.Is(err, errors.ErrRecipientNotOnTelegram):
+		return localization.LocaleAdminPanelNotOnTelegram, true
+	default:
+		return "", false
+	}
+}
+
+// messageTargetKey - ключ кэша с адресатом сообщения, которое вводит администратор.
+func (h *AdminPanelHandler) messageTargetKey(admin *models.User) string {
+	return localization.AdminPanelDMTargetPrefix + strconv.FormatInt(admin.TelegramID, 10)
+}
+
+// messageTarget возвращает ID адресата сообщения или 0, если ввод истек.
+func (h *AdminPanelHandler) messageTarget(admin *models.User) int {
+	if h.base.Service.Cache == nil {
+		return 0
+	}
+
+	var targetID int
+	if err := h.base.Service.Cache.Get(context.Background(), h.messageTargetKey(admin), &targetID); err != nil {
+		return 0
+	}
+
+	return targetID
+}
+
+// clearMessageTarget удаляет адресата сообщения из кэша.
+func (h *AdminPanelHandler) clearMessageTarget(admin *models.User) {
+	if h.base.Service.Cache != nil {
+		_ = h.base.Service.Cache.Delete(context.Background(), h.messageTargetKey(admin))
+	}
+}
+
+// text возвращает локализованную строку.
+func (h *AdminPanelHandler) text(lang, key string) string {
+	return h.base.Service.Localizer.Get(lang, key)
+}
+
+// prefixed добавляет префикс к непустому значению.
+func prefixed(prefix, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return prefix + value
+}
+
+// orDash заменяет пустое значение прочерком.
+func orDash(value string) string {
+	if value == "" {
+		return "—"
+	}
+
+	return value
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"time"
 
 	"language-exchange-bot/internal/localization"
@@ -137,51 +136,11 @@ func (h *AvailabilityHandlerImpl) HandleTimeAvailabilityStart(callback *tgbotapi
 		"interface_language": user.InterfaceLanguageCode,
 	})
 
-	lang := user.InterfaceLanguageCode
-	localizer := h.baseHandler.Service.Localizer
-
-	// Показываем приветственное сообщение
-	introMessage := fmt.Sprintf("%s\n\n%s",
-		localizer.Get(lang, "time_availability_intro"),
-		localizer.Get(lang, "select_day_type"),
-	)
-
-	// Создаем клавиатуру для выбора типа дней
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleTimeWeekdays),
-				"availability_daytype_weekdays",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleTimeWeekends),
-				"availability_daytype_weekends",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleTimeAny),
-				"availability_daytype_any",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, "select_specific_days_button"),
-				"availability_daytype_specific",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			h.baseHandler.KeyboardBuilder.CreateBackButton(lang, "back_to_primary_interests"),
-		),
-	)
-
-	return h.baseHandler.MessageFactory.EditWithKeyboard(
+	// Показываем приветственное сообщение с выбором типа дней
+	return h.baseHandler.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		introMessage,
-		&keyboard,
+		h.baseHandler.Screens.AvailabilityStart(user.InterfaceLanguageCode),
 	)
 }
 
@@ -297,8 +256,6 @@ func (h *AvailabilityHandlerImpl) HandleSpecificDaysSelection(callback *tgbotapi
 // ShowSpecificDaysSelection shows specific days selection interface
 func (h *AvailabilityHandlerImpl) ShowSpecificDaysSelection(callback *tgbotapi.CallbackQuery, user *models.User) error {
 	loggingService := h.baseHandler.Service.LoggingService.Telegram()
-	lang := user.InterfaceLanguageCode
-	localizer := h.baseHandler.Service.Localizer
 
 	// Получаем текущие выбранные дни
 	cacheKey := fmt.Sprintf("availability_setup:%d", user.ID)
@@ -320,137 +277,11 @@ func (h *AvailabilityHandlerImpl) ShowSpecificDaysSelection(callback *tgbotapi.C
 		selectedDays[i] = d.(string)
 	}
 
-	// Сортируем дни по порядку недели для отображения
-	weekOrder := []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
-	selectedDaysMap := make(map[string]bool)
-	for _, day := range selectedDays {
-		selectedDaysMap[day] = true
-	}
-
-	var sortedDayNames []string
-	for _, day := range weekOrder {
-		if selectedDaysMap[day] {
-			switch day {
-			case "monday":
-				sortedDayNames = append(sortedDayNames, localizer.Get(lang, "day_monday"))
-			case "tuesday":
-				sortedDayNames = append(sortedDayNames, localizer.Get(lang, "day_tuesday"))
-			case "wednesday":
-				sortedDayNames = append(sortedDayNames, localizer.Get(lang, "day_wednesday"))
-			case "thursday":
-				sortedDayNames = append(sortedDayNames, localizer.Get(lang, "day_thursday"))
-			case "friday":
-				sortedDayNames = append(sortedDayNames, localizer.Get(lang, "day_friday"))
-			case "saturday":
-				sortedDayNames = append(sortedDayNames, localizer.Get(lang, "day_saturday"))
-			case "sunday":
-				sortedDayNames = append(sortedDayNames, localizer.Get(lang, "day_sunday"))
-			}
-		}
-	}
-
-	// Форматируем выбранные дни
-	selectedDaysText := localizer.Get(lang, "no_days_selected")
-	if len(sortedDayNames) > 0 {
-		selectedDaysText = strings.Join(sortedDayNames, ", ")
-	}
-
-	message := fmt.Sprintf("%s\n\n%s: %s",
-		localizer.Get(lang, "select_specific_days"),
-		localizer.Get(lang, "selected_days"),
-		selectedDaysText,
-	)
-
-	// Создаем клавиатуру с днями недели
-	keyboard := tgbotapi.NewInlineKeyboardMarkup()
-
-	// Дни недели в 2 колонки
-	days := []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
-	selectedDaysMap = make(map[string]bool)
-	for _, day := range selectedDays {
-		selectedDaysMap[day] = true
-	}
-
-	for i := 0; i < len(days); i += 2 {
-		var buttons []tgbotapi.InlineKeyboardButton
-
-		// Первая колонка
-		if i < len(days) {
-			day := days[i]
-			symbol := "☑"
-			if selectedDaysMap[day] {
-				symbol = "✅"
-			}
-			var dayName string
-			switch day {
-			case "monday":
-				dayName = localizer.Get(lang, "day_monday")
-			case "tuesday":
-				dayName = localizer.Get(lang, "day_tuesday")
-			case "wednesday":
-				dayName = localizer.Get(lang, "day_wednesday")
-			case "thursday":
-				dayName = localizer.Get(lang, "day_thursday")
-			case "friday":
-				dayName = localizer.Get(lang, "day_friday")
-			case "saturday":
-				dayName = localizer.Get(lang, "day_saturday")
-			case "sunday":
-				dayName = localizer.Get(lang, "day_sunday")
-			}
-			buttonText := fmt.Sprintf("%s %s", symbol, dayName)
-			buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(buttonText, fmt.Sprintf("availability_specific_day_%s", day)))
-		}
-
-		// Вторая колонка
-		if i+1 < len(days) {
-			day := days[i+1]
-			symbol := "☑"
-			if selectedDaysMap[day] {
-				symbol = "✅"
-			}
-			var dayName string
-			switch day {
-			case "monday":
-				dayName = localizer.Get(lang, "day_monday")
-			case "tuesday":
-				dayName = localizer.Get(lang, "day_tuesday")
-			case "wednesday":
-				dayName = localizer.Get(lang, "day_wednesday")
-			case "thursday":
-				dayName = localizer.Get(lang, "day_thursday")
-			case "friday":
-				dayName = localizer.Get(lang, "day_friday")
-			case "saturday":
-				dayName = localizer.Get(lang, "day_saturday")
-			case "sunday":
-				dayName = localizer.Get(lang, "day_sunday")
-			}
-			buttonText := fmt.Sprintf("%s %s", symbol, dayName)
-			buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(buttonText, fmt.Sprintf("availability_specific_day_%s", day)))
-		}
-
-		keyboard.InlineKeyboard = append(keyboard.InlineKeyboard, buttons)
-	}
-
-	// Кнопки "Назад" и "Продолжить"
-	keyboard.InlineKeyboard = append(keyboard.InlineKeyboard,
-		h.baseHandler.KeyboardBuilder.CreateNavigationRow(
-			lang,
-			"availability_back_to_daytype",
-			"availability_proceed_to_time",
-		),
-	)
-
-	// Редактируем текущее сообщение вместо отправки нового
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	err = h.baseHandler.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		message,
-		keyboard,
+		h.baseHandler.Screens.AvailabilitySpecificDays(user.InterfaceLanguageCode, selectedDays),
 	)
-
-	_, err = h.baseHandler.Bot.Send(editMsg)
 	if err != nil {
 		loggingService.ErrorWithContext("Failed to edit message", "", int64(user.ID), callback.Message.Chat.ID, "ShowSpecificDaysSelection", map[string]interface{}{
 			"user_id": user.ID,
@@ -465,8 +296,6 @@ func (h *AvailabilityHandlerImpl) ShowSpecificDaysSelection(callback *tgbotapi.C
 // ShowTimeSlotSelectionWithError shows time slot selection interface with an error message
 func (h *AvailabilityHandlerImpl) ShowTimeSlotSelectionWithError(callback *tgbotapi.CallbackQuery, user *models.User, errorKey string) error {
 	loggingService := h.baseHandler.Service.LoggingService.Telegram()
-	lang := user.InterfaceLanguageCode
-	localizer := h.baseHandler.Service.Localizer
 
 	// Получаем данные из кеша
 	cacheKey := fmt.Sprintf("availability_setup:%d", user.ID)
@@ -497,88 +326,11 @@ func (h *AvailabilityHandlerImpl) ShowTimeSlotSelectionWithError(callback *tgbot
 		}
 	}
 
-	// Сортируем слоты по порядку времени суток для отображения
-	timeOrder := []string{"morning", "day", "evening", "late"}
-	selectedSlotsMap := make(map[string]bool)
-	for _, slot := range selectedSlots {
-		selectedSlotsMap[slot] = true
-	}
-
-	var sortedSlotNames []string
-	for _, slot := range timeOrder {
-		if selectedSlotsMap[slot] {
-			switch slot {
-			case "morning":
-				sortedSlotNames = append(sortedSlotNames, localizer.Get(lang, localization.LocaleTimeMorning))
-			case "day":
-				sortedSlotNames = append(sortedSlotNames, localizer.Get(lang, localization.LocaleTimeDay))
-			case "evening":
-				sortedSlotNames = append(sortedSlotNames, localizer.Get(lang, localization.LocaleTimeEvening))
-			case "late":
-				sortedSlotNames = append(sortedSlotNames, localizer.Get(lang, localization.LocaleTimeLate))
-			}
-		}
-	}
-
-	// Форматируем выбранные слоты
-	selectedSlotsText := localizer.Get(lang, "none_selected")
-	if len(sortedSlotNames) > 0 {
-		selectedSlotsText = strings.Join(sortedSlotNames, ", ")
-	}
-
-	// Формируем сообщение с ошибкой
-	errorMessage := localizer.Get(lang, errorKey)
-	message := fmt.Sprintf("%s\n\n%s: %s\n\n%s",
-		localizer.Get(lang, "select_time_slot"),
-		localizer.Get(lang, "selected_slots"),
-		selectedSlotsText,
-		errorMessage,
-	)
-
-	// Создаем клавиатуру с временными слотами
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getSlotSymbol("morning", selectedSlotsMap), localizer.Get(lang, localization.LocaleTimeMorning)),
-				"availability_timeslot_morning",
-			),
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getSlotSymbol("day", selectedSlotsMap), localizer.Get(lang, localization.LocaleTimeDay)),
-				"availability_timeslot_day",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getSlotSymbol("evening", selectedSlotsMap), localizer.Get(lang, localization.LocaleTimeEvening)),
-				"availability_timeslot_evening",
-			),
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getSlotSymbol("late", selectedSlotsMap), localizer.Get(lang, localization.LocaleTimeLate)),
-				"availability_timeslot_late",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("✅ %s", localizer.Get(lang, "select_all")),
-				"availability_timeslot_select_all",
-			),
-		),
-		h.baseHandler.KeyboardBuilder.CreateNavigationRow(
-			lang,
-			"availability_back_to_days",
-			"availability_proceed_to_communication",
-		),
-	)
-
-	// Редактируем текущее сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	err = h.baseHandler.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		message,
-		keyboard,
+		h.baseHandler.Screens.AvailabilityTimeSlotsStep(user.InterfaceLanguageCode, selectedSlots, errorKey),
 	)
-
-	_, err = h.baseHandler.Bot.Send(editMsg)
 	if err != nil {
 		loggingService.ErrorWithContext("Failed to edit message with error", "", int64(user.ID), callback.Message.Chat.ID, "ShowTimeSlotSelectionWithError", map[string]interface{}{
 			"user_id": user.ID,
@@ -631,100 +383,17 @@ func (h *AvailabilityHandlerImpl) ShowTimeSlotSelection(callback *tgbotapi.Callb
 		)
 	}
 
-	// Продолжаем работать с уже загруженными данными
-	lang := user.InterfaceLanguageCode
-	localizer := h.baseHandler.Service.Localizer
-
 	timeSlots := setupData["time_slots"].([]interface{})
 	selectedSlots := make([]string, len(timeSlots))
 	for i, t := range timeSlots {
 		selectedSlots[i] = t.(string)
 	}
 
-	// Сортируем слоты по порядку времени суток для отображения
-	timeOrder := []string{"morning", "day", "evening", "late"}
-	selectedSlotsMap := make(map[string]bool)
-	for _, slot := range selectedSlots {
-		selectedSlotsMap[slot] = true
-	}
-
-	var sortedSlotNames []string
-	for _, slot := range timeOrder {
-		if selectedSlotsMap[slot] {
-			switch slot {
-			case "morning":
-				sortedSlotNames = append(sortedSlotNames, localizer.Get(lang, localization.LocaleTimeMorning))
-			case "day":
-				sortedSlotNames = append(sortedSlotNames, localizer.Get(lang, localization.LocaleTimeDay))
-			case "evening":
-				sortedSlotNames = append(sortedSlotNames, localizer.Get(lang, localization.LocaleTimeEvening))
-			case "late":
-				sortedSlotNames = append(sortedSlotNames, localizer.Get(lang, localization.LocaleTimeLate))
-			}
-		}
-	}
-
-	// Форматируем выбранные слоты
-	selectedSlotsText := localizer.Get(lang, "none_selected")
-	if len(sortedSlotNames) > 0 {
-		selectedSlotsText = strings.Join(sortedSlotNames, ", ")
-	}
-
-	message := fmt.Sprintf("%s\n\n%s: %s",
-		localizer.Get(lang, "select_time_slot"),
-		localizer.Get(lang, "selected_slots"),
-		selectedSlotsText,
-	)
-
-	// Создаем клавиатуру с временными слотами
-	selectedSlotsMap = make(map[string]bool)
-	for _, slot := range selectedSlots {
-		selectedSlotsMap[slot] = true
-	}
-
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getSlotSymbol("morning", selectedSlotsMap), localizer.Get(lang, localization.LocaleTimeMorning)),
-				"availability_timeslot_morning",
-			),
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getSlotSymbol("day", selectedSlotsMap), localizer.Get(lang, localization.LocaleTimeDay)),
-				"availability_timeslot_day",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getSlotSymbol("evening", selectedSlotsMap), localizer.Get(lang, localization.LocaleTimeEvening)),
-				"availability_timeslot_evening",
-			),
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getSlotSymbol("late", selectedSlotsMap), localizer.Get(lang, localization.LocaleTimeLate)),
-				"availability_timeslot_late",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("✅ %s", localizer.Get(lang, "select_all")),
-				"availability_timeslot_select_all",
-			),
-		),
-		h.baseHandler.KeyboardBuilder.CreateNavigationRow(
-			lang,
-			"availability_back_to_days",
-			"availability_proceed_to_communication",
-		),
-	)
-
-	// Редактируем текущее сообщение вместо отправки нового
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	err = h.baseHandler.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		message,
-		keyboard,
+		h.baseHandler.Screens.AvailabilityTimeSlotsStep(user.InterfaceLanguageCode, selectedSlots, ""),
 	)
-
-	_, err = h.baseHandler.Bot.Send(editMsg)
 	if err != nil {
 		loggingService.ErrorWithContext("Failed to edit message", "", int64(user.ID), callback.Message.Chat.ID, "ShowTimeSlotSelection", map[string]interface{}{
 			"user_id": user.ID,
@@ -736,14 +405,6 @@ func (h *AvailabilityHandlerImpl) ShowTimeSlotSelection(callback *tgbotapi.Callb
 	return nil
 }
 
-// Helper function to get checkbox symbol for time slots
-func getSlotSymbol(slot string, selectedSlots map[string]bool) string {
-	if selectedSlots[slot] {
-		return "✅"
-	}
-	return "☑"
-}
-
 // HandleTimeSlotSelection handles time slot selection
 func (h *AvailabilityHandlerImpl) HandleTimeSlotSelection(callback *tgbotapi.CallbackQuery, user *models.User, timeSlot string) error {
 	loggingService := h.baseHandler.Service.LoggingService.Telegram()
@@ -1013,8 +674,6 @@ func (h *AvailabilityHandlerImpl) HandleSelectAllCommunication(callback *tgbotap
 // ShowCommunicationStyleSelection shows communication style selection interface
 func (h *AvailabilityHandlerImpl) ShowCommunicationStyleSelection(callback *tgbotapi.CallbackQuery, user *models.User) error {
 	loggingService := h.baseHandler.Service.LoggingService.Telegram()
-	lang := user.InterfaceLanguageCode
-	localizer := h.baseHandler.Service.Localizer
 
 	// Получаем текущие выбранные способы общения
 	cacheKey := fmt.Sprintf("availability_setup:%d", user.ID)
@@ -1036,90 +695,11 @@ func (h *AvailabilityHandlerImpl) ShowCommunicationStyleSelection(callback *tgbo
 		selectedStyles[i] = s.(string)
 	}
 
-	// Форматируем выбранные способы общения
-	selectedStylesText := localizer.Get(lang, "none_selected")
-	if len(selectedStyles) > 0 {
-		styleNames := make([]string, len(selectedStyles))
-		for i, style := range selectedStyles {
-			switch style {
-			case "text":
-				styleNames[i] = localizer.Get(lang, localization.LocaleCommText)
-			case "voice_msg":
-				styleNames[i] = localizer.Get(lang, localization.LocaleCommVoice)
-			case "audio_call":
-				styleNames[i] = localizer.Get(lang, localization.LocaleCommAudio)
-			case "video_call":
-				styleNames[i] = localizer.Get(lang, localization.LocaleCommVideo)
-			case "meet_person":
-				styleNames[i] = localizer.Get(lang, localization.LocaleCommMeet)
-			default:
-				styleNames[i] = style
-			}
-		}
-		selectedStylesText = strings.Join(styleNames, ", ")
-	}
-
-	message := fmt.Sprintf("%s\n\n%s: %s",
-		localizer.Get(lang, "select_communication_style"),
-		localizer.Get(lang, "selected_styles"),
-		selectedStylesText,
-	)
-
-	// Создаем клавиатуру с чекбоксами
-	selectedStylesMap := make(map[string]bool)
-	for _, style := range selectedStyles {
-		selectedStylesMap[style] = true
-	}
-
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getStyleSymbol("text", selectedStylesMap), localizer.Get(lang, localization.LocaleCommText)),
-				"availability_communication_text",
-			),
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getStyleSymbol("voice_msg", selectedStylesMap), localizer.Get(lang, localization.LocaleCommVoice)),
-				"availability_communication_voice_msg",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getStyleSymbol("audio_call", selectedStylesMap), localizer.Get(lang, localization.LocaleCommAudio)),
-				"availability_communication_audio_call",
-			),
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getStyleSymbol("video_call", selectedStylesMap), localizer.Get(lang, localization.LocaleCommVideo)),
-				"availability_communication_video_call",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("%s %s", getStyleSymbol("meet_person", selectedStylesMap), localizer.Get(lang, localization.LocaleCommMeet)),
-				"availability_communication_meet_person",
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				fmt.Sprintf("✅ %s", localizer.Get(lang, "select_all")),
-				"availability_communication_select_all",
-			),
-		),
-		h.baseHandler.KeyboardBuilder.CreateNavigationRow(
-			lang,
-			"availability_back_to_time",
-			"availability_proceed_to_frequency",
-		),
-	)
-
-	// Редактируем текущее сообщение вместо отправки нового
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	err = h.baseHandler.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		message,
-		keyboard,
+		h.baseHandler.Screens.AvailabilityCommunicationStep(user.InterfaceLanguageCode, selectedStyles),
 	)
-
-	_, err = h.baseHandler.Bot.Send(editMsg)
 	if err != nil {
 		loggingService.ErrorWithContext("Failed to edit message", "", int64(user.ID), callback.Message.Chat.ID, "ShowCommunicationStyleSelection", map[string]interface{}{
 			"user_id": user.ID,
@@ -1131,14 +711,6 @@ func (h *AvailabilityHandlerImpl) ShowCommunicationStyleSelection(callback *tgbo
 	return nil
 }
 
-// Helper function to get checkbox symbol for communication styles
-func getStyleSymbol(style string, selectedStyles map[string]bool) string {
-	if selectedStyles[style] {
-		return "✅"
-	}
-	return "☑"
-}
-
 // CompleteAvailabilitySetup завершает настройку доступности и сохраняет данные
 func (h *AvailabilityHandlerImpl) CompleteAvailabilitySetup(callback *tgbotapi.CallbackQuery, user *models.User) error {
 	loggingService := h.baseHandler.Service.LoggingService.Telegram()
@@ -1155,33 +727,19 @@ func (h *AvailabilityHandlerImpl) CompleteAvailabilitySetup(callback *tgbotapi.C
 	})
 
 	// НЕМЕДЛЕННО редактируем сообщение об успехе, чтобы пользователь увидел реакцию
-	lang := user.InterfaceLanguageCode
-	localizer := h.baseHandler.Service.Localizer
+	successScreen := h.baseHandler.Screens.AvailabilityCompleted(user.InterfaceLanguageCode)
 
-	successMessage := fmt.Sprintf("%s\n\n%s",
-		localizer.Get(lang, "availability_setup_complete"),
-		localizer.Get(lang, "profile_completed"),
-	)
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		h.baseHandler.KeyboardBuilder.CreateProfileActionsRow(lang),
-	)
-
-	editResult := h.baseHandler.MessageFactory.EditWithKeyboard(
+	editResult := h.baseHandler.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		successMessage,
-		&keyboard,
+		successScreen,
 	)
 	if editResult != nil {
 		loggingService.ErrorWithContext("Failed to edit message with success", "", int64(user.ID), callback.Message.Chat.ID, "CompleteAvailabilitySetup", map[string]interface{}{
 			"error": editResult.Error(),
 		})
 		// Если редактирование не удалось, отправляем новое сообщение
-		sendResult := h.baseHandler.MessageFactory.SendWithKeyboard(
-			callback.Message.Chat.ID,
-			successMessage,
-			keyboard,
-		)
+		sendResult := h.baseHandler.MessageFactory.SendScreen(callback.Message.Chat.ID, successScreen)
 		if sendResult != nil {
 			loggingService.ErrorWithContext("Failed to send success message as fallback", "", int64(user.ID), callback.Message.Chat.ID, "CompleteAvailabilitySetup", map[string]interface{}{
 				"error": sendResult.Error(),
@@ -2,10 +2,14 @@ package availability
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"language-exchange-bot/internal/core"
+	errorsPkg "language-exchange-bot/internal/errors"
 	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/models"
 
@@ -234,38 +238,7 @@ func (e *IsolatedAvailabilityEditor) ShowDayTypeSelection(callback *tgbotapi.Cal
 		message = "📅 Выберите тип дней:" // Fallback
 	}
 
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleTimeWeekdays),
-				localization.CallbackAvailEditDayTypeWeekdays,
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleTimeWeekends),
-				localization.CallbackAvailEditDayTypeWeekends,
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleTimeAny),
-				localization.CallbackAvailEditDayTypeAny,
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, "select_specific_days_button"),
-				localization.CallbackAvailEditDayTypeSpecific,
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleBackToEditMenu),
-				localization.CallbackAvailBackToEditMenu,
-			),
-		),
-	)
+	keyboard := base.RenderKeyboard(e.baseHandler.Screens.AvailabilityEditorDayTypeKeyboard(lang))
 
 	return e.baseHandler.MessageFactory.EditWithKeyboard(
 		callback.Message.Chat.ID,
@@ -595,38 +568,7 @@ func (e *IsolatedAvailabilityEditor) ShowFrequencySelection(callback *tgbotapi.C
 
 	message := localizer.Get(lang, "select_communication_frequency")
 
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleFreqMultipleWeekly),
-				localization.CallbackAvailEditFreqMultipleWeekly,
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleFreqWeekly),
-				localization.CallbackAvailEditFreqWeekly,
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleFreqMultipleMonthly),
-				localization.CallbackAvailEditFreqMultipleMonthly,
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleFreqFlexible),
-				localization.CallbackAvailEditFreqFlexible,
-			),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(
-				localizer.Get(lang, localization.LocaleBackToEditMenu),
-				localization.CallbackAvailBackToEditMenu,
-			),
-		),
-	)
+	keyboard := base.RenderKeyboard(e.baseHandler.Screens.AvailabilityEditorFrequencyKeyboard(lang))
 
 	return e.baseHandler.MessageFactory.EditWithKeyboard(
 		callback.Message.Chat.ID,
@@ -801,11 +743,7 @@ func (e *IsolatedAvailabilityEditor) CancelEdit(callback *tgbotapi.CallbackQuery
 		localizer.Get(lang, "changes_not_saved"),
 	)
 
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			e.baseHandler.KeyboardBuilder.CreateViewProfileButton(lang),
-		),
-	)
+	keyboard := base.RenderKeyboard(e.baseHandler.Screens.AvailabilityEditorClosedKeyboard(lang))
 
 	return e.baseHandler.MessageFactory.EditWithKeyboard(
 		callback.Message.Chat.ID,
@@ -828,11 +766,7 @@ func (e *IsolatedAvailabilityEditor) ShowSaveConfirmation(callback *tgbotapi.Cal
 		localizer.Get(lang, "redirecting_to_profile"),
 	)
 
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			e.baseHandler.KeyboardBuilder.CreateViewProfileButton(lang),
-		),
-	)
+	keyboard := base.RenderKeyboard(e.baseHandler.Screens.AvailabilityEditorClosedKeyboard(lang))
 
 	return e.baseHandler.MessageFactory.EditWithKeyboard(
 		callback.Message.Chat.ID,
@@ -842,6 +776,171 @@ func (e *IsolatedAvailabilityEditor) ShowSaveConfirmation(callback *tgbotapi.Cal
 	)
 }
 
+// =============================================================================
+// МЕТОДЫ РЕДАКТИРОВАНИЯ ОТПУСКА (ПЕРИОДОВ НЕДОСТУПНОСТИ)
+// =============================================================================
+// Периоды недоступности сохраняются сразу, не дожидаясь общего "Сохранить":
+// это отдельный список записей, а не поле сессии редактирования.
+
+// EditVacation показывает список периодов недоступности пользователя
+func (e *IsolatedAvailabilityEditor) EditVacation(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	if session, err := e.getEditSession(user.ID); err == nil {
+		session.CurrentStep = "vacation"
+		session.LastActivity = time.Now()
+		e.saveEditSession(session)
+	}
+
+	text, keyboard, err := e.buildVacationScreen(user, "")
+	if err != nil {
+		return err
+	}
+
+	return e.baseHandler.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID,
+		callback.Message.MessageID,
+		text,
+		&keyboard,
+	)
+}
+
+// StartVacationInput переводит пользователя в режим ввода дат отпуска
+func (e *IsolatedAvailabilityEditor) StartVacationInput(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+
+	periods, err := e.baseHandler.Service.GetUnavailabilityPeriods(user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get unavailability periods: %w", err)
+	}
+
+	if len(periods) >= localization.MaxUnavailabilityPeriods {
+		text, keyboard, err := e.buildVacationScreen(user,
+			e.baseHandler.Service.UnavailabilityErrorMessage(errorsPkg.ErrTooManyUnavailabilityPeriods, lang))
+		if err != nil {
+			return err
+		}
+
+		return e.baseHandler.MessageFactory.EditWithKeyboard(callback.Message.Chat.ID, callback.Message.MessageID, text, &keyboard)
+	}
+
+	if err := e.baseHandler.Service.UpdateUserState(user.ID, models.StateWaitingUnavailabilityDates); err != nil {
+		return fmt.Errorf("failed to update user state: %w", err)
+	}
+
+	screen := e.baseHandler.Screens.AvailabilityVacationInput(lang)
+	keyboard := base.RenderKeyboard(screen.Keyboard)
+
+	return e.baseHandler.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID,
+		callback.Message.MessageID,
+		screen.Text,
+		&keyboard,
+	)
+}
+
+// CancelVacationInput отменяет ввод дат и возвращает к списку периодов
+func (e *IsolatedAvailabilityEditor) CancelVacationInput(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	if err := e.baseHandler.Service.UpdateUserState(user.ID, models.StateActive); err != nil {
+		return fmt.Errorf("failed to update user state: %w", err)
+	}
+
+	return e.EditVacation(callback, user)
+}
+
+// HandleVacationDatesMessage обрабатывает текстовое сообщение с датами отпуска
+func (e *IsolatedAvailabilityEditor) HandleVacationDatesMessage(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+	localizer := e.baseHandler.Service.Localizer
+
+	period, err := core.ParseUnavailabilityPeriod(message.Text, time.Now())
+	if err == nil {
+		err = e.baseHandler.Service.AddUnavailabilityPeriod(user.ID, period)
+	}
+
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrTooManyUnavailabilityPeriods) {
+			_ = e.baseHandler.Service.UpdateUserState(user.ID, models.StateActive)
+		}
+
+		var customErr *errorsPkg.CustomError
+		if !errors.As(err, &customErr) || customErr.Type != errorsPkg.ErrorTypeValidation {
+			return fmt.Errorf("failed to add unavailability period: %w", err)
+		}
+
+		// Пользователь остается в режиме ввода и может повторить попытку
+		return e.baseHandler.MessageFactory.SendText(message.Chat.ID, fmt.Sprintf("%s\n\n%s",
+			e.baseHandler.Service.UnavailabilityErrorMessage(err, lang),
+			localizer.Get(lang, localization.LocaleVacationEnterDates),
+		))
+	}
+
+	if err := e.baseHandler.Service.UpdateUserState(user.ID, models.StateActive); err != nil {
+		return fmt.Errorf("failed to update user state: %w", err)
+	}
+
+	notice := localizer.GetWithParams(lang, localization.LocaleVacationAdded, map[string]string{
+		"period": core.FormatUnavailabilityPeriod(*period),
+	})
+
+	text, keyboard, err := e.buildVacationScreen(user, notice)
+	if err != nil {
+		return err
+	}
+
+	return e.baseHandler.MessageFactory.SendWithKeyboard(message.Chat.ID, text, keyboard)
+}
+
+// DeleteVacation удаляет период недоступности
+func (e *IsolatedAvailabilityEditor) DeleteVacation(callback *tgbotapi.CallbackQuery, user *models.User, periodIDStr string) error {
+	periodID, err := strconv.Atoi(periodIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid unavailability period id %q: %w", periodIDStr, err)
+	}
+
+	if err := e.baseHandler.Service.DeleteUnavailabilityPeriod(user.ID, periodID); err != nil {
+		return fmt.Errorf("failed to delete unavailability period: %w", err)
+	}
+
+	text, keyboard, err := e.buildVacationScreen(user,
+		e.baseHandler.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleVacationDeleted))
+	if err != nil {
+		return err
+	}
+
+	return e.baseHandler.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID,
+		callback.Message.MessageID,
+		text,
+		&keyboard,
+	)
+}
+
+// BackFromVacation возвращает из раздела отпуска в меню редактирования без сброса изменений сессии
+func (e *IsolatedAvailabilityEditor) BackFromVacation(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	session, err := e.getEditSession(user.ID)
+	if err != nil {
+		// Сессия могла истечь, пока пользователь вводил даты
+		return e.StartEditSession(callback, user)
+	}
+
+	session.CurrentStep = "menu"
+	session.LastActivity = time.Now()
+	e.saveEditSession(session)
+
+	return e.ShowEditMenu(callback, session, user)
+}
+
+// buildVacationScreen формирует текст и клавиатуру раздела отпуска
+func (e *IsolatedAvailabilityEditor) buildVacationScreen(user *models.User, notice string) (string, tgbotapi.InlineKeyboardMarkup, error) {
+	periods, err := e.baseHandler.Service.GetUnavailabilityPeriods(user.ID)
+	if err != nil {
+		return "", tgbotapi.InlineKeyboardMarkup{}, fmt.Errorf("failed to get unavailability periods: %w", err)
+	}
+
+	screen := e.baseHandler.Screens.AvailabilityVacation(user.InterfaceLanguageCode, notice, periods)
+
+	return screen.Text, base.RenderKeyboard(screen.Keyboard), nil
+}
+
 // =============================================================================
 // ВСПОМОГАТЕЛЬНЫЕ МЕТОДЫ
 // =============================================================================
@@ -1275,241 +1374,20 @@ func (e *IsolatedAvailabilityEditor) formatFieldName(field, lang string) string
 
 // createEditMenuKeyboard создает клавиатуру главного меню редактирования
 func (e *IsolatedAvailabilityEditor) createEditMenuKeyboard(session *AvailabilityEditSession, lang string) tgbotapi.InlineKeyboardMarkup {
-	localizer := e.baseHandler.Service.Localizer
-
-	var rows [][]tgbotapi.InlineKeyboardButton
-
-	// Кнопки редактирования
-	editDaysText := localizer.Get(lang, "edit_days")
-	if editDaysText == "edit_days" {
-		editDaysText = "📅 Edit days" // Fallback
-	}
-	rows = append(rows, []tgbotapi.InlineKeyboardButton{
-		tgbotapi.NewInlineKeyboardButtonData(
-			editDaysText,
-			localization.CallbackAvailEditDays,
-		),
-	})
-
-	editTimeText := localizer.Get(lang, "edit_time")
-	if editTimeText == "edit_time" {
-		editTimeText = "🕐 Edit time" // Fallback
-	}
-	rows = append(rows, []tgbotapi.InlineKeyboardButton{
-		tgbotapi.NewInlineKeyboardButtonData(
-			editTimeText,
-			localization.CallbackAvailEditTime,
-		),
-	})
-
-	editCommText := localizer.Get(lang, "edit_communication")
-	if editCommText == "edit_communication" {
-		editCommText = "💬 Edit communication" // Fallback
-	}
-	rows = append(rows, []tgbotapi.InlineKeyboardButton{
-		tgbotapi.NewInlineKeyboardButtonData(
-			editCommText,
-			localization.CallbackAvailEditCommunication,
-		),
-	})
-
-	editFreqText := localizer.Get(lang, "edit_frequency")
-	if editFreqText == "edit_frequency" {
-		editFreqText = "📊 Edit frequency" // Fallback
-	}
-	rows = append(rows, []tgbotapi.InlineKeyboardButton{
-		tgbotapi.NewInlineKeyboardButtonData(
-			editFreqText,
-			localization.CallbackAvailEditFrequency,
-		),
-	})
-
-	// Кнопки действий
-	var actionButtons []tgbotapi.InlineKeyboardButton
-
-	// Кнопка сохранения (только если есть изменения)
-	if len(session.Changes) > 0 {
-		actionButtons = append(actionButtons, tgbotapi.NewInlineKeyboardButtonData(
-			"✅ "+localizer.Get(lang, localization.LocaleSaveChanges),
-			localization.CallbackAvailSaveChanges,
-		))
-	}
-
-	// Кнопка отмены
-	actionButtons = append(actionButtons, tgbotapi.NewInlineKeyboardButtonData(
-		"❌ "+localizer.Get(lang, localization.LocaleCancelEdit),
-		localization.CallbackAvailCancelEdit,
-	))
-
-	// Добавляем кнопки действий в отдельный ряд
-	if len(actionButtons) > 0 {
-		rows = append(rows, actionButtons)
-	}
-
-	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+	return base.RenderKeyboard(e.baseHandler.Screens.AvailabilityEditorMenuKeyboard(lang, len(session.Changes) > 0))
 }
 
 // createSpecificDaysKeyboard создает клавиатуру выбора конкретных дней
 func (e *IsolatedAvailabilityEditor) createSpecificDaysKeyboard(session *AvailabilityEditSession, lang string) tgbotapi.InlineKeyboardMarkup {
-	localizer := e.baseHandler.Service.Localizer
-	selectedDays := make(map[string]bool)
-	for _, day := range session.CurrentTimeAvailability.SpecificDays {
-		selectedDays[day] = true
-	}
-
-	var rows [][]tgbotapi.InlineKeyboardButton
-
-	// Дни недели (2 колонки)
-	days := []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
-	for i := 0; i < len(days); i += 2 {
-		var row []tgbotapi.InlineKeyboardButton
-
-		// Первая колонка
-		if i < len(days) {
-			day := days[i]
-			symbol := "☑"
-			if selectedDays[day] {
-				symbol = "✅"
-			}
-			buttonText := fmt.Sprintf("%s %s", symbol, e.formatDayName(day, lang))
-			callbackData := fmt.Sprintf("%s_%s", localization.CallbackPrefixAvailEditDay, day)
-			row = append(row, tgbotapi.NewInlineKeyboardButtonData(buttonText, callbackData))
-		}
-
-		// Вторая колонка
-		if i+1 < len(days) {
-			day := days[i+1]
-			symbol := "☑"
-			if selectedDays[day] {
-				symbol = "✅"
-			}
-			buttonText := fmt.Sprintf("%s %s", symbol, e.formatDayName(day, lang))
-			callbackData := fmt.Sprintf("%s_%s", localization.CallbackPrefixAvailEditDay, day)
-			row = append(row, tgbotapi.NewInlineKeyboardButtonData(buttonText, callbackData))
-		}
-
-		rows = append(rows, row)
-	}
-
-	// Кнопки действий
-	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData(
-			"✅ "+localizer.Get(lang, localization.LocaleSaveChanges),
-			localization.CallbackAvailApplyDays,
-		),
-		tgbotapi.NewInlineKeyboardButtonData(
-			localizer.Get(lang, localization.LocaleBackToEditMenu),
-			localization.CallbackAvailBackToEditMenu,
-		),
-	))
-
-	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+	return base.RenderKeyboard(e.baseHandler.Screens.AvailabilityEditorDaysKeyboard(lang, session.CurrentTimeAvailability.SpecificDays))
 }
 
 // createTimeSlotsKeyboard создает клавиатуру выбора временных слотов
 func (e *IsolatedAvailabilityEditor) createTimeSlotsKeyboard(session *AvailabilityEditSession, lang string) tgbotapi.InlineKeyboardMarkup {
-	localizer := e.baseHandler.Service.Localizer
-	selectedSlots := make(map[string]bool)
-	for _, slot := range session.CurrentTimeAvailability.TimeSlots {
-		selectedSlots[slot] = true
-	}
-
-	var rows [][]tgbotapi.InlineKeyboardButton
-
-	// Временные слоты
-	slots := []string{"morning", "day", "evening", "late"}
-	for _, slot := range slots {
-		symbol := "☑"
-		if selectedSlots[slot] {
-			symbol = "✅"
-		}
-
-		var slotText string
-		switch slot {
-		case "morning":
-			slotText = localizer.Get(lang, localization.LocaleTimeMorning)
-		case "day":
-			slotText = localizer.Get(lang, localization.LocaleTimeDay)
-		case "evening":
-			slotText = localizer.Get(lang, localization.LocaleTimeEvening)
-		case "late":
-			slotText = localizer.Get(lang, localization.LocaleTimeLate)
-		}
-
-		buttonText := fmt.Sprintf("%s %s", symbol, slotText)
-		callbackData := fmt.Sprintf("%s_%s", localization.CallbackPrefixAvailEditTimeSlot, slot)
-
-		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(buttonText, callbackData),
-		))
-	}
-
-	// Кнопки действий
-	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData(
-			"✅ "+localizer.Get(lang, localization.LocaleSaveChanges),
-			localization.CallbackAvailApplyTime,
-		),
-		tgbotapi.NewInlineKeyboardButtonData(
-			localizer.Get(lang, localization.LocaleBackToEditMenu),
-			localization.CallbackAvailBackToEditMenu,
-		),
-	))
-
-	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+	return base.RenderKeyboard(e.baseHandler.Screens.AvailabilityEditorTimeSlotsKeyboard(lang, session.CurrentTimeAvailability.TimeSlots))
 }
 
 // createCommunicationKeyboard создает клавиатуру выбора способов общения
 func (e *IsolatedAvailabilityEditor) createCommunicationKeyboard(session *AvailabilityEditSession, lang string) tgbotapi.InlineKeyboardMarkup {
-	localizer := e.baseHandler.Service.Localizer
-	selectedStyles := make(map[string]bool)
-	for _, style := range session.CurrentPreferences.CommunicationStyles {
-		selectedStyles[style] = true
-	}
-
-	var rows [][]tgbotapi.InlineKeyboardButton
-
-	// Способы общения
-	styles := []string{"text", "voice_msg", "audio_call", "video_call", "meet_person"}
-	for _, style := range styles {
-		symbol := "☑"
-		if selectedStyles[style] {
-			symbol = "✅"
-		}
-
-		var styleText string
-		switch style {
-		case "text":
-			styleText = localizer.Get(lang, localization.LocaleCommText)
-		case "voice_msg":
-			styleText = localizer.Get(lang, localization.LocaleCommVoice)
-		case "audio_call":
-			styleText = localizer.Get(lang, localization.LocaleCommAudio)
-		case "video_call":
-			styleText = localizer.Get(lang, localization.LocaleCommVideo)
-		case "meet_person":
-			styleText = localizer.Get(lang, localization.LocaleCommMeet)
-		}
-
-		buttonText := fmt.Sprintf("%s %s", symbol, styleText)
-		callbackData := fmt.Sprintf("%s_%s", localization.CallbackPrefixAvailEditCommStyle, style)
-
-		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(buttonText, callbackData),
-		))
-	}
-
-	// Кнопки действий
-	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData(
-			"✅ "+localizer.Get(lang, localization.LocaleSaveChanges),
-			localization.CallbackAvailApplyCommunication,
-		),
-		tgbotapi.NewInlineKeyboardButtonData(
-			localizer.Get(lang, localization.LocaleBackToEditMenu),
-			localization.CallbackAvailBackToEditMenu,
-		),
-	))
-
-	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+	return base.RenderKeyboard(e.baseHandler.Screens.AvailabilityEditorCommunicationKeyboard(lang, session.CurrentPreferences.CommunicationStyles))
 }
@@ -3,6 +3,7 @@ package base
 import (
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 
+	"language-exchange-bot/internal/adapters/screens"
 	"language-exchange-bot/internal/core"
 	"language-exchange-bot/internal/errors"
 )
@@ -13,7 +14,7 @@ import (
 // Пример использования:
 //
 //	base := NewBaseHandler(bot, service, keyboardBuilder, errorHandler, messageFactory)
-//	handler := NewFeedbackHandler(base, adminChatIDs, adminUsernames)
+//	handler := NewFeedbackHandler(base, adminChatIDs)
 //	handler.Base.MessageFactory.SendText(chatID, "Hello")
 type BaseHandler struct {
 	Bot             *tgbotapi.BotAPI
@@ -21,6 +22,7 @@ type BaseHandler struct {
 	KeyboardBuilder *KeyboardBuilder
 	ErrorHandler    *errors.ErrorHandler
 	MessageFactory  *MessageFactory
+	Screens         *screens.Builder // Экраны сценариев, общие с другими платформами
 }
 
 // NewBaseHandler создает новый BaseHandler с общими зависимостями.
@@ -37,6 +39,7 @@ func NewBaseHandler(
 		KeyboardBuilder: keyboardBuilder,
 		ErrorHandler:    errorHandler,
 		MessageFactory:  messageFactory,
+		Screens:         screens.NewBuilder(service),
 	}
 }
 
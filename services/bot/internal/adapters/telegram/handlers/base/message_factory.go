@@ -118,6 +118,19 @@ func (f *MessageFactory) EditHTMLWithKeyboard(chatID int64, messageID int, htmlT
 	return f.sendWithLogging(edit, chatID, 0, "EditHTMLWithKeyboard", "edit_html_with_keyboard")
 }
 
+// SendDocument отправляет файл из памяти с подписью.
+func (f *MessageFactory) SendDocument(chatID int64, fileName string, data []byte, caption string) error {
+	document := tgbotapi.NewDocument(chatID, tgbotapi.FileBytes{Name: fileName, Bytes: data})
+	document.Caption = caption
+
+	return f.sendWithLogging(document, chatID, 0, "SendDocument", "document")
+}
+
+// SendMedia отправляет готовое сообщение с фото, документом или голосовым сообщением.
+func (f *MessageFactory) SendMedia(chatID int64, media tgbotapi.Chattable) error {
+	return f.sendWithLogging(media, chatID, 0, "SendMedia", "media")
+}
+
 // =============================================================================
 // BUILDER API - для сложных случаев (20% использования)
 // =============================================================================
@@ -286,6 +299,16 @@ func (b *EditMessageBuilder) Send() error {
 // ВНУТРЕННЯЯ ЛОГИКА
 // =============================================================================
 
+// CheckRecipientChat проверяет чат перед отправкой. Все отправки пользователям идут через эту проверку:
+// у пользователей других платформ telegram_id нет (в модели 0), и сообщение им не доставить.
+func CheckRecipientChat(chatID int64) error {
+	if chatID == 0 {
+		return errors.ErrRecipientNotOnTelegram
+	}
+
+	return nil
+}
+
 // sendWithLogging отправляет сообщение с логированием и обработкой ошибок.
 func (f *MessageFactory) sendWithLogging(
 	msg tgbotapi.Chattable,
@@ -294,6 +317,10 @@ func (f *MessageFactory) sendWithLogging(
 	operation string,
 	messageType string,
 ) error {
+	if err := CheckRecipientChat(chatID); err != nil {
+		return err
+	}
+
 	// Логирование перед отправкой
 	f.logOutgoingMessage(chatID, userID, operation, messageType)
 
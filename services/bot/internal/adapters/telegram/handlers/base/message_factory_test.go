@@ -3,6 +3,8 @@ package base
 import (
 	"testing"
 
+	"language-exchange-bot/internal/errors"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -92,3 +94,11 @@ func TestMessageFactory_NewEditMessage(t *testing.T) {
 	assert.Equal(t, int64(123), builder.chatID)
 	assert.Equal(t, 456, builder.messageID)
 }
+
+// TestMessageFactory_SendTextWithoutTelegramID tests that a user without telegram_id never reaches the Bot API.
+func TestMessageFactory_SendTextWithoutTelegramID(t *testing.T) {
+	factory := NewMessageFactory(nil, nil, nil)
+
+	assert.ErrorIs(t, factory.SendText(0, "hello"), errors.ErrRecipientNotOnTelegram)
+	assert.NoError(t, CheckRecipientChat(-1001234567890))
+}
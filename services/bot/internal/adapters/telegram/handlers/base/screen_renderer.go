@@ -0,0 +1,71 @@
+package base
+
+import (
+	"language-exchange-bot/internal/adapters"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// RenderKeyboard превращает клавиатуру экрана в inline-клавиатуру Telegram.
+// Действие кнопки становится ее callback data.
+func RenderKeyboard(keyboard adapters.Keyboard) tgbotapi.InlineKeyboardMarkup {
+	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(keyboard))
+
+	for _, row := range keyboard {
+		buttons := make([]tgbotapi.InlineKeyboardButton, 0, len(row))
+		for _, button := range row {
+			buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(button.Text, button.Action))
+		}
+
+		rows = append(rows, buttons)
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+// SendScreen отправляет экран новым сообщением.
+func (f *MessageFactory) SendScreen(chatID int64, screen adapters.Screen) error {
+	if len(screen.Keyboard) == 0 {
+		return f.SendText(chatID, screen.Text)
+	}
+
+	return f.SendWithKeyboard(chatID, screen.Text, RenderKeyboard(screen.Keyboard))
+}
+
+// EditScreen заменяет сообщение экраном.
+func (f *MessageFactory) EditScreen(chatID int64, messageID int, screen adapters.Screen) error {
+	if len(screen.Keyboard) == 0 {
+		return f.EditText(chatID, messageID, screen.Text)
+	}
+
+	keyboard := RenderKeyboard(screen.Keyboard)
+
+	return f.EditWithKeyboard(chatID, messageID, screen.Text, &keyboard)
+}
+
+// SendHTMLScreen отправляет экран с текстом в HTML новым сообщением.
+func (f *MessageFactory) SendHTMLScreen(chatID int64, screen adapters.Screen) error {
+	if len(screen.Keyboard) == 0 {
+		return f.SendHTML(chatID, screen.Text)
+	}
+
+	return f.SendHTMLWithKeyboard(chatID, screen.Text, RenderKeyboard(screen.Keyboard))
+}
+
+// EditHTMLScreen заменяет сообщение экраном с текстом в HTML.
+func (f *MessageFactory) EditHTMLScreen(chatID int64, messageID int, screen adapters.Screen) error {
+	if len(screen.Keyboard) == 0 {
+		return f.EditHTML(chatID, messageID, screen.Text)
+	}
+
+	keyboard := RenderKeyboard(screen.Keyboard)
+
+	return f.EditHTMLWithKeyboard(chatID, messageID, screen.Text, &keyboard)
+}
+
+// EditKeyboard заменяет только клавиатуру сообщения, текст остается прежним.
+func (f *MessageFactory) EditKeyboard(chatID int64, messageID int, keyboard adapters.Keyboard) error {
+	edit := tgbotapi.NewEditMessageReplyMarkup(chatID, messageID, RenderKeyboard(keyboard))
+
+	return f.sendWithLogging(edit, chatID, 0, "EditKeyboard", "edit_keyboard")
+}
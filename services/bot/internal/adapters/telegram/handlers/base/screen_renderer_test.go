@@ -0,0 +1,22 @@
+package base
+
+import (
+	"testing"
+
+	"language-exchange-bot/internal/adapters"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRenderKeyboard tests rendering a screen keyboard as inline keyboard.
+func TestRenderKeyboard(t *testing.T) {
+	markup := RenderKeyboard(adapters.Keyboard{
+		adapters.Row(adapters.Button{Text: "Profile", Action: "main_view_profile"}, adapters.Button{Text: "Edit", Action: "main_edit_profile"}),
+		adapters.Row(adapters.Button{Text: "Back", Action: "back_to_main_menu"}),
+	})
+
+	assert.Len(t, markup.InlineKeyboard, 2)
+	assert.Len(t, markup.InlineKeyboard[0], 2)
+	assert.Equal(t, "Edit", markup.InlineKeyboard[0][1].Text)
+	assert.Equal(t, "back_to_main_menu", *markup.InlineKeyboard[1][0].CallbackData)
+}
@@ -5,38 +5,17 @@ import (
 	"strconv"
 	"time"
 
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/models"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
 
 func (fh *FeedbackHandlerImpl) HandleFeedbacksCommand(message *tgbotapi.Message, user *models.User) error {
-	// Проверяем права администратора по Chat ID и username
-	isAdminByID := false
-	isAdminByUsername := false
-
-	// Проверяем по Chat ID
-	for _, adminID := range fh.adminChatIDs {
-		if message.Chat.ID == adminID {
-			isAdminByID = true
-
-			break
-		}
-	}
-
-	// Проверяем по username
-	if message.From != nil && message.From.UserName != "" {
-		for _, adminUsername := range fh.adminUsernames {
-			if message.From.UserName == adminUsername {
-				isAdminByUsername = true
-
-				break
-			}
-		}
-	}
-
-	// Если пользователь не является администратором, отправляем сообщение об отказе
-	if !isAdminByID && !isAdminByUsername {
+	// Просмотр отзывов доступен модераторам и администраторам
+	if !fh.base.Service.UserHasPermission(user, core.PermissionViewFeedback) {
 		return fh.sendMessage(message.Chat.ID, fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "access_denied"))
 	}
 
@@ -63,6 +42,13 @@ func (fh *FeedbackHandlerImpl) changeFeedbackStatus(callback *tgbotapi.CallbackQ
 		return fh.sendMessage(callback.Message.Chat.ID, "❌ Ошибка обновления статуса")
 	}
 
+	auditAction := audit.ActionFeedbackReopen
+	if processed {
+		auditAction = audit.ActionFeedbackProcess
+	}
+
+	fh.base.Service.RecordUserAudit(user, auditAction, audit.TargetFeedback, feedbackID, nil)
+
 	// Используем MessageFactory для отправки HTML сообщения
 	if err := fh.base.MessageFactory.SendHTML(callback.Message.Chat.ID, confirmMsg); err != nil {
 		// Используем структурированное логирование
@@ -85,7 +71,7 @@ func (fh *FeedbackHandlerImpl) changeFeedbackStatus(callback *tgbotapi.CallbackQ
 // processArchiveFeedbackAction обрабатывает действия над архивными отзывами.
 //
 //nolint:cyclop // функция содержит последовательную логику обработки, сложность оправдана
-func (fh *FeedbackHandlerImpl) processArchiveFeedbackAction(callback *tgbotapi.CallbackQuery, user *models.User, indexStr string, actionFunc func(int) error, successMessage string) error {
+func (fh *FeedbackHandlerImpl) processArchiveFeedbackAction(callback *tgbotapi.CallbackQuery, user *models.User, indexStr string, actionFunc func(int) error, auditAction, successMessage string) error {
 	// Получаем все обработанные отзывы
 	allFeedbacks, err := fh.base.Service.GetAllFeedback()
 	if err != nil {
@@ -116,22 +102,15 @@ func (fh *FeedbackHandlerImpl) processArchiveFeedbackAction(callback *tgbotapi.C
 		return fh.sendMessage(callback.Message.Chat.ID, "❌ "+err.Error())
 	}
 
+	fh.base.Service.RecordUserAudit(user, auditAction, audit.TargetFeedback, feedbackID, nil)
+
 	// Обновляем список (удаляем обработанный отзыв)
 	archiveFeedbacks = append(archiveFeedbacks[:index], archiveFeedbacks[index+1:]...)
 
 	// Показываем следующий отзыв или сообщение об отсутствии отзывов
 	if len(archiveFeedbacks) == 0 {
 		// Редактируем сообщение
-		text := successMessage
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-			),
-		)
-
-		err = fh.base.MessageFactory.EditWithKeyboard(callback.Message.Chat.ID, callback.Message.MessageID, text, &keyboard)
-
-		return err
+		return fh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, fh.base.Screens.FeedbackNotice(successMessage))
 	}
 
 	// Показываем следующий отзыв (или предыдущий, если это был последний)
@@ -199,6 +178,8 @@ func (fh *FeedbackHandlerImpl) HandleFeedbackDelete(callback *tgbotapi.CallbackQ
 		return fh.sendMessage(callback.Message.Chat.ID, "❌ Ошибка удаления отзыва")
 	}
 
+	fh.base.Service.RecordUserAudit(user, audit.ActionFeedbackDelete, audit.TargetFeedback, feedbackID, nil)
+
 	// Используем MessageFactory для отправки HTML сообщения
 	deleteMsg := fmt.Sprintf("🗑️ Отзыв #%d <b>удален</b>", feedbackID)
 	if err := fh.base.MessageFactory.SendHTML(callback.Message.Chat.ID, deleteMsg); err != nil {
@@ -377,24 +358,7 @@ func (fh *FeedbackHandlerImpl) handleBrowseFeedbacks(callback *tgbotapi.Callback
 	}
 
 	// Фильтруем отзывы по типу
-	var feedbacks []map[string]interface{}
-
-	switch feedbackType {
-	case "active":
-		for _, fb := range allFeedbacks {
-			if isArchived, ok := fb["is_processed"].(bool); !ok || !isArchived {
-				feedbacks = append(feedbacks, fb)
-			}
-		}
-	case "archive":
-		for _, fb := range allFeedbacks {
-			if isArchived, ok := fb["is_processed"].(bool); ok && isArchived {
-				feedbacks = append(feedbacks, fb)
-			}
-		}
-	case "all":
-		feedbacks = allFeedbacks
-	}
+	feedbacks := fh.feedbacksByType(allFeedbacks, feedbackType, user)
 
 	if len(feedbacks) == 0 {
 		return fh.sendMessage(callback.Message.Chat.ID, "📝 Отзывов нет")
@@ -479,22 +443,15 @@ func (fh *FeedbackHandlerImpl) HandleArchiveFeedback(callback *tgbotapi.Callback
 		return fh.sendMessage(callback.Message.Chat.ID, "❌ Ошибка архивирования отзыва")
 	}
 
+	fh.base.Service.RecordUserAudit(user, audit.ActionFeedbackArchive, audit.TargetFeedback, feedbackID, nil)
+
 	// Обновляем список активных отзывов
 	activeFeedbacks = append(activeFeedbacks[:index], activeFeedbacks[index+1:]...)
 
 	// Показываем следующий отзыв или сообщение об отсутствии отзывов
 	if len(activeFeedbacks) == 0 {
 		// Редактируем сообщение, показывая что все отзывы обработаны
-		text := "✅ Отзыв обработан!\n\n🎉 Все отзывы обработаны!"
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-			),
-		)
-
-		err = fh.base.MessageFactory.EditWithKeyboard(callback.Message.Chat.ID, callback.Message.MessageID, text, &keyboard)
-
-		return err
+		return fh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, fh.base.Screens.FeedbackNotice("✅ Отзыв обработан!\n\n🎉 Все отзывы обработаны!"))
 	}
 
 	// Показываем следующий отзыв (или предыдущий, если это был последний)
@@ -515,6 +472,8 @@ func (fh *FeedbackHandlerImpl) HandleBackToFeedbacks(callback *tgbotapi.Callback
 		return fh.editArchiveFeedbacksList(callback.Message.Chat.ID, callback.Message.MessageID, user)
 	case "all":
 		return fh.editAllFeedbacksList(callback.Message.Chat.ID, callback.Message.MessageID, user)
+	case localization.FeedbackTypeFilteredLocal:
+		return fh.editFilterMenu(callback.Message.Chat.ID, callback.Message.MessageID, user, fh.loadFeedbackFilter(user))
 	default:
 		return fh.editFeedbackStatistics(callback.Message.Chat.ID, callback.Message.MessageID, user)
 	}
@@ -545,18 +504,7 @@ func (fh *FeedbackHandlerImpl) editActiveFeedbacks(chatID int64, messageID int,
 	// Проверяем, есть ли активные отзывы
 	if len(activeFeedbacks) == 0 {
 		// Показываем сообщение об отсутствии активных отзывов
-		text := "🎉 Все отзывы обработаны!"
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-			),
-		)
-
-		editMsg := tgbotapi.NewEditMessageText(chatID, messageID, text)
-		editMsg.ReplyMarkup = &keyboard
-		_, err := fh.base.Bot.Send(editMsg)
-
-		return err
+		return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.base.Screens.FeedbackNotice("🎉 Все отзывы обработаны!"))
 	}
 
 	// Показываем первый отзыв с навигацией
@@ -583,18 +531,7 @@ func (fh *FeedbackHandlerImpl) editArchiveFeedbacks(chatID int64, messageID int,
 	// Проверяем, есть ли обработанные отзывы
 	if len(archiveFeedbacks) == 0 {
 		// Показываем сообщение об отсутствии обработанных отзывов
-		text := "📦 Обработанных отзывов пока нет"
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-			),
-		)
-
-		editMsg := tgbotapi.NewEditMessageText(chatID, messageID, text)
-		editMsg.ReplyMarkup = &keyboard
-		_, err := fh.base.Bot.Send(editMsg)
-
-		return err
+		return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.base.Screens.FeedbackNotice("📦 Обработанных отзывов пока нет"))
 	}
 
 	// Показываем первый отзыв с навигацией
@@ -612,18 +549,7 @@ func (fh *FeedbackHandlerImpl) editAllFeedbacks(chatID int64, messageID int, use
 	// Проверяем, есть ли отзывы
 	if len(allFeedbacks) == 0 {
 		// Показываем сообщение об отсутствии отзывов
-		text := "📝 Отзывов пока нет"
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-			),
-		)
-
-		editMsg := tgbotapi.NewEditMessageText(chatID, messageID, text)
-		editMsg.ReplyMarkup = &keyboard
-		_, err := fh.base.Bot.Send(editMsg)
-
-		return err
+		return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.base.Screens.FeedbackNotice("📝 Отзывов пока нет"))
 	}
 
 	// Показываем первый отзыв с навигацией
@@ -650,50 +576,11 @@ func (fh *FeedbackHandlerImpl) editActiveFeedbacksList(chatID int64, messageID i
 	// Проверяем, есть ли активные отзывы
 	if len(activeFeedbacks) == 0 {
 		// Показываем сообщение об отсутствии активных отзывов
-		text := "🎉 Все отзывы обработаны!"
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-			),
-		)
-
-		sendErr := fh.base.MessageFactory.EditWithKeyboard(chatID, messageID, text, &keyboard)
-
-		return sendErr
+		return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.base.Screens.FeedbackNotice("🎉 Все отзывы обработаны!"))
 	}
 
-	// Показываем заголовок списка активных отзывов
-	text := fmt.Sprintf("🔥 <b>Активные отзывы (%d):</b>\n\nВыберите отзыв для просмотра:", len(activeFeedbacks))
-
-	// Создаем клавиатуру с кнопками для каждого отзыва
-	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(activeFeedbacks))
-
-	for i, feedback := range activeFeedbacks {
-		feedbackID := feedback["id"].(int)
-		firstName := feedback["first_name"].(string)
-
-		buttonText := fmt.Sprintf("📝 %s (ID: %d)", firstName, feedbackID)
-		if username, ok := feedback["username"].(string); ok && username != "" {
-			buttonText = fmt.Sprintf("📝 %s (@%s) (ID: %d)", firstName, username, feedbackID)
-		}
-
-		buttonData := fmt.Sprintf("nav_active_feedback_%d", i)
-
-		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(buttonText, buttonData),
-		))
-	}
-
-	// Добавляем кнопки навигации
-	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-	))
-
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(rows...)
-
-	err = fh.base.MessageFactory.EditHTMLWithKeyboard(chatID, messageID, text, &keyboard)
-
-	return err
+	// Показываем список отзывов для выбора
+	return fh.base.MessageFactory.EditHTMLScreen(chatID, messageID, fh.base.Screens.FeedbackList(localization.FeedbackTypeActiveLocal, activeFeedbacks))
 }
 
 // editArchiveFeedbacksList редактирует сообщение со списком обработанных отзывов (заголовок).
@@ -716,50 +603,11 @@ func (fh *FeedbackHandlerImpl) editArchiveFeedbacksList(chatID int64, messageID
 	// Проверяем, есть ли обработанные отзывы
 	if len(archiveFeedbacks) == 0 {
 		// Показываем сообщение об отсутствии обработанных отзывов
-		text := "📦 Обработанных отзывов пока нет"
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-			),
-		)
-
-		sendErr := fh.base.MessageFactory.EditWithKeyboard(chatID, messageID, text, &keyboard)
-
-		return sendErr
-	}
-
-	// Показываем заголовок списка обработанных отзывов
-	text := fmt.Sprintf("📦 <b>Обработанные отзывы (%d):</b>\n\nВыберите отзыв для просмотра:", len(archiveFeedbacks))
-
-	// Создаем клавиатуру с кнопками для каждого отзыва
-	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(archiveFeedbacks))
-
-	for i, feedback := range archiveFeedbacks {
-		feedbackID := feedback["id"].(int)
-		firstName := feedback["first_name"].(string)
-
-		buttonText := fmt.Sprintf("📝 %s (ID: %d)", firstName, feedbackID)
-		if username, ok := feedback["username"].(string); ok && username != "" {
-			buttonText = fmt.Sprintf("📝 %s (@%s) (ID: %d)", firstName, username, feedbackID)
-		}
-
-		buttonData := fmt.Sprintf("nav_archive_feedback_%d", i)
-
-		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(buttonText, buttonData),
-		))
+		return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.base.Screens.FeedbackNotice("📦 Обработанных отзывов пока нет"))
 	}
 
-	// Добавляем кнопки навигации
-	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-	))
-
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(rows...)
-
-	err = fh.base.MessageFactory.EditHTMLWithKeyboard(chatID, messageID, text, &keyboard)
-
-	return err
+	// Показываем список отзывов для выбора
+	return fh.base.MessageFactory.EditHTMLScreen(chatID, messageID, fh.base.Screens.FeedbackList(localization.FeedbackTypeArchiveLocal, archiveFeedbacks))
 }
 
 // editAllFeedbacksList редактирует сообщение со списком всех отзывов (заголовок).
@@ -773,56 +621,11 @@ func (fh *FeedbackHandlerImpl) editAllFeedbacksList(chatID int64, messageID int,
 	// Проверяем, есть ли отзывы
 	if len(allFeedbacks) == 0 {
 		// Показываем сообщение об отсутствии отзывов
-		text := "📝 Отзывов пока нет"
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-			),
-		)
-
-		sendErr := fh.base.MessageFactory.EditWithKeyboard(chatID, messageID, text, &keyboard)
-
-		return sendErr
-	}
-
-	// Показываем заголовок списка всех отзывов
-	text := fmt.Sprintf("📋 <b>Все отзывы (%d):</b>\n\nВыберите отзыв для просмотра:", len(allFeedbacks))
-
-	// Создаем клавиатуру с кнопками для каждого отзыва
-	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(allFeedbacks))
-
-	for i, feedback := range allFeedbacks {
-		feedbackID := feedback["id"].(int)
-		firstName := feedback["first_name"].(string)
-		isProcessed := feedback["is_processed"].(bool)
-
-		status := "🔥"
-		if isProcessed {
-			status = "📦"
-		}
-
-		buttonText := fmt.Sprintf("%s %s (ID: %d)", status, firstName, feedbackID)
-		if username, ok := feedback["username"].(string); ok && username != "" {
-			buttonText = fmt.Sprintf("%s %s (@%s) (ID: %d)", status, firstName, username, feedbackID)
-		}
-
-		buttonData := fmt.Sprintf("nav_all_feedback_%d", i)
-
-		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(buttonText, buttonData),
-		))
+		return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.base.Screens.FeedbackNotice("📝 Отзывов пока нет"))
 	}
 
-	// Добавляем кнопки навигации
-	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-	))
-
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(rows...)
-
-	err = fh.base.MessageFactory.EditHTMLWithKeyboard(chatID, messageID, text, &keyboard)
-
-	return err
+	// Показываем список отзывов для выбора
+	return fh.base.MessageFactory.EditHTMLScreen(chatID, messageID, fh.base.Screens.FeedbackList(localization.FeedbackTypeAllLocal, allFeedbacks))
 }
 
 // HandleDeleteCurrentFeedback удаляет текущий отзыв.
@@ -832,6 +635,7 @@ func (fh *FeedbackHandlerImpl) HandleDeleteCurrentFeedback(callback *tgbotapi.Ca
 		user,
 		indexStr,
 		fh.base.Service.DeleteFeedback,
+		audit.ActionFeedbackDelete,
 		"✅ Отзыв удален!\n\n🎉 Все обработанные отзывы удалены!",
 	)
 }
@@ -858,18 +662,9 @@ func (fh *FeedbackHandlerImpl) HandleDeleteAllArchiveFeedbacks(callback *tgbotap
 	}
 
 	// Показываем подтверждение
-	text := fmt.Sprintf("⚠️ <b>Подтверждение удаления</b>\n\nВы действительно хотите удалить <b>%d обработанных отзывов</b>?\n\n❗️ <b>Это действие нельзя отменить!</b>", processedCount)
-
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("✅ Да, удалить все", "confirm_delete_all_archive"),
-			tgbotapi.NewInlineKeyboardButtonData("❌ Отмена", "back_to_archive_feedbacks"),
-		),
-	)
+	screen := fh.base.Screens.FeedbackDeleteAllConfirm(processedCount)
 
-	err = fh.base.MessageFactory.EditHTMLWithKeyboard(callback.Message.Chat.ID, callback.Message.MessageID, text, &keyboard)
-
-	return err
+	return fh.base.MessageFactory.EditHTMLScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // HandleConfirmDeleteAllArchive подтверждает и выполняет удаление всех обработанных отзывов.
@@ -880,18 +675,12 @@ func (fh *FeedbackHandlerImpl) HandleConfirmDeleteAllArchive(callback *tgbotapi.
 		return fh.sendMessage(callback.Message.Chat.ID, "❌ Ошибка удаления отзывов: "+err.Error())
 	}
 
+	fh.base.Service.RecordUserAudit(user, audit.ActionFeedbackDeleteAll, audit.TargetFeedback, 0, map[string]interface{}{"deleted": deletedCount})
+
 	// Показываем результат
 	text := fmt.Sprintf("✅ <b>Удаление завершено!</b>\n\n🗑️ Удалено отзывов: <b>%d</b>\n\n📊 Все обработанные отзывы удалены из базы данных.", deletedCount)
 
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("📊 К статистике", "back_to_feedback_stats"),
-		),
-	)
-
-	err = fh.base.MessageFactory.EditHTMLWithKeyboard(callback.Message.Chat.ID, callback.Message.MessageID, text, &keyboard)
-
-	return err
+	return fh.base.MessageFactory.EditHTMLScreen(callback.Message.Chat.ID, callback.Message.MessageID, fh.base.Screens.FeedbackNotice(text))
 }
 
 // HandleUnarchiveFeedback возвращает отзыв в активные.
@@ -901,6 +690,7 @@ func (fh *FeedbackHandlerImpl) HandleUnarchiveFeedback(callback *tgbotapi.Callba
 		user,
 		indexStr,
 		fh.base.Service.UnarchiveFeedback,
+		audit.ActionFeedbackReopen,
 		"✅ Отзыв возвращен в активные!\n\n🎉 Все обработанные отзывы возвращены!",
 	)
 }
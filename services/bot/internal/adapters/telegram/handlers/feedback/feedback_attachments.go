@@ -0,0 +1,215 @@
+package feedback
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"strconv"
+
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// AttachmentFromMessage возвращает вложение отзыва из сообщения с фото, документом или голосовым сообщением.
+// Из фото берется самый крупный размер.
+func AttachmentFromMessage(message *tgbotapi.Message) (models.FeedbackAttachment, bool) {
+	switch {
+	case len(message.Photo) > 0:
+		photo := message.Photo[len(message.Photo)-1]
+
+		return models.FeedbackAttachment{
+			Kind:         models.FeedbackAttachmentPhoto,
+			FileID:       photo.FileID,
+			FileUniqueID: photo.FileUniqueID,
+			FileSize:     photo.FileSize,
+		}, true
+	case message.Document != nil:
+		return models.FeedbackAttachment{
+			Kind:         models.FeedbackAttachmentDocument,
+			FileID:       message.Document.FileID,
+			FileUniqueID: message.Document.FileUniqueID,
+			FileName:     message.Document.FileName,
+			MimeType:     message.Document.MimeType,
+			FileSize:     message.Document.FileSize,
+		}, true
+	case message.Voice != nil:
+		return models.FeedbackAttachment{
+			Kind:         models.FeedbackAttachmentVoice,
+			FileID:       message.Voice.FileID,
+			FileUniqueID: message.Voice.FileUniqueID,
+			MimeType:     message.Voice.MimeType,
+			FileSize:     message.Voice.FileSize,
+		}, true
+	default:
+		return models.FeedbackAttachment{}, false
+	}
+}
+
+// AttachmentMessage возвращает сообщение, которое повторно отправляет вложение в чат chatID по его FileID.
+func AttachmentMessage(chatID int64, attachment models.FeedbackAttachment, caption string) tgbotapi.Chattable {
+	file := tgbotapi.FileID(attachment.FileID)
+
+	switch attachment.Kind {
+	case models.FeedbackAttachmentPhoto:
+		photo := tgbotapi.NewPhoto(chatID, file)
+		photo.Caption = caption
+
+		return photo
+	case models.FeedbackAttachmentVoice:
+		voice := tgbotapi.NewVoice(chatID, file)
+		voice.Caption = caption
+
+		return voice
+	default:
+		document := tgbotapi.NewDocument(chatID, file)
+		document.Caption = caption
+
+		return document
+	}
+}
+
+// AttachmentCaption возвращает подпись вложения, пересылаемого администраторам.
+func AttachmentCaption(feedbackID int) string {
+	return fmt.Sprintf("📎 К отзыву #%d", feedbackID)
+}
+
+// handleFeedbackAttachment добавляет файл к составляемому отзыву. Подпись к файлу становится
+// текстом отзыва, если текста еще нет. Из альбома подтверждение отправляется один раз.
+func (fh *FeedbackHandlerImpl) handleFeedbackAttachment(message *tgbotapi.Message, user *models.User, attachment models.FeedbackAttachment) error {
+	lang := user.InterfaceLanguageCode
+	chatID := message.Chat.ID
+
+	if fh.base.Service.Cache == nil {
+		return fh.base.ErrorHandler.HandleTelegramError(stdErrors.New("cache is not configured"), chatID, int64(user.ID), "handleFeedbackAttachment")
+	}
+
+	// Файлы альбома приходят отдельными обновлениями и обрабатываются параллельно
+	fh.draftMu.Lock()
+
+	draft := fh.loadFeedbackDraft(user)
+	if len(draft.Attachments) >= localization.MaxFeedbackAttachments {
+		fh.draftMu.Unlock()
+
+		return fh.sendMessage(chatID, fh.base.Service.Localizer.GetWithParams(lang, localization.LocaleFeedbackAttachmentLimit, map[string]string{
+			"max": strconv.Itoa(localization.MaxFeedbackAttachments),
+		}))
+	}
+
+	draft.Attachments = append(draft.Attachments, attachment)
+	if draft.Text == "" {
+		draft.Text = message.Caption
+	}
+
+	sameAlbum := message.MediaGroupID != "" && message.MediaGroupID == draft.MediaGroupID
+	draft.MediaGroupID = message.MediaGroupID
+
+	err := fh.saveFeedbackDraft(user, draft)
+
+	fh.draftMu.Unlock()
+
+	if err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "saveFeedbackDraft")
+	}
+
+	if sameAlbum {
+		return nil
+	}
+
+	return fh.base.MessageFactory.SendScreen(chatID, fh.base.Screens.FeedbackAttachmentAdded(lang))
+}
+
+// HandleFeedbackDraftSend отправляет составляемый отзыв из одних вложений, без текстового сообщения.
+func (fh *FeedbackHandlerImpl) HandleFeedbackDraftSend(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	if user.State != models.StateWaitingFeedback {
+		return nil
+	}
+
+	draft := fh.loadFeedbackDraft(user)
+	if len(draft.Attachments) == 0 {
+		return fh.sendMessage(callback.Message.Chat.ID, fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "feedback_text"))
+	}
+
+	if user.Username == "" {
+		return fh.handleFeedbackContactRequest(callback.Message, user, draft.Text)
+	}
+
+	return fh.handleFeedbackComplete(callback.Message, user, draft.Text, nil, draft.Attachments)
+}
+
+// HandleFeedbackAttachmentsCallback присылает администратору вложения отзыва из карточки.
+func (fh *FeedbackHandlerImpl) HandleFeedbackAttachmentsCallback(callback *tgbotapi.CallbackQuery, user *models.User, feedbackIDStr string) error {
+	chatID := callback.Message.Chat.ID
+
+	feedbackID, err := strconv.Atoi(feedbackIDStr)
+	if err != nil {
+		return nil
+	}
+
+	attachments, err := fh.base.Service.GetFeedbackAttachments(feedbackID)
+	if err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "GetFeedbackAttachments")
+	}
+
+	if len(attachments) == 0 {
+		return fh.sendMessage(chatID, fmt.Sprintf("📎 У отзыва #%d нет вложений", feedbackID))
+	}
+
+	for _, attachment := range attachments {
+		if err := fh.base.MessageFactory.SendMedia(chatID, AttachmentMessage(chatID, attachment, AttachmentCaption(feedbackID))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// feedbackDraftKey возвращает ключ кэша составляемого отзыва пользователя.
+func feedbackDraftKey(user *models.User) string {
+	return localization.FeedbackDraftPrefix + strconv.FormatInt(user.TelegramID, 10)
+}
+
+// loadFeedbackDraft возвращает составляемый отзыв пользователя или пустой черновик.
+func (fh *FeedbackHandlerImpl) loadFeedbackDraft(user *models.User) models.FeedbackDraft {
+	var draft models.FeedbackDraft
+
+	if fh.base.Service.Cache == nil {
+		return draft
+	}
+
+	if err := fh.base.Service.Cache.Get(context.Background(), feedbackDraftKey(user), &draft); err != nil {
+		return models.FeedbackDraft{}
+	}
+
+	return draft
+}
+
+// saveFeedbackDraft сохраняет составляемый отзыв на FeedbackDraftTTL.
+func (fh *FeedbackHandlerImpl) saveFeedbackDraft(user *models.User, draft models.FeedbackDraft) error {
+	if fh.base.Service.Cache == nil {
+		return stdErrors.New("cache is not configured")
+	}
+
+	return fh.base.Service.Cache.Set(context.Background(), feedbackDraftKey(user), draft, localization.FeedbackDraftTTL)
+}
+
+// clearFeedbackDraft удаляет составляемый отзыв пользователя.
+func (fh *FeedbackHandlerImpl) clearFeedbackDraft(user *models.User) {
+	if fh.base.Service.Cache != nil {
+		_ = fh.base.Service.Cache.Delete(context.Background(), feedbackDraftKey(user))
+	}
+}
+
+// feedbackDraftText дописывает текст сообщения к подписи из черновика.
+func feedbackDraftText(draft models.FeedbackDraft, text string) string {
+	if draft.Text == "" {
+		return text
+	}
+
+	if text == "" {
+		return draft.Text
+	}
+
+	return draft.Text + "\n\n" + text
+}
@@ -2,15 +2,19 @@ package feedback
 
 import (
 	"fmt"
+	"html"
 	"log"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/adapters/screens"
+	"language-exchange-bot/internal/adapters/telegram/handlers/base"
 	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/models"
 
-	"language-exchange-bot/internal/adapters/telegram/handlers/base"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 )
 
@@ -49,31 +53,44 @@ type FeedbackHandler interface {
 	HandleFeedbackPrev(callback *tgbotapi.CallbackQuery, user *models.User, indexStr string, feedbackType string) error
 	HandleFeedbackNext(callback *tgbotapi.CallbackQuery, user *models.User, indexStr string, feedbackType string) error
 	HandleFeedbackBack(callback *tgbotapi.CallbackQuery, user *models.User, feedbackType string) error
+	HandleFeedbackReplyStart(callback *tgbotapi.CallbackQuery, user *models.User, feedbackIDStr string) error
+	HandleFeedbackReplyMessage(message *tgbotapi.Message, user *models.User) error
+	HandleFeedbackReplyCancel(callback *tgbotapi.CallbackQuery, user *models.User) error
+	HandleFeedbackAnswerStart(callback *tgbotapi.CallbackQuery, user *models.User, feedbackIDStr string) error
+	HandleFeedbackAnswerMessage(message *tgbotapi.Message, user *models.User) error
+	HandleFeedbackAnswerCancel(callback *tgbotapi.CallbackQuery, user *models.User) error
+	HandleFeedbackTriageCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error
+	HandleFeedbackFilterCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error
+	HandleFeedbackSearchCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error
+	HandleFeedbackSearchMessage(message *tgbotapi.Message, user *models.User) error
+	HandleFeedbackExportCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error
+	HandleFeedbackDraftSend(callback *tgbotapi.CallbackQuery, user *models.User) error
+	HandleFeedbackAttachmentsCallback(callback *tgbotapi.CallbackQuery, user *models.User, feedbackIDStr string) error
 }
 
 // FeedbackHandlerImpl реализация обработчиков отзывов.
 type FeedbackHandlerImpl struct {
-	base           *base.BaseHandler
-	adminChatIDs   []int64
-	adminUsernames []string
+	base         *base.BaseHandler
+	adminChatIDs []int64    // Chat ID для уведомлений о новых отзывах; доступ проверяется по роли
+	draftMu      sync.Mutex // Сериализует изменения черновиков отзывов
 }
 
 // NewFeedbackHandler создает новый экземпляр FeedbackHandler.
 func NewFeedbackHandler(
 	base *base.BaseHandler,
 	adminChatIDs []int64,
-	adminUsernames []string,
 ) *FeedbackHandlerImpl {
 	return &FeedbackHandlerImpl{
-		base:           base,
-		adminChatIDs:   adminChatIDs,
-		adminUsernames: adminUsernames,
+		base:         base,
+		adminChatIDs: adminChatIDs,
 	}
 }
 
 // HandleFeedbackCommand обрабатывает команду /feedback.
 func (fh *FeedbackHandlerImpl) HandleFeedbackCommand(message *tgbotapi.Message, user *models.User) error {
 	text := fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "feedback_text")
+	fh.clearFeedbackDraft(user)
+
 	if err := fh.base.Service.DB.UpdateUserState(user.ID, models.StateWaitingFeedback); err != nil {
 		log.Printf("Failed to update user state to waiting feedback for user %d: %v", user.ID, err)
 	}
@@ -106,40 +123,7 @@ func (fh *FeedbackHandlerImpl) editFeedbackStatistics(chatID int64, messageID in
 		return fh.sendMessage(chatID, "❌ Ошибка получения отзывов: "+err.Error())
 	}
 
-	// Подсчитываем статистику
-	activeCount := 0
-	archivedCount := 0
-	totalCount := len(allFeedbacks)
-
-	for _, feedback := range allFeedbacks {
-		if isArchived, ok := feedback["is_processed"].(bool); ok && isArchived {
-			archivedCount++
-		} else {
-			activeCount++
-		}
-	}
-
-	// Формируем текст
-	text := "📊 Статистика отзывов:\n\n"
-	text += fmt.Sprintf("🔥 Активные: %d\n", activeCount)
-	text += fmt.Sprintf("📦 Обработанные: %d\n", archivedCount)
-	text += fmt.Sprintf("📈 Всего: %d", totalCount)
-
-	// Создаем клавиатуру
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("🔥 Активные", "show_active_feedbacks"),
-			tgbotapi.NewInlineKeyboardButtonData("📦 Обработанные", "show_archive_feedbacks"),
-		),
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("📋 Все отзывы", "show_all_feedbacks"),
-		),
-	)
-
-	// Редактируем сообщение
-	err = fh.base.MessageFactory.EditWithKeyboard(chatID, messageID, text, &keyboard)
-
-	return err
+	return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.feedbackStatsScreen(allFeedbacks))
 }
 
 // showFeedbackStatistics показывает статистику отзывов.
@@ -162,30 +146,28 @@ func (fh *FeedbackHandlerImpl) showFeedbackStatistics(chatID int64, user *models
 		return fh.sendMessage(chatID, "❌ Ошибка загрузки отзывов")
 	}
 
-	// Подсчитываем статистику
+	return fh.base.MessageFactory.SendScreen(chatID, fh.feedbackStatsScreen(allFeedbacks))
+}
+
+// feedbackStatsScreen подсчитывает активные, просроченные и обработанные отзывы для экрана статистики.
+func (fh *FeedbackHandlerImpl) feedbackStatsScreen(allFeedbacks []map[string]interface{}) adapters.Screen {
 	activeCount := 0
 	archivedCount := 0
-	totalCount := len(allFeedbacks)
+	breachedCount := 0
 
-	for _, fb := range allFeedbacks {
-		if isArchived, ok := fb["is_processed"].(bool); ok && isArchived {
+	for _, feedback := range allFeedbacks {
+		if isArchived, ok := feedback["is_processed"].(bool); ok && isArchived {
 			archivedCount++
 		} else {
 			activeCount++
 		}
-	}
-
-	// Формируем текст статистики
-	text := "📊 Статистика отзывов:\n\n"
-	text += fmt.Sprintf("🔥 Активные: %d\n", activeCount)
-	text += fmt.Sprintf("📦 Обработанные: %d\n", archivedCount)
-	text += fmt.Sprintf("📈 Всего: %d", totalCount)
 
-	// Создаем клавиатуру управления отзывами
-	keyboard := fh.base.KeyboardBuilder.CreateFeedbackAdminKeyboard(user.InterfaceLanguageCode)
+		if breached, _ := feedback["sla_breached"].(bool); breached {
+			breachedCount++
+		}
+	}
 
-	// Используем MessageFactory для отправки сообщения
-	return fh.base.MessageFactory.SendWithKeyboard(chatID, text, keyboard)
+	return fh.base.Screens.FeedbackStats(activeCount, breachedCount, archivedCount, len(allFeedbacks))
 }
 
 // editFeedbackWithNavigation обновляет существующее сообщение с отзывом.
@@ -206,7 +188,14 @@ func (fh *FeedbackHandlerImpl) editFeedbackWithNavigation(
 	text := fh.formatFeedbackText(feedback, currentIndex+1, len(feedbackList))
 
 	// Создаем клавиатуру навигации
-	keyboard := fh.createNavigationKeyboard(currentIndex, len(feedbackList), feedbackType)
+	attachmentCount, _ := feedback["attachments"].(int)
+	keyboard := base.RenderKeyboard(fh.base.Screens.FeedbackCardKeyboard(screens.FeedbackCard{
+		FeedbackID:  feedback["id"].(int),
+		Index:       currentIndex,
+		Total:       len(feedbackList),
+		ListType:    feedbackType,
+		Attachments: attachmentCount,
+	}))
 
 	err := fh.base.MessageFactory.EditHTMLWithKeyboard(chatID, messageID, text, &keyboard)
 
@@ -230,7 +219,8 @@ func (fh *FeedbackHandlerImpl) formatFeedbackText(feedback map[string]interface{
 		text += fmt.Sprintf("👤 <b>Username:</b> @%s\n", username)
 	}
 
-	text += fmt.Sprintf("📅 <b>Дата:</b> %s\n\n", createdAt.Format("02.01.2006 15:04"))
+	text += fmt.Sprintf("📅 <b>Дата:</b> %s\n", createdAt.Format("02.01.2006 15:04"))
+	text += formatTriageLines(feedback) + "\n"
 	text += "💬 <b>Отзыв:</b>\n" + feedbackText
 
 	// Добавляем контактную информацию если есть
@@ -238,102 +228,64 @@ func (fh *FeedbackHandlerImpl) formatFeedbackText(feedback map[string]interface{
 		text += "\n\n📞 <b>Контакты:</b> " + *contactInfo
 	}
 
-	return text
-}
-
-// createNavigationKeyboard создает клавиатуру навигации.
-//
-//nolint:funlen
-func (fh *FeedbackHandlerImpl) createNavigationKeyboard(currentIndex, totalCount int, feedbackType string) tgbotapi.InlineKeyboardMarkup {
-	var buttons []tgbotapi.InlineKeyboardButton
-
-	// Кнопка "Предыдущий"
-	if currentIndex > 0 {
-		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-			"⬅️ Предыдущий",
-			fmt.Sprintf("nav_%s_feedback_%d", feedbackType, currentIndex-1),
-		))
+	if attachments, ok := feedback["attachments"].(int); ok && attachments > 0 {
+		text += fmt.Sprintf("\n\n📎 <b>Вложения:</b> %d", attachments)
 	}
 
-	// Кнопка "Следующий"
-	if currentIndex < totalCount-1 {
-		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-			"➡️ Следующий",
-			fmt.Sprintf("nav_%s_feedback_%d", feedbackType, currentIndex+1),
-		))
-	}
+	return text + fh.formatFeedbackThread(feedbackID)
+}
 
-	// Кнопка "В обработанные" (только для активных отзывов)
-	if feedbackType == localization.FeedbackTypeActiveLocal {
-		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-			"📦 В обработанные",
-			fmt.Sprintf("archive_feedback_%d", currentIndex),
-		))
+// formatFeedbackThread форматирует последние сообщения переписки по отзыву.
+func (fh *FeedbackHandlerImpl) formatFeedbackThread(feedbackID int) string {
+	thread, err := fh.base.Service.GetFeedbackThread(feedbackID)
+	if err != nil || len(thread.Messages) == 0 {
+		return ""
 	}
 
-	// Кнопки для архивных отзывов
-	if feedbackType == localization.FeedbackTypeArchiveLocal {
-		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-			"🔄 Вернуть в активные",
-			fmt.Sprintf("unarchive_feedback_%d", currentIndex),
-		))
-		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-			"🗑️ Удалить текущий",
-			fmt.Sprintf("delete_current_feedback_%d", currentIndex),
-		))
-		buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-			"🗑️ Удалить все",
-			"delete_all_archive_feedbacks",
-		))
+	messages := thread.Messages
+	text := fmt.Sprintf("\n\n💬 <b>Переписка (%d):</b>", len(messages))
+
+	if len(messages) > localization.FeedbackThreadPreviewLimit {
+		messages = messages[len(messages)-localization.FeedbackThreadPreviewLimit:]
 	}
 
-	// Кнопка "Назад к списку"
-	buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-		"📋 К списку",
-		fmt.Sprintf("back_to_%s_feedbacks", feedbackType),
-	))
-
-	// Кнопка "К статистике"
-	buttons = append(buttons, tgbotapi.NewInlineKeyboardButtonData(
-		"📊 К статистике",
-		"back_to_feedback_stats",
-	))
-
-	// Разбиваем кнопки на строки
-	var rows [][]tgbotapi.InlineKeyboardButton
-
-	if len(buttons) > 0 {
-		// Первая строка: навигация
-		if len(buttons) >= localization.ButtonsPerRow {
-			rows = append(rows, []tgbotapi.InlineKeyboardButton{buttons[0], buttons[1]})
-			buttons = buttons[localization.ButtonsPerRow:]
-		} else if len(buttons) == 1 {
-			rows = append(rows, []tgbotapi.InlineKeyboardButton{buttons[0]})
-			buttons = buttons[1:]
+	for _, message := range messages {
+		author := "👤 Пользователь"
+		if message.AuthorType == models.FeedbackAuthorAdmin {
+			author = "🛡 Администратор"
 		}
 
-		// Остальные кнопки
-		for _, button := range buttons {
-			rows = append(rows, []tgbotapi.InlineKeyboardButton{button})
-		}
+		text += fmt.Sprintf("\n<b>%s</b>, %s:\n%s", author, message.CreatedAt.Format("02.01.2006 15:04"),
+			html.EscapeString(truncateRunes(message.Text, localization.FeedbackThreadPreviewLength)))
 	}
 
-	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+	return text
 }
 
 // ========== Заглушки для интерфейса (будут реализованы позже) ==========
 
 // HandleFeedbackMessage обрабатывает сообщение с отзывом.
 func (fh *FeedbackHandlerImpl) HandleFeedbackMessage(message *tgbotapi.Message, user *models.User) error {
-	feedbackText := message.Text
+	// Фото, документы и голосовые сообщения собираются в черновик отзыва
+	if attachment, ok := AttachmentFromMessage(message); ok {
+		return fh.handleFeedbackAttachment(message, user, attachment)
+	}
+
+	if message.Text == "" {
+		return fh.sendMessage(message.Chat.ID,
+			fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleFeedbackAttachmentUnsupported))
+	}
 
-	// Проверяем валидность отзыва
-	if len([]rune(feedbackText)) < localization.MinFeedbackLength {
-		return fh.handleFeedbackTooShort(message, user)
+	draft := fh.loadFeedbackDraft(user)
+	feedbackText := feedbackDraftText(draft, message.Text)
+
+	// Проверяем валидность отзыва; с вложениями короткий текст допустим
+	if len(draft.Attachments) == 0 && len([]rune(feedbackText)) < localization.MinFeedbackLength {
+		return fh.handleFeedbackRejected(message, user, false)
 	}
 
 	if len([]rune(feedbackText)) > localization.MaxFeedbackItems {
-		return fh.handleFeedbackTooLong(message, user)
+		return fh.handleFeedbackRejected(message, user, true)
 	}
 
 	// Проверяем наличие username
@@ -352,50 +304,38 @@ func (fh *FeedbackHandlerImpl) HandleFeedbackMessage(message *tgbotapi.Message,
 			"text_length":  len([]rune(feedbackText)),
 			"has_username": user.Username != "",
 			"username":     user.Username,
+			"attachments":  len(draft.Attachments),
 		},
 	)
 
 	// Сохраняем полный отзыв и отправляем уведомление
-	return fh.handleFeedbackComplete(message, user, feedbackText, nil)
+	return fh.handleFeedbackComplete(message, user, feedbackText, nil, draft.Attachments)
 }
 
-// handleFeedbackTooShort обрабатывает слишком короткий отзыв.
-func (fh *FeedbackHandlerImpl) handleFeedbackTooShort(message *tgbotapi.Message, user *models.User) error {
-	feedbackText := message.Text
-	count := len([]rune(feedbackText))
+// handleFeedbackRejected сообщает, что отзыв слишком короткий или слишком длинный.
+func (fh *FeedbackHandlerImpl) handleFeedbackRejected(message *tgbotapi.Message, user *models.User, tooLong bool) error {
+	screen := fh.base.Screens.FeedbackRejected(user.InterfaceLanguageCode, len([]rune(message.Text)), tooLong)
 
-	errorText := fmt.Sprintf("%s\n\n%s",
-		fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "feedback_too_short"),
-		fh.base.Service.Localizer.GetWithParams(user.InterfaceLanguageCode, "feedback_char_count", map[string]string{
-			"count": strconv.Itoa(count),
-		}),
-	)
-
-	return fh.sendMessage(message.Chat.ID, errorText)
+	return fh.base.MessageFactory.SendScreen(message.Chat.ID, screen)
 }
 
-// handleFeedbackTooLong обрабатывает слишком длинный отзыв.
-func (fh *FeedbackHandlerImpl) handleFeedbackTooLong(message *tgbotapi.Message, user *models.User) error {
-	feedbackText := message.Text
-	count := len([]rune(feedbackText))
+// handleFeedbackContactRequest запрашивает контактные данные при отсутствии username.
+func (fh *FeedbackHandlerImpl) handleFeedbackContactRequest(message *tgbotapi.Message, user *models.User, feedbackText string) error {
+	// Сохраняем текст в черновик рядом с вложениями до получения контактов
+	fh.draftMu.Lock()
 
-	errorText := fmt.Sprintf("%s\n\n%s",
-		fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "feedback_too_long"),
-		fh.base.Service.Localizer.GetWithParams(user.InterfaceLanguageCode, "feedback_char_count", map[string]string{
-			"count": strconv.Itoa(count),
-		}),
-	)
+	draft := fh.loadFeedbackDraft(user)
+	draft.Text = feedbackText
+	err := fh.saveFeedbackDraft(user, draft)
 
-	return fh.sendMessage(message.Chat.ID, errorText)
-}
+	fh.draftMu.Unlock()
 
-// handleFeedbackContactRequest запрашивает контактные данные при отсутствии username.
-func (fh *FeedbackHandlerImpl) handleFeedbackContactRequest(message *tgbotapi.Message, user *models.User, feedbackText string) error {
-	// Сохраняем отзыв во временном хранилище (в будущем можно добавить в redis/кэш)
-	// Пока просто переходим к следующему состоянию
+	if err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, message.Chat.ID, int64(user.ID), "saveFeedbackDraft")
+	}
 
 	// Обновляем состояние для ожидания контактных данных
-	err := fh.base.Service.DB.UpdateUserState(user.ID, models.StateWaitingFeedbackContact)
+	err = fh.base.Service.DB.UpdateUserState(user.ID, models.StateWaitingFeedbackContact)
 	if err != nil {
 		return err
 	}
@@ -410,12 +350,14 @@ func (fh *FeedbackHandlerImpl) handleFeedbackContactRequest(message *tgbotapi.Me
 }
 
 // handleFeedbackComplete завершает процесс обратной связи.
-func (fh *FeedbackHandlerImpl) handleFeedbackComplete(message *tgbotapi.Message, user *models.User, feedbackText string, contactInfo *string) error {
+func (fh *FeedbackHandlerImpl) handleFeedbackComplete(
+	message *tgbotapi.Message, user *models.User, feedbackText string, contactInfo *string, attachments []models.FeedbackAttachment,
+) error {
 	// Используем ID администраторов из обработчика
 	adminIDs := fh.adminChatIDs
 
 	// Сохраняем отзыв через сервис
-	err := fh.base.Service.SaveUserFeedback(user.ID, feedbackText, contactInfo, adminIDs)
+	err := fh.base.Service.SaveUserFeedback(user, feedbackText, contactInfo, attachments, adminIDs)
 	if err != nil {
 		// Используем структурированное логирование
 		fh.base.Service.LoggingService.Database().ErrorWithContext(
@@ -437,11 +379,7 @@ func (fh *FeedbackHandlerImpl) handleFeedbackComplete(message *tgbotapi.Message,
 		return fh.sendMessage(message.Chat.ID, errorText)
 	}
 
-	// Отправляем подтверждение пользователю
-	successText := fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "feedback_saved")
-	if successText == "feedback_saved" { // fallback в случае отсутствия перевода
-		successText = "✅ Спасибо за ваш отзыв! Мы обязательно его рассмотрим."
-	}
+	fh.clearFeedbackDraft(user)
 
 	// Возвращаем пользователя в активное состояние
 	err = fh.base.Service.DB.UpdateUserState(user.ID, models.StateActive)
@@ -474,15 +412,8 @@ func (fh *FeedbackHandlerImpl) handleFeedbackComplete(message *tgbotapi.Message,
 		)
 	}
 
-	// Создаем клавиатуру с кнопкой "Главное меню"
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			fh.base.KeyboardBuilder.CreateBackToMainButton(user.InterfaceLanguageCode),
-		),
-	)
-
-	// Используем MessageFactory для отправки сообщения
-	if err := fh.base.MessageFactory.SendWithKeyboard(message.Chat.ID, successText, keyboard); err != nil {
+	// Отправляем подтверждение с кнопкой "Главное меню"
+	if err := fh.base.MessageFactory.SendScreen(message.Chat.ID, fh.base.Screens.FeedbackSaved(user.InterfaceLanguageCode)); err != nil {
 		// Используем структурированное логирование
 		fh.base.Service.LoggingService.Database().ErrorWithContext(
 			"Failed to send success message",
@@ -511,17 +442,17 @@ func (fh *FeedbackHandlerImpl) HandleFeedbackContactMessage(message *tgbotapi.Me
 			fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "feedback_contact_placeholder"))
 	}
 
+	draft := fh.loadFeedbackDraft(user)
+	if draft.Text == "" && len(draft.Attachments) == 0 {
+		// Черновик истек: просим написать отзыв заново
+		return fh.HandleFeedbackCommand(message, user)
+	}
+
 	// Подтверждаем получение контактов
 	confirmedText := fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "feedback_contact_provided")
 	if err := fh.sendMessage(message.Chat.ID, confirmedText); err != nil {
 		return err
 	}
 
-	// Теперь нужно получить сохраненный отзыв пользователя
-	// Пока что используем временное решение - просим написать отзыв заново
-	// В будущем здесь будет получение из кэша
-
-	feedbackText := "Отзыв был сохранен в предыдущем шаге (требуется интеграция с кэшем)" // временное решение
-
-	return fh.handleFeedbackComplete(message, user, feedbackText, &contactInfo)
+	return fh.handleFeedbackComplete(message, user, draft.Text, &contactInfo, draft.Attachments)
 }
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"language-exchange-bot/internal/adapters/telegram/handlers/base"
+	"language-exchange-bot/internal/models"
 
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,21 +14,19 @@ import (
 func TestNewFeedbackHandler(t *testing.T) {
 	base := &base.BaseHandler{}
 	adminChatIDs := []int64{123, 456}
-	adminUsernames := []string{"admin1", "admin2"}
 
-	handler := NewFeedbackHandler(base, adminChatIDs, adminUsernames)
+	handler := NewFeedbackHandler(base, adminChatIDs)
 
 	assert.NotNil(t, handler)
 	assert.Equal(t, base, handler.base)
 	assert.Equal(t, adminChatIDs, handler.adminChatIDs)
-	assert.Equal(t, adminUsernames, handler.adminUsernames)
 }
 
 // TestFeedbackHandlerImpl_HandleFeedbackCommand tests feedback command handling.
 func TestFeedbackHandlerImpl_HandleFeedbackCommand(t *testing.T) {
 	// Just test that the method exists and can be called
 	base := &base.BaseHandler{}
-	handler := NewFeedbackHandler(base, []int64{}, []string{})
+	handler := NewFeedbackHandler(base, []int64{})
 
 	// Test method signature exists
 	assert.NotNil(t, handler.HandleFeedbackCommand)
@@ -36,7 +36,7 @@ func TestFeedbackHandlerImpl_HandleFeedbackCommand(t *testing.T) {
 func TestFeedbackHandlerImpl_HandleFeedbacksCommand(t *testing.T) {
 	// Just test that the method exists and can be called
 	base := &base.BaseHandler{}
-	handler := NewFeedbackHandler(base, []int64{123}, []string{"admin"})
+	handler := NewFeedbackHandler(base, []int64{123})
 
 	// Test method signature exists
 	assert.NotNil(t, handler.HandleFeedbacksCommand)
@@ -46,7 +46,7 @@ func TestFeedbackHandlerImpl_HandleFeedbacksCommand(t *testing.T) {
 func TestFeedbackHandlerImpl_HandleFeedbackMessage(t *testing.T) {
 	// Just test that the method exists and can be called
 	base := &base.BaseHandler{}
-	handler := NewFeedbackHandler(base, []int64{}, []string{})
+	handler := NewFeedbackHandler(base, []int64{})
 
 	// Test method signature exists
 	assert.NotNil(t, handler.HandleFeedbackMessage)
@@ -56,8 +56,73 @@ func TestFeedbackHandlerImpl_HandleFeedbackMessage(t *testing.T) {
 func TestFeedbackHandlerImpl_HandleFeedbackContactMessage(t *testing.T) {
 	// Just test that the method exists and can be called
 	base := &base.BaseHandler{}
-	handler := NewFeedbackHandler(base, []int64{}, []string{})
+	handler := NewFeedbackHandler(base, []int64{})
 
 	// Test method signature exists
 	assert.NotNil(t, handler.HandleFeedbackContactMessage)
 }
+
+// TestFilterFeedbacks tests filtering active feedback by triage fields.
+func TestFilterFeedbacks(t *testing.T) {
+	feedbacks := []map[string]interface{}{
+		{"id": 1, "is_processed": false, "category": models.FeedbackCategoryBug, "priority": models.FeedbackPriorityHigh, "assignee_id": 7, "sla_breached": true},
+		{"id": 2, "is_processed": false, "category": models.FeedbackCategoryBug, "priority": models.FeedbackPriorityNormal, "assignee_id": 0, "sla_breached": false},
+		{"id": 3, "is_processed": true, "category": models.FeedbackCategoryBug, "priority": models.FeedbackPriorityHigh, "assignee_id": 7, "sla_breached": false},
+		{"id": 4, "is_processed": false, "category": "", "priority": models.FeedbackPriorityLow, "assignee_id": 8, "sla_breached": false},
+	}
+
+	ids := func(filtered []map[string]interface{}) []int {
+		var result []int
+		for _, feedback := range filtered {
+			result = append(result, feedback["id"].(int))
+		}
+
+		return result
+	}
+
+	assert.Equal(t, []int{1, 2, 4}, ids(FilterFeedbacks(feedbacks, models.FeedbackFilter{}, 7)))
+	assert.Equal(t, []int{1, 2}, ids(FilterFeedbacks(feedbacks, models.FeedbackFilter{Category: models.FeedbackCategoryBug}, 7)))
+	assert.Equal(t, []int{1}, ids(FilterFeedbacks(feedbacks, models.FeedbackFilter{Priority: models.FeedbackPriorityHigh}, 7)))
+	assert.Equal(t, []int{1}, ids(FilterFeedbacks(feedbacks, models.FeedbackFilter{AssignedToMe: true}, 7)))
+	assert.Equal(t, []int{4}, ids(FilterFeedbacks(feedbacks, models.FeedbackFilter{AssignedToMe: true}, 8)))
+	assert.Equal(t, []int{1}, ids(FilterFeedbacks(feedbacks, models.FeedbackFilter{Breached: true}, 0)))
+}
+
+// TestAttachmentFromMessage tests extracting feedback attachments from Telegram messages.
+func TestAttachmentFromMessage(t *testing.T) {
+	photo, ok := AttachmentFromMessage(&tgbotapi.Message{Photo: []tgbotapi.PhotoSize{
+		{FileID: "small", FileUniqueID: "s", FileSize: 100},
+		{FileID: "large", FileUniqueID: "l", FileSize: 5000},
+	}})
+	assert.True(t, ok)
+	assert.Equal(t, models.FeedbackAttachment{
+		Kind: models.FeedbackAttachmentPhoto, FileID: "large", FileUniqueID: "l", FileSize: 5000,
+	}, photo)
+
+	document, ok := AttachmentFromMessage(&tgbotapi.Message{Document: &tgbotapi.Document{
+		FileID: "doc", FileUniqueID: "d", FileName: "log.txt", MimeType: "text/plain", FileSize: 42,
+	}})
+	assert.True(t, ok)
+	assert.Equal(t, models.FeedbackAttachmentDocument, document.Kind)
+	assert.Equal(t, "log.txt", document.FileName)
+
+	voice, ok := AttachmentFromMessage(&tgbotapi.Message{Voice: &tgbotapi.Voice{FileID: "voice", MimeType: "audio/ogg"}})
+	assert.True(t, ok)
+	assert.Equal(t, models.FeedbackAttachmentVoice, voice.Kind)
+
+	_, ok = AttachmentFromMessage(&tgbotapi.Message{Text: "just text"})
+	assert.False(t, ok)
+
+	_, isPhoto := AttachmentMessage(1, photo, "").(tgbotapi.PhotoConfig)
+	assert.True(t, isPhoto)
+
+	_, isVoice := AttachmentMessage(1, voice, "").(tgbotapi.VoiceConfig)
+	assert.True(t, isVoice)
+}
+
+// TestFeedbackDraftText tests joining an attachment caption with the feedback message.
+func TestFeedbackDraftText(t *testing.T) {
+	assert.Equal(t, "text", feedbackDraftText(models.FeedbackDraft{}, "text"))
+	assert.Equal(t, "caption", feedbackDraftText(models.FeedbackDraft{Text: "caption"}, ""))
+	assert.Equal(t, "caption\n\ntext", feedbackDraftText(models.FeedbackDraft{Text: "caption"}, "text"))
+}
@@ -0,0 +1,231 @@
+package feedback
+
+import (
+	"context"
+	stdErrors "errors"
+	"strconv"
+	"unicode/utf8"
+
+	"language-exchange-bot/internal/adapters/telegram/handlers/base"
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// HandleFeedbackReplyStart переводит администратора в режим ввода ответа на отзыв.
+// Отзыв, на который вводится ответ, хранится в кэше на FeedbackReplyInputTTL.
+func (fh *FeedbackHandlerImpl) HandleFeedbackReplyStart(callback *tgbotapi.CallbackQuery, user *models.User, feedbackIDStr string) error {
+	feedbackID, err := strconv.Atoi(feedbackIDStr)
+	if err != nil {
+		return nil
+	}
+
+	if err := fh.startReplyInput(callback.Message.Chat.ID, user, localization.FeedbackReplyTargetPrefix, feedbackID, models.StateWaitingFeedbackReply); err != nil {
+		return err
+	}
+
+	lang := user.InterfaceLanguageCode
+	text := fh.base.Service.Localizer.GetWithParams(lang, localization.LocaleFeedbackReplyPrompt, map[string]string{
+		"id":  strconv.Itoa(feedbackID),
+		"max": strconv.Itoa(localization.MaxFeedbackReplyLength),
+	})
+
+	return fh.base.MessageFactory.SendWithKeyboard(callback.Message.Chat.ID, text, fh.cancelReplyKeyboard(lang, localization.CallbackFeedbackReplyCancel))
+}
+
+// HandleFeedbackReplyMessage сохраняет ответ администратора и доставляет его автору отзыва на языке его интерфейса.
+func (fh *FeedbackHandlerImpl) HandleFeedbackReplyMessage(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+	chatID := message.Chat.ID
+
+	feedbackID := fh.replyTarget(localization.FeedbackReplyTargetPrefix, user)
+	if feedbackID == 0 {
+		_ = fh.base.Service.UpdateUserState(user.ID, models.StateActive)
+
+		return fh.sendMessage(chatID, fh.base.Service.Localizer.Get(lang, localization.LocaleFeedbackReplyExpired))
+	}
+
+	reply, err := fh.base.Service.ReplyToFeedback(user, feedbackID, message.Text)
+	if stdErrors.Is(err, errors.ErrInvalidUserInput) {
+		// Администратор остается в режиме ввода и может повторить попытку
+		return fh.sendInvalidReply(chatID, lang)
+	}
+
+	if err := fh.finishReplyInput(localization.FeedbackReplyTargetPrefix, user); err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+	}
+
+	if err != nil {
+		return fh.sendReplyError(chatID, user, err, "ReplyToFeedback")
+	}
+
+	recipient := reply.Recipient
+	delivered := fh.base.Screens.FeedbackReplyDelivered(recipient.InterfaceLanguageCode, feedbackID,
+		truncateRunes(reply.FeedbackText, localization.FeedbackThreadPreviewLength), reply.Message.Text)
+
+	resultKey := localization.LocaleFeedbackReplySent
+	if err := fh.base.MessageFactory.SendScreen(recipient.TelegramID, delivered); err != nil {
+		resultKey = localization.LocaleFeedbackReplyFailed
+	}
+
+	return fh.sendMessage(chatID, fh.base.Service.Localizer.GetWithParams(lang, resultKey, map[string]string{
+		"id": strconv.Itoa(feedbackID),
+	}))
+}
+
+// HandleFeedbackReplyCancel отменяет ввод ответа администратора.
+func (fh *FeedbackHandlerImpl) HandleFeedbackReplyCancel(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	return fh.cancelReplyInput(callback, user, localization.FeedbackReplyTargetPrefix, models.StateWaitingFeedbackReply)
+}
+
+// HandleFeedbackAnswerStart переводит автора отзыва в режим ввода ответа администратору.
+func (fh *FeedbackHandlerImpl) HandleFeedbackAnswerStart(callback *tgbotapi.CallbackQuery, user *models.User, feedbackIDStr string) error {
+	feedbackID, err := strconv.Atoi(feedbackIDStr)
+	if err != nil {
+		return nil
+	}
+
+	if err := fh.startReplyInput(callback.Message.Chat.ID, user, localization.FeedbackAnswerTargetPrefix, feedbackID, models.StateWaitingFeedbackAnswer); err != nil {
+		return err
+	}
+
+	lang := user.InterfaceLanguageCode
+	text := fh.base.Service.Localizer.GetWithParams(lang, localization.LocaleFeedbackAnswerPrompt, map[string]string{
+		"max": strconv.Itoa(localization.MaxFeedbackReplyLength),
+	})
+
+	return fh.base.MessageFactory.SendWithKeyboard(callback.Message.Chat.ID, text, fh.cancelReplyKeyboard(lang, localization.CallbackFeedbackAnswerCancel))
+}
+
+// HandleFeedbackAnswerMessage сохраняет ответ автора отзыва и передает его администраторам.
+func (fh *FeedbackHandlerImpl) HandleFeedbackAnswerMessage(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+	chatID := message.Chat.ID
+
+	feedbackID := fh.replyTarget(localization.FeedbackAnswerTargetPrefix, user)
+	if feedbackID == 0 {
+		_ = fh.base.Service.UpdateUserState(user.ID, models.StateActive)
+
+		return fh.sendMessage(chatID, fh.base.Service.Localizer.Get(lang, localization.LocaleFeedbackReplyExpired))
+	}
+
+	err := fh.base.Service.AddFeedbackAuthorReply(user, feedbackID, message.Text)
+	if stdErrors.Is(err, errors.ErrInvalidUserInput) {
+		return fh.sendInvalidReply(chatID, lang)
+	}
+
+	if err := fh.finishReplyInput(localization.FeedbackAnswerTargetPrefix, user); err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+	}
+
+	if err != nil {
+		return fh.sendReplyError(chatID, user, err, "AddFeedbackAuthorReply")
+	}
+
+	return fh.sendMessage(chatID, fh.base.Service.Localizer.Get(lang, localization.LocaleFeedbackAnswerSent))
+}
+
+// HandleFeedbackAnswerCancel отменяет ввод ответа автора отзыва.
+func (fh *FeedbackHandlerImpl) HandleFeedbackAnswerCancel(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	return fh.cancelReplyInput(callback, user, localization.FeedbackAnswerTargetPrefix, models.StateWaitingFeedbackAnswer)
+}
+
+// startReplyInput запоминает отзыв в кэше и переводит пользователя в состояние ввода ответа.
+func (fh *FeedbackHandlerImpl) startReplyInput(chatID int64, user *models.User, prefix string, feedbackID int, state string) error {
+	if fh.base.Service.Cache == nil {
+		return fh.base.ErrorHandler.HandleTelegramError(stdErrors.New("cache is not configured"), chatID, int64(user.ID), "startReplyInput")
+	}
+
+	err := fh.base.Service.Cache.Set(context.Background(), replyTargetKey(prefix, user), feedbackID, localization.FeedbackReplyInputTTL)
+	if err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "startReplyInput")
+	}
+
+	if err := fh.base.Service.UpdateUserState(user.ID, state); err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+	}
+
+	return nil
+}
+
+// finishReplyInput удаляет отзыв из кэша и возвращает пользователя в обычное состояние.
+func (fh *FeedbackHandlerImpl) finishReplyInput(prefix string, user *models.User) error {
+	if fh.base.Service.Cache != nil {
+		_ = fh.base.Service.Cache.Delete(context.Background(), replyTargetKey(prefix, user))
+	}
+
+	return fh.base.Service.UpdateUserState(user.ID, models.StateActive)
+}
+
+// cancelReplyInput отменяет ввод ответа, если пользователь все еще в состоянии state.
+func (fh *FeedbackHandlerImpl) cancelReplyInput(callback *tgbotapi.CallbackQuery, user *models.User, prefix, state string) error {
+	chatID := callback.Message.Chat.ID
+
+	if user.State == state {
+		if err := fh.finishReplyInput(prefix, user); err != nil {
+			return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+		}
+	}
+
+	return fh.base.MessageFactory.EditText(
+		chatID, callback.Message.MessageID, fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleFeedbackReplyCancelled),
+	)
+}
+
+// replyTarget возвращает ID отзыва, на который пользователь вводит ответ, или 0, если ввод истек.
+func (fh *FeedbackHandlerImpl) replyTarget(prefix string, user *models.User) int {
+	if fh.base.Service.Cache == nil {
+		return 0
+	}
+
+	var feedbackID int
+	if err := fh.base.Service.Cache.Get(context.Background(), replyTargetKey(prefix, user), &feedbackID); err != nil {
+		return 0
+	}
+
+	return feedbackID
+}
+
+// sendInvalidReply сообщает о недопустимой длине ответа.
+func (fh *FeedbackHandlerImpl) sendInvalidReply(chatID int64, lang string) error {
+	return fh.sendMessage(chatID, fh.base.Service.Localizer.GetWithParams(lang, localization.LocaleFeedbackReplyInvalid, map[string]string{
+		"max": strconv.Itoa(localization.MaxFeedbackReplyLength),
+	}))
+}
+
+// sendReplyError сообщает об ожидаемых ошибках ответа, остальные передает обработчику ошибок.
+func (fh *FeedbackHandlerImpl) sendReplyError(chatID int64, user *models.User, err error, operation string) error {
+	lang := user.InterfaceLanguageCode
+
+	switch {
+	case stdErrors.Is(err, errors.ErrFeedbackNotFound), stdErrors.Is(err, errors.ErrUserNotFound):
+		return fh.sendMessage(chatID, fh.base.Service.Localizer.Get(lang, localization.LocaleFeedbackReplyNotFound))
+	case stdErrors.Is(err, errors.ErrPermissionDenied):
+		return fh.sendMessage(chatID, fh.base.Service.Localizer.Get(lang, "access_denied"))
+	case stdErrors.Is(err, errors.ErrRecipientNotOnTelegram):
+		return fh.sendMessage(chatID, fh.base.Service.Localizer.Get(lang, localization.LocaleFeedbackReplyNoTelegram))
+	default:
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), operation)
+	}
+}
+
+// cancelReplyKeyboard - клавиатура отмены ввода ответа.
+func (fh *FeedbackHandlerImpl) cancelReplyKeyboard(lang, callbackData string) tgbotapi.InlineKeyboardMarkup {
+	return base.RenderKeyboard(fh.base.Screens.FeedbackCancelKeyboard(lang, callbackData))
+}
+
+// replyTargetKey - ключ кэша с отзывом, на который пользователь вводит ответ.
+func replyTargetKey(prefix string, user *models.User) string {
+	return prefix + strconv.FormatInt(user.TelegramID, 10)
+}
+
+// truncateRunes обрезает текст до limit символов, добавляя многоточие.
+func truncateRunes(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+
+	return string([]rune(text)[:limit]) + "…"
+}
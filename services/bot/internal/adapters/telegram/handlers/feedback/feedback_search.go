@@ -0,0 +1,172 @@
+package feedback
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"language-exchange-bot/internal/adapters"
+	"language-exchange-bot/internal/adapters/screens"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/export"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// HandleFeedbackSearchCallback обрабатывает кнопки поиска по отзывам: начало ввода запроса,
+// отмену и открытие найденного отзыва.
+func (fh *FeedbackHandlerImpl) HandleFeedbackSearchCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
+	chatID := callback.Message.Chat.ID
+	messageID := callback.Message.MessageID
+
+	switch {
+	case data == localization.CallbackFeedbackSearchStart:
+		if err := fh.base.Service.UpdateUserState(user.ID, models.StateWaitingFeedbackSearch); err != nil {
+			return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+		}
+
+		text := "🔍 Введите запрос для поиска по тексту отзывов.\n\n" +
+			"Ищутся формы слов на русском, английском и испанском; китайский текст ищется по подстроке. " +
+			"Фраза в кавычках ищется целиком, слово с минусом исключается."
+
+		return fh.base.MessageFactory.SendWithKeyboard(chatID, text, fh.cancelReplyKeyboard(user.InterfaceLanguageCode, localization.CallbackFeedbackSearchCancel))
+	case data == localization.CallbackFeedbackSearchCancel:
+		if user.State == models.StateWaitingFeedbackSearch {
+			if err := fh.base.Service.UpdateUserState(user.ID, models.StateActive); err != nil {
+				return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+			}
+		}
+
+		return fh.base.MessageFactory.EditText(chatID, messageID, "🔍 Поиск отменен")
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackSearchOpen):
+		feedbackID, err := strconv.Atoi(strings.TrimPrefix(data, localization.CallbackPrefixFeedbackSearchOpen))
+		if err != nil {
+			return nil
+		}
+
+		return fh.editFeedbackCard(chatID, messageID, user, localization.FeedbackTypeAllLocal, feedbackID)
+	}
+
+	return nil
+}
+
+// HandleFeedbackSearchMessage ищет отзывы по введенному запросу и показывает самые релевантные.
+func (fh *FeedbackHandlerImpl) HandleFeedbackSearchMessage(message *tgbotapi.Message, user *models.User) error {
+	chatID := message.Chat.ID
+
+	if !fh.base.Service.UserHasPermission(user, core.PermissionViewFeedback) {
+		_ = fh.base.Service.UpdateUserState(user.ID, models.StateActive)
+
+		return fh.sendMessage(chatID, fh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "access_denied"))
+	}
+
+	records, err := fh.base.Service.SearchFeedback(models.FeedbackQuery{
+		Text:  message.Text,
+		Limit: localization.FeedbackSearchResultsShown,
+	})
+	if stdErrors.Is(err, errors.ErrInvalidUserInput) {
+		// Администратор остается в режиме ввода и может повторить попытку
+		return fh.sendMessage(chatID, fmt.Sprintf("❌ Запрос должен быть непустым и не длиннее %d символов", localization.MaxFeedbackSearchQueryLength))
+	}
+
+	if err := fh.base.Service.UpdateUserState(user.ID, models.StateActive); err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "UpdateUserState")
+	}
+
+	if err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "SearchFeedback")
+	}
+
+	return fh.base.MessageFactory.SendScreen(chatID, adapters.Screen{
+		Text:     formatSearchResults(strings.TrimSpace(message.Text), records),
+		Keyboard: fh.base.Screens.FeedbackSearchKeyboard(records),
+	})
+}
+
+// formatSearchResults форматирует результаты поиска.
+func formatSearchResults(query string, records []models.FeedbackRecord) string {
+	text := fmt.Sprintf("🔍 По запросу «%s» ничего не найдено", query)
+
+	if len(records) > 0 {
+		text = fmt.Sprintf("🔍 Найдено по запросу «%s»: %d\n", query, len(records))
+
+		for _, record := range records {
+			status := "🔥"
+			if record.IsProcessed {
+				status = "📦"
+			}
+
+			author := record.FirstName
+			if record.Username != "" {
+				author = "@" + record.Username
+			}
+
+			text += fmt.Sprintf("\n#%d %s %s · %s\n«%s»\n",
+				record.ID, status, record.CreatedAt.Format("02.01.2006"), author,
+				truncateRunes(record.Text, localization.FeedbackSearchPreviewLength))
+		}
+	}
+
+	return text
+}
+
+// HandleFeedbackExportCallback обрабатывает меню выгрузки отзывов: выбор формата, периода
+// и статуса и отправку файла.
+func (fh *FeedbackHandlerImpl) HandleFeedbackExportCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
+	chatID := callback.Message.Chat.ID
+	messageID := callback.Message.MessageID
+
+	switch {
+	case data == localization.CallbackFeedbackExportMenu:
+		return fh.editExportMenu(chatID, messageID, screens.FeedbackExportOptions{
+			Format: models.FeedbackExportCSV, Period: screens.FeedbackExportPeriodDefault, Status: screens.FeedbackExportStatusAll,
+		})
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackExportSet):
+		options, ok := screens.ParseFeedbackExportOptions(strings.TrimPrefix(data, localization.CallbackPrefixFeedbackExportSet))
+		if !ok {
+			return nil
+		}
+
+		return fh.editExportMenu(chatID, messageID, options)
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackExportGet):
+		options, ok := screens.ParseFeedbackExportOptions(strings.TrimPrefix(data, localization.CallbackPrefixFeedbackExportGet))
+		if !ok {
+			return nil
+		}
+
+		return fh.sendFeedbackExport(chatID, user, options)
+	}
+
+	return nil
+}
+
+// editExportMenu показывает выбранные параметры выгрузки и кнопки для их изменения.
+func (fh *FeedbackHandlerImpl) editExportMenu(chatID int64, messageID int, options screens.FeedbackExportOptions) error {
+	return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.base.Screens.FeedbackExport(options))
+}
+
+// sendFeedbackExport отправляет администратору файл с отзывами по выбранным параметрам.
+func (fh *FeedbackHandlerImpl) sendFeedbackExport(chatID int64, user *models.User, options screens.FeedbackExportOptions) error {
+	now := time.Now()
+
+	data, count, err := fh.base.Service.ExportFeedbackFile(user, options.Query(now), options.Format)
+	if err != nil {
+		return fh.sendReplyError(chatID, user, err, "ExportFeedbackFile")
+	}
+
+	if count == 0 {
+		return fh.sendMessage(chatID, "📤 Нет отзывов для выгрузки с выбранными параметрами")
+	}
+
+	caption := fmt.Sprintf("📤 Отзывы: %d · %s · %s", count, screens.FeedbackExportPeriodLabel(options.Period), screens.FeedbackExportStatusLabel(options.Status))
+	if count == localization.MaxFeedbackExportRows {
+		caption += fmt.Sprintf("\n⚠️ Выгружены только последние %d отзывов", localization.MaxFeedbackExportRows)
+	}
+
+	return fh.base.MessageFactory.SendDocument(chatID, export.FeedbackFileName(options.Format, now), data, caption)
+}
@@ -0,0 +1,366 @@
+package feedback
+
+import (
+	"context"
+	stdErrors "errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"language-exchange-bot/internal/adapters/screens"
+	"language-exchange-bot/internal/adapters/telegram/handlers/base"
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// FormatSLABreaches форматирует уведомление о необработанных отзывах с истекшим сроком.
+func FormatSLABreaches(breaches []models.FeedbackSLABreach) string {
+	text := fmt.Sprintf("⏰ Истек срок обработки отзывов (%d):\n", len(breaches))
+
+	for _, breach := range breaches {
+		text += fmt.Sprintf("\n#%d · %s · %s · срок %s\n«%s»\n",
+			breach.FeedbackID, screens.FeedbackCategoryLabel(breach.Category), screens.FeedbackPriorityLabel(breach.Priority),
+			breach.SLADueAt.Format("02.01.2006 15:04"),
+			truncateRunes(breach.FeedbackText, localization.FeedbackThreadPreviewLength))
+	}
+
+	return text + "\nОткройте /feedbacks → 🔎 Фильтр → ⏰ Просроченные."
+}
+
+// HandleFeedbackTriageCallback обрабатывает меню разметки отзыва: категорию, приоритет и ответственного.
+// Callback'и содержат тип списка и ID отзыва, чтобы после разметки вернуться к той же карточке.
+func (fh *FeedbackHandlerImpl) HandleFeedbackTriageCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
+	chatID := callback.Message.Chat.ID
+	messageID := callback.Message.MessageID
+
+	var (
+		prefix string
+		update models.FeedbackTriageUpdate
+	)
+
+	for _, candidate := range []string{
+		localization.CallbackPrefixFeedbackTriageMenu, localization.CallbackPrefixFeedbackTriageCat,
+		localization.CallbackPrefixFeedbackTriagePri, localization.CallbackPrefixFeedbackTriageMine,
+		localization.CallbackPrefixFeedbackTriageBack,
+	} {
+		if strings.HasPrefix(data, candidate) {
+			prefix = candidate
+
+			break
+		}
+	}
+
+	// тип_ID[_значение]
+	parts := strings.SplitN(strings.TrimPrefix(data, prefix), "_", 3)
+	if prefix == "" || len(parts) < 2 {
+		return nil
+	}
+
+	feedbackType := parts[0]
+
+	feedbackID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil
+	}
+
+	value := ""
+	if len(parts) == 3 {
+		value = parts[2]
+	}
+
+	switch prefix {
+	case localization.CallbackPrefixFeedbackTriageMenu:
+		return fh.editTriageMenu(chatID, messageID, user, feedbackType, feedbackID)
+	case localization.CallbackPrefixFeedbackTriageBack:
+		return fh.editFeedbackCard(chatID, messageID, user, feedbackType, feedbackID)
+	case localization.CallbackPrefixFeedbackTriageCat:
+		update.Category = &value
+	case localization.CallbackPrefixFeedbackTriagePri:
+		update.Priority = &value
+	case localization.CallbackPrefixFeedbackTriageMine:
+		current, err := fh.base.Service.GetFeedbackTriage(feedbackID)
+		if err != nil {
+			return fh.sendReplyError(chatID, user, err, "GetFeedbackTriage")
+		}
+
+		// Повторное нажатие снимает назначение
+		assigneeID := user.ID
+		if current.AssigneeID == user.ID {
+			assigneeID = 0
+		}
+
+		update.AssigneeID = &assigneeID
+	}
+
+	if _, err := fh.base.Service.UpdateFeedbackTriage(user, feedbackID, update); err != nil {
+		if stdErrors.Is(err, errors.ErrInvalidUserInput) {
+			return nil
+		}
+
+		return fh.sendReplyError(chatID, user, err, "UpdateFeedbackTriage")
+	}
+
+	return fh.editTriageMenu(chatID, messageID, user, feedbackType, feedbackID)
+}
+
+// editTriageMenu показывает текущую разметку отзыва и кнопки для ее изменения.
+func (fh *FeedbackHandlerImpl) editTriageMenu(chatID int64, messageID int, user *models.User, feedbackType string, feedbackID int) error {
+	feedback, err := fh.findFeedback(feedbackID)
+	if err != nil {
+		return fh.sendReplyError(chatID, user, err, "GetAllFeedback")
+	}
+
+	text := fmt.Sprintf("🏷 <b>Разметка отзыва #%d</b>\n\n%s", feedbackID, formatTriageLines(feedback))
+	category, _ := feedback["category"].(string)
+	priority, _ := feedback["priority"].(string)
+	assigneeID, _ := feedback["assignee_id"].(int)
+
+	keyboard := base.RenderKeyboard(fh.base.Screens.FeedbackTriageKeyboard(feedbackType, feedbackID, category, priority, assigneeID == user.ID))
+
+	return fh.base.MessageFactory.EditHTMLWithKeyboard(chatID, messageID, text, &keyboard)
+}
+
+// editFeedbackCard возвращает к карточке отзыва в списке feedbackType. Если после разметки
+// отзыв выпал из списка (например, из отфильтрованного), показывается начало списка.
+func (fh *FeedbackHandlerImpl) editFeedbackCard(chatID int64, messageID int, user *models.User, feedbackType string, feedbackID int) error {
+	allFeedbacks, err := fh.base.Service.GetAllFeedback()
+	if err != nil {
+		return fh.sendMessage(chatID, "❌ Ошибка получения отзывов: "+err.Error())
+	}
+
+	feedbacks := fh.feedbacksByType(allFeedbacks, feedbackType, user)
+	if len(feedbacks) == 0 {
+		return fh.base.MessageFactory.EditScreen(chatID, messageID, fh.base.Screens.FeedbackNotice("📝 Отзывов нет"))
+	}
+
+	index := 0
+
+	for i, feedback := range feedbacks {
+		if id, ok := feedback["id"].(int); ok && id == feedbackID {
+			index = i
+
+			break
+		}
+	}
+
+	return fh.editFeedbackWithNavigation(chatID, messageID, feedbacks, index, feedbackType)
+}
+
+// findFeedback возвращает отзыв из списка всех отзывов по ID.
+func (fh *FeedbackHandlerImpl) findFeedback(feedbackID int) (map[string]interface{}, error) {
+	allFeedbacks, err := fh.base.Service.GetAllFeedback()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedbacks: %w", err)
+	}
+
+	for _, feedback := range allFeedbacks {
+		if id, ok := feedback["id"].(int); ok && id == feedbackID {
+			return feedback, nil
+		}
+	}
+
+	return nil, errors.ErrFeedbackNotFound
+}
+
+// HandleFeedbackFilterCallback обрабатывает меню фильтра активных отзывов.
+// Фильтр хранится в кэше для каждого администратора на FeedbackFilterTTL.
+func (fh *FeedbackHandlerImpl) HandleFeedbackFilterCallback(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
+	chatID := callback.Message.Chat.ID
+	messageID := callback.Message.MessageID
+	filter := fh.loadFeedbackFilter(user)
+
+	switch {
+	case data == localization.CallbackFeedbackFilterMenu:
+		return fh.editFilterMenu(chatID, messageID, user, filter)
+	case data == localization.CallbackFeedbackFilterShow:
+		return fh.editFeedbackCard(chatID, messageID, user, localization.FeedbackTypeFilteredLocal, 0)
+	case data == localization.CallbackFeedbackFilterReset:
+		filter = models.FeedbackFilter{}
+	case data == localization.CallbackFeedbackFilterMine:
+		filter.AssignedToMe = !filter.AssignedToMe
+	case data == localization.CallbackFeedbackFilterBreached:
+		filter.Breached = !filter.Breached
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackFilterCat):
+		filter.Category = toggleFilterValue(filter.Category, strings.TrimPrefix(data, localization.CallbackPrefixFeedbackFilterCat))
+	case strings.HasPrefix(data, localization.CallbackPrefixFeedbackFilterPri):
+		filter.Priority = toggleFilterValue(filter.Priority, strings.TrimPrefix(data, localization.CallbackPrefixFeedbackFilterPri))
+	default:
+		return nil
+	}
+
+	if err := fh.saveFeedbackFilter(user, filter); err != nil {
+		return fh.base.ErrorHandler.HandleTelegramError(err, chatID, int64(user.ID), "saveFeedbackFilter")
+	}
+
+	return fh.editFilterMenu(chatID, messageID, user, filter)
+}
+
+// editFilterMenu показывает фильтр и количество подходящих активных отзывов.
+func (fh *FeedbackHandlerImpl) editFilterMenu(chatID int64, messageID int, user *models.User, filter models.FeedbackFilter) error {
+	allFeedbacks, err := fh.base.Service.GetAllFeedback()
+	if err != nil {
+		return fh.sendMessage(chatID, "❌ Ошибка получения отзывов: "+err.Error())
+	}
+
+	matched := len(FilterFeedbacks(allFeedbacks, filter, user.ID))
+
+	category, priority := "все", "все"
+	if filter.Category != "" {
+		category = screens.FeedbackCategoryLabel(filter.Category)
+	}
+
+	if filter.Priority != "" {
+		priority = screens.FeedbackPriorityLabel(filter.Priority)
+	}
+
+	text := "🔎 Фильтр активных отзывов:\n\n"
+	text += fmt.Sprintf("🏷 Категория: %s\n", category)
+	text += fmt.Sprintf("⚡ Приоритет: %s\n", priority)
+	text += fmt.Sprintf("🙋 Только мои: %s\n", yesNo(filter.AssignedToMe))
+	text += fmt.Sprintf("⏰ Только просроченные: %s\n\n", yesNo(filter.Breached))
+	text += fmt.Sprintf("Подходит отзывов: %d", matched)
+
+	keyboard := base.RenderKeyboard(fh.base.Screens.FeedbackFilterKeyboard(filter, matched))
+
+	return fh.base.MessageFactory.EditWithKeyboard(chatID, messageID, text, &keyboard)
+}
+
+// loadFeedbackFilter возвращает сохраненный фильтр администратора или пустой фильтр.
+func (fh *FeedbackHandlerImpl) loadFeedbackFilter(user *models.User) models.FeedbackFilter {
+	var filter models.FeedbackFilter
+
+	if fh.base.Service.Cache == nil {
+		return filter
+	}
+
+	if err := fh.base.Service.Cache.Get(context.Background(), feedbackFilterKey(user), &filter); err != nil {
+		return models.FeedbackFilter{}
+	}
+
+	return filter
+}
+
+// saveFeedbackFilter сохраняет фильтр администратора в кэше.
+func (fh *FeedbackHandlerImpl) saveFeedbackFilter(user *models.User, filter models.FeedbackFilter) error {
+	if fh.base.Service.Cache == nil {
+		return stdErrors.New("cache is not configured")
+	}
+
+	return fh.base.Service.Cache.Set(context.Background(), feedbackFilterKey(user), filter, localization.FeedbackFilterTTL)
+}
+
+// feedbacksByType возвращает отзывы списка feedbackType в порядке просмотра.
+func (fh *FeedbackHandlerImpl) feedbacksByType(allFeedbacks []map[string]interface{}, feedbackType string, user *models.User) []map[string]interface{} {
+	var feedbacks []map[string]interface{}
+
+	switch feedbackType {
+	case localization.FeedbackTypeActiveLocal:
+		for _, fb := range allFeedbacks {
+			if isArchived, ok := fb["is_processed"].(bool); !ok || !isArchived {
+				feedbacks = append(feedbacks, fb)
+			}
+		}
+	case localization.FeedbackTypeArchiveLocal:
+		for _, fb := range allFeedbacks {
+			if isArchived, ok := fb["is_processed"].(bool); ok && isArchived {
+				feedbacks = append(feedbacks, fb)
+			}
+		}
+	case localization.FeedbackTypeAllLocal:
+		feedbacks = allFeedbacks
+	case localization.FeedbackTypeFilteredLocal:
+		feedbacks = FilterFeedbacks(allFeedbacks, fh.loadFeedbackFilter(user), user.ID)
+	}
+
+	return feedbacks
+}
+
+// FilterFeedbacks возвращает активные отзывы, подходящие под фильтр; userID - администратор,
+// для которого применяется условие "только мои".
+func FilterFeedbacks(feedbacks []map[string]interface{}, filter models.FeedbackFilter, userID int) []map[string]interface{} {
+	var result []map[string]interface{}
+
+	for _, fb := range feedbacks {
+		if isArchived, ok := fb["is_processed"].(bool); ok && isArchived {
+			continue
+		}
+
+		if category, _ := fb["category"].(string); filter.Category != "" && category != filter.Category {
+			continue
+		}
+
+		if priority, _ := fb["priority"].(string); filter.Priority != "" && priority != filter.Priority {
+			continue
+		}
+
+		if assigneeID, _ := fb["assignee_id"].(int); filter.AssignedToMe && assigneeID != userID {
+			continue
+		}
+
+		if breached, _ := fb["sla_breached"].(bool); filter.Breached && !breached {
+			continue
+		}
+
+		result = append(result, fb)
+	}
+
+	return result
+}
+
+// formatTriageLines форматирует разметку отзыва для карточки (HTML).
+func formatTriageLines(feedback map[string]interface{}) string {
+	category, _ := feedback["category"].(string)
+	priority, _ := feedback["priority"].(string)
+
+	categoryText := screens.FeedbackCategoryLabel(category)
+	if auto, _ := feedback["category_auto"].(bool); auto {
+		categoryText += " (авто)"
+	}
+
+	text := fmt.Sprintf("🏷 <b>Категория:</b> %s\n", categoryText)
+	text += fmt.Sprintf("⚡ <b>Приоритет:</b> %s\n", screens.FeedbackPriorityLabel(priority))
+
+	if assignee, ok := feedback["assignee_name"].(string); ok && assignee != "" {
+		text += fmt.Sprintf("🙋 <b>Ответственный:</b> %s\n", html.EscapeString(assignee))
+	}
+
+	if dueAt, ok := feedback["sla_due_at"].(time.Time); ok {
+		text += fmt.Sprintf("⏰ <b>Срок:</b> %s", dueAt.Format("02.01.2006 15:04"))
+
+		if breached, _ := feedback["sla_breached"].(bool); breached {
+			text += " ⚠️ просрочен"
+		}
+
+		text += "\n"
+	}
+
+	return text
+}
+
+// toggleFilterValue выбирает значение фильтра или снимает его при повторном выборе.
+func toggleFilterValue(current, value string) string {
+	if current == value {
+		return ""
+	}
+
+	return value
+}
+
+// yesNo форматирует флаг фильтра.
+func yesNo(value bool) string {
+	if value {
+		return "да"
+	}
+
+	return "нет"
+}
+
+// feedbackFilterKey - ключ кэша с фильтром просмотра отзывов администратора.
+func feedbackFilterKey(user *models.User) string {
+	return localization.FeedbackFilterPrefix + strconv.FormatInt(user.TelegramID, 10)
+}
@@ -1,11 +1,10 @@
 package interests
 
 import (
-	"math"
 	"strconv"
-	"strings"
 	"sync"
 
+	"language-exchange-bot/internal/adapters/screens"
 	"language-exchange-bot/internal/adapters/telegram/handlers/base"
 	"language-exchange-bot/internal/core"
 	"language-exchange-bot/internal/errors"
@@ -196,6 +195,8 @@ type ImprovedInterestHandler struct {
 	interestService *core.InterestService
 	bot             *tgbotapi.BotAPI
 	keyboardBuilder *base.KeyboardBuilder
+	messageFactory  *base.MessageFactory
+	screens         *screens.Builder
 	errorHandler    *errors.ErrorHandler
 	tempStorage     *TemporaryInterestStorage
 }
@@ -213,6 +214,8 @@ func NewImprovedInterestHandler(
 		interestService: interestService,
 		bot:             bot,
 		keyboardBuilder: keyboardBuilder,
+		messageFactory:  base.NewMessageFactory(bot, errorHandler, service.LoggingService),
+		screens:         screens.NewBuilder(service),
 		errorHandler:    errorHandler,
 		tempStorage:     NewTemporaryInterestStorage(),
 	}
@@ -255,29 +258,12 @@ func (h *ImprovedInterestHandler) HandleInterestCategorySelection(callback *tgbo
 		selectedMap[selection.InterestID] = true
 	}
 
-	// Создаем клавиатуру
-	keyboard := h.keyboardBuilder.CreateCategoryInterestsKeyboard(
-		interests,
-		selectedMap,
-		selectedCategory.KeyName,
-		user.InterfaceLanguageCode,
-	)
-
-	// Создаем текст сообщения
-	categoryName := h.service.Localizer.Get(user.InterfaceLanguageCode, "category_"+categoryKey)
-	messageText := categoryName + " - " + h.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleChooseInterests)
-
 	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	return h.messageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		messageText,
-		keyboard,
+		h.screens.CategoryInterests(user.InterfaceLanguageCode, selectedCategory.KeyName, interests, selectedMap),
 	)
-
-	_, err = h.bot.Request(editMsg)
-
-	return err
 }
 
 // HandleInterestSelection обрабатывает выбор интереса (только во временном хранилище).
@@ -338,47 +324,18 @@ func (h *ImprovedInterestHandler) HandleInterestsContinue(callback *tgbotapi.Cal
 
 	// Проверяем, выбраны ли интересы
 	if len(selectedInterests) == 0 {
-		warningMsg := "❗ " + h.service.Localizer.Get(user.InterfaceLanguageCode, localization.MessageChooseAtLeastOneInterest)
-		if warningMsg == localization.MessageChooseAtLeastOneInterest {
-			warningMsg = "❗ Пожалуйста, выберите хотя бы один интерес"
-		}
-
 		// Показываем предупреждение и возвращаем к категориям
-		keyboard := h.keyboardBuilder.CreateInterestCategoriesKeyboard(user.InterfaceLanguageCode)
-		editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+		return h.messageFactory.EditScreen(
 			callback.Message.Chat.ID,
 			callback.Message.MessageID,
-			warningMsg+"\n\n"+h.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleChooseInterests),
-			keyboard,
+			h.screens.InterestCategoriesRequired(user),
 		)
-		_, err := h.bot.Request(editMsg)
-
-		return err
 	}
 
-	// Получаем конфигурацию лимитов
-	limits, err := h.interestService.GetInterestLimitsConfig()
-	if err != nil {
-		return h.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetInterestLimitsConfig")
-	}
-
-	// Получаем общее количество интересов в системе
-	allInterests, err := h.interestService.GetAllInterests()
+	// Рекомендуемое количество основных интересов
+	recommendedPrimary, err := recommendedPrimaryInterests(h.interestService)
 	if err != nil {
-		return h.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetAllInterests")
-	}
-
-	// Вычисляем рекомендуемое количество основных интересов
-	totalInterestsInSystem := len(allInterests)
-	recommendedPrimary := int(math.Ceil(float64(totalInterestsInSystem) * limits.PrimaryPercentage))
-
-	// Ограничиваем минимумом и максимумом
-	if recommendedPrimary < limits.MinPrimaryInterests {
-		recommendedPrimary = limits.MinPrimaryInterests
-	}
-
-	if recommendedPrimary > limits.MaxPrimaryInterests {
-		recommendedPrimary = limits.MaxPrimaryInterests
+		return h.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "RecommendedPrimaryInterests")
 	}
 
 	// Если выбранных интересов меньше или равно максимальному количеству основных,
@@ -412,8 +369,7 @@ func (h *ImprovedInterestHandler) HandleInterestsContinue(callback *tgbotapi.Cal
 
 // HandlePrimaryInterestsContinue обрабатывает завершение выбора основных интересов.
 func (h *ImprovedInterestHandler) HandlePrimaryInterestsContinue(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	// Получаем временные выборы
-	tempSelections := h.tempStorage.GetSelections(user.ID)
+	// Считаем основные среди временных выборов
 	primaryCount := len(h.tempStorage.GetPrimaryInterests(user.ID))
 
 	// Получаем конфигурацию лимитов
@@ -424,21 +380,8 @@ func (h *ImprovedInterestHandler) HandlePrimaryInterestsContinue(callback *tgbot
 
 	// Проверяем минимальное количество основных интересов
 	if primaryCount < limits.MinPrimaryInterests {
-		warningMsg := h.service.Localizer.Get(user.InterfaceLanguageCode, "choose_at_least_primary_interests")
-		if warningMsg == "choose_at_least_primary_interests" {
-			warningMsg = "❗ Пожалуйста, выберите минимум " + strconv.Itoa(limits.MinPrimaryInterests) + " основных интереса"
-		}
-
 		// Показываем предупреждение
-		editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-			callback.Message.Chat.ID,
-			callback.Message.MessageID,
-			warningMsg+"\n\n"+h.service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests"),
-			h.keyboardBuilder.CreatePrimaryInterestsKeyboard(tempSelections, user.InterfaceLanguageCode),
-		)
-		_, err = h.bot.Request(editMsg)
-
-		return err
+		return h.showPrimaryInterests(callback, user, h.screens.PrimaryInterestsMinimum(user.InterfaceLanguageCode, limits.MinPrimaryInterests))
 	}
 
 	// Сохраняем в базу данных
@@ -453,210 +396,46 @@ func (h *ImprovedInterestHandler) HandlePrimaryInterestsContinue(callback *tgbot
 
 // showPrimaryInterestsSelection показывает интерфейс выбора основных интересов.
 func (h *ImprovedInterestHandler) showPrimaryInterestsSelection(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	// Получаем временные выборы
-	tempSelections := h.tempStorage.GetSelections(user.ID)
-
-	// Создаем клавиатуру для выбора основных интересов
-	keyboard := h.keyboardBuilder.CreatePrimaryInterestsKeyboard(tempSelections, user.InterfaceLanguageCode)
+	return h.showPrimaryInterests(callback, user, "")
+}
 
+// showPrimaryInterests показывает выбор основных среди временных выборов; warning, если не пуст, заменяет подсказку.
+func (h *ImprovedInterestHandler) showPrimaryInterests(callback *tgbotapi.CallbackQuery, user *models.User, warning string) error {
 	// Получаем рекомендуемое количество основных интересов
-	limits, err := h.interestService.GetInterestLimitsConfig()
-	if err != nil {
-		return h.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetInterestLimitsConfig")
-	}
-
-	// Получаем общее количество интересов в системе
-	allInterests, err := h.interestService.GetAllInterests()
+	recommendedPrimary, err := recommendedPrimaryInterests(h.interestService)
 	if err != nil {
-		return h.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetAllInterests")
+		return h.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "RecommendedPrimaryInterests")
 	}
 
-	// Вычисляем рекомендуемое количество основных интересов от общего количества интересов в системе
-	totalInterestsInSystem := len(allInterests)
-	recommendedPrimary := int(math.Ceil(float64(totalInterestsInSystem) * limits.PrimaryPercentage))
-
-	// Ограничиваем минимумом и максимумом
-	if recommendedPrimary < limits.MinPrimaryInterests {
-		recommendedPrimary = limits.MinPrimaryInterests
-	}
-
-	if recommendedPrimary > limits.MaxPrimaryInterests {
-		recommendedPrimary = limits.MaxPrimaryInterests
-	}
-
-	// Подсчитываем уже выбранные основные интересы
-	selectedPrimaryCount := 0
+	// Временные выборы в том же виде, что и сохраненные
+	tempSelections := h.tempStorage.GetSelections(user.ID)
 
+	selections := make([]models.InterestSelection, 0, len(tempSelections))
 	for _, selection := range tempSelections {
-		if selection.IsPrimary {
-			selectedPrimaryCount++
-		}
+		selections = append(selections, models.InterestSelection{
+			InterestID:     selection.InterestID,
+			IsPrimary:      selection.IsPrimary,
+			SelectionOrder: selection.SelectionOrder,
+		})
 	}
 
-	// Создаем текст сообщения с динамическим количеством
-	var messageText string
-	if selectedPrimaryCount == 0 {
-		messageText = h.service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests_dynamic")
-		messageText = strings.ReplaceAll(messageText, "{max}", strconv.Itoa(recommendedPrimary))
-	} else {
-		remaining := recommendedPrimary - selectedPrimaryCount
-		if remaining > 0 {
-			messageText = h.service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests_remaining")
-			messageText = strings.ReplaceAll(messageText, "{remaining}", strconv.Itoa(remaining))
-			messageText = strings.ReplaceAll(messageText, "{max}", strconv.Itoa(recommendedPrimary))
-		} else {
-			messageText = h.service.Localizer.Get(user.InterfaceLanguageCode, localization.MessageMaxPrimaryInterestsReached)
-			if messageText == localization.MessageMaxPrimaryInterestsReached {
-				messageText = localization.MessageMaxPrimaryInterestsFallback
-			}
-		}
-	}
+	interests, primary := selectedInterests(h.interestService, selections)
 
-	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	return h.messageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		messageText,
-		keyboard,
+		h.screens.PrimaryInterests(user.InterfaceLanguageCode, interests, primary, recommendedPrimary, warning),
 	)
-
-	_, err = h.bot.Request(editMsg)
-
-	return err
 }
 
 // updateCategoryInterestsKeyboard обновляет клавиатуру интересов в категории.
 func (h *ImprovedInterestHandler) updateCategoryInterestsKeyboard(callback *tgbotapi.CallbackQuery, user *models.User, categoryKey string) error {
-	// Получаем категории
-	categories, err := h.interestService.GetInterestCategories()
-	if err != nil {
-		return err
-	}
-
-	// Находим категорию
-	var selectedCategory *models.InterestCategory
-
-	for _, category := range categories {
-		if category.KeyName == categoryKey {
-			selectedCategory = &category
-
-			break
-		}
-	}
-
-	if selectedCategory == nil {
-		return err
-	}
-
-	// Получаем интересы в категории
-	interests, err := h.interestService.GetInterestsByCategory(selectedCategory.ID)
-	if err != nil {
-		return err
-	}
-
-	// Получаем временные выборы
-	tempSelections := h.tempStorage.GetSelections(user.ID)
-
-	selectedMap := make(map[int]bool)
-	for _, selection := range tempSelections {
-		selectedMap[selection.InterestID] = true
-	}
-
-	// Создаем клавиатуру
-	keyboard := h.keyboardBuilder.CreateCategoryInterestsKeyboard(
-		interests,
-		selectedMap,
-		categoryKey,
-		user.InterfaceLanguageCode,
-	)
-
-	// Обновляем сообщение
-	categoryName := h.service.Localizer.Get(user.InterfaceLanguageCode, "category_"+categoryKey)
-	messageText := categoryName + " - " + h.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleChooseInterests)
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		messageText,
-		keyboard,
-	)
-
-	_, err = h.bot.Request(editMsg)
-
-	return err
+	return h.HandleInterestCategorySelection(callback, user, categoryKey)
 }
 
 // updatePrimaryInterestsKeyboard обновляет клавиатуру выбора основных интересов.
 func (h *ImprovedInterestHandler) updatePrimaryInterestsKeyboard(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	// Получаем временные выборы
-	tempSelections := h.tempStorage.GetSelections(user.ID)
-
-	// Создаем клавиатуру
-	keyboard := h.keyboardBuilder.CreatePrimaryInterestsKeyboard(tempSelections, user.InterfaceLanguageCode)
-
-	// Получаем рекомендуемое количество основных интересов
-	limits, err := h.interestService.GetInterestLimitsConfig()
-	if err != nil {
-		return err
-	}
-
-	// Получаем общее количество интересов в системе
-	allInterests, err := h.interestService.GetAllInterests()
-	if err != nil {
-		return err
-	}
-
-	// Вычисляем рекомендуемое количество основных интересов от общего количества интересов в системе
-	totalInterestsInSystem := len(allInterests)
-	recommendedPrimary := int(math.Ceil(float64(totalInterestsInSystem) * limits.PrimaryPercentage))
-
-	// Ограничиваем минимумом и максимумом
-	if recommendedPrimary < limits.MinPrimaryInterests {
-		recommendedPrimary = limits.MinPrimaryInterests
-	}
-
-	if recommendedPrimary > limits.MaxPrimaryInterests {
-		recommendedPrimary = limits.MaxPrimaryInterests
-	}
-
-	// Подсчитываем уже выбранные основные интересы
-	selectedPrimaryCount := 0
-
-	for _, selection := range tempSelections {
-		if selection.IsPrimary {
-			selectedPrimaryCount++
-		}
-	}
-
-	// Создаем текст сообщения с динамическим количеством
-	var messageText string
-	if selectedPrimaryCount == 0 {
-		messageText = h.service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests_dynamic")
-		messageText = strings.ReplaceAll(messageText, "{max}", strconv.Itoa(recommendedPrimary))
-	} else {
-		remaining := recommendedPrimary - selectedPrimaryCount
-		if remaining > 0 {
-			messageText = h.service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests_remaining")
-			messageText = strings.ReplaceAll(messageText, "{remaining}", strconv.Itoa(remaining))
-			messageText = strings.ReplaceAll(messageText, "{max}", strconv.Itoa(recommendedPrimary))
-		} else {
-			messageText = h.service.Localizer.Get(user.InterfaceLanguageCode, localization.MessageMaxPrimaryInterestsReached)
-			if messageText == localization.MessageMaxPrimaryInterestsReached {
-				messageText = localization.MessageMaxPrimaryInterestsFallback
-			}
-		}
-	}
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		messageText,
-		keyboard,
-	)
-
-	_, err = h.bot.Request(editMsg)
-
-	return err
+	return h.showPrimaryInterests(callback, user, "")
 }
 
 // completeProfileSetup завершает настройку профиля интересов пользователя.
@@ -669,57 +448,11 @@ func (h *ImprovedInterestHandler) completeProfileSetup(callback *tgbotapi.Callba
 		return h.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetUserInterestSummary")
 	}
 
-	// Создаем текст с основными и дополнительными интересами
-	var primaryText, additionalText strings.Builder
-
-	if len(summary.PrimaryInterests) > 0 {
-		primaryText.WriteString(h.service.Localizer.Get(user.InterfaceLanguageCode, "primary_interests_label") + " ")
-
-		for i, interest := range summary.PrimaryInterests {
-			if i > 0 {
-				primaryText.WriteString(", ")
-			}
-
-			interestName := h.service.Localizer.Get(user.InterfaceLanguageCode, "interest_"+interest.KeyName)
-			primaryText.WriteString(interestName)
-		}
-
-		primaryText.WriteString("\n")
-	}
-
-	if len(summary.AdditionalInterests) > 0 {
-		additionalText.WriteString(h.service.Localizer.Get(user.InterfaceLanguageCode, "additional_interests_label") + " ")
-
-		for i, interest := range summary.AdditionalInterests {
-			if i > 0 {
-				additionalText.WriteString(", ")
-			}
-
-			interestName := h.service.Localizer.Get(user.InterfaceLanguageCode, "interest_"+interest.KeyName)
-			additionalText.WriteString(interestName)
-		}
-
-		additionalText.WriteString("\n")
-	}
-
-	// Создаем итоговое сообщение
-	completionMsg := h.service.Localizer.Get(user.InterfaceLanguageCode, "interests_selection_complete")
-	feedbackSuggestion := h.service.Localizer.Get(user.InterfaceLanguageCode, "interests_feedback_suggestion")
-
-	fullMessage := completionMsg + "\n\n" + primaryText.String() + additionalText.String() + "\n" + feedbackSuggestion
-
-	// Создаем клавиатуру
-	keyboard := h.keyboardBuilder.CreateProfileCompletedKeyboard(user.InterfaceLanguageCode)
-
-	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		fullMessage,
-		keyboard,
-	)
+	// Итог выбора ведет к профилю: доступность здесь не настраивается
+	screen := h.screens.InterestsCompleted(user.InterfaceLanguageCode, summary)
+	screen.Keyboard = h.screens.ProfileCompletedKeyboard(user.InterfaceLanguageCode)
 
-	_, err = h.bot.Request(editMsg)
+	err = h.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 	if err != nil {
 		return err
 	}
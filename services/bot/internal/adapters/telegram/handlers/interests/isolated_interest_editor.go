@@ -2,10 +2,11 @@ package interests
 
 import (
 	"context"
+	stdErrors "errors"
 	"fmt"
-	"strings"
 	"time"
 
+	"language-exchange-bot/internal/adapters/screens"
 	"language-exchange-bot/internal/cache"
 	"language-exchange-bot/internal/core"
 	"language-exchange-bot/internal/errors"
@@ -24,6 +25,8 @@ type IsolatedInterestEditor struct {
 	interestService *core.InterestService
 	bot             *tgbotapi.BotAPI
 	keyboardBuilder *base.KeyboardBuilder
+	messageFactory  *base.MessageFactory
+	screens         *screens.Builder
 	errorHandler    *errors.ErrorHandler
 	cache           cache.ServiceInterface
 }
@@ -71,6 +74,8 @@ func NewIsolatedInterestEditor(
 		interestService: interestService,
 		bot:             bot,
 		keyboardBuilder: keyboardBuilder,
+		messageFactory:  base.NewMessageFactory(bot, errorHandler, service.LoggingService),
+		screens:         screens.NewBuilder(service),
 		errorHandler:    errorHandler,
 		cache:           cache,
 	}
@@ -145,31 +150,9 @@ func (e *IsolatedInterestEditor) StartEditSession(callback *tgbotapi.CallbackQue
 
 // ShowEditMainMenu показывает главное меню редактирования.
 func (e *IsolatedInterestEditor) ShowEditMainMenu(callback *tgbotapi.CallbackQuery, user *models.User, session *EditSession) error {
-	// Получаем статистику
-	stats := e.calculateEditStats(session)
+	screen := e.screens.InterestEditorMenu(user.InterfaceLanguageCode, e.screenStats(e.calculateEditStats(session)))
 
-	// Создаем текст с хлебными крошками и статистикой
-	breadcrumb := e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_breadcrumb")
-	statsText := e.formatEditStats(stats, user.InterfaceLanguageCode)
-
-	text := fmt.Sprintf("%s\n\n%s\n\n%s",
-		breadcrumb,
-		e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_main_menu"),
-		statsText)
-
-	// Создаем клавиатуру главного меню
-	keyboard := e.createEditMainMenuKeyboard(user.InterfaceLanguageCode, stats)
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
-
-	_, err := e.bot.Request(editMsg)
-
-	return err
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // ShowEditCategoriesMenu показывает меню категорий для редактирования.
@@ -180,25 +163,14 @@ func (e *IsolatedInterestEditor) ShowEditCategoriesMenu(callback *tgbotapi.Callb
 		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetInterestCategories")
 	}
 
-	// Создаем текст с хлебными крошками
-	breadcrumb := e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_breadcrumb_categories")
-	text := fmt.Sprintf("%s\n\n%s",
-		breadcrumb,
-		e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_choose_category"))
+	// Рекомендации по выбору похожих пользователей
+	suggestions := e.getSuggestedInterests(user, session)
 
-	// Создаем клавиатуру категорий с индикаторами
-	keyboard := e.createEditCategoriesKeyboard(categories, session, user.InterfaceLanguageCode)
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
+	screen := e.screens.InterestEditorCategories(
+		user.InterfaceLanguageCode, categories, e.categoryProgress(session, categories), suggestions,
 	)
 
-	_, err = e.bot.Request(editMsg)
-
-	return err
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // ShowEditCategoryInterests показывает интересы в категории для редактирования.
@@ -219,134 +191,66 @@ func (e *IsolatedInterestEditor) ShowEditCategoryInterests(callback *tgbotapi.Ca
 		selectedMap[selection.InterestID] = true
 	}
 
-	// Создаем текст с хлебными крошками
-	categoryName := e.service.Localizer.Get(user.InterfaceLanguageCode, "category_"+categoryKey)
-	breadcrumb := fmt.Sprintf("%s > %s",
-		e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_breadcrumb_categories"),
-		categoryName)
+	screen := e.screens.InterestEditorCategory(user.InterfaceLanguageCode, categoryKey, interests, selectedMap)
 
-	text := fmt.Sprintf("%s\n\n%s",
-		breadcrumb,
-		e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_in_category"))
-
-	// Создаем клавиатуру интересов
-	keyboard := e.createEditCategoryInterestsKeyboard(interests, selectedMap, categoryKey, user.InterfaceLanguageCode)
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
-
-	_, err = e.bot.Request(editMsg)
-
-	return err
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
-// ShowEditPrimaryInterests показывает основные интересы для редактирования.
+// ShowEditPrimaryInterests показывает основные интересы сессии со счетчиком выбранных из рекомендуемых.
 func (e *IsolatedInterestEditor) ShowEditPrimaryInterests(callback *tgbotapi.CallbackQuery, user *models.User, session *EditSession) error {
-	e.service.LoggingService.Telegram().DebugWithContext(
-		"ShowEditPrimaryInterests called",
-		base.GenerateRequestID("ShowEditPrimaryInterests"),
-		int64(user.ID),
-		callback.Message.Chat.ID,
-		"ShowEditPrimaryInterests",
-		map[string]interface{}{"userID": user.ID},
-	)
-
-	// Получаем текущие выборы пользователя
-	selections, err := e.interestService.GetUserInterestSelections(user.ID)
-	if err != nil {
-		e.service.LoggingService.Database().DebugWithContext(
-			"ShowEditPrimaryInterests GetUserInterestSelections error",
-			base.GenerateRequestID("ShowEditPrimaryInterests"),
-			int64(user.ID),
-			callback.Message.Chat.ID,
-			"ShowEditPrimaryInterests",
-			map[string]interface{}{"userID": user.ID, "error": err.Error()},
-		)
-
-		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetUserInterestSelections")
-	}
-
-	e.service.LoggingService.Database().DebugWithContext(
-		"ShowEditPrimaryInterests found selections",
-		base.GenerateRequestID("ShowEditPrimaryInterests"),
-		int64(user.ID),
-		callback.Message.Chat.ID,
-		"ShowEditPrimaryInterests",
-		map[string]interface{}{"userID": user.ID, "selectionsCount": len(selections)},
-	)
-
-	// Получаем общее количество интересов в системе
-	allInterests, err := e.interestService.GetAllInterests()
+	recommendedPrimary, err := e.recommendedPrimaryCount()
 	if err != nil {
 		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetAllInterests")
 	}
 
-	// Вычисляем рекомендуемое количество основных интересов
-	config := e.service.GetConfig()
-	totalInterestsInSystem := len(allInterests)
-	recommendedPrimary := int(float64(totalInterestsInSystem) * config.PrimaryPercentage)
+	interests, primary := e.primaryInterests(session.CurrentSelections)
+	screen := e.screens.InterestEditorPrimary(user.InterfaceLanguageCode, interests, primary, recommendedPrimary)
 
-	// Ограничиваем минимумом и максимумом
-	if recommendedPrimary < config.MinPrimaryInterests {
-		recommendedPrimary = config.MinPrimaryInterests
-	}
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
+}
 
-	if recommendedPrimary > config.MaxPrimaryInterests {
-		recommendedPrimary = config.MaxPrimaryInterests
+// ToggleInterestSelection переключает выбор интереса.
+func (e *IsolatedInterestEditor) ToggleInterestSelection(callback *tgbotapi.CallbackQuery, user *models.User, interestID int) error {
+	// Получаем сессию
+	session, err := e.GetEditSession(user.ID)
+	if err != nil {
+		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetEditSession")
 	}
 
-	// Подсчитываем уже выбранные основные интересы
-	selectedPrimaryCount := 0
-
-	for _, selection := range selections {
-		if selection.IsPrimary {
-			selectedPrimaryCount++
-		}
+	// Получаем информацию об интересе
+	interest, err := e.interestService.GetInterestByID(interestID)
+	if err != nil {
+		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetInterestByID")
 	}
 
-	// Создаем хлебные крошки
-	breadcrumb := e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_breadcrumb_primary")
+	e.toggleSessionInterest(session, user, interest)
 
-	// Создаем текст сообщения с счетчиком
-	text := fmt.Sprintf("%s\n\n%s (%d из %d выбрано)",
-		breadcrumb,
-		e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_primary_description"),
-		selectedPrimaryCount,
-		recommendedPrimary)
-
-	// Создаем клавиатуру для основных интересов
-	keyboard := e.createEditPrimaryInterestsKeyboard(selections, user.InterfaceLanguageCode)
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
-
-	_, err = e.bot.Request(editMsg)
-
-	return err
+	// Обновляем клавиатуру
+	return e.ShowEditCategoryInterests(callback, user, session, session.CurrentCategory)
 }
 
-// ToggleInterestSelection переключает выбор интереса.
-func (e *IsolatedInterestEditor) ToggleInterestSelection(callback *tgbotapi.CallbackQuery, user *models.User, interestID int) error {
-	// Получаем сессию
+// ToggleSuggestedInterest переключает рекомендованный интерес из ряда «Рекомендуем вам»
+// и остается в меню категорий.
+func (e *IsolatedInterestEditor) ToggleSuggestedInterest(callback *tgbotapi.CallbackQuery, user *models.User, interestID int) error {
 	session, err := e.GetEditSession(user.ID)
 	if err != nil {
 		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetEditSession")
 	}
 
-	// Получаем информацию об интересе
 	interest, err := e.interestService.GetInterestByID(interestID)
 	if err != nil {
 		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetInterestByID")
 	}
 
+	e.toggleSessionInterest(session, user, interest)
+
+	return e.ShowEditCategoriesMenu(callback, user, session)
+}
+
+// toggleSessionInterest добавляет интерес в сессию или убирает его и записывает изменение.
+func (e *IsolatedInterestEditor) toggleSessionInterest(session *EditSession, user *models.User, interest *models.Interest) {
+	interestID := interest.ID
+
 	// Проверяем, выбран ли уже этот интерес
 	isSelected := false
 
@@ -388,29 +292,38 @@ func (e *IsolatedInterestEditor) ToggleInterestSelection(callback *tgbotapi.Call
 
 	// Обновляем сессию
 	e.updateSession(session)
-
-	// Обновляем клавиатуру
-	return e.ShowEditCategoryInterests(callback, user, session, session.CurrentCategory)
 }
 
-// ShowChangesPreview показывает предварительный просмотр изменений.
-func (e *IsolatedInterestEditor) ShowChangesPreview(callback *tgbotapi.CallbackQuery, user *models.User, session *EditSession) error {
-	// Создаем текст с изменениями
-	text := e.formatChangesPreview(session, user.InterfaceLanguageCode)
+// getSuggestedInterests возвращает рекомендации по текущему выбору в сессии.
+// Рекомендации не критичны: при ошибке меню показывается без них.
+func (e *IsolatedInterestEditor) getSuggestedInterests(user *models.User, session *EditSession) []models.InterestRecommendation {
+	selectedIDs := make([]int, 0, len(session.CurrentSelections))
+	for _, selection := range session.CurrentSelections {
+		selectedIDs = append(selectedIDs, selection.InterestID)
+	}
 
-	// Создаем клавиатуру для предварительного просмотра
-	keyboard := e.createChangesPreviewKeyboard(user.InterfaceLanguageCode)
+	suggestions, err := e.service.GetSuggestedInterests(selectedIDs)
+	if err != nil {
+		e.service.LoggingService.Database().WarnWithContext(
+			"Failed to get suggested interests",
+			base.GenerateRequestID("getSuggestedInterests"),
+			int64(user.ID),
+			0,
+			"getSuggestedInterests",
+			map[string]interface{}{"userID": user.ID, "error": err.Error()},
+		)
 
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
+		return nil
+	}
+
+	return suggestions
+}
 
-	_, err := e.bot.Request(editMsg)
+// ShowChangesPreview показывает предварительный просмотр изменений.
+func (e *IsolatedInterestEditor) ShowChangesPreview(callback *tgbotapi.CallbackQuery, user *models.User, session *EditSession) error {
+	screen := e.screens.InterestEditorPreview(user.InterfaceLanguageCode, e.screenChanges(session))
 
-	return err
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // SaveChanges сохраняет изменения.
@@ -463,6 +376,11 @@ func (e *IsolatedInterestEditor) SaveChanges(callback *tgbotapi.CallbackQuery, u
 			map[string]interface{}{"userID": user.ID, "selectionsCount": len(session.CurrentSelections), "error": err.Error()},
 		)
 
+		// Нарушение политики основных интересов объясняем и возвращаем к их выбору
+		if e.service.PrimaryPolicyErrorMessage(err, user.InterfaceLanguageCode) != "" {
+			return e.showPrimaryRefusal(callback, user, session, err)
+		}
+
 		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "BatchUpdateUserInterests")
 	}
 
@@ -470,32 +388,9 @@ func (e *IsolatedInterestEditor) SaveChanges(callback *tgbotapi.CallbackQuery, u
 	e.clearEditSession(user.ID)
 
 	// Показываем уведомление об изменениях и возвращаемся к профилю
-	changesCount := len(session.Changes)
-	text := fmt.Sprintf("✅ Изменения успешно сохранены!\n\n📊 Внесено изменений: %d", changesCount)
-
-	// Добавляем детальную информацию об изменениях
-	if changesCount > 0 {
-		text += "\n\n📝 Детали изменений:"
-		text += e.formatChangesSummary(session, user.InterfaceLanguageCode)
-	}
-
-	// Создаем клавиатуру профиля
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("👤 Показать профиль", "profile_show"),
-		),
-	)
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
+	screen := e.screens.InterestEditorSaved(user.InterfaceLanguageCode, e.screenChanges(session))
 
-	_, err = e.bot.Request(editMsg)
-
-	return err
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // CancelEdit отменяет редактирование.
@@ -509,43 +404,77 @@ func (e *IsolatedInterestEditor) CancelEdit(callback *tgbotapi.CallbackQuery, us
 		map[string]interface{}{"userID": user.ID},
 	)
 
-	// Получаем сессию для подсчета изменений
-	session, err := e.GetEditSession(user.ID)
-
-	changesCount := 0
-	if err == nil {
-		changesCount = len(session.Changes)
+	// Получаем сессию для подсчета изменений; без сессии отменять нечего
+	var changes []screens.InterestEditorChange
+	if session, err := e.GetEditSession(user.ID); err == nil {
+		changes = e.screenChanges(session)
 	}
 
 	// Очищаем сессию
 	e.clearEditSession(user.ID)
 
 	// Показываем уведомление об отмене и возвращаемся к профилю
-	text := fmt.Sprintf("❌ Редактирование отменено!\n\n📊 Отменено изменений: %d", changesCount)
+	screen := e.screens.InterestEditorCancelled(user.InterfaceLanguageCode, changes)
+
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
+}
+
+// StartSuggestInterest переводит пользователя в режим ввода предлагаемого интереса.
+// Сессия редактирования сохраняется, чтобы после отправки можно было вернуться к ней.
+func (e *IsolatedInterestEditor) StartSuggestInterest(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	lang := user.InterfaceLanguageCode
 
-	// Добавляем детальную информацию об отмененных изменениях
-	if err == nil && changesCount > 0 {
-		text += "\n\n📝 Отмененные изменения:"
-		text += e.formatChangesSummary(session, user.InterfaceLanguageCode)
+	if err := e.service.UpdateUserState(user.ID, models.StateWaitingInterestSuggestion); err != nil {
+		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "UpdateUserState")
 	}
 
-	// Создаем клавиатуру профиля
-	keyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData("👤 Показать профиль", "profile_show"),
-		),
-	)
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, e.screens.InterestSuggestionPrompt(lang))
+}
 
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
+// CancelSuggestInterest отменяет ввод предложения и возвращает в меню редактора.
+func (e *IsolatedInterestEditor) CancelSuggestInterest(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	if err := e.service.UpdateUserState(user.ID, models.StateActive); err != nil {
+		return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "UpdateUserState")
+	}
 
-	_, err = e.bot.Request(editMsg)
+	session, err := e.GetEditSession(user.ID)
+	if err != nil {
+		// Сессия истекла, пока пользователь думал над предложением, — начинаем новую
+		return e.StartEditSession(callback, user)
+	}
 
-	return err
+	return e.ShowEditMainMenu(callback, user, session)
+}
+
+// HandleSuggestionMessage обрабатывает текст предложенного интереса.
+func (e *IsolatedInterestEditor) HandleSuggestionMessage(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+
+	_, err := e.service.SuggestInterest(user.ID, message.Text, lang)
+	if err != nil {
+		var customErr *errors.CustomError
+		if !stdErrors.As(err, &customErr) || customErr.Type != errors.ErrorTypeValidation {
+			return e.errorHandler.HandleTelegramError(err, message.Chat.ID, int64(user.ID), "SuggestInterest")
+		}
+
+		text := e.service.InterestSuggestionErrorMessage(err, lang)
+
+		if stdErrors.Is(err, errors.ErrTooManyInterestSuggestions) {
+			// Повторять ввод бессмысленно, пока модератор не разберет очередь
+			_ = e.service.UpdateUserState(user.ID, models.StateActive)
+
+			return e.messageFactory.SendScreen(message.Chat.ID, e.screens.InterestSuggestionClosed(lang, text))
+		}
+
+		// Пользователь остается в режиме ввода и может повторить попытку
+		return e.messageFactory.SendText(message.Chat.ID, text)
+	}
+
+	if err := e.service.UpdateUserState(user.ID, models.StateActive); err != nil {
+		return e.errorHandler.HandleTelegramError(err, message.Chat.ID, int64(user.ID), "UpdateUserState")
+	}
+
+	return e.messageFactory.SendScreen(message.Chat.ID, e.screens.InterestSuggestionSent(lang))
 }
 
 // Вспомогательные методы
@@ -647,59 +576,6 @@ func (e *IsolatedInterestEditor) calculateEditStats(session *EditSession) EditSt
 	return stats
 }
 
-func (e *IsolatedInterestEditor) formatEditStats(stats EditStats, lang string) string {
-	return fmt.Sprintf("📊 %s: %d | ⭐ %s: %d | 🔄 %s: %d",
-		e.service.Localizer.Get(lang, "total_interests"),
-		stats.TotalSelected,
-		e.service.Localizer.Get(lang, "primary_interests_label"),
-		stats.PrimaryCount,
-		e.service.Localizer.Get(lang, "changes_count"),
-		stats.ChangesCount)
-}
-
-func (e *IsolatedInterestEditor) formatChangesPreview(session *EditSession, lang string) string {
-	text := e.service.Localizer.Get(lang, "edit_interests_changes_preview") + "\n\n"
-
-	if len(session.Changes) == 0 {
-		text += e.service.Localizer.Get(lang, "no_changes_made")
-
-		return text
-	}
-
-	// Группируем изменения
-	added := []InterestChange{}
-	removed := []InterestChange{}
-
-	for _, change := range session.Changes {
-		switch change.Action {
-		case localization.ActionAdd:
-			added = append(added, change)
-		case localization.ActionRemove:
-			removed = append(removed, change)
-		}
-	}
-
-	if len(added) > 0 {
-		text += "✅ " + e.service.Localizer.Get(lang, "added_interests") + ":\n"
-		for _, change := range added {
-			text += fmt.Sprintf("• %s\n", change.InterestName)
-		}
-
-		text += "\n"
-	}
-
-	if len(removed) > 0 {
-		text += "❌ " + e.service.Localizer.Get(lang, "removed_interests") + ":\n"
-		for _, change := range removed {
-			text += fmt.Sprintf("• %s\n", change.InterestName)
-		}
-
-		text += "\n"
-	}
-
-	return text
-}
-
 func (e *IsolatedInterestEditor) validateSelections(session *EditSession) error {
 	// Разрешаем сохранение даже если нет выбранных интересов
 	// Это позволяет пользователю очистить все свои интересы
@@ -741,73 +617,6 @@ func (e *IsolatedInterestEditor) validateSelections(session *EditSession) error
 	return nil
 }
 
-// formatChangesSummary форматирует краткое описание изменений.
-func (e *IsolatedInterestEditor) formatChangesSummary(session *EditSession, lang string) string {
-	if len(session.Changes) == 0 {
-		return "\n• Изменений не было"
-	}
-
-	var (
-		addedInterests   []string
-		removedInterests []string
-		primarySet       []string
-		primaryUnset     []string
-	)
-
-	// Группируем изменения по типам
-
-	for _, change := range session.Changes {
-		interestName := e.service.Localizer.Get(lang, "interest_"+change.InterestName)
-
-		switch change.Action {
-		case localization.ActionAdd:
-			addedInterests = append(addedInterests, "• "+interestName)
-		case localization.ActionRemove:
-			removedInterests = append(removedInterests, "• "+interestName)
-		case localization.ActionSetPrimary:
-			primarySet = append(primarySet, "• "+interestName)
-		case localization.ActionUnsetPrimary:
-			primaryUnset = append(primaryUnset, "• "+interestName)
-		}
-	}
-
-	var summary strings.Builder
-
-	if len(addedInterests) > 0 {
-		summary.WriteString("\n\n➕ Добавлены:")
-
-		for _, interest := range addedInterests {
-			summary.WriteString("\n" + interest)
-		}
-	}
-
-	if len(removedInterests) > 0 {
-		summary.WriteString("\n\n➖ Удалены:")
-
-		for _, interest := range removedInterests {
-			summary.WriteString("\n" + interest)
-		}
-	}
-
-	if len(primarySet) > 0 {
-		summary.WriteString("\n\n⭐ Сделаны основными:")
-
-		for _, interest := range primarySet {
-			summary.WriteString("\n" + interest)
-		}
-	}
-
-	if len(primaryUnset) > 0 {
-		summary.WriteString("\n\n☐ Убраны из основных:")
-
-		for _, interest := range primaryUnset {
-			summary.WriteString("\n" + interest)
-		}
-	}
-
-	return summary.String()
-}
-
 // TogglePrimaryInterest переключает статус основного интереса.
 func (e *IsolatedInterestEditor) TogglePrimaryInterest(callback *tgbotapi.CallbackQuery, user *models.User, interestID int) error {
 	session, err := e.GetEditSession(user.ID)
@@ -829,44 +638,26 @@ func (e *IsolatedInterestEditor) TogglePrimaryInterest(callback *tgbotapi.Callba
 
 			// Если пытаемся сделать основным, проверяем максимум
 			if !session.CurrentSelections[i].IsPrimary {
-				// Получаем общее количество интересов в системе
-				allInterests, err := e.interestService.GetAllInterests()
+				recommendedPrimary, err := e.recommendedPrimaryCount()
 				if err != nil {
 					return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetAllInterests")
 				}
 
-				// Вычисляем рекомендуемое количество основных интересов
-				config := e.service.GetConfig()
-				totalInterestsInSystem := len(allInterests)
-				recommendedPrimary := int(float64(totalInterestsInSystem) * config.PrimaryPercentage)
-
-				// Ограничиваем минимумом и максимумом
-				if recommendedPrimary < config.MinPrimaryInterests {
-					recommendedPrimary = config.MinPrimaryInterests
+				if currentPrimaryCount >= recommendedPrimary {
+					// Показываем предупреждение о достижении максимума
+					return e.showPrimaryRefusal(callback, user, session, &core.PrimaryPolicyViolation{
+						Rule:  core.PrimaryPolicyRuleTotal,
+						Limit: recommendedPrimary,
+					})
 				}
 
-				if recommendedPrimary > config.MaxPrimaryInterests {
-					recommendedPrimary = config.MaxPrimaryInterests
-				}
+				// Проверяем лимит категории из interests.json
+				if err := e.interestService.CheckPrimaryMarkInSelections(session.CurrentSelections, interestID); err != nil {
+					if e.service.PrimaryPolicyErrorMessage(err, user.InterfaceLanguageCode) == "" {
+						return e.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "CheckPrimaryMark")
+					}
 
-				if currentPrimaryCount >= recommendedPrimary {
-					// Показываем предупреждение о достижении максимума
-					text := fmt.Sprintf("❌ Максимальное количество основных интересов (%d) уже выбрано!\n\n%s (%d из %d выбрано)",
-						recommendedPrimary,
-						e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_primary_description"),
-						currentPrimaryCount,
-						recommendedPrimary)
-
-					keyboard := e.createEditPrimaryInterestsKeyboard(session.CurrentSelections, user.InterfaceLanguageCode)
-					editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-						callback.Message.Chat.ID,
-						callback.Message.MessageID,
-						text,
-						keyboard,
-					)
-					_, err = e.bot.Request(editMsg)
-
-					return err
+					return e.showPrimaryRefusal(callback, user, session, err)
 				}
 			}
 
@@ -898,30 +689,18 @@ func (e *IsolatedInterestEditor) TogglePrimaryInterest(callback *tgbotapi.Callba
 	e.updateSession(session)
 
 	// Показываем обновленную клавиатуру основных интересов
-	return e.showEditPrimaryInterests(callback, user, session)
+	return e.ShowEditPrimaryInterests(callback, user, session)
 }
 
-// showEditPrimaryInterests показывает интерфейс редактирования основных интересов.
-func (e *IsolatedInterestEditor) showEditPrimaryInterests(callback *tgbotapi.CallbackQuery, user *models.User, session *EditSession) error {
-	// Создаем текст с хлебными крошками
-	breadcrumb := e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_breadcrumb_primary")
-	text := fmt.Sprintf("%s\n\n%s",
-		breadcrumb,
-		e.service.Localizer.Get(user.InterfaceLanguageCode, "edit_interests_primary_description"))
-
-	// Создаем клавиатуру основных интересов
-	keyboard := e.createEditPrimaryInterestsKeyboard(session.CurrentSelections, user.InterfaceLanguageCode)
+// showPrimaryRefusal объясняет, почему интерес нельзя отметить основным, и оставляет выбор основных интересов.
+func (e *IsolatedInterestEditor) showPrimaryRefusal(
+	callback *tgbotapi.CallbackQuery, user *models.User, session *EditSession, violation error,
+) error {
+	lang := user.InterfaceLanguageCode
+	interests, primary := e.primaryInterests(session.CurrentSelections)
+	screen := e.screens.InterestEditorPrimaryRefusal(lang, e.service.PrimaryPolicyErrorMessage(violation, lang), interests, primary)
 
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
-
-	_, err := e.bot.Request(editMsg)
-
-	return err
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // MassSelectCategory выбирает все интересы в категории.
@@ -1064,65 +843,9 @@ func (e *IsolatedInterestEditor) UndoLastChange(callback *tgbotapi.CallbackQuery
 
 // ShowEditStatistics показывает статистику редактирования.
 func (e *IsolatedInterestEditor) ShowEditStatistics(callback *tgbotapi.CallbackQuery, user *models.User, session *EditSession) error {
-	stats := e.calculateEditStats(session)
-
-	// Создаем детальную статистику
-	text := e.formatDetailedStatistics(stats, session, user.InterfaceLanguageCode)
-
-	// Создаем клавиатуру для статистики
-	keyboard := e.createStatisticsKeyboard(user.InterfaceLanguageCode)
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
+	screen := e.screens.InterestEditorStatistics(
+		user.InterfaceLanguageCode, e.screenStats(e.calculateEditStats(session)), time.Since(session.SessionStart),
 	)
 
-	_, err := e.bot.Request(editMsg)
-
-	return err
-}
-
-// formatDetailedStatistics форматирует детальную статистику.
-func (e *IsolatedInterestEditor) formatDetailedStatistics(stats EditStats, session *EditSession, lang string) string {
-	text := e.service.Localizer.Get(lang, "edit_interests_detailed_statistics") + "\n\n"
-
-	// Общая статистика
-	text += fmt.Sprintf("📊 %s: %d\n", e.service.Localizer.Get(lang, "total_interests"), stats.TotalSelected)
-	text += fmt.Sprintf("⭐ %s: %d\n", e.service.Localizer.Get(lang, "primary_interests_label"), stats.PrimaryCount)
-	text += fmt.Sprintf("🔄 %s: %d\n", e.service.Localizer.Get(lang, "changes_count"), stats.ChangesCount)
-	text += fmt.Sprintf("⏱️ %s: %s\n\n", e.service.Localizer.Get(lang, "session_duration"),
-		time.Since(session.SessionStart).Round(time.Minute))
-
-	// Статистика по категориям
-	if len(stats.CategoryCounts) > 0 {
-		text += e.service.Localizer.Get(lang, "category_statistics") + ":\n"
-		for category, count := range stats.CategoryCounts {
-			categoryName := e.service.Localizer.Get(lang, "category_"+category)
-			text += fmt.Sprintf("• %s: %d\n", categoryName, count)
-		}
-	}
-
-	return text
-}
-
-// createStatisticsKeyboard создает клавиатуру для статистики.
-func (e *IsolatedInterestEditor) createStatisticsKeyboard(interfaceLang string) tgbotapi.InlineKeyboardMarkup {
-	var buttonRows [][]tgbotapi.InlineKeyboardButton
-
-	// Навигация
-	navRow := []tgbotapi.InlineKeyboardButton{
-		tgbotapi.NewInlineKeyboardButtonData(
-			"🏠 "+e.service.Localizer.Get(interfaceLang, "back_to_main_menu"),
-			"isolated_main_menu",
-		),
-		tgbotapi.NewInlineKeyboardButtonData(
-			"👁️ "+e.service.Localizer.Get(interfaceLang, "preview_changes"),
-			"isolated_preview_changes",
-		),
-	}
-	buttonRows = append(buttonRows, navRow)
-
-	return tgbotapi.NewInlineKeyboardMarkup(buttonRows...)
+	return e.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
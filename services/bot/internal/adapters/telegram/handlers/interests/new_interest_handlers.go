@@ -3,8 +3,9 @@ package interests
 import (
 	"errors"
 	"math"
+	"slices"
+	"sort"
 	"strconv"
-	"strings"
 
 	"language-exchange-bot/internal/core"
 	"language-exchange-bot/internal/models"
@@ -86,27 +87,12 @@ func (h *NewInterestHandlerImpl) HandleInterestCategorySelection(callback *tgbot
 		selectedMap[selection.InterestID] = true
 	}
 
-	// Создаем клавиатуру для интересов в категории
-	keyboard := h.base.KeyboardBuilder.CreateCategoryInterestsKeyboard(
-		interests,
-		selectedMap,
-		selectedCategory.KeyName,
-		user.InterfaceLanguageCode,
-	)
-
-	// Создаем текст сообщения
-	categoryName := h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "category_"+categoryKey)
-	messageText := categoryName + " - " + h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_interests")
-
 	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	err = h.base.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		messageText,
-		keyboard,
+		h.base.Screens.CategoryInterests(user.InterfaceLanguageCode, selectedCategory.KeyName, interests, selectedMap),
 	)
-
-	_, err = h.base.Bot.Request(editMsg)
 	if err != nil {
 		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "EditMessage")
 	}
@@ -200,6 +186,11 @@ func (h *NewInterestHandlerImpl) HandlePrimaryInterestSelection(callback *tgbota
 	}
 
 	if err != nil {
+		// Отказ по политике основных интересов объясняем пользователю
+		if text := h.base.Service.PrimaryPolicyErrorMessage(err, user.InterfaceLanguageCode); text != "" {
+			return h.base.MessageFactory.SendText(callback.Message.Chat.ID, text)
+		}
+
 		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "TogglePrimaryInterest")
 	}
 
@@ -217,47 +208,18 @@ func (h *NewInterestHandlerImpl) HandleInterestsContinue(callback *tgbotapi.Call
 
 	// Проверяем, выбраны ли интересы
 	if len(userSelections) == 0 {
-		warningMsg := "❗ " + h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_at_least_one_interest")
-		if warningMsg == "choose_at_least_one_interest" {
-			warningMsg = "❗ Пожалуйста, выберите хотя бы один интерес"
-		}
-
 		// Показываем предупреждение и возвращаем к категориям
-		keyboard := h.base.KeyboardBuilder.CreateInterestCategoriesKeyboard(user.InterfaceLanguageCode)
-		editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+		return h.base.MessageFactory.EditScreen(
 			callback.Message.Chat.ID,
 			callback.Message.MessageID,
-			warningMsg+"\n\n"+h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_interests"),
-			keyboard,
+			h.base.Screens.InterestCategoriesRequired(user),
 		)
-		_, err = h.base.Bot.Request(editMsg)
-
-		return err
 	}
 
-	// Получаем конфигурацию лимитов
-	limits, err := h.interestService.GetInterestLimitsConfig()
+	// Рекомендуемое количество основных интересов
+	recommendedPrimary, err := recommendedPrimaryInterests(h.interestService)
 	if err != nil {
-		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetInterestLimitsConfig")
-	}
-
-	// Получаем общее количество интересов в системе
-	allInterests, err := h.interestService.GetAllInterests()
-	if err != nil {
-		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetAllInterests")
-	}
-
-	// Вычисляем рекомендуемое количество основных интересов
-	totalInterestsInSystem := len(allInterests)
-	recommendedPrimary := int(math.Ceil(float64(totalInterestsInSystem) * limits.PrimaryPercentage))
-
-	// Ограничиваем минимумом и максимумом
-	if recommendedPrimary < limits.MinPrimaryInterests {
-		recommendedPrimary = limits.MinPrimaryInterests
-	}
-
-	if recommendedPrimary > limits.MaxPrimaryInterests {
-		recommendedPrimary = limits.MaxPrimaryInterests
+		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "RecommendedPrimaryInterests")
 	}
 
 	// Если выбранных интересов меньше или равно максимальному количеству основных,
@@ -267,6 +229,13 @@ func (h *NewInterestHandlerImpl) HandleInterestsContinue(callback *tgbotapi.Call
 		for _, selection := range userSelections {
 			if !selection.IsPrimary {
 				err = h.interestService.SetPrimaryInterest(user.ID, selection.InterestID, true)
+
+				// Интересы сверх лимита категории остаются дополнительными
+				var violation *core.PrimaryPolicyViolation
+				if errors.As(err, &violation) {
+					continue
+				}
+
 				if err != nil {
 					return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "SetPrimaryInterest")
 				}
@@ -311,21 +280,8 @@ func (h *NewInterestHandlerImpl) HandlePrimaryInterestsContinue(callback *tgbota
 
 	// Проверяем минимальное количество основных интересов
 	if primaryCount < limits.MinPrimaryInterests {
-		warningMsg := h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_at_least_primary_interests")
-		if warningMsg == "choose_at_least_primary_interests" {
-			warningMsg = "❗ Пожалуйста, выберите минимум " + strconv.Itoa(limits.MinPrimaryInterests) + " основных интереса"
-		}
-
 		// Показываем предупреждение
-		editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-			callback.Message.Chat.ID,
-			callback.Message.MessageID,
-			warningMsg+"\n\n"+h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests"),
-			h.base.KeyboardBuilder.CreatePrimaryInterestsKeyboard(userSelections, user.InterfaceLanguageCode),
-		)
-		_, err = h.base.Bot.Request(editMsg)
-
-		return err
+		return h.showPrimaryInterests(callback, user, h.base.Screens.PrimaryInterestsMinimum(user.InterfaceLanguageCode, limits.MinPrimaryInterests))
 	}
 
 	// Завершаем настройку профиля
@@ -334,19 +290,11 @@ func (h *NewInterestHandlerImpl) HandlePrimaryInterestsContinue(callback *tgbota
 
 // HandleBackToCategories возвращает к выбору категорий.
 func (h *NewInterestHandlerImpl) HandleBackToCategories(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	keyboard := h.base.KeyboardBuilder.CreateInterestCategoriesKeyboard(user.InterfaceLanguageCode)
-	messageText := h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_interests")
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	return h.base.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		messageText,
-		keyboard,
+		h.base.Screens.InterestCategories(user, ""),
 	)
-
-	_, err := h.base.Bot.Request(editMsg)
-
-	return err
 }
 
 // HandleBackToInterests возвращает к выбору интересов.
@@ -357,215 +305,40 @@ func (h *NewInterestHandlerImpl) HandleBackToInterests(callback *tgbotapi.Callba
 
 // showPrimaryInterestsSelection показывает интерфейс выбора основных интересов.
 func (h *NewInterestHandlerImpl) showPrimaryInterestsSelection(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	return h.showPrimaryInterests(callback, user, "")
+}
+
+// showPrimaryInterests показывает выбор основных интересов; warning, если не пуст, заменяет подсказку.
+func (h *NewInterestHandlerImpl) showPrimaryInterests(callback *tgbotapi.CallbackQuery, user *models.User, warning string) error {
 	// Получаем выборы пользователя
 	userSelections, err := h.interestService.GetUserInterestSelections(user.ID)
 	if err != nil {
 		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetUserInterestSelections")
 	}
 
-	// Создаем клавиатуру для выбора основных интересов
-	keyboard := h.base.KeyboardBuilder.CreatePrimaryInterestsKeyboard(userSelections, user.InterfaceLanguageCode)
-
 	// Получаем рекомендуемое количество основных интересов
-	limits, err := h.interestService.GetInterestLimitsConfig()
+	recommendedPrimary, err := recommendedPrimaryInterests(h.interestService)
 	if err != nil {
-		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetInterestLimitsConfig")
-	}
-
-	// Получаем общее количество интересов в системе
-	allInterests, err := h.interestService.GetAllInterests()
-	if err != nil {
-		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetAllInterests")
-	}
-
-	// Вычисляем рекомендуемое количество основных интересов от общего количества интересов в системе
-	totalInterestsInSystem := len(allInterests)
-	recommendedPrimary := int(math.Ceil(float64(totalInterestsInSystem) * limits.PrimaryPercentage))
-
-	// Ограничиваем минимумом и максимумом
-	if recommendedPrimary < limits.MinPrimaryInterests {
-		recommendedPrimary = limits.MinPrimaryInterests
+		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "RecommendedPrimaryInterests")
 	}
 
-	if recommendedPrimary > limits.MaxPrimaryInterests {
-		recommendedPrimary = limits.MaxPrimaryInterests
-	}
-
-	// Подсчитываем уже выбранные основные интересы
-	selectedPrimaryCount := 0
-
-	for _, selection := range userSelections {
-		if selection.IsPrimary {
-			selectedPrimaryCount++
-		}
-	}
-
-	// Создаем текст сообщения с динамическим количеством
-	var messageText string
-	if selectedPrimaryCount == 0 {
-		messageText = h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests_dynamic")
-		messageText = strings.ReplaceAll(messageText, "{max}", strconv.Itoa(recommendedPrimary))
-	} else {
-		remaining := recommendedPrimary - selectedPrimaryCount
-		if remaining > 0 {
-			messageText = h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests_remaining")
-			messageText = strings.ReplaceAll(messageText, "{remaining}", strconv.Itoa(remaining))
-			messageText = strings.ReplaceAll(messageText, "{max}", strconv.Itoa(recommendedPrimary))
-		} else {
-			messageText = h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "max_primary_interests_reached")
-			if messageText == "max_primary_interests_reached" {
-				messageText = "✅ Максимальное количество основных интересов выбрано!"
-			}
-		}
-	}
+	interests, primary := selectedInterests(h.interestService, userSelections)
 
-	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	return h.base.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		messageText,
-		keyboard,
+		h.base.Screens.PrimaryInterests(user.InterfaceLanguageCode, interests, primary, recommendedPrimary, warning),
 	)
-
-	_, err = h.base.Bot.Request(editMsg)
-
-	return err
 }
 
 // updateCategoryInterestsKeyboard обновляет клавиатуру интересов в категории.
 func (h *NewInterestHandlerImpl) updateCategoryInterestsKeyboard(callback *tgbotapi.CallbackQuery, user *models.User, categoryKey string) error {
-	// Получаем категории
-	categories, err := h.interestService.GetInterestCategories()
-	if err != nil {
-		return err
-	}
-
-	// Находим категорию
-	var selectedCategory *models.InterestCategory
-
-	for _, category := range categories {
-		if category.KeyName == categoryKey {
-			selectedCategory = &category
-
-			break
-		}
-	}
-
-	if selectedCategory == nil {
-		return err
-	}
-
-	// Получаем интересы в категории
-	interests, err := h.interestService.GetInterestsByCategory(selectedCategory.ID)
-	if err != nil {
-		return err
-	}
-
-	// Получаем выборы пользователя
-	userSelections, err := h.interestService.GetUserInterestSelections(user.ID)
-	if err != nil {
-		return err
-	}
-
-	// Создаем карту выбранных интересов
-	selectedMap := make(map[int]bool)
-	for _, selection := range userSelections {
-		selectedMap[selection.InterestID] = true
-	}
-
-	// Создаем клавиатуру
-	keyboard := h.base.KeyboardBuilder.CreateCategoryInterestsKeyboard(interests, selectedMap, categoryKey, user.InterfaceLanguageCode)
-
-	// Обновляем сообщение
-	categoryName := h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "category_"+categoryKey)
-	messageText := categoryName + " - " + h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_interests")
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		messageText,
-		keyboard,
-	)
-
-	_, err = h.base.Bot.Request(editMsg)
-
-	return err
+	return h.HandleInterestCategorySelection(callback, user, categoryKey)
 }
 
 // updatePrimaryInterestsKeyboard обновляет клавиатуру выбора основных интересов.
 func (h *NewInterestHandlerImpl) updatePrimaryInterestsKeyboard(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	// Получаем выборы пользователя
-	userSelections, err := h.interestService.GetUserInterestSelections(user.ID)
-	if err != nil {
-		return err
-	}
-
-	// Создаем клавиатуру
-	keyboard := h.base.KeyboardBuilder.CreatePrimaryInterestsKeyboard(userSelections, user.InterfaceLanguageCode)
-
-	// Получаем рекомендуемое количество основных интересов
-	limits, err := h.interestService.GetInterestLimitsConfig()
-	if err != nil {
-		return err
-	}
-
-	// Получаем общее количество интересов в системе
-	allInterests, err := h.interestService.GetAllInterests()
-	if err != nil {
-		return err
-	}
-
-	// Вычисляем рекомендуемое количество основных интересов от общего количества интересов в системе
-	totalInterestsInSystem := len(allInterests)
-	recommendedPrimary := int(math.Ceil(float64(totalInterestsInSystem) * limits.PrimaryPercentage))
-
-	// Ограничиваем минимумом и максимумом
-	if recommendedPrimary < limits.MinPrimaryInterests {
-		recommendedPrimary = limits.MinPrimaryInterests
-	}
-
-	if recommendedPrimary > limits.MaxPrimaryInterests {
-		recommendedPrimary = limits.MaxPrimaryInterests
-	}
-
-	// Подсчитываем уже выбранные основные интересы
-	selectedPrimaryCount := 0
-
-	for _, selection := range userSelections {
-		if selection.IsPrimary {
-			selectedPrimaryCount++
-		}
-	}
-
-	// Создаем текст сообщения с динамическим количеством
-	var messageText string
-	if selectedPrimaryCount == 0 {
-		messageText = h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests_dynamic")
-		messageText = strings.ReplaceAll(messageText, "{max}", strconv.Itoa(recommendedPrimary))
-	} else {
-		remaining := recommendedPrimary - selectedPrimaryCount
-		if remaining > 0 {
-			messageText = h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_primary_interests_remaining")
-			messageText = strings.ReplaceAll(messageText, "{remaining}", strconv.Itoa(remaining))
-			messageText = strings.ReplaceAll(messageText, "{max}", strconv.Itoa(recommendedPrimary))
-		} else {
-			messageText = h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "max_primary_interests_reached")
-			if messageText == "max_primary_interests_reached" {
-				messageText = "✅ Максимальное количество основных интересов выбрано!"
-			}
-		}
-	}
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		messageText,
-		keyboard,
-	)
-
-	_, err = h.base.Bot.Request(editMsg)
-
-	return err
+	return h.showPrimaryInterests(callback, user, "")
 }
 
 // completeProfileSetup завершает настройку профиля.
@@ -584,63 +357,12 @@ func (h *NewInterestHandlerImpl) completeProfileSetup(callback *tgbotapi.Callbac
 		return h.base.ErrorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetUserInterestSummary")
 	}
 
-	// Создаем текст с основными и дополнительными интересами
-	var primaryText, additionalText strings.Builder
-
-	if len(summary.PrimaryInterests) > 0 {
-		primaryText.WriteString(h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "primary_interests_label") + " ")
-
-		for i, interest := range summary.PrimaryInterests {
-			if i > 0 {
-				primaryText.WriteString(", ")
-			}
-
-			interestName := h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "interest_"+interest.KeyName)
-			primaryText.WriteString(interestName)
-		}
-
-		primaryText.WriteString("\n")
-	}
-
-	if len(summary.AdditionalInterests) > 0 {
-		additionalText.WriteString(h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "additional_interests_label") + " ")
-
-		for i, interest := range summary.AdditionalInterests {
-			if i > 0 {
-				additionalText.WriteString(", ")
-			}
-
-			interestName := h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "interest_"+interest.KeyName)
-			additionalText.WriteString(interestName)
-		}
-
-		additionalText.WriteString("\n")
-	}
-
-	// Создаем итоговое сообщение
-	completionMsg := h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "interests_selection_complete")
-	feedbackSuggestion := h.base.Service.Localizer.Get(user.InterfaceLanguageCode, "interests_feedback_suggestion")
-
-	fullMessage := completionMsg + "\n\n" + primaryText.String() + additionalText.String() + "\n" + feedbackSuggestion
-
 	// Показываем сообщение о завершении интересов
-	completionKeyboard := tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			h.base.KeyboardBuilder.CreateContinueButton(
-				user.InterfaceLanguageCode,
-				"continue_to_availability",
-			),
-		),
-	)
-
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	err = h.base.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		fullMessage,
-		completionKeyboard,
+		h.base.Screens.InterestsCompleted(user.InterfaceLanguageCode, summary),
 	)
-
-	_, err = h.base.Bot.Request(editMsg)
 	if err != nil {
 		return err
 	}
@@ -650,6 +372,48 @@ func (h *NewInterestHandlerImpl) completeProfileSetup(callback *tgbotapi.Callbac
 	return nil
 }
 
+// recommendedPrimaryInterests возвращает рекомендуемое количество основных интересов:
+// доля от всех интересов в системе в пределах лимитов.
+func recommendedPrimaryInterests(interestService *core.InterestService) (int, error) {
+	limits, err := interestService.GetInterestLimitsConfig()
+	if err != nil {
+		return 0, err
+	}
+
+	allInterests, err := interestService.GetAllInterests()
+	if err != nil {
+		return 0, err
+	}
+
+	recommended := int(math.Ceil(float64(len(allInterests)) * limits.PrimaryPercentage))
+
+	return min(max(recommended, limits.MinPrimaryInterests), limits.MaxPrimaryInterests), nil
+}
+
+// selectedInterests возвращает выбранные интересы в порядке выбора и отметки основных.
+// Интересы, которых уже нет в справочнике, пропускаются.
+func selectedInterests(interestService *core.InterestService, selections []models.InterestSelection) ([]models.Interest, map[int]bool) {
+	sorted := slices.Clone(selections)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].SelectionOrder < sorted[j].SelectionOrder
+	})
+
+	interests := make([]models.Interest, 0, len(sorted))
+	primary := make(map[int]bool, len(sorted))
+
+	for _, selection := range sorted {
+		interest, err := interestService.GetInterestByID(selection.InterestID)
+		if err != nil {
+			continue
+		}
+
+		interests = append(interests, *interest)
+		primary[interest.ID] = selection.IsPrimary
+	}
+
+	return interests, primary
+}
+
 // updateProfileCompletionLevel обновляет уровень завершения профиля.
 //
 //nolint:unused
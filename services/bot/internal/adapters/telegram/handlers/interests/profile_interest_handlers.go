@@ -1,12 +1,11 @@
 package interests
 
 import (
-	"fmt"
 	"strconv"
 
+	"language-exchange-bot/internal/adapters/screens"
 	"language-exchange-bot/internal/core"
 	"language-exchange-bot/internal/errors"
-	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/models"
 
 	"language-exchange-bot/internal/adapters/telegram/handlers/base"
@@ -21,6 +20,8 @@ type ProfileInterestHandler struct {
 	interestService *core.InterestService
 	bot             *tgbotapi.BotAPI
 	keyboardBuilder *base.KeyboardBuilder
+	messageFactory  *base.MessageFactory
+	screens         *screens.Builder
 	errorHandler    *errors.ErrorHandler
 }
 
@@ -37,6 +38,8 @@ func NewProfileInterestHandler(
 		interestService: interestService,
 		bot:             bot,
 		keyboardBuilder: keyboardBuilder,
+		messageFactory:  base.NewMessageFactory(bot, errorHandler, service.LoggingService),
+		screens:         screens.NewBuilder(service),
 		errorHandler:    errorHandler,
 	}
 }
@@ -77,38 +80,10 @@ func (pih *ProfileInterestHandler) HandleEditInterestsFromProfile(callback *tgbo
 		map[string]interface{}{"userID": user.ID, "categoriesCount": len(categories)},
 	)
 
-	// Создаем клавиатуру с категориями для редактирования
-	keyboard := pih.keyboardBuilder.CreateEditInterestCategoriesKeyboard(user.InterfaceLanguageCode)
-	pih.service.LoggingService.Telegram().DebugWithContext(
-		"Created keyboard for editing interests",
-		base.GenerateRequestID("HandleEditInterestsFromProfile"),
-		int64(user.ID),
-		callback.Message.Chat.ID,
-		"HandleEditInterestsFromProfile",
-		map[string]interface{}{"userID": user.ID, "keyboardType": "edit_interest_categories"},
-	)
+	// Показываем категории для редактирования
+	screen := pih.screens.ProfileInterestCategories(user.InterfaceLanguageCode)
 
-	// Создаем текст с инструкциями
-	text := pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleEditInterestsFromProfile) + "\n\n" +
-		pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleChooseInterestCategory)
-	pih.service.LoggingService.Telegram().DebugWithContext(
-		"Created text for editing interests",
-		base.GenerateRequestID("HandleEditInterestsFromProfile"),
-		int64(user.ID),
-		callback.Message.Chat.ID,
-		"HandleEditInterestsFromProfile",
-		map[string]interface{}{"userID": user.ID, "textLength": len(text)},
-	)
-
-	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
-
-	_, err = pih.bot.Request(editMsg)
+	err = pih.messageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 	if err != nil {
 		pih.service.LoggingService.Telegram().ErrorWithContext(
 			"Failed to send edit message",
@@ -189,22 +164,12 @@ func (pih *ProfileInterestHandler) HandleEditInterestCategoryFromProfile(callbac
 		selectedMap[selection.InterestID] = true
 	}
 
-	// Создаем клавиатуру с интересами для редактирования
-	keyboard := pih.keyboardBuilder.CreateEditCategoryInterestsKeyboard(interests, selectedMap, categoryKey, user.InterfaceLanguageCode)
-
-	// Создаем текст
-	categoryName := pih.service.Localizer.Get(user.InterfaceLanguageCode, "category_"+categoryKey)
-	text := pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleEditInterestsInCategory) + " " + categoryName
-
 	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	err = pih.messageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		text,
-		keyboard,
+		pih.screens.ProfileCategoryInterests(user.InterfaceLanguageCode, categoryKey, interests, selectedMap),
 	)
-
-	_, err = pih.bot.Request(editMsg)
 	if err != nil {
 		return pih.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "EditMessage")
 	}
@@ -270,22 +235,14 @@ func (pih *ProfileInterestHandler) HandleEditPrimaryInterestsFromProfile(callbac
 		return pih.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetUserInterestSelections")
 	}
 
-	// Создаем клавиатуру для выбора основных интересов в режиме редактирования
-	keyboard := pih.keyboardBuilder.CreateEditPrimaryInterestsKeyboard(userSelections, user.InterfaceLanguageCode)
-
-	// Создаем текст
-	text := pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleEditPrimaryInterests) + "\n\n" +
-		pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleChoosePrimaryInterests)
-
 	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	interests, primary := selectedInterests(pih.interestService, userSelections)
+
+	err = pih.messageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		text,
-		keyboard,
+		pih.screens.ProfilePrimaryInterests(user.InterfaceLanguageCode, interests, primary),
 	)
-
-	_, err = pih.bot.Request(editMsg)
 	if err != nil {
 		return pih.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "EditMessage")
 	}
@@ -334,6 +291,11 @@ func (pih *ProfileInterestHandler) HandleEditPrimaryInterestSelectionFromProfile
 		!currentSelection.IsPrimary,
 	)
 	if err != nil {
+		// Отказ по политике основных интересов объясняем пользователю
+		if text := pih.service.PrimaryPolicyErrorMessage(err, user.InterfaceLanguageCode); text != "" {
+			return pih.messageFactory.SendText(callback.Message.Chat.ID, text)
+		}
+
 		return pih.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "TogglePrimaryInterest")
 	}
 
@@ -358,28 +320,12 @@ func (pih *ProfileInterestHandler) HandleSaveInterestEditsFromProfile(callback *
 		return pih.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetUserInterestSummary")
 	}
 
-	// Создаем текст с обновленными интересами
-	text := pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleInterestsUpdatedSuccessfully) + "\n\n" +
-		fmt.Sprintf("%s: %d\n%s: %d\n%s: %d",
-			pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleTotalInterests),
-			summary.TotalInterests,
-			pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocalePrimaryInterestsLabel),
-			len(summary.PrimaryInterests),
-			pih.service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleAdditionalInterestsLabel),
-			len(summary.AdditionalInterests))
-
-	// Создаем клавиатуру для возврата к профилю
-	keyboard := pih.keyboardBuilder.CreateProfileMenuKeyboard(user.InterfaceLanguageCode)
-
-	// Обновляем сообщение
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+	// Обновляем сообщение и возвращаемся к меню профиля
+	err = pih.messageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		text,
-		keyboard,
+		pih.screens.ProfileInterestsSaved(user.InterfaceLanguageCode, summary),
 	)
-
-	_, err = pih.bot.Request(editMsg)
 	if err != nil {
 		return pih.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "EditMessage")
 	}
@@ -427,19 +373,12 @@ func (pih *ProfileInterestHandler) updateCategoryInterestsKeyboardFromProfile(ca
 		selectedMap[selection.InterestID] = true
 	}
 
-	// Создаем обновленную клавиатуру
-	keyboard := pih.keyboardBuilder.CreateCategoryInterestsKeyboard(
-		interests,
-		selectedMap,
-		selectedCategory.KeyName,
-		user.InterfaceLanguageCode,
-	)
-
 	// Обновляем только клавиатуру
-	editMsg := tgbotapi.NewEditMessageReplyMarkup(callback.Message.Chat.ID, callback.Message.MessageID, keyboard)
-	_, err = pih.bot.Request(editMsg)
-
-	return err
+	return pih.messageFactory.EditKeyboard(
+		callback.Message.Chat.ID,
+		callback.Message.MessageID,
+		pih.screens.CategoryInterestsKeyboard(user.InterfaceLanguageCode, interests, selectedMap),
+	)
 }
 
 // updatePrimaryInterestsKeyboardFromProfile обновляет клавиатуру основных интересов.
@@ -450,12 +389,12 @@ func (pih *ProfileInterestHandler) updatePrimaryInterestsKeyboardFromProfile(cal
 		return pih.errorHandler.HandleTelegramError(err, callback.Message.Chat.ID, int64(user.ID), "GetUserInterestSelections")
 	}
 
-	// Создаем обновленную клавиатуру для редактирования
-	keyboard := pih.keyboardBuilder.CreateEditPrimaryInterestsKeyboard(userSelections, user.InterfaceLanguageCode)
-
 	// Обновляем только клавиатуру
-	editMsg := tgbotapi.NewEditMessageReplyMarkup(callback.Message.Chat.ID, callback.Message.MessageID, keyboard)
-	_, err = pih.bot.Request(editMsg)
+	interests, primary := selectedInterests(pih.interestService, userSelections)
 
-	return err
+	return pih.messageFactory.EditKeyboard(
+		callback.Message.Chat.ID,
+		callback.Message.MessageID,
+		pih.screens.ProfilePrimaryInterestsKeyboard(user.InterfaceLanguageCode, interests, primary),
+	)
 }
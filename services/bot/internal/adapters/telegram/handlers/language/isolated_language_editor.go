@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"language-exchange-bot/internal/adapters/screens"
 	"language-exchange-bot/internal/models"
 
 	"language-exchange-bot/internal/adapters/telegram/handlers/base"
@@ -179,56 +180,7 @@ func (e *IsolatedLanguageEditor) buildLanguageSettingsText(user *models.User, se
 
 // createEditMainMenuKeyboard создает клавиатуру главного меню редактирования
 func (e *IsolatedLanguageEditor) createEditMainMenuKeyboard(interfaceLang string, session *LanguageEditSession) tgbotapi.InlineKeyboardMarkup {
-	localizer := e.baseHandler.Service.Localizer
-
-	buttonRows := [][]tgbotapi.InlineKeyboardButton{
-		// Редактирование родного языка
-		{
-			tgbotapi.NewInlineKeyboardButtonData(
-				"🏠 "+localizer.Get(interfaceLang, "edit_native_language"),
-				"isolated_lang_edit_native",
-			),
-		},
-		// Редактирование изучаемого языка
-		{
-			tgbotapi.NewInlineKeyboardButtonData(
-				"📚 "+localizer.Get(interfaceLang, "edit_target_language"),
-				"isolated_lang_edit_target",
-			),
-		},
-		// Редактирование уровня
-		{
-			tgbotapi.NewInlineKeyboardButtonData(
-				"📊 "+localizer.Get(interfaceLang, "edit_language_level"),
-				"isolated_lang_edit_level",
-			),
-		},
-	}
-
-	// Если есть изменения, показываем кнопку предпросмотра
-	if len(session.Changes) > 0 {
-		buttonRows = append(buttonRows, []tgbotapi.InlineKeyboardButton{
-			tgbotapi.NewInlineKeyboardButtonData(
-				"👁️ "+localizer.Get(interfaceLang, "preview_changes"),
-				"isolated_lang_preview",
-			),
-		})
-	}
-
-	// Кнопки управления - используем специфичные для языкового редактора
-	controlRow := []tgbotapi.InlineKeyboardButton{
-		tgbotapi.NewInlineKeyboardButtonData(
-			"💾 "+localizer.Get(interfaceLang, "save_changes"),
-			"isolated_lang_save",
-		),
-		tgbotapi.NewInlineKeyboardButtonData(
-			"❌ "+localizer.Get(interfaceLang, "cancel_edit"),
-			"isolated_lang_cancel",
-		),
-	}
-	buttonRows = append(buttonRows, controlRow)
-
-	return tgbotapi.NewInlineKeyboardMarkup(buttonRows...)
+	return base.RenderKeyboard(e.baseHandler.Screens.LanguageEditorMenuKeyboard(interfaceLang, len(session.Changes) > 0))
 }
 
 // =============================================================================
@@ -262,19 +214,7 @@ func (e *IsolatedLanguageEditor) HandleEditNativeLanguage(callback *tgbotapi.Cal
 
 // createNativeLanguageKeyboard создает клавиатуру выбора родного языка
 func (e *IsolatedLanguageEditor) createNativeLanguageKeyboard(interfaceLang string, session *LanguageEditSession) tgbotapi.InlineKeyboardMarkup {
-	kb := e.baseHandler.KeyboardBuilder
-
-	// Получаем базовую клавиатуру с языками
-	keyboard := kb.CreateLanguageKeyboard(interfaceLang, "isolated_native", "", false)
-
-	// Добавляем кнопки навигации
-	backRow := []tgbotapi.InlineKeyboardButton{
-		kb.CreateBackButton(interfaceLang, "isolated_lang_back_to_menu"),
-	}
-
-	keyboard.InlineKeyboard = append(keyboard.InlineKeyboard, backRow)
-
-	return keyboard
+	return base.RenderKeyboard(e.baseHandler.Screens.LanguageEditorChoiceKeyboard(interfaceLang, screens.LanguageEditorNative, ""))
 }
 
 // HandleNativeLanguageSelection обрабатывает выбор родного языка
@@ -345,11 +285,7 @@ func (e *IsolatedLanguageEditor) HandleEditTargetLanguage(callback *tgbotapi.Cal
 	// Проверяем, что родной язык - русский
 	if session.CurrentNativeLang != "ru" {
 		text := e.baseHandler.Service.Localizer.Get(user.InterfaceLanguageCode, "target_language_locked")
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			[]tgbotapi.InlineKeyboardButton{
-				e.baseHandler.KeyboardBuilder.CreateBackButton(user.InterfaceLanguageCode, "isolated_lang_back_to_menu"),
-			},
-		)
+		keyboard := base.RenderKeyboard(e.baseHandler.Screens.LanguageEditorBackKeyboard(user.InterfaceLanguageCode))
 		return e.baseHandler.MessageFactory.EditWithKeyboard(
 			callback.Message.Chat.ID,
 			callback.Message.MessageID,
@@ -378,19 +314,8 @@ func (e *IsolatedLanguageEditor) HandleEditTargetLanguage(callback *tgbotapi.Cal
 
 // createTargetLanguageKeyboard создает клавиатуру выбора изучаемого языка
 func (e *IsolatedLanguageEditor) createTargetLanguageKeyboard(interfaceLang string, session *LanguageEditSession) tgbotapi.InlineKeyboardMarkup {
-	kb := e.baseHandler.KeyboardBuilder
-
 	// Исключаем родной язык из списка
-	keyboard := kb.CreateLanguageKeyboard(interfaceLang, "isolated_target", session.CurrentNativeLang, false)
-
-	// Добавляем кнопки навигации
-	backRow := []tgbotapi.InlineKeyboardButton{
-		kb.CreateBackButton(interfaceLang, "isolated_lang_back_to_menu"),
-	}
-
-	keyboard.InlineKeyboard = append(keyboard.InlineKeyboard, backRow)
-
-	return keyboard
+	return base.RenderKeyboard(e.baseHandler.Screens.LanguageEditorChoiceKeyboard(interfaceLang, screens.LanguageEditorTarget, session.CurrentNativeLang))
 }
 
 // HandleTargetLanguageSelection обрабатывает выбор изучаемого языка
@@ -465,18 +390,7 @@ func (e *IsolatedLanguageEditor) showLevelSelection(callback *tgbotapi.CallbackQ
 
 // createLevelKeyboard создает клавиатуру выбора уровня владения
 func (e *IsolatedLanguageEditor) createLevelKeyboard(interfaceLang string, session *LanguageEditSession) tgbotapi.InlineKeyboardMarkup {
-	kb := e.baseHandler.KeyboardBuilder
-
-	keyboard := kb.CreateLanguageLevelKeyboardWithPrefix(interfaceLang, session.CurrentTargetLang, "isolated_level_", false)
-
-	// Добавляем кнопки навигации
-	backRow := []tgbotapi.InlineKeyboardButton{
-		kb.CreateBackButton(interfaceLang, "isolated_lang_back_to_menu"),
-	}
-
-	keyboard.InlineKeyboard = append(keyboard.InlineKeyboard, backRow)
-
-	return keyboard
+	return base.RenderKeyboard(e.baseHandler.Screens.LanguageEditorLevelKeyboard(interfaceLang))
 }
 
 // HandleLanguageLevelSelection обрабатывает выбор уровня владения языком
@@ -574,30 +488,7 @@ func (e *IsolatedLanguageEditor) buildChangesPreviewText(user *models.User, sess
 
 // createChangesPreviewKeyboard создает клавиатуру предпросмотра изменений
 func (e *IsolatedLanguageEditor) createChangesPreviewKeyboard(interfaceLang string, session *LanguageEditSession) tgbotapi.InlineKeyboardMarkup {
-	localizer := e.baseHandler.Service.Localizer
-	kb := e.baseHandler.KeyboardBuilder
-
-	mainRow := []tgbotapi.InlineKeyboardButton{
-		tgbotapi.NewInlineKeyboardButtonData(
-			"💾 "+localizer.Get(interfaceLang, "save_changes"),
-			"isolated_lang_save",
-		),
-		tgbotapi.NewInlineKeyboardButtonData(
-			localizer.Get(interfaceLang, "undo_last_change"),
-			"isolated_lang_undo_last",
-		),
-	}
-
-	// Навигация
-	backRow := []tgbotapi.InlineKeyboardButton{
-		kb.CreateBackButton(interfaceLang, "isolated_lang_back_to_menu"),
-		tgbotapi.NewInlineKeyboardButtonData(
-			"❌ "+localizer.Get(interfaceLang, "cancel_edit"),
-			"isolated_lang_cancel",
-		),
-	}
-
-	return tgbotapi.NewInlineKeyboardMarkup(mainRow, backRow)
+	return base.RenderKeyboard(e.baseHandler.Screens.LanguageEditorPreviewKeyboard(interfaceLang))
 }
 
 // =============================================================================
@@ -614,11 +505,7 @@ func (e *IsolatedLanguageEditor) HandleUndoLastChange(callback *tgbotapi.Callbac
 	if len(session.Changes) == 0 {
 		// Нет изменений для отмены
 		text := e.baseHandler.Service.Localizer.Get(user.InterfaceLanguageCode, "no_changes_to_undo")
-		keyboard := tgbotapi.NewInlineKeyboardMarkup(
-			[]tgbotapi.InlineKeyboardButton{
-				e.baseHandler.KeyboardBuilder.CreateBackButton(user.InterfaceLanguageCode, "isolated_lang_back_to_menu"),
-			},
-		)
+		keyboard := base.RenderKeyboard(e.baseHandler.Screens.LanguageEditorBackKeyboard(user.InterfaceLanguageCode))
 		return e.baseHandler.MessageFactory.EditWithKeyboard(
 			callback.Message.Chat.ID,
 			callback.Message.MessageID,
@@ -49,21 +49,7 @@ func (lh *LanguageHandlerImpl) HandleLanguagesContinueFilling(callback *tgbotapi
 	}
 
 	// Предлагаем выбрать уровень владения языком
-	langName := lh.base.Service.Localizer.GetLanguageName(user.TargetLanguageCode, user.InterfaceLanguageCode)
-	title := lh.base.Service.Localizer.GetWithParams(user.InterfaceLanguageCode, "choose_level_title", map[string]string{
-		"language": langName,
-	})
-
-	keyboard := lh.base.KeyboardBuilder.CreateLanguageLevelKeyboardWithPrefix(user.InterfaceLanguageCode, user.TargetLanguageCode, "level_", true)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		title,
-		keyboard,
-	)
-	_, err = lh.base.Bot.Request(editMsg)
-
-	return err
+	return lh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, lh.base.Screens.LanguageLevel(user))
 }
 
 // HandleLanguagesReselect обрабатывает повторный выбор языков.
@@ -87,17 +73,7 @@ func (lh *LanguageHandlerImpl) HandleLanguagesReselect(callback *tgbotapi.Callba
 	}
 
 	// Предлагаем выбрать родной язык снова
-	text := lh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_native_language")
-	keyboard := lh.base.KeyboardBuilder.CreateLanguageKeyboard(user.InterfaceLanguageCode, "native", "", true)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
-	_, err := lh.base.Bot.Request(editMsg)
-
-	return err
+	return lh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, lh.base.Screens.NativeLanguage(user))
 }
 
 // HandleLanguageLevelSelection обрабатывает выбор уровня владения языком.
@@ -128,16 +104,10 @@ func (lh *LanguageHandlerImpl) HandleLanguageLevelSelection(callback *tgbotapi.C
 	}
 
 	// Используем новую систему интересов
-	keyboard := lh.base.KeyboardBuilder.CreateInterestCategoriesKeyboard(user.InterfaceLanguageCode)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		confirmMsg,
-		keyboard,
-	)
-	_, err = lh.base.Bot.Request(editMsg)
+	screen := lh.base.Screens.InterestCategories(user, "")
+	screen.Text = confirmMsg
 
-	return err
+	return lh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // HandleNativeLanguageCallback обрабатывает выбор родного языка.
@@ -173,14 +143,8 @@ func (lh *LanguageHandlerImpl) proceedToNextOnboardingStep(callback *tgbotapi.Ca
 
 // handleRussianNativeLanguage обрабатывает случай, когда русский выбран как родной язык.
 func (lh *LanguageHandlerImpl) handleRussianNativeLanguage(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	// Если выбран русский как родной, предлагаем выбрать изучаемый язык
-	text := lh.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_target_language")
-
-	// Исключаем русский из списка изучаемых языков
-	keyboard := lh.base.KeyboardBuilder.CreateLanguageKeyboard(user.InterfaceLanguageCode, "target", "ru", true)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(callback.Message.Chat.ID, callback.Message.MessageID, text, keyboard)
-
-	_, err := lh.base.Bot.Request(editMsg)
+	// Если выбран русский как родной, предлагаем выбрать изучаемый язык (русский исключен)
+	err := lh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, lh.base.Screens.TargetLanguage(user))
 	if err != nil {
 		return err
 	}
@@ -217,20 +181,10 @@ func (lh *LanguageHandlerImpl) handleNonRussianNativeLanguage(callback *tgbotapi
 	)
 
 	// Предлагаем выбрать уровень владения русским языком
-	langName := lh.base.Service.Localizer.GetLanguageName(user.TargetLanguageCode, user.InterfaceLanguageCode)
-	levelTitle := targetExplanation + "\n\n" + lh.base.Service.Localizer.GetWithParams(user.InterfaceLanguageCode, "choose_level_title", map[string]string{
-		"language": langName,
-	})
-
-	keyboard := lh.base.KeyboardBuilder.CreateLanguageLevelKeyboardWithPrefix(user.InterfaceLanguageCode, user.TargetLanguageCode, "level_", true)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		levelTitle,
-		keyboard,
-	)
+	screen := lh.base.Screens.LanguageLevel(user)
+	screen.Text = targetExplanation + "\n\n" + screen.Text
 
-	_, err = lh.base.Bot.Request(editMsg)
+	err = lh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 	if err != nil {
 		return err
 	}
@@ -267,23 +221,9 @@ func (lh *LanguageHandlerImpl) HandleTargetLanguageCallback(callback *tgbotapi.C
 	}
 
 	user.TargetLanguageCode = langCode
-	langName := lh.base.Service.Localizer.GetLanguageName(langCode, user.InterfaceLanguageCode)
 
 	// Предлагаем выбрать уровень владения языком
-	title := lh.base.Service.Localizer.GetWithParams(user.InterfaceLanguageCode, "choose_level_title", map[string]string{
-		"language": langName,
-	})
-
-	keyboard := lh.base.KeyboardBuilder.CreateLanguageLevelKeyboardWithPrefix(user.InterfaceLanguageCode, langCode, "level_", true)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		title,
-		keyboard,
-	)
-	_, err = lh.base.Bot.Request(editMsg)
-
-	return err
+	return lh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, lh.base.Screens.LanguageLevel(user))
 }
 
 // HandleInterfaceLanguageSelection обрабатывает выбор языка интерфейса.
@@ -304,43 +244,20 @@ func (lh *LanguageHandlerImpl) HandleInterfaceLanguageSelection(callback *tgbota
 	// Обновляем язык интерфейса пользователя и получаем новое сообщение
 	user.InterfaceLanguageCode = langCode
 	langName := lh.base.Service.Localizer.GetLanguageName(langCode, langCode)
-	text := fmt.Sprintf("%s\n\n%s: %s",
-		lh.base.Service.Localizer.Get(langCode, "choose_interface_language"),
+
+	// Редактируем сообщение, сохраняя клавиатуру с языками интерфейса
+	screen := lh.base.Screens.InterfaceLanguage(user)
+	screen.Text = fmt.Sprintf("%s\n\n%s: %s",
+		screen.Text,
 		lh.base.Service.Localizer.Get(langCode, "language_updated"),
 		langName,
 	)
 
-	// Создаем клавиатуру с языками интерфейса (остальные кнопки остаются)
-	keyboard := lh.base.KeyboardBuilder.CreateLanguageKeyboard(langCode, "interface", "", true)
-
-	// Редактируем сообщение, сохраняя клавиатуру
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
-	_, err := lh.base.Bot.Request(editMsg)
-
-	return err
+	return lh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // HandleBackToLanguageLevel возвращает к выбору уровня языка.
 func (lh *LanguageHandlerImpl) HandleBackToLanguageLevel(callback *tgbotapi.CallbackQuery, user *models.User) error {
 	// Предлагаем выбрать уровень владения языком
-	langName := lh.base.Service.Localizer.GetLanguageName(user.TargetLanguageCode, user.InterfaceLanguageCode)
-	title := lh.base.Service.Localizer.GetWithParams(user.InterfaceLanguageCode, "choose_level_title", map[string]string{
-		"language": langName,
-	})
-
-	keyboard := lh.base.KeyboardBuilder.CreateLanguageLevelKeyboardWithPrefix(user.InterfaceLanguageCode, user.TargetLanguageCode, "level_", true)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		title,
-		keyboard,
-	)
-	_, err := lh.base.Bot.Request(editMsg)
-
-	return err
+	return lh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, lh.base.Screens.LanguageLevel(user))
 }
@@ -28,14 +28,7 @@ func NewMenuHandler(baseHandler *base.BaseHandler) *MenuHandler {
 // HandleStartCommand обрабатывает команду /start.
 func (mh *MenuHandler) HandleStartCommand(message *tgbotapi.Message, user *models.User) error {
 	// Всегда показываем главное меню, независимо от состояния профиля
-	welcomeText := mh.base.Service.GetWelcomeMessage(user)
-	menuText := welcomeText + "\n\n" + mh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleMainMenuTitle)
-
-	hasProfile := user.ProfileCompletionLevel > 0
-	keyboard := mh.base.KeyboardBuilder.CreateMainMenuKeyboard(user.InterfaceLanguageCode, hasProfile)
-
-	// Используем MessageFactory для отправки сообщения
-	return mh.base.MessageFactory.SendWithKeyboard(message.Chat.ID, menuText, keyboard)
+	return mh.base.MessageFactory.SendScreen(message.Chat.ID, mh.base.Screens.MainMenu(user))
 }
 
 // HandleStatusCommand обрабатывает команду /status.
@@ -69,44 +62,17 @@ func (mh *MenuHandler) HandleResetCommand(message *tgbotapi.Message, user *model
 
 // HandleLanguageCommand обрабатывает команду /language.
 func (mh *MenuHandler) HandleLanguageCommand(message *tgbotapi.Message, user *models.User) error {
-	text := mh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleChooseInterfaceLanguage)
-	keyboard := mh.base.KeyboardBuilder.CreateLanguageKeyboard(user.InterfaceLanguageCode, "interface", "", true)
-
-	// Используем MessageFactory для отправки сообщения
-	return mh.base.MessageFactory.SendWithKeyboard(message.Chat.ID, text, keyboard)
+	return mh.base.MessageFactory.SendScreen(message.Chat.ID, mh.base.Screens.InterfaceLanguage(user))
 }
 
 // HandleBackToMainMenu возвращает пользователя в главное меню.
 func (mh *MenuHandler) HandleBackToMainMenu(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	welcomeText := mh.base.Service.GetWelcomeMessage(user)
-	menuText := welcomeText + "\n\n" + mh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleMainMenuTitle)
-
-	hasProfile := user.ProfileCompletionLevel > 0
-	keyboard := mh.base.KeyboardBuilder.CreateMainMenuKeyboard(user.InterfaceLanguageCode, hasProfile)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		menuText,
-		keyboard,
-	)
-	_, err := mh.base.Bot.Request(editMsg)
-
-	return err
+	return mh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, mh.base.Screens.MainMenu(user))
 }
 
 // HandleMainChangeLanguage обрабатывает смену языка интерфейса.
 func (mh *MenuHandler) HandleMainChangeLanguage(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	text := mh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleChooseInterfaceLanguage)
-	keyboard := mh.base.KeyboardBuilder.CreateLanguageKeyboard(user.InterfaceLanguageCode, "interface", "", true)
-	editMsg := tgbotapi.NewEditMessageTextAndMarkup(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		keyboard,
-	)
-	_, err := mh.base.Bot.Request(editMsg)
-
-	return err
+	return mh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, mh.base.Screens.InterfaceLanguage(user))
 }
 
 // HandleMainViewProfile обрабатывает просмотр профиля.
@@ -130,26 +96,11 @@ func (mh *MenuHandler) HandleMainViewProfile(callback *tgbotapi.CallbackQuery, u
 	// Проверяем, заполнен ли профиль по уровню завершения профиля
 	if freshUser.ProfileCompletionLevel == 0 {
 		// Профиль не заполнен - показываем информационное сообщение и кнопку настройки
-		text := mh.base.Service.Localizer.Get(freshUser.InterfaceLanguageCode, localization.LocaleEmptyProfileMessage)
-
-		// Создаем клавиатуру с кнопками настройки профиля
-		setupButton := tgbotapi.NewInlineKeyboardButtonData(
-			mh.base.Service.Localizer.Get(freshUser.InterfaceLanguageCode, localization.LocaleSetupProfileButton),
-			"show_profile_setup_features",
-		)
-
-		keyboard := tgbotapi.NewInlineKeyboardMarkup([]tgbotapi.InlineKeyboardButton{setupButton})
-
-		// Редактируем существующее сообщение вместо создания нового
-		editMsg := tgbotapi.NewEditMessageTextAndMarkup(
+		return mh.base.MessageFactory.EditScreen(
 			callback.Message.Chat.ID,
 			callback.Message.MessageID,
-			text,
-			keyboard,
+			mh.base.Screens.EmptyProfile(freshUser.InterfaceLanguageCode),
 		)
-		_, err := mh.base.Bot.Request(editMsg)
-
-		return err
 	}
 
 	// Профиль заполнен - показываем его
@@ -168,50 +119,13 @@ func (mh *MenuHandler) HandleMainFeedback(callback *tgbotapi.CallbackQuery, user
 		log.Printf("Failed to update user state to waiting feedback for user %d: %v", user.ID, err)
 	}
 
-	// Получаем текст обратной связи
-	text := mh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleFeedbackText)
-
-	// Создаем клавиатуру для обратной связи
-	keyboard := mh.createFeedbackKeyboard(user.InterfaceLanguageCode)
-
 	// Редактируем сообщение вместо отправки нового
-	return mh.editMessageTextAndMarkup(callback.Message.Chat.ID, callback.Message.MessageID, text, &keyboard)
+	return mh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, mh.base.Screens.Feedback(user.InterfaceLanguageCode))
 }
 
 // HandleFeedbackHelp обрабатывает помощь по обратной связи.
 func (mh *MenuHandler) HandleFeedbackHelp(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	helpTitle := mh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleFeedbackHelpTitle)
-	helpContent := mh.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleFeedbackHelpContent)
-	helpText := helpTitle + "\n\n" + helpContent
-
-	keyboard := mh.createFeedbackKeyboard(user.InterfaceLanguageCode)
-
-	// Редактируем сообщение с помощью
-	return mh.editMessageTextAndMarkup(callback.Message.Chat.ID, callback.Message.MessageID, helpText, &keyboard)
-}
-
-// createFeedbackKeyboard создает клавиатуру для обратной связи.
-func (mh *MenuHandler) createFeedbackKeyboard(lang string) tgbotapi.InlineKeyboardMarkup {
-	keyboard := [][]tgbotapi.InlineKeyboardButton{
-		{
-			mh.base.KeyboardBuilder.CreateBackToMainButton(lang),
-		},
-		{
-			tgbotapi.NewInlineKeyboardButtonData(mh.base.Service.Localizer.Get(lang, localization.LocaleFeedbackHelp), "feedback_help"),
-		},
-	}
-
-	return tgbotapi.NewInlineKeyboardMarkup(keyboard...)
-}
-
-// editMessageTextAndMarkup редактирует сообщение с клавиатурой.
-func (mh *MenuHandler) editMessageTextAndMarkup(chatID int64, messageID int, text string, keyboard *tgbotapi.InlineKeyboardMarkup) error {
-	// Используем MessageFactory для редактирования сообщения
-	if keyboard != nil {
-		return mh.base.MessageFactory.EditWithKeyboard(chatID, messageID, text, keyboard)
-	}
-
-	return mh.base.MessageFactory.EditText(chatID, messageID, text)
+	return mh.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, mh.base.Screens.FeedbackHelp(user.InterfaceLanguageCode))
 }
 
 // ProfileHandler интерфейс для работы с профилем.
@@ -0,0 +1,49 @@
+package profile
+
+import (
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// HandleDeleteMeCommand обрабатывает команду /deleteme: предупреждает, что удалится,
+// и просит подтвердить удаление аккаунта.
+func (ph *ProfileHandlerImpl) HandleDeleteMeCommand(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+	localizer := ph.base.Service.Localizer
+
+	keyboard := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(localizer.Get(lang, localization.LocaleDeleteMeConfirmButton), localization.CallbackDeleteAccountConfirm),
+		),
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(localizer.Get(lang, localization.LocaleDeleteMeCancelButton), localization.CallbackDeleteAccountCancel),
+		),
+	)
+
+	return ph.base.MessageFactory.SendWithKeyboard(message.Chat.ID, localizer.Get(lang, localization.LocaleDeleteMeWarning), keyboard)
+}
+
+// HandleDeleteMeConfirm удаляет аккаунт после подтверждения; текущих собеседников уведомляет сервис.
+func (ph *ProfileHandlerImpl) HandleDeleteMeConfirm(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+	chatID := callback.Message.Chat.ID
+
+	if _, err := ph.base.Service.DeleteUserAccount(user); err != nil {
+		_ = ph.base.ErrorHandler.HandleDatabaseError(err, user.TelegramID, chatID, "DeleteUserAccount")
+
+		return ph.base.MessageFactory.EditText(chatID, callback.Message.MessageID, ph.base.Service.Localizer.Get(lang, localization.LocaleDeleteMeError))
+	}
+
+	return ph.base.MessageFactory.EditText(chatID, callback.Message.MessageID, ph.base.Service.Localizer.Get(lang, localization.LocaleDeleteMeDone))
+}
+
+// HandleDeleteMeCancel отменяет удаление аккаунта.
+func (ph *ProfileHandlerImpl) HandleDeleteMeCancel(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	return ph.base.MessageFactory.EditText(
+		callback.Message.Chat.ID,
+		callback.Message.MessageID,
+		ph.base.Service.Localizer.Get(user.InterfaceLanguageCode, localization.LocaleDeleteMeCancelled),
+	)
+}
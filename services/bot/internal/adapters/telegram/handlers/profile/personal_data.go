@@ -0,0 +1,39 @@
+package profile
+
+import (
+	"bytes"
+	"strconv"
+	"time"
+
+	"language-exchange-bot/internal/export"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// HandleMyDataCommand обрабатывает команду /mydata: отправляет пользователю JSON-файл
+// со всеми данными, которые бот о нем хранит.
+func (ph *ProfileHandlerImpl) HandleMyDataCommand(message *tgbotapi.Message, user *models.User) error {
+	lang := user.InterfaceLanguageCode
+
+	data, err := ph.base.Service.ExportUserData(user)
+	if err != nil {
+		_ = ph.base.ErrorHandler.HandleDatabaseError(err, user.TelegramID, message.Chat.ID, "ExportUserData")
+
+		return ph.base.MessageFactory.SendText(message.Chat.ID, ph.base.Service.Localizer.Get(lang, localization.LocaleMyDataError))
+	}
+
+	var buf bytes.Buffer
+	if err := export.WriteUserData(&buf, data); err != nil {
+		_ = ph.base.ErrorHandler.HandleTelegramError(err, message.Chat.ID, user.TelegramID, "WriteUserData")
+
+		return ph.base.MessageFactory.SendText(message.Chat.ID, ph.base.Service.Localizer.Get(lang, localization.LocaleMyDataError))
+	}
+
+	caption := ph.base.Service.Localizer.GetWithParams(lang, localization.LocaleMyDataCaption, map[string]string{
+		"version": strconv.Itoa(data.SchemaVersion),
+	})
+
+	return ph.base.MessageFactory.SendDocument(message.Chat.ID, export.UserDataFileName(user.TelegramID, time.Now()), buf.Bytes(), caption)
+}
@@ -5,6 +5,7 @@ import (
 
 	"language-exchange-bot/internal/adapters/telegram/handlers/base"
 	"language-exchange-bot/internal/adapters/telegram/handlers/language"
+	"language-exchange-bot/internal/audit"
 	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/models"
 
@@ -27,36 +28,23 @@ func NewProfileHandler(baseHandler *base.BaseHandler) *ProfileHandlerImpl {
 
 // HandleProfileCommand обрабатывает команду /profile.
 func (ph *ProfileHandlerImpl) HandleProfileCommand(message *tgbotapi.Message, user *models.User) error {
-	summary, err := ph.base.Service.BuildProfileSummary(user)
+	screen, err := ph.base.Screens.Profile(user)
 	if err != nil {
 		// Используем MessageFactory для отправки сообщения об ошибке
 		return ph.base.MessageFactory.SendText(message.Chat.ID, ph.base.Service.Localizer.Get(user.InterfaceLanguageCode, "unknown_command"))
 	}
 
-	text := summary + "\n\n" + ph.base.Service.Localizer.Get(user.InterfaceLanguageCode, "profile_actions")
-	keyboard := ph.base.KeyboardBuilder.CreateProfileMenuKeyboard(user.InterfaceLanguageCode)
-
-	// Используем MessageFactory для отправки сообщения с клавиатурой
-	return ph.base.MessageFactory.SendWithKeyboard(message.Chat.ID, text, keyboard)
+	return ph.base.MessageFactory.SendScreen(message.Chat.ID, screen)
 }
 
 // HandleProfileShow показывает профиль пользователя.
 func (ph *ProfileHandlerImpl) HandleProfileShow(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	summary, err := ph.base.Service.BuildProfileSummary(user)
+	screen, err := ph.base.Screens.Profile(user)
 	if err != nil {
 		return err
 	}
 
-	text := summary + "\n\n" + ph.base.Service.Localizer.Get(user.InterfaceLanguageCode, "profile_actions")
-	keyboard := ph.base.KeyboardBuilder.CreateProfileMenuKeyboard(user.InterfaceLanguageCode)
-	err = ph.base.MessageFactory.EditWithKeyboard(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		&keyboard,
-	)
-
-	return err
+	return ph.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // HandleProfileResetAsk запрашивает подтверждение сброса профиля.
@@ -81,6 +69,9 @@ func (ph *ProfileHandlerImpl) HandleProfileResetYes(callback *tgbotapi.CallbackQ
 	if err != nil {
 		return err
 	}
+
+	ph.base.Service.RecordUserAudit(user, audit.ActionUserProfileReset, audit.TargetUser, user.ID, nil)
+
 	// Обновляем в памяти базовые поля
 	user.NativeLanguageCode = ""
 	user.TargetLanguageCode = ""
@@ -88,36 +79,17 @@ func (ph *ProfileHandlerImpl) HandleProfileResetYes(callback *tgbotapi.CallbackQ
 	user.Status = models.StatusFilling
 	user.ProfileCompletionLevel = 0
 
-	done := ph.base.Service.Localizer.Get(user.InterfaceLanguageCode, "profile_reset_done")
 	// Предложим сразу начать с выбора родного языка
-	next := ph.base.Service.GetLanguagePrompt(user, "native")
-	text := done + "\n\n" + next
-
-	keyboard := ph.base.KeyboardBuilder.CreateLanguageKeyboard(user.InterfaceLanguageCode, "native", "", true)
-	err = ph.base.MessageFactory.EditWithKeyboard(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		&keyboard,
-	)
+	screen := ph.base.Screens.NativeLanguage(user)
+	screen.Text = ph.base.Service.Localizer.Get(user.InterfaceLanguageCode, "profile_reset_done") + "\n\n" + screen.Text
 
-	return err
+	return ph.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, screen)
 }
 
 // StartProfileSetup начинает настройку профиля с выбора родного языка.
 func (ph *ProfileHandlerImpl) StartProfileSetup(callback *tgbotapi.CallbackQuery, user *models.User) error {
-	text := ph.base.Service.Localizer.Get(user.InterfaceLanguageCode, "choose_native_language")
-	keyboard := ph.base.KeyboardBuilder.CreateLanguageKeyboard(user.InterfaceLanguageCode, "native", "", true)
-
 	// Редактируем существующее сообщение вместо создания нового
-	err := ph.base.MessageFactory.EditWithKeyboard(
-		callback.Message.Chat.ID,
-		callback.Message.MessageID,
-		text,
-		&keyboard,
-	)
-
-	return err
+	return ph.base.MessageFactory.EditScreen(callback.Message.Chat.ID, callback.Message.MessageID, ph.base.Screens.NativeLanguage(user))
 }
 
 // HandleInterestsContinue обрабатывает продолжение после выбора интересов.
@@ -182,14 +154,10 @@ func (ph *ProfileHandlerImpl) HandleInterestsContinue(callback *tgbotapi.Callbac
 	}
 
 	// Если интересы выбраны, завершаем профиль
-	completedMsg := ph.base.Service.Localizer.Get(user.InterfaceLanguageCode, "profile_completed")
-	keyboard := ph.base.KeyboardBuilder.CreateProfileCompletedKeyboard(user.InterfaceLanguageCode)
-
-	err = ph.base.MessageFactory.EditWithKeyboard(
+	err = ph.base.MessageFactory.EditScreen(
 		callback.Message.Chat.ID,
 		callback.Message.MessageID,
-		completedMsg,
-		&keyboard,
+		ph.base.Screens.ProfileCompleted(user.InterfaceLanguageCode),
 	)
 	if err != nil {
 		return err
@@ -367,3 +335,44 @@ func (ph *ProfileHandlerImpl) ShowProfileSetupFeatures(callback *tgbotapi.Callba
 
 	return err
 }
+
+// HandleEditLearningGoals показывает экран выбора целей изучения языка.
+func (ph *ProfileHandlerImpl) HandleEditLearningGoals(callback *tgbotapi.CallbackQuery, user *models.User) error {
+	goals, err := ph.base.Service.GetLearningGoals(user.ID)
+	if err != nil {
+		return err
+	}
+
+	return ph.showLearningGoals(callback, user, goals)
+}
+
+// HandleToggleLearningGoal включает или выключает цель изучения языка.
+func (ph *ProfileHandlerImpl) HandleToggleLearningGoal(callback *tgbotapi.CallbackQuery, user *models.User, goal string) error {
+	goals, err := ph.base.Service.ToggleLearningGoal(user.ID, goal)
+	if err != nil {
+		return err
+	}
+
+	return ph.showLearningGoals(callback, user, goals)
+}
+
+// showLearningGoals отрисовывает экран целей изучения с отмеченными целями.
+func (ph *ProfileHandlerImpl) showLearningGoals(callback *tgbotapi.CallbackQuery, user *models.User, goals []string) error {
+	lang := user.InterfaceLanguageCode
+
+	selected := make(map[string]bool, len(goals))
+	for _, goal := range goals {
+		selected[goal] = true
+	}
+
+	text := ph.base.Service.Localizer.Get(lang, localization.LocaleLearningGoalsTitle) + "\n\n" +
+		ph.base.Service.Localizer.Get(lang, localization.LocaleLearningGoalsDescription)
+	keyboard := ph.base.KeyboardBuilder.CreateLearningGoalsKeyboard(lang, selected)
+
+	return ph.base.MessageFactory.EditWithKeyboard(
+		callback.Message.Chat.ID,
+		callback.Message.MessageID,
+		text,
+		&keyboard,
+	)
+}
@@ -2,6 +2,7 @@ package telegram
 
 import (
 	"fmt"
+	"language-exchange-bot/internal/adapters/telegram/handlers/base"
 	"language-exchange-bot/internal/errors"
 	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/logging"
@@ -287,6 +288,10 @@ func (f *MessageFactory) sendWithLogging(
 	operation string,
 	messageType string,
 ) error {
+	if err := base.CheckRecipientChat(chatID); err != nil {
+		return err
+	}
+
 	// Логирование перед отправкой
 	f.logOutgoingMessage(chatID, userID, operation, messageType)
 
@@ -0,0 +1,182 @@
+package telegram
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"language-exchange-bot/internal/adapters/telegram/handlers/base"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// npsButtonsPerRow - кнопок оценки NPS в ряду: 0-5 и 6-10.
+const npsButtonsPerRow = 6
+
+// SurveySender отправляет вопросы опросов через Telegram Bot API (реализует core.SurveySender).
+type SurveySender struct {
+	bot       *tgbotapi.BotAPI
+	localizer *localization.Localizer
+}
+
+// NewSurveySender создает отправителя вопросов опросов.
+func NewSurveySender(bot *tgbotapi.BotAPI, localizer *localization.Localizer) *SurveySender {
+	return &SurveySender{bot: bot, localizer: localizer}
+}
+
+// SendSurveyPrompt отправляет вопрос опроса с кнопками ответа.
+func (s *SurveySender) SendSurveyPrompt(chatID int64, prompt models.SurveyPrompt) error {
+	if err := base.CheckRecipientChat(chatID); err != nil {
+		return err
+	}
+
+	_, err := s.bot.Send(surveyPromptMessage(s.localizer, chatID, prompt))
+
+	return deliveryError(err)
+}
+
+// surveyPromptMessage собирает сообщение с вопросом опроса и клавиатурой ответов.
+func surveyPromptMessage(localizer *localization.Localizer, chatID int64, prompt models.SurveyPrompt) tgbotapi.MessageConfig {
+	text := prompt.Text
+	if prompt.QuestionCount > 1 {
+		text = localizer.GetWithParams(prompt.Language, localization.LocaleSurveyProgress, map[string]string{
+			"current": strconv.Itoa(prompt.QuestionIndex + 1),
+			"total":   strconv.Itoa(prompt.QuestionCount),
+		}) + "\n\n" + text
+	}
+
+	msg := tgbotapi.NewMessage(chatID, text)
+	msg.ReplyMarkup = surveyKeyboard(prompt)
+
+	return msg
+}
+
+// surveyKeyboard возвращает кнопки ответа: шкалу 0-10 для NPS, звезды 1-5 для оценки
+// или по варианту в ряд для выбора.
+func surveyKeyboard(prompt models.SurveyPrompt) tgbotapi.InlineKeyboardMarkup {
+	button := func(label string, value int) tgbotapi.InlineKeyboardButton {
+		return tgbotapi.NewInlineKeyboardButtonData(label, surveyAnswerCallback(prompt, value))
+	}
+
+	var rows [][]tgbotapi.InlineKeyboardButton
+
+	switch prompt.Kind {
+	case models.SurveyQuestionNPS:
+		var row []tgbotapi.InlineKeyboardButton
+
+		for score := 0; score <= 10; score++ {
+			row = append(row, button(strconv.Itoa(score), score))
+
+			if len(row) == npsButtonsPerRow || score == 10 {
+				rows = append(rows, row)
+				row = nil
+			}
+		}
+	case models.SurveyQuestionRating:
+		var row []tgbotapi.InlineKeyboardButton
+
+		for score := 1; score <= 5; score++ {
+			row = append(row, button(strconv.Itoa(score)+"⭐", score))
+		}
+
+		rows = append(rows, row)
+	default:
+		for i, option := range prompt.Options {
+			rows = append(rows, tgbotapi.NewInlineKeyboardRow(button(option, i)))
+		}
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+// surveyAnswerCallback возвращает callback_data ответа: srv_<опрос>_<вопрос>_<значение>.
+func surveyAnswerCallback(prompt models.SurveyPrompt, value int) string {
+	return fmt.Sprintf("%s%d_%d_%d", localization.CallbackPrefixSurveyAnswer, prompt.SurveyID, prompt.QuestionIndex, value)
+}
+
+// parseSurveyAnswerCallback разбирает callback_data ответа на опрос.
+func parseSurveyAnswerCallback(data string) (surveyID, questionIndex, value int, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(data, localization.CallbackPrefixSurveyAnswer), "_")
+	if len(parts) != 3 {
+		return 0, 0, 0, false
+	}
+
+	numbers := make([]int, len(parts))
+
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, 0, 0, false
+		}
+
+		numbers[i] = number
+	}
+
+	return numbers[0], numbers[1], numbers[2], true
+}
+
+// handleSurveyCallbacks сохраняет ответ на вопрос опроса, убирает кнопки и присылает
+// следующий вопрос или благодарность. Доступно любому пользователю, получившему опрос.
+func (h *TelegramHandler) handleSurveyCallbacks(callback *tgbotapi.CallbackQuery, user *models.User, data string) error {
+	if !strings.HasPrefix(data, localization.CallbackPrefixSurveyAnswer) {
+		return nil
+	}
+
+	surveyID, questionIndex, value, ok := parseSurveyAnswerCallback(data)
+	if !ok {
+		return nil
+	}
+
+	lang := user.InterfaceLanguageCode
+	chatID := callback.Message.Chat.ID
+
+	next, err := h.service.AnswerSurvey(user, surveyID, questionIndex, value)
+
+	switch {
+	case errors.Is(err, errorsPkg.ErrSurveyClosed), errors.Is(err, errorsPkg.ErrSurveyNotFound):
+		return h.messageFactory.EditText(chatID, callback.Message.MessageID,
+			callback.Message.Text+"\n\n"+h.service.Localizer.Get(lang, localization.LocaleSurveyClosed))
+	case errors.Is(err, errorsPkg.ErrInvalidSurvey):
+		log.Printf("Ignoring invalid survey answer %q from user %d: %v", data, user.ID, err)
+
+		return nil
+	case err != nil:
+		return h.errorHandler.HandleTelegramError(err, chatID, int64(user.ID), "AnswerSurvey")
+	}
+
+	// Ответ фиксируется в тексте вопроса, кнопки убираются, чтобы не отвечать повторно
+	answer := h.service.Localizer.GetWithParams(lang, localization.LocaleSurveyAnswerRecorded, map[string]string{
+		"answer": surveyAnswerLabel(callback.Message, data, value),
+	})
+	if err := h.messageFactory.EditText(chatID, callback.Message.MessageID, callback.Message.Text+"\n\n"+answer); err != nil {
+		log.Printf("Failed to mark survey answer in message: %v", err)
+	}
+
+	if next == nil {
+		return h.messageFactory.SendText(chatID, h.service.Localizer.Get(lang, localization.LocaleSurveyThanks))
+	}
+
+	_, err = h.bot.Send(surveyPromptMessage(h.service.Localizer, chatID, *next))
+
+	return err
+}
+
+// surveyAnswerLabel возвращает подпись нажатой кнопки, а если ее нет - само значение.
+func surveyAnswerLabel(message *tgbotapi.Message, data string, value int) string {
+	if message.ReplyMarkup != nil {
+		for _, row := range message.ReplyMarkup.InlineKeyboard {
+			for _, button := range row {
+				if button.CallbackData != nil && *button.CallbackData == data {
+					return button.Text
+				}
+			}
+		}
+	}
+
+	return strconv.Itoa(value)
+}
@@ -92,51 +92,30 @@ func TestTelegramHandler_HandleUpdate_CallbackQuery(t *testing.T) {
 	assert.Contains(t, err.Error(), "service not initialized")
 }
 
-// TestTelegramHandler_isAdmin - тест проверки прав администратора.
-func TestTelegramHandler_isAdmin(t *testing.T) {
+// TestFeedbackCallbackPermission - тест выбора права для callback'ов отзывов.
+func TestFeedbackCallbackPermission(t *testing.T) {
 	tests := []struct {
-		name       string
-		adminIDs   []int64
-		adminNames []string
-		userID     int64
-		username   string
-		expected   bool
+		data     string
+		expected core.Permission
 	}{
-		{
-			name:       "Admin by Chat ID",
-			adminIDs:   []int64{123, 456},
-			adminNames: []string{},
-			userID:     123,
-			username:   "user",
-			expected:   true,
-		},
-		{
-			name:       "Admin by Username",
-			adminIDs:   []int64{},
-			adminNames: []string{"admin", "moderator"},
-			userID:     999,
-			username:   "admin",
-			expected:   true,
-		},
-		{
-			name:       "Not Admin",
-			adminIDs:   []int64{123},
-			adminNames: []string{"admin"},
-			userID:     999,
-			username:   "user",
-			expected:   false,
-		},
+		{data: "browse_active_feedbacks_0", expected: core.PermissionViewFeedback},
+		{data: "show_all_feedbacks", expected: core.PermissionViewFeedback},
+		{data: "fb_process_12", expected: core.PermissionManageFeedback},
+		{data: "fb_delete_12", expected: core.PermissionManageFeedback},
+		{data: "archive_feedback_0", expected: core.PermissionManageFeedback},
+		{data: "delete_current_feedback_0", expected: core.PermissionManageFeedback},
+		{data: "fb_reply_12", expected: core.PermissionManageFeedback},
+		{data: "fb_reply_cancel", expected: core.PermissionManageFeedback},
+		{data: "fbt_pri_active_12_urgent", expected: core.PermissionManageFeedback},
+		{data: "fbf_breached", expected: core.PermissionViewFeedback},
+		{data: "fbs_open_12", expected: core.PermissionViewFeedback},
+		{data: "fbx_get_csv_30_all", expected: core.PermissionViewFeedback},
+		{data: "fba_12", expected: core.PermissionViewFeedback},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			handler := &TelegramHandler{
-				adminChatIDs:   tt.adminIDs,
-				adminUsernames: tt.adminNames,
-			}
-
-			result := handler.isAdmin(tt.userID, tt.username)
-			assert.Equal(t, tt.expected, result)
+		t.Run(tt.data, func(t *testing.T) {
+			assert.Equal(t, tt.expected, feedbackCallbackPermission(tt.data))
 		})
 	}
 }
@@ -256,7 +235,6 @@ func TestNewTelegramHandlerWithAdmins(t *testing.T) {
 	// 	bot,
 	// 	service,
 	// 	[]int64{123},
-	// 	[]string{"admin"},
 	// 	errorHandler,
 	// )
 	//
@@ -441,3 +419,28 @@ func TestTelegramBot_ResolveUsernameToChatID(t *testing.T) {
 	// Test method signature exists
 	assert.NotNil(t, bot.ResolveUsernameToChatID)
 }
+
+// TestSurveyKeyboard проверяет кнопки ответов на опрос и разбор их callback_data.
+func TestSurveyKeyboard(t *testing.T) {
+	nps := surveyKeyboard(models.SurveyPrompt{SurveyID: 4, Kind: models.SurveyQuestionNPS})
+	assert.Len(t, nps.InlineKeyboard, 2)
+	assert.Len(t, nps.InlineKeyboard[0], 6)
+	assert.Equal(t, "srv_4_0_10", *nps.InlineKeyboard[1][4].CallbackData)
+
+	rating := surveyKeyboard(models.SurveyPrompt{SurveyID: 4, QuestionIndex: 1, Kind: models.SurveyQuestionRating})
+	assert.Len(t, rating.InlineKeyboard, 1)
+	assert.Len(t, rating.InlineKeyboard[0], 5)
+
+	choice := surveyKeyboard(models.SurveyPrompt{SurveyID: 4, QuestionIndex: 2, Kind: models.SurveyQuestionChoice, Options: []string{"Profiles", "Search"}})
+	assert.Len(t, choice.InlineKeyboard, 2)
+	assert.Equal(t, "Search", choice.InlineKeyboard[1][0].Text)
+
+	surveyID, questionIndex, value, ok := parseSurveyAnswerCallback(*choice.InlineKeyboard[1][0].CallbackData)
+	assert.True(t, ok)
+	assert.Equal(t, []int{4, 2, 1}, []int{surveyID, questionIndex, value})
+
+	for _, data := range []string{"srv_4_2", "srv_4_x_1", "srv_4_2_1_0"} {
+		_, _, _, ok := parseSurveyAnswerCallback(data)
+		assert.False(t, ok, data)
+	}
+}
@@ -0,0 +1,131 @@
+// Package audit describes the audit log events and keeps the log tamper-evident:
+// every record stores the hash of the previous one, so editing or deleting a record
+// breaks the chain from that point on.
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"language-exchange-bot/internal/models"
+)
+
+// Действия, записываемые в журнал.
+const (
+	ActionFeedbackProcess   = "feedback.process"    // Отзыв отмечен обработанным
+	ActionFeedbackReopen    = "feedback.reopen"     // Отзыв возвращен в работу
+	ActionFeedbackArchive   = "feedback.archive"    // Обработанные отзывы перенесены в архив
+	ActionFeedbackDelete    = "feedback.delete"     // Отзыв удален
+	ActionFeedbackDeleteAll = "feedback.delete_all" // Удалены все отзывы
+	ActionFeedbackReply     = "feedback.reply"      // Администратор ответил автору отзыва
+	ActionFeedbackUserReply = "feedback.user_reply" // Автор отзыва ответил администратору
+	ActionFeedbackTriage    = "feedback.triage"     // Изменены категория, приоритет или ответственный отзыва
+	ActionFeedbackExport    = "feedback.export"     // Отзывы выгружены в файл
+	ActionUserProfileReset  = "user.profile.reset"  // Сброшен профиль пользователя
+	ActionUserDataExport    = "user.data.export"    // Пользователь выгрузил свои данные (/mydata)
+	ActionUserAccountDelete = "user.account.delete" // Пользователь удалил аккаунт (/deleteme), запись обезличена
+	ActionAdminLogin        = "admin.login"         // Вход в admin API через Telegram
+	ActionAPIRequest        = "api.request"         // Вызов admin API
+	ActionWebhookSetup      = "webhook.setup"       // Установлен webhook Telegram
+	ActionWebhookRemove     = "webhook.remove"      // Удален webhook Telegram
+	ActionRetentionPurge    = "retention.purge"     // Удалены данные с истекшим сроком хранения
+)
+
+// Типы объектов действий.
+const (
+	TargetFeedback = "feedback"
+	TargetUser     = "user"
+	TargetWebhook  = "webhook"
+	TargetMatch    = "match"
+)
+
+// GenesisHash - prev_hash первой записи журнала.
+var GenesisHash = strings.Repeat("0", sha256.Size*2)
+
+// ChainHash возвращает SHA-256 (hex) записи event, следующей за записью с хешем prevHash.
+// CreatedAt учитывается с точностью до микросекунд - точностью TIMESTAMPTZ.
+func ChainHash(prevHash string, event *models.AuditEvent) (string, error) {
+	details, err := CanonicalDetails(event.Details)
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal([]interface{}{
+		prevHash,
+		event.CreatedAt.UnixMicro(),
+		event.ActorType,
+		event.ActorID,
+		event.Action,
+		event.TargetType,
+		event.TargetID,
+		event.Result,
+		event.IPAddress,
+		details,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit event: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// CanonicalDetails возвращает детали события в JSON с отсортированными ключами и числами
+// в исходной записи, чтобы хеш не зависел от того, прочитаны детали из БД или заданы в коде.
+func CanonicalDetails(details map[string]interface{}) (json.RawMessage, error) {
+	if len(details) == 0 {
+		return json.RawMessage("{}"), nil
+	}
+
+	raw, err := json.Marshal(details)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit details: %w", err)
+	}
+
+	normalized, err := DecodeDetails(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit details: %w", err)
+	}
+
+	return canonical, nil
+}
+
+// DecodeDetails раскладывает JSON деталей события, сохраняя числа как json.Number.
+func DecodeDetails(raw []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	details := map[string]interface{}{}
+	if err := decoder.Decode(&details); err != nil {
+		return nil, fmt.Errorf("failed to decode audit details: %w", err)
+	}
+
+	return details, nil
+}
+
+// Verify проверяет, что записи events (по возрастанию ID) продолжают цепочку с хешем prevHash.
+// Возвращает хеш последней записи и ID первой несошедшейся записи (0 - цепочка цела).
+func Verify(prevHash string, events []models.AuditEvent) (string, int64) {
+	for i := range events {
+		event := &events[i]
+
+		hash, err := ChainHash(prevHash, event)
+		if err != nil || event.PrevHash != prevHash || event.Hash != hash {
+			return prevHash, event.ID
+		}
+
+		prevHash = event.Hash
+	}
+
+	return prevHash, 0
+}
@@ -0,0 +1,104 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/models"
+)
+
+// chain строит цепочку из событий, заполняя хеши.
+func chain(t *testing.T, events []models.AuditEvent) []models.AuditEvent {
+	t.Helper()
+
+	prevHash := GenesisHash
+
+	for i := range events {
+		hash, err := ChainHash(prevHash, &events[i])
+		require.NoError(t, err)
+
+		events[i].ID = int64(i + 1)
+		events[i].PrevHash = prevHash
+		events[i].Hash = hash
+		prevHash = hash
+	}
+
+	return events
+}
+
+// TestVerify тестирует обнаружение измененных и удаленных записей.
+func TestVerify(t *testing.T) {
+	createdAt := time.Date(2026, 10, 18, 12, 0, 0, 123456000, time.UTC)
+
+	newChain := func() []models.AuditEvent {
+		return chain(t, []models.AuditEvent{
+			{CreatedAt: createdAt, ActorType: models.AuditActorAdmin, ActorID: "42", Action: ActionFeedbackProcess, TargetType: TargetFeedback, TargetID: "7", Result: models.AuditResultSuccess},
+			{CreatedAt: createdAt, ActorType: models.AuditActorUser, ActorID: "100", Action: ActionUserProfileReset, TargetType: TargetUser, TargetID: "100", Result: models.AuditResultSuccess},
+			{CreatedAt: createdAt, ActorType: models.AuditActorAPIKey, ActorID: "3", Action: ActionAPIRequest, Result: models.AuditResultFailure, Details: map[string]interface{}{"status": 403, "path": "/api/v2/audit"}},
+		})
+	}
+
+	lastHash, brokenAt := Verify(GenesisHash, newChain())
+	assert.Zero(t, brokenAt)
+	assert.Equal(t, newChain()[2].Hash, lastHash)
+
+	edited := newChain()
+	edited[1].ActorID = "101"
+	_, brokenAt = Verify(GenesisHash, edited)
+	assert.Equal(t, int64(2), brokenAt)
+
+	deleted := newChain()
+	_, brokenAt = Verify(GenesisHash, append(deleted[:1], deleted[2:]...))
+	assert.Equal(t, int64(3), brokenAt)
+}
+
+// TestChainHash_DetailsRoundTrip тестирует, что хеш не меняется после чтения деталей из JSON.
+func TestChainHash_DetailsRoundTrip(t *testing.T) {
+	event := models.AuditEvent{
+		CreatedAt: time.Now(),
+		Action:    ActionWebhookSetup,
+		Details:   map[string]interface{}{"url": "https://example.com/hook", "attempt": 2, "ratio": 0.5},
+	}
+
+	hash, err := ChainHash(GenesisHash, &event)
+	require.NoError(t, err)
+
+	raw, err := CanonicalDetails(event.Details)
+	require.NoError(t, err)
+
+	event.Details, err = DecodeDetails(raw)
+	require.NoError(t, err)
+
+	roundTrip, err := ChainHash(GenesisHash, &event)
+	require.NoError(t, err)
+	assert.Equal(t, hash, roundTrip)
+}
+
+// TestWriteCSV тестирует выгрузку и экранирование формул.
+func TestWriteCSV(t *testing.T) {
+	events := chain(t, []models.AuditEvent{{
+		CreatedAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		ActorType: models.AuditActorUser,
+		ActorID:   "=HYPERLINK()",
+		Action:    ActionFeedbackDelete,
+		Result:    models.AuditResultSuccess,
+	}})
+
+	var buffer bytes.Buffer
+
+	require.NoError(t, WriteCSV(&buffer, events))
+
+	records, err := csv.NewReader(&buffer).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, csvHeader, records[0])
+	assert.Equal(t, "2026-10-18T12:00:00Z", records[1][1])
+	assert.Equal(t, "'=HYPERLINK()", records[1][3])
+	assert.Equal(t, "{}", records[1][9])
+	assert.Equal(t, events[0].Hash, records[1][11])
+}
@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"language-exchange-bot/internal/models"
+)
+
+// csvHeader - колонки выгрузки журнала аудита.
+var csvHeader = []string{
+	"id", "created_at", "actor_type", "actor_id", "action", "target_type", "target_id",
+	"result", "ip_address", "details", "prev_hash", "hash",
+}
+
+// WriteCSV выгружает записи журнала в CSV. Хеши выгружаются вместе с записями,
+// чтобы цепочку можно было проверить и по выгрузке.
+func WriteCSV(w io.Writer, events []models.AuditEvent) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return fmt.Errorf("failed to write audit csv: %w", err)
+	}
+
+	for i := range events {
+		event := &events[i]
+
+		details, err := CanonicalDetails(event.Details)
+		if err != nil {
+			return err
+		}
+
+		record := []string{
+			strconv.FormatInt(event.ID, 10),
+			event.CreatedAt.UTC().Format(time.RFC3339Nano),
+			event.ActorType,
+			csvSafe(event.ActorID),
+			event.Action,
+			event.TargetType,
+			csvSafe(event.TargetID),
+			event.Result,
+			csvSafe(event.IPAddress),
+			csvSafe(string(details)),
+			event.PrevHash,
+			event.Hash,
+		}
+
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write audit csv: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write audit csv: %w", err)
+	}
+
+	return nil
+}
+
+// csvSafe экранирует значения, которые табличный редактор принял бы за формулу.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
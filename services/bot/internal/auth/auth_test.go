@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+)
+
+type testClaims struct {
+	Subject string `json:"sub"`
+	Role    string `json:"role"`
+}
+
+// TestJWT тестирует подпись и отказ для подмененных токенов.
+func TestJWT(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	token, err := SignJWT(testClaims{Subject: "42", Role: "moderator"}, secret)
+	require.NoError(t, err)
+
+	var claims testClaims
+
+	require.NoError(t, ParseJWT(token, secret, &claims))
+	assert.Equal(t, testClaims{Subject: "42", Role: "moderator"}, claims)
+
+	parts := strings.Split(token, ".")
+	forgedPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"42","role":"admin"}`))
+	noneHeader := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+
+	invalid := map[string]string{
+		"wrong secret":   token,
+		"forged payload": parts[0] + "." + forgedPayload + "." + parts[2],
+		"alg none":       noneHeader + "." + parts[1] + ".",
+		"malformed":      "not-a-jwt",
+	}
+
+	for name, candidate := range invalid {
+		key := secret
+		if name == "wrong secret" {
+			key = []byte("another-secret-another-secret-00")
+		}
+
+		require.ErrorIs(t, ParseJWT(candidate, key, &claims), errorsPkg.ErrInvalidAdminToken, name)
+	}
+}
+
+// TestVerifyTelegramLogin тестирует проверку подписи и срока данных Telegram Login Widget.
+func TestVerifyTelegramLogin(t *testing.T) {
+	const botToken = "123456:test-token"
+
+	now := time.Now()
+	signed := func(authDate time.Time) map[string]string {
+		fields := map[string]string{
+			"id":         "1001",
+			"first_name": "Anna",
+			"username":   "anna",
+			"auth_date":  strconv.FormatInt(authDate.Unix(), 10),
+		}
+		fields["hash"] = TelegramLoginHash(fields, botToken)
+
+		return fields
+	}
+
+	telegramID, err := VerifyTelegramLogin(signed(now.Add(-time.Minute)), botToken, now)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1001), telegramID)
+
+	tampered := signed(now)
+	tampered["id"] = "1002"
+
+	for name, fields := range map[string]map[string]string{
+		"tampered":     tampered,
+		"expired":      signed(now.Add(-25 * time.Hour)),
+		"future":       signed(now.Add(time.Hour)),
+		"without hash": {"id": "1001", "auth_date": strconv.FormatInt(now.Unix(), 10)},
+	} {
+		_, err := VerifyTelegramLogin(fields, botToken, now)
+		require.ErrorIs(t, err, errorsPkg.ErrInvalidTelegramLogin, name)
+	}
+
+	_, err = VerifyTelegramLogin(signed(now), "654321:other-token", now)
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidTelegramLogin)
+}
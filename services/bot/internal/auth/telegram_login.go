@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+)
+
+// VerifyTelegramLogin проверяет данные Telegram Login Widget и возвращает Telegram ID пользователя.
+// Данные старше TelegramLoginMaxAge отклоняются, чтобы перехваченную подпись нельзя было
+// использовать позже.
+func VerifyTelegramLogin(fields map[string]string, botToken string, now time.Time) (int64, error) {
+	hash, err := hex.DecodeString(fields["hash"])
+	if err != nil || len(hash) == 0 || botToken == "" {
+		return 0, fmt.Errorf("%w: missing hash", errorsPkg.ErrInvalidTelegramLogin)
+	}
+
+	if !hmac.Equal(hash, telegramLoginMAC(fields, botToken)) {
+		return 0, fmt.Errorf("%w: invalid hash", errorsPkg.ErrInvalidTelegramLogin)
+	}
+
+	authDate, err := strconv.ParseInt(fields["auth_date"], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid auth_date", errorsPkg.ErrInvalidTelegramLogin)
+	}
+
+	signedAt := time.Unix(authDate, 0)
+	if now.Sub(signedAt) > localization.TelegramLoginMaxAge || signedAt.Sub(now) > localization.TelegramLoginMaxClockSkew {
+		return 0, fmt.Errorf("%w: login data expired", errorsPkg.ErrInvalidTelegramLogin)
+	}
+
+	telegramID, err := strconv.ParseInt(fields["id"], 10, 64)
+	if err != nil || telegramID <= 0 {
+		return 0, fmt.Errorf("%w: invalid id", errorsPkg.ErrInvalidTelegramLogin)
+	}
+
+	return telegramID, nil
+}
+
+// TelegramLoginHash возвращает подпись hash данных Telegram Login Widget в hex.
+func TelegramLoginHash(fields map[string]string, botToken string) string {
+	return hex.EncodeToString(telegramLoginMAC(fields, botToken))
+}
+
+// telegramLoginMAC считает HMAC-SHA256 от отсортированных полей "key=value" через \n (без hash)
+// с ключом SHA256(токен бота): https://core.telegram.org/widgets/login#checking-authorization.
+func telegramLoginMAC(fields map[string]string, botToken string) []byte {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		if key != "hash" {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+fields[key])
+	}
+
+	secret := sha256.Sum256([]byte(botToken))
+	mac := hmac.New(sha256.New, secret[:])
+	mac.Write([]byte(strings.Join(pairs, "\n")))
+
+	return mac.Sum(nil)
+}
@@ -3,6 +3,7 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"sync"
@@ -151,12 +152,12 @@ func (cacheService *Service) SetInterests(_ context.Context, lang string, intere
 	cacheService.updateSize()
 }
 
-// GetUser получает пользователя из кэша или возвращает nil если нет в кэше.
-func (cacheService *Service) GetUser(_ context.Context, userID int64) (*models.User, bool) {
+// GetUser получает пользователя по Telegram ID из кэша или возвращает nil если нет в кэше.
+func (cacheService *Service) GetUser(_ context.Context, telegramID int64) (*models.User, bool) {
 	cacheService.mutex.RLock()
 	defer cacheService.mutex.RUnlock()
 
-	entry, exists := cacheService.users[userID]
+	entry, exists := cacheService.users[telegramID]
 	if !exists || entry == nil || entry.IsExpired() {
 		cacheService.cacheStats.Misses++
 
@@ -174,12 +175,17 @@ func (cacheService *Service) GetUser(_ context.Context, userID int64) (*models.U
 	return nil, false
 }
 
-// SetUser сохраняет пользователя в кэш.
+// SetUser сохраняет пользователя в кэш по Telegram ID: по нему пользователя и ищут.
+// Пользователи других платформ без telegram_id не кэшируются, иначе делили бы ключ 0.
 func (cacheService *Service) SetUser(_ context.Context, user *models.User) {
+	if user.TelegramID == 0 {
+		return
+	}
+
 	cacheService.mutex.Lock()
 	defer cacheService.mutex.Unlock()
 
-	cacheService.users[int64(user.ID)] = &Entry{
+	cacheService.users[user.TelegramID] = &Entry{
 		Data: &CachedUser{
 			User: user,
 			Lang: user.InterfaceLanguageCode,
@@ -266,14 +272,14 @@ func (cacheService *Service) SetStats(_ context.Context, statsType string, data
 }
 
 // InvalidateUser удаляет пользователя из кэша.
-func (cacheService *Service) InvalidateUser(_ context.Context, userID int64) {
+func (cacheService *Service) InvalidateUser(_ context.Context, telegramID int64) {
 	cacheService.mutex.Lock()
 	defer cacheService.mutex.Unlock()
 
-	delete(cacheService.users, userID)
+	delete(cacheService.users, telegramID)
 	cacheService.updateSize()
 
-	log.Printf("Cache: Invalidated user %d", userID)
+	log.Printf("Cache: Invalidated user %d", telegramID)
 }
 
 // InvalidateLanguages удаляет языки из кэша.
@@ -556,9 +562,22 @@ func (cacheService *Service) Get(ctx context.Context, key string, dest interface
 		return fmt.Errorf("key not found: %s", key)
 	}
 
-	// Простое присваивание для совместимости
-	// В реальной реализации нужно было бы использовать reflection
-	*dest.(*interface{}) = entry.Data
+	// *interface{} получает сохраненное значение как есть, типизированный dest -
+	// через JSON, как в RedisCacheService
+	if target, ok := dest.(*interface{}); ok {
+		*target = entry.Data
+
+		return nil
+	}
+
+	data, err := json.Marshal(entry.Data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal value: %w", err)
+	}
+
+	if err := json.Unmarshal(data, dest); err != nil {
+		return fmt.Errorf("failed to unmarshal value: %w", err)
+	}
 
 	return nil
 }
@@ -588,9 +607,17 @@ func (cacheService *Service) GetInterestCategories(ctx context.Context, lang str
 		return nil, false
 	}
 
+	// Прогрев кэша кладет сюда заготовку другого типа - считаем ее промахом
+	categories, ok := entry.Data.([]*models.InterestCategory)
+	if !ok {
+		cacheService.cacheStats.Misses++
+
+		return nil, false
+	}
+
 	cacheService.cacheStats.Hits++
 
-	return entry.Data.([]*models.InterestCategory), true
+	return categories, true
 }
 
 // SetInterestCategories сохраняет категории интересов в кэш.
@@ -155,7 +155,7 @@ func TestService_GetUser_CacheHit(t *testing.T) {
 	service.SetUser(context.Background(), user)
 
 	// Получаем из кэша
-	result, found := service.GetUser(context.Background(), int64(user.ID))
+	result, found := service.GetUser(context.Background(), user.TelegramID)
 
 	// Проверяем результаты
 	assert.True(t, found)
@@ -183,19 +183,32 @@ func TestService_InvalidateUser(t *testing.T) {
 	service.SetUser(context.Background(), user)
 
 	// Проверяем, что пользователь в кэше
-	result, found := service.GetUser(context.Background(), int64(user.ID))
+	result, found := service.GetUser(context.Background(), user.TelegramID)
 	assert.True(t, found)
 	assert.NotNil(t, result)
 
 	// Инвалидируем пользователя
-	service.InvalidateUser(context.Background(), int64(user.ID))
+	service.InvalidateUser(context.Background(), user.TelegramID)
 
 	// Проверяем, что пользователь удален из кэша
-	result, found = service.GetUser(context.Background(), int64(user.ID))
+	result, found = service.GetUser(context.Background(), user.TelegramID)
 	assert.False(t, found)
 	assert.Nil(t, result)
 }
 
+func TestService_SetUserWithoutTelegramID(t *testing.T) {
+	t.Parallel()
+	service := NewService(DefaultConfig())
+
+	// Пользователи других платформ без telegram_id не кэшируются: иначе они делили бы ключ 0
+	service.SetUser(context.Background(), &models.User{ID: 1, Platform: models.PlatformDiscord})
+	service.SetUser(context.Background(), &models.User{ID: 2, Platform: models.PlatformDiscord})
+
+	_, found := service.GetUser(context.Background(), 0)
+	assert.False(t, found)
+	assert.Equal(t, 0, service.GetCacheStats(context.Background()).Size)
+}
+
 func TestService_ClearAll(t *testing.T) {
 	t.Parallel()
 	// Создаем сервис кэша
@@ -229,7 +242,7 @@ func TestService_ClearAll(t *testing.T) {
 	_, found = service.GetInterests(context.Background(), "ru")
 	assert.False(t, found)
 
-	_, found = service.GetUser(context.Background(), int64(user.ID))
+	_, found = service.GetUser(context.Background(), user.TelegramID)
 	assert.False(t, found)
 }
 
@@ -633,3 +646,24 @@ func TestService_InvalidateUserStats(t *testing.T) {
 	_, found = service.GetUserStats(context.Background(), 12345)
 	assert.False(t, found)
 }
+
+func TestService_GetTypedDestination(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(DefaultConfig())
+	ctx := context.Background()
+
+	require.NoError(t, service.Set(ctx, "target", 42, time.Minute))
+
+	// Типизированный dest заполняется так же, как в Redis
+	var targetID int
+	require.NoError(t, service.Get(ctx, "target", &targetID))
+	assert.Equal(t, 42, targetID)
+
+	var raw interface{}
+	require.NoError(t, service.Get(ctx, "target", &raw))
+	assert.Equal(t, 42, raw)
+
+	var wrong []string
+	assert.Error(t, service.Get(ctx, "target", &wrong))
+}
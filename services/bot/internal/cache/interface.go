@@ -17,8 +17,8 @@ type ServiceInterface interface {
 	GetInterests(ctx context.Context, lang string) (map[int]string, bool)
 	SetInterests(ctx context.Context, lang string, interests map[int]string)
 
-	// Users
-	GetUser(ctx context.Context, userID int64) (*models.User, bool)
+	// Users: ключ - telegram_id; пользователи других платформ (telegram_id 0) не кэшируются
+	GetUser(ctx context.Context, telegramID int64) (*models.User, bool)
 	SetUser(ctx context.Context, user *models.User)
 
 	// Translations
@@ -42,7 +42,7 @@ type ServiceInterface interface {
 	SetConfig(ctx context.Context, configKey string, value interface{})
 
 	// Invalidation
-	InvalidateUser(ctx context.Context, userID int64)
+	InvalidateUser(ctx context.Context, telegramID int64)
 	InvalidateLanguages(ctx context.Context)
 	InvalidateInterests(ctx context.Context)
 	InvalidateTranslations(ctx context.Context)
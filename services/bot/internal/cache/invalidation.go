@@ -48,6 +48,7 @@ func (is *InvalidationService) InvalidateUserLanguages(userID int64) {
 func (is *InvalidationService) InvalidateStaticData() {
 	is.cache.InvalidateLanguages(context.Background())
 	is.cache.InvalidateInterests(context.Background())
+	is.cache.InvalidateInterestCategories(context.Background())
 	is.cache.InvalidateTranslations(context.Background())
 	log.Printf("Invalidation: Cleared all static data")
 }
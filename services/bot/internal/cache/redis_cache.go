@@ -177,9 +177,9 @@ func (r *RedisCacheService) SetInterests(ctx context.Context, lang string, inter
 	}
 }
 
-// GetUser получает пользователя из Redis кэша.
-func (r *RedisCacheService) GetUser(ctx context.Context, userID int64) (*models.User, bool) {
-	key := fmt.Sprintf("user:%d", userID)
+// GetUser получает пользователя по Telegram ID из Redis кэша.
+func (r *RedisCacheService) GetUser(ctx context.Context, telegramID int64) (*models.User, bool) {
+	key := fmt.Sprintf("user:%d", telegramID)
 
 	val, err := r.client.Get(ctx, key).Result()
 	if errors.Is(err, redis.Nil) {
@@ -202,9 +202,14 @@ func (r *RedisCacheService) GetUser(ctx context.Context, userID int64) (*models.
 	return &user, true
 }
 
-// SetUser сохраняет пользователя в Redis кэш.
+// SetUser сохраняет пользователя в Redis кэш по Telegram ID. Пользователи других платформ
+// без telegram_id не кэшируются.
 func (r *RedisCacheService) SetUser(ctx context.Context, user *models.User) {
-	key := fmt.Sprintf("user:%d", user.ID)
+	if user.TelegramID == 0 {
+		return
+	}
+
+	key := fmt.Sprintf("user:%d", user.TelegramID)
 
 	data, err := json.Marshal(user)
 	if err != nil {
@@ -304,14 +309,14 @@ func (r *RedisCacheService) SetStats(ctx context.Context, statsType string, data
 }
 
 // InvalidateUser удаляет пользователя из Redis кэша.
-func (r *RedisCacheService) InvalidateUser(ctx context.Context, userID int64) {
-	key := fmt.Sprintf("user:%d", userID)
+func (r *RedisCacheService) InvalidateUser(ctx context.Context, telegramID int64) {
+	key := fmt.Sprintf("user:%d", telegramID)
 
 	err := r.client.Del(ctx, key).Err()
 	if err != nil {
 		log.Printf("Redis error deleting user: %v", err)
 	} else {
-		log.Printf("Redis: Invalidated user %d", userID)
+		log.Printf("Redis: Invalidated user %d", telegramID)
 	}
 }
 
@@ -661,7 +666,11 @@ func (r *RedisCacheService) BatchSetUsers(ctx context.Context, users []*models.U
 	items := make(map[string]interface{})
 
 	for _, user := range users {
-		key := fmt.Sprintf("user:%d", user.ID)
+		if user.TelegramID == 0 {
+			continue
+		}
+
+		key := fmt.Sprintf("user:%d", user.TelegramID)
 		items[key] = user
 	}
 
@@ -669,14 +678,14 @@ func (r *RedisCacheService) BatchSetUsers(ctx context.Context, users []*models.U
 }
 
 // BatchGetUsers получает множественных пользователей через pipeline.
-func (r *RedisCacheService) BatchGetUsers(ctx context.Context, userIDs []int64) ([]*models.User, error) {
-	if len(userIDs) == 0 {
+func (r *RedisCacheService) BatchGetUsers(ctx context.Context, telegramIDs []int64) ([]*models.User, error) {
+	if len(telegramIDs) == 0 {
 		return nil, nil
 	}
 
-	keys := make([]string, len(userIDs))
-	for i, userID := range userIDs {
-		keys[i] = fmt.Sprintf("user:%d", userID)
+	keys := make([]string, len(telegramIDs))
+	for i, telegramID := range telegramIDs {
+		keys[i] = fmt.Sprintf("user:%d", telegramID)
 	}
 
 	data, err := r.BatchGet(ctx, keys)
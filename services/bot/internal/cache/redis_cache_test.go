@@ -103,7 +103,7 @@ func TestRedisCacheServiceUsers(t *testing.T) {
 	redisCache.SetUser(context.Background(), user)
 
 	// Получаем из Redis
-	cached, found := redisCache.GetUser(context.Background(), 1)
+	cached, found := redisCache.GetUser(context.Background(), user.TelegramID)
 	if !found {
 		t.Error("Expected to find user in Redis cache")
 	}
@@ -17,6 +17,8 @@ import (
 type Config struct {
 	// Telegram Bot
 	TelegramToken string
+	// Discord Bot
+	DiscordToken string
 	// Database
 	DatabaseURL          string
 	DatabaseMaxOpenConns int // Максимум открытых соединений
@@ -31,7 +33,7 @@ type Config struct {
 	WebhookURL string
 	// Bot Platform Settings
 	EnableTelegram bool
-	EnableDiscord  bool // Для будущего расширения
+	EnableDiscord  bool
 	// Telegram Bot Mode: "polling" or "webhook"
 	TelegramMode string
 	// Admin IDs for notifications
@@ -46,6 +48,21 @@ type Config struct {
 	MinPrimaryInterests int     // Минимум основных интересов
 	MaxPrimaryInterests int     // Максимум основных интересов
 	PrimaryPercentage   float64 // Процент основных интересов от общего количества
+	// Admin API Keys
+	APIKeyLifetimeDays    int // Срок действия новых ключей в днях (0 - бессрочные)
+	APIKeyGracePeriodDays int // Сколько дней старый ключ действует после ротации
+	// Admin API Sessions (JWT)
+	AdminJWTSecret          string // Ключ подписи access-токенов (пусто - вход по JWT отключен)
+	AdminAccessTokenMinutes int    // Время жизни access-токена в минутах
+	AdminRefreshTokenDays   int    // Время жизни refresh-токена в днях
+	// Data Retention (0 - хранить бессрочно)
+	RetentionFeedbackMonths     int // Обработанные отзывы старше N месяцев удаляются
+	RetentionInactiveUserMonths int // Пользователи без активности дольше N месяцев удаляются
+	RetentionMatchMonths        int // Отмененные совпадения старше N месяцев удаляются
+	// Personal Data Encryption (ключи не заданы - персональные данные хранятся открытым текстом)
+	EncryptionKeyFile     string // Файл ключей в формате <id>=<ключ в base64>
+	EncryptionKeyDir      string // Каталог смонтированного секрета: файл на ключ
+	EncryptionActiveKeyID string // Ключ для новых значений (по умолчанию последний)
 }
 
 // Load loads configuration from environment variables and .env file.
@@ -56,6 +73,7 @@ func Load() *Config {
 
 	config := &Config{
 		TelegramToken:           getTelegramToken(getFromFile),
+		DiscordToken:            getDiscordToken(getFromFile),
 		DatabaseURL:             getDatabaseURL(getFromFile),
 		DatabaseMaxOpenConns:    getDatabaseMaxOpenConns(),
 		DatabaseMaxIdleConns:    getDatabaseMaxIdleConns(),
@@ -77,6 +95,19 @@ func Load() *Config {
 		MinPrimaryInterests:     getMinPrimaryInterests(),
 		MaxPrimaryInterests:     getMaxPrimaryInterests(),
 		PrimaryPercentage:       getPrimaryPercentage(),
+		APIKeyLifetimeDays:      getNonNegativeInt("API_KEY_DEFAULT_LIFETIME_DAYS", localization.DefaultAPIKeyLifetimeDays),
+		APIKeyGracePeriodDays:   getNonNegativeInt("API_KEY_GRACE_PERIOD_DAYS", localization.DefaultAPIKeyGracePeriodDays),
+		AdminJWTSecret:          getAdminJWTSecret(getFromFile),
+		AdminAccessTokenMinutes: getPositiveInt("ADMIN_ACCESS_TOKEN_TTL_MINUTES", localization.DefaultAdminAccessTokenMinutes),
+		AdminRefreshTokenDays:   getPositiveInt("ADMIN_REFRESH_TOKEN_TTL_DAYS", localization.DefaultAdminRefreshTokenDays),
+
+		RetentionFeedbackMonths:     getNonNegativeInt("RETENTION_FEEDBACK_MONTHS", localization.DefaultRetentionFeedbackMonths),
+		RetentionInactiveUserMonths: getNonNegativeInt("RETENTION_INACTIVE_USER_MONTHS", localization.DefaultRetentionInactiveUserMonths),
+		RetentionMatchMonths:        getNonNegativeInt("RETENTION_MATCH_MONTHS", localization.DefaultRetentionMatchMonths),
+
+		EncryptionKeyFile:     getEnv("ENCRYPTION_KEY_FILE", ""),
+		EncryptionKeyDir:      getEnv("ENCRYPTION_KEY_DIR", ""),
+		EncryptionActiveKeyID: getEnv("ENCRYPTION_ACTIVE_KEY_ID", ""),
 	}
 
 	return config
@@ -92,6 +123,16 @@ func getTelegramToken(getFromFile func(string) string) string {
 	return telegramToken
 }
 
+// getDiscordToken получает токен Discord бота из переменных окружения или файла.
+func getDiscordToken(getFromFile func(string) string) string {
+	discordToken := os.Getenv("DISCORD_TOKEN")
+	if discordToken == "" {
+		discordToken = getFromFile(os.Getenv("DISCORD_TOKEN_FILE"))
+	}
+
+	return discordToken
+}
+
 // getDatabaseURL получает URL базы данных из переменных окружения или файла.
 func getDatabaseURL(getFromFile func(string) string) string {
 	databaseURL := os.Getenv("DATABASE_URL")
@@ -256,6 +297,43 @@ func getMaxMatchesPerUser() int {
 	return matches
 }
 
+// getNonNegativeInt получает неотрицательное целое из переменной окружения или значение по умолчанию.
+func getNonNegativeInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(getEnv(key, strconv.Itoa(defaultValue)))
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// getPositiveInt получает положительное целое из переменной окружения или значение по умолчанию.
+func getPositiveInt(key string, defaultValue int) int {
+	value, err := strconv.Atoi(getEnv(key, strconv.Itoa(defaultValue)))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// getAdminJWTSecret получает ключ подписи JWT admin API из переменной окружения или файла.
+// Слишком короткий ключ отключает вход по JWT, чтобы токены нельзя было подобрать.
+func getAdminJWTSecret(getFromFile func(string) string) string {
+	secret := os.Getenv("ADMIN_JWT_SECRET")
+	if secret == "" {
+		secret = getFromFile(os.Getenv("ADMIN_JWT_SECRET_FILE"))
+	}
+
+	if secret != "" && len(secret) < localization.MinAdminJWTSecretLength {
+		log.Printf("ADMIN_JWT_SECRET is shorter than %d characters, JWT sessions are disabled", localization.MinAdminJWTSecretLength)
+
+		return ""
+	}
+
+	return secret
+}
+
 // getMinPrimaryInterests получает минимальное количество основных интересов.
 func getMinPrimaryInterests() int {
 	interests, err := strconv.Atoi(getEnv("MIN_PRIMARY_INTERESTS", "1"))
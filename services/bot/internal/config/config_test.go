@@ -278,6 +278,14 @@ func TestConfig_Load_TokenFromFile(t *testing.T) {
 	config := Load()
 
 	assert.Equal(t, tokenContent, config.TelegramToken)
+	assert.Empty(t, config.DiscordToken)
+
+	// Токен Discord читается из файла так же
+	if err := os.Setenv("DISCORD_TOKEN_FILE", tokenFile); err != nil {
+		t.Logf("Failed to set DISCORD_TOKEN_FILE: %v", err)
+	}
+
+	assert.Equal(t, tokenContent, Load().DiscordToken)
 }
 
 // TestConfig_Load_DatabaseURLFromFile тестирует загрузку database URL из файла.
@@ -477,6 +485,8 @@ func clearEnvironment() {
 	envKeys := []string{
 		"TELEGRAM_TOKEN",
 		"TELEGRAM_TOKEN_FILE",
+		"DISCORD_TOKEN",
+		"DISCORD_TOKEN_FILE",
 		"DATABASE_URL",
 		"DATABASE_URL_FILE",
 		"REDIS_URL",
@@ -15,31 +15,48 @@ import (
 // Interest configuration constants are now centralized in localization/constants.go
 
 // InterestsConfig представляет конфигурацию системы интересов.
+// Теги совпадают с ключами config/interests.json (snake_case). До перехода на snake_case
+// ключи файла не совпадали с тегами и все баллы совместимости и лимиты оставались нулевыми
+// (см. CHANGELOG.md); ключи в прежнем написании camelCase теперь отклоняются при загрузке.
 type InterestsConfig struct {
 	Matching       MatchingConfig            `json:"matching"`
-	InterestLimits InterestLimitsConfig      `json:"interestLimits"`
+	InterestLimits InterestLimitsConfig      `json:"interest_limits"`
 	Categories     map[string]CategoryConfig `json:"categories"`
+	// Interests - каталог интересов, источник истины для синхронизации с БД (cmd/catalog-sync)
+	Interests map[string]InterestCatalogEntry `json:"interests"`
 }
 
 // MatchingConfig конфигурация для алгоритма сопоставления.
 type MatchingConfig struct {
-	PrimaryInterestScore    int `json:"primaryInterestScore"`
-	AdditionalInterestScore int `json:"additionalInterestScore"`
-	MinCompatibilityScore   int `json:"minCompatibilityScore"`
-	MaxMatchesPerUser       int `json:"maxMatchesPerUser"`
+	PrimaryInterestScore    int `json:"primary_interest_score"`
+	AdditionalInterestScore int `json:"additional_interest_score"`
+	LearningGoalScore       int `json:"learning_goal_score"` // Бонус за каждую общую цель изучения языка
+	// Частичные баллы за связанные (но не совпадающие) интересы из графа interest_relations
+	RelatedInterestScore     int `json:"related_interest_score"`      // Похожие интересы (anime и manga)
+	ParentChildInterestScore int `json:"parent_child_interest_score"` // Общий интерес и его частный случай (sports и fitness)
+	MinCompatibilityScore    int `json:"min_compatibility_score"`
+	MaxMatchesPerUser        int `json:"max_matches_per_user"`
 }
 
 // InterestLimitsConfig конфигурация лимитов интересов.
 type InterestLimitsConfig struct {
-	MinPrimaryInterests int     `json:"minPrimaryInterests"`
-	MaxPrimaryInterests int     `json:"maxPrimaryInterests"`
-	PrimaryPercentage   float64 `json:"primaryPercentage"`
+	MinPrimaryInterests int     `json:"min_primary_interests"`
+	MaxPrimaryInterests int     `json:"max_primary_interests"`
+	PrimaryPercentage   float64 `json:"primary_percentage"`
 }
 
 // CategoryConfig конфигурация категории.
 type CategoryConfig struct {
-	DisplayOrder          int `json:"displayOrder"`
-	MaxPrimaryPerCategory int `json:"maxPrimaryPerCategory"`
+	DisplayOrder          int `json:"display_order"`
+	MaxPrimaryPerCategory int `json:"max_primary_per_category"`
+}
+
+// InterestCatalogEntry описывает интерес в каталоге interests.json (ключ - key_name интереса).
+type InterestCatalogEntry struct {
+	Category     string            `json:"category"`
+	DisplayOrder int               `json:"display_order"`
+	PreviousKeys []string          `json:"previous_keys,omitempty"` // Прежние ключи: переименование вместо удаления
+	Translations map[string]string `json:"translations"`            // Код языка -> название
 }
 
 // LoadInterestsConfig загружает конфигурацию интересов из файла.
@@ -66,10 +83,13 @@ func LoadInterestsConfig() (*InterestsConfig, error) {
 		// Если файл не найден, создаем с дефолтными значениями
 		config := &InterestsConfig{
 			Matching: MatchingConfig{
-				PrimaryInterestScore:    localization.DefaultPrimaryInterestScore,
-				AdditionalInterestScore: localization.DefaultAdditionalInterestScore,
-				MinCompatibilityScore:   localization.DefaultMinCompatibilityScore,
-				MaxMatchesPerUser:       localization.DefaultMaxMatchesPerUser,
+				PrimaryInterestScore:     localization.DefaultPrimaryInterestScore,
+				AdditionalInterestScore:  localization.DefaultAdditionalInterestScore,
+				LearningGoalScore:        localization.DefaultLearningGoalScore,
+				RelatedInterestScore:     localization.DefaultRelatedInterestScore,
+				ParentChildInterestScore: localization.DefaultParentChildInterestScore,
+				MinCompatibilityScore:    localization.DefaultMinCompatibilityScore,
+				MaxMatchesPerUser:        localization.DefaultMaxMatchesPerUser,
 			},
 			InterestLimits: InterestLimitsConfig{
 				MinPrimaryInterests: localization.DefaultMinPrimaryInterests,
@@ -96,8 +116,19 @@ func LoadInterestsConfig() (*InterestsConfig, error) {
 		return nil, errorsPkg.ErrUnsafeFilePath
 	}
 
+	return readInterestsConfig(cleanPath)
+}
+
+// LoadInterestsConfigFile загружает конфигурацию интересов из явно указанного файла
+// (например, переданного флагом утилите синхронизации каталога).
+func LoadInterestsConfigFile(path string) (*InterestsConfig, error) {
+	return readInterestsConfig(filepath.Clean(path))
+}
+
+// readInterestsConfig читает и разбирает файл конфигурации интересов.
+func readInterestsConfig(path string) (*InterestsConfig, error) {
 	// Читаем файл
-	data, err := os.ReadFile(cleanPath)
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
 	}
@@ -108,9 +139,48 @@ func LoadInterestsConfig() (*InterestsConfig, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	if err := checkLegacyInterestsKeys(data); err != nil {
+		return nil, err
+	}
+
 	return &config, nil
 }
 
+// checkLegacyInterestsKeys отклоняет файл с ключами в camelCase (interestLimits,
+// primaryInterestScore, displayOrder, ...). Такие ключи не совпадают с тегами и
+// молча оставили бы баллы и лимиты нулевыми.
+func checkLegacyInterestsKeys(data []byte) error {
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal(data, &root); err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	var (
+		matching, limits map[string]json.RawMessage
+		categories       map[string]map[string]json.RawMessage
+	)
+
+	// Ошибки типов секций уже отсеяны разбором в InterestsConfig
+	_ = json.Unmarshal(root["matching"], &matching)
+	_ = json.Unmarshal(root["interest_limits"], &limits)
+	_ = json.Unmarshal(root["categories"], &categories)
+
+	sections := []map[string]json.RawMessage{root, matching, limits}
+	for _, category := range categories {
+		sections = append(sections, category)
+	}
+
+	for _, section := range sections {
+		for key := range section {
+			if strings.ToLower(key) != key {
+				return fmt.Errorf("%w: %q, use snake_case", errorsPkg.ErrLegacyInterestsConfigKey, key)
+			}
+		}
+	}
+
+	return nil
+}
+
 // GetInterestsConfig возвращает загруженную конфигурацию.
 func GetInterestsConfig() *InterestsConfig {
 	config, _ := LoadInterestsConfig()
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	errorsPkg "language-exchange-bot/internal/errors"
 	"language-exchange-bot/internal/localization"
 
 	"github.com/stretchr/testify/assert"
@@ -494,3 +495,74 @@ func TestInterestsConfig_Load_MultiplePaths(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 99, config.Matching.PrimaryInterestScore)
 }
+
+// TestInterestsConfig_Load_SnakeCaseKeys тестирует, что ключи файла (snake_case) совпадают с тегами структуры.
+func TestInterestsConfig_Load_SnakeCaseKeys(t *testing.T) {
+	tempDir := t.TempDir()
+	configDir := filepath.Join(tempDir, "config")
+	require.NoError(t, os.MkdirAll(configDir, 0755))
+
+	data := `{
+  "matching": {"primary_interest_score": 3, "additional_interest_score": 1, "learning_goal_score": 2,
+               "related_interest_score": 1, "parent_child_interest_score": 2,
+               "min_compatibility_score": 5, "max_matches_per_user": 10},
+  "interest_limits": {"min_primary_interests": 1, "max_primary_interests": 10, "primary_percentage": 0.1},
+  "categories": {"education": {"display_order": 2, "max_primary_per_category": 2}}
+}`
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "interests.json"), []byte(data), 0600))
+
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+
+	defer func() {
+		if chdirErr := os.Chdir(oldWd); chdirErr != nil {
+			t.Logf("Failed to restore working directory: %v", chdirErr)
+		}
+	}()
+
+	require.NoError(t, os.Chdir(tempDir))
+
+	config, err := LoadInterestsConfig()
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, config.Matching.PrimaryInterestScore)
+	assert.Equal(t, 2, config.Matching.LearningGoalScore)
+	assert.Equal(t, 1, config.Matching.RelatedInterestScore)
+	assert.Equal(t, 2, config.Matching.ParentChildInterestScore)
+	assert.Equal(t, 10, config.InterestLimits.MaxPrimaryInterests)
+	assert.Equal(t, 2, config.Categories["education"].MaxPrimaryPerCategory)
+}
+
+// TestInterestsConfig_LegacyCamelCaseKeys тестирует отказ загружать файл с ключами в camelCase,
+// которые раньше молча обнуляли баллы совместимости и лимиты.
+func TestInterestsConfig_LegacyCamelCaseKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		key  string
+	}{
+		{name: "matching", data: `{"matching": {"primaryInterestScore": 3}}`, key: "primaryInterestScore"},
+		{name: "limits section", data: `{"interestLimits": {"maxPrimaryInterests": 10}}`, key: "interestLimits"},
+		{name: "category", data: `{"categories": {"education": {"displayOrder": 2}}}`, key: "displayOrder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "interests.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.data), 0600))
+
+			_, err := LoadInterestsConfigFile(path)
+
+			require.ErrorIs(t, err, errorsPkg.ErrLegacyInterestsConfigKey)
+			assert.Contains(t, err.Error(), tt.key)
+		})
+	}
+
+	// Служебные комментарии и ключи категорий в нижнем регистре допустимы
+	path := filepath.Join(t.TempDir(), "interests.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"_comment": "x", "matching": {"primary_interest_score": 3}}`), 0600))
+
+	config, err := LoadInterestsConfigFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, config.Matching.PrimaryInterestScore)
+}
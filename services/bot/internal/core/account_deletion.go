@@ -0,0 +1,111 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// DeleteUserAccount удаляет аккаунт пользователя по его запросу (/deleteme): строки в базе,
+// записи кэша и незавершенные черновики, а текущих собеседников уведомляет. Журнал аудита только дополняется и не меняется: пользователь записан в нем
+// псевдонимом, а связь псевдонима с аккаунтом удаляется вместе с ним. Записи, сделанные до
+// введения псевдонимов, содержат Telegram ID и сохраняются как журнал безопасности.
+// Само удаление записывается без данных пользователя.
+func (s *BotService) DeleteUserAccount(user *models.User) (*models.AccountDeletionResult, error) {
+	result, err := s.deleteAccount(user)
+	if err != nil {
+		return nil, err
+	}
+
+	s.RecordAudit(&models.AuditEvent{
+		ActorType:  models.AuditActorUser,
+		Action:     audit.ActionUserAccountDelete,
+		TargetType: audit.TargetUser,
+		Details: map[string]interface{}{
+			"deletion_id":       result.Deletion.ID,
+			"matches_cancelled": result.Deletion.MatchesCancelled,
+			"partners_notified": result.Deletion.PartnersNotified,
+		},
+	})
+
+	return result, nil
+}
+
+// deleteAccount удаляет аккаунт, очищает кэш пользователя и уведомляет собеседников.
+// Общий путь для /deleteme и удаления неактивных пользователей по сроку хранения.
+func (s *BotService) deleteAccount(user *models.User) (*models.AccountDeletionResult, error) {
+	result, err := s.DB.DeleteUserAccount(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	s.purgeUserCache(user)
+
+	if len(result.Partners) > 0 && s.AccountDeletionNoticeFunc != nil {
+		if err := s.AccountDeletionNoticeFunc(result.Partners); err != nil {
+			log.Printf("Failed to notify partners about account deletion: %v", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetAccountDeletionStats возвращает обезличенную статистику удалений аккаунтов:
+// всего и за последние AccountDeletionStatsDays дней.
+func (s *BotService) GetAccountDeletionStats() (models.AccountDeletionStats, error) {
+	since := time.Now().AddDate(0, 0, -localization.AccountDeletionStatsDays)
+
+	stats, err := s.DB.GetAccountDeletionStats(since)
+	if err != nil {
+		return stats, fmt.Errorf("failed to get account deletion stats: %w", err)
+	}
+
+	stats.RecentDays = localization.AccountDeletionStatsDays
+
+	return stats, nil
+}
+
+// purgeUserCache удаляет из кэша (Redis или память) профиль и статистику пользователя,
+// а также его незавершенные черновики и сессии редактирования.
+func (s *BotService) purgeUserCache(user *models.User) {
+	if s.Cache == nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	// Профиль кэшируется по внутреннему ID, а ищется по Telegram ID - чистим оба ключа
+	for _, id := range []int64{int64(user.ID), user.TelegramID} {
+		s.Cache.InvalidateUser(ctx, id)
+		s.Cache.InvalidateUserStats(ctx, id)
+	}
+
+	for _, key := range UserDraftCacheKeys(user) {
+		_ = s.Cache.Delete(ctx, key)
+	}
+}
+
+// UserDraftCacheKeys возвращает ключи кэша, под которыми хранятся черновики и сессии
+// ввода пользователя: настройка и редактирование доступности, языков и интересов,
+// составляемый отзыв, ответы на отзывы и состояние админ-панели.
+func UserDraftCacheKeys(user *models.User) []string {
+	telegramID := strconv.FormatInt(user.TelegramID, 10)
+
+	return []string{
+		fmt.Sprintf("availability_setup:%d", user.ID),
+		fmt.Sprintf("availability_edit_session:%d", user.ID),
+		fmt.Sprintf("language_edit_session:%d", user.ID),
+		fmt.Sprintf("edit_session_%d", user.ID),
+		localization.FeedbackDraftPrefix + telegramID,
+		localization.FeedbackReplyTargetPrefix + telegramID,
+		localization.FeedbackAnswerTargetPrefix + telegramID,
+		localization.FeedbackFilterPrefix + telegramID,
+		localization.AdminPanelDMTargetPrefix + telegramID,
+	}
+}
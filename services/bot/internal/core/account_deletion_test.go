@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/cache"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestDeleteUserAccount тестирует удаление аккаунта: черновики уходят из кэша, собеседники
+// получают уведомление, в журнал аудита пишется запись без данных пользователя.
+func TestDeleteUserAccount(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	cacheService := cache.NewService(cache.DefaultConfig())
+	t.Cleanup(cacheService.Stop)
+	service.Cache = cacheService
+
+	user := &models.User{ID: 7, TelegramID: 1007, Username: "anna"}
+	keys := UserDraftCacheKeys(user)
+
+	for _, key := range keys {
+		require.NoError(t, cacheService.Set(context.Background(), key, "draft", time.Hour))
+	}
+
+	var notified []models.MatchPartner
+
+	service.SetAccountDeletionNoticeFunc(func(partners []models.MatchPartner) error {
+		notified = partners
+
+		return nil
+	})
+
+	partners := []models.MatchPartner{{UserID: 8, TelegramID: 1008, InterfaceLanguageCode: "es"}}
+	mockDB.On("DeleteUserAccount", 7).Return(&models.AccountDeletionResult{
+		Deletion: models.AccountDeletion{ID: 3, MatchesCancelled: 2, PartnersNotified: 1},
+		Partners: partners,
+	}, nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionUserAccountDelete && event.ActorID == "" && event.TargetID == "" &&
+			event.Details["deletion_id"] == 3 && event.Details["matches_cancelled"] == 2
+	})).Return(nil)
+
+	result, err := service.DeleteUserAccount(user)
+
+	require.NoError(t, err)
+	assert.Equal(t, partners, result.Partners)
+	assert.Equal(t, partners, notified)
+
+	for _, key := range keys {
+		var draft string
+		assert.Error(t, cacheService.Get(context.Background(), key, &draft), key)
+	}
+
+	mockDB.AssertExpectations(t)
+}
+
+// TestDeleteUserAccount_Error тестирует, что при ошибке базы аудит не пишется.
+func TestDeleteUserAccount_Error(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("DeleteUserAccount", 7).Return(nil, errors.New("db down"))
+
+	_, err := service.DeleteUserAccount(&models.User{ID: 7})
+
+	require.Error(t, err)
+	mockDB.AssertNotCalled(t, "AppendAuditEvent", mock.Anything)
+}
+
+// TestGetAccountDeletionStats тестирует окно статистики удалений.
+func TestGetAccountDeletionStats(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	before := time.Now().AddDate(0, 0, -localization.AccountDeletionStatsDays)
+
+	mockDB.On("GetAccountDeletionStats", mock.MatchedBy(func(since time.Time) bool {
+		return !since.Before(before) && since.Before(time.Now())
+	})).Return(models.AccountDeletionStats{Total: 5, Recent: 2}, nil)
+
+	stats, err := service.GetAccountDeletionStats()
+
+	require.NoError(t, err)
+	assert.Equal(t, models.AccountDeletionStats{Total: 5, Recent: 2, RecentDays: localization.AccountDeletionStatsDays}, stats)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,250 @@
+package core
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// AdminAssignableStatuses - статусы, которые администратор может назначить пользователю.
+var AdminAssignableStatuses = []string{
+	models.StatusNew,
+	models.StatusFilling,
+	models.StatusActive,
+	models.StatusPaused,
+}
+
+// AdminForcibleStates - состояния, в которые администратор может перевести пользователя.
+// Служебные состояния ввода (отзыв, даты отпуска, админ-панель) сюда не входят:
+// без контекста диалога пользователь в них застрянет.
+var AdminForcibleStates = []string{
+	models.StateActive,
+	models.StateNew,
+	models.StateWaitingLanguage,
+	models.StateWaitingTargetLanguage,
+	models.StateWaitingLanguageLevel,
+	models.StateWaitingInterests,
+	models.StateWaitingTimeAvailability,
+	models.StateWaitingFriendshipPreferences,
+}
+
+// SearchUsersForAdmin ищет пользователей по username, Telegram ID или внутреннему ID.
+func (s *BotService) SearchUsersForAdmin(admin *models.User, query string) ([]*models.User, error) {
+	if !s.UserHasPermission(admin, PermissionViewUsers) {
+		return nil, errorsPkg.ErrPermissionDenied
+	}
+
+	query = strings.TrimPrefix(strings.TrimSpace(query), "@")
+	if query == "" {
+		return nil, errorsPkg.ErrInvalidUserInput
+	}
+
+	users, err := s.DB.SearchUsers(query, localization.AdminUserSearchLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	return users, nil
+}
+
+// GetAdminUserCard собирает карточку пользователя: профиль, последние отзывы и действия администраторов.
+func (s *BotService) GetAdminUserCard(admin *models.User, userID int) (*models.AdminUserCard, error) {
+	if !s.UserHasPermission(admin, PermissionViewUsers) {
+		return nil, errorsPkg.ErrPermissionDenied
+	}
+
+	user, err := s.getAdminTarget(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	feedback, err := s.DB.GetUserFeedbackByUserID(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user feedback: %w", err)
+	}
+
+	if len(feedback) > localization.AdminCardFeedbackLimit {
+		feedback = feedback[:localization.AdminCardFeedbackLimit]
+	}
+
+	actions, err := s.DB.GetAdminActionLogs(models.AdminTargetUser, user.ID, localization.AdminCardActionLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin action logs: %w", err)
+	}
+
+	return &models.AdminUserCard{User: user, Feedback: feedback, Actions: actions}, nil
+}
+
+// AdminResetUserProfile сбрасывает профиль пользователя и записывает действие в журнал.
+func (s *BotService) AdminResetUserProfile(admin *models.User, userID int) (*models.User, error) {
+	if !s.UserHasPermission(admin, PermissionManageUsers) {
+		return nil, errorsPkg.ErrPermissionDenied
+	}
+
+	user, err := s.getAdminTarget(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.ResetUserProfile(user.ID); err != nil {
+		return nil, fmt.Errorf("failed to reset user profile: %w", err)
+	}
+
+	s.InvalidateUserCache(user.TelegramID)
+
+	details := map[string]interface{}{"state": user.State, "status": user.Status}
+	if err := s.logAdminAction(admin, models.AdminActionResetProfile, user.ID, details); err != nil {
+		return nil, err
+	}
+
+	return s.getAdminTarget(user.ID)
+}
+
+// AdminChangeUserStatus меняет статус пользователя на один из AdminAssignableStatuses.
+func (s *BotService) AdminChangeUserStatus(admin *models.User, userID int, status string) (*models.User, error) {
+	if !s.UserHasPermission(admin, PermissionManageUsers) {
+		return nil, errorsPkg.ErrPermissionDenied
+	}
+
+	if !slices.Contains(AdminAssignableStatuses, status) {
+		return nil, fmt.Errorf("%w: status %q", errorsPkg.ErrInvalidAdminAction, status)
+	}
+
+	user, err := s.getAdminTarget(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.UpdateUserStatus(user.ID, status); err != nil {
+		return nil, fmt.Errorf("failed to update user status: %w", err)
+	}
+
+	s.InvalidateUserCache(user.TelegramID)
+
+	details := map[string]interface{}{"from": user.Status, "to": status}
+	if err := s.logAdminAction(admin, models.AdminActionChangeStatus, user.ID, details); err != nil {
+		return nil, err
+	}
+
+	user.Status = status
+
+	return user, nil
+}
+
+// AdminForceUserState переводит пользователя в одно из AdminForcibleStates.
+func (s *BotService) AdminForceUserState(admin *models.User, userID int, state string) (*models.User, error) {
+	if !s.UserHasPermission(admin, PermissionManageUsers) {
+		return nil, errorsPkg.ErrPermissionDenied
+	}
+
+	if !slices.Contains(AdminForcibleStates, state) {
+		return nil, fmt.Errorf("%w: state %q", errorsPkg.ErrInvalidAdminAction, state)
+	}
+
+	user, err := s.getAdminTarget(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.UpdateUserState(user.ID, state); err != nil {
+		return nil, fmt.Errorf("failed to update user state: %w", err)
+	}
+
+	s.InvalidateUserCache(user.TelegramID)
+
+	details := map[string]interface{}{"from": user.State, "to": state}
+	if err := s.logAdminAction(admin, models.AdminActionForceState, user.ID, details); err != nil {
+		return nil, err
+	}
+
+	user.State = state
+
+	return user, nil
+}
+
+// PrepareAdminMessage проверяет право и текст сообщения пользователю и возвращает адресата.
+// Сообщения доставляются через Telegram: пользователям других платформ писать нельзя.
+// Отправку выполняет адаптер мессенджера, после нее вызывается LogAdminMessage.
+func (s *BotService) PrepareAdminMessage(admin *models.User, userID int, text string) (*models.User, string, error) {
+	if !s.UserHasPermission(admin, PermissionMessageUsers) {
+		return nil, "", errorsPkg.ErrPermissionDenied
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > localization.MaxAdminMessageLength {
+		return nil, "", errorsPkg.ErrInvalidUserInput
+	}
+
+	user, err := s.getMessageRecipient(userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return user, text, nil
+}
+
+// LogAdminMessage записывает в журнал отправленное пользователю сообщение.
+func (s *BotService) LogAdminMessage(admin *models.User, userID int, text string) error {
+	if !s.UserHasPermission(admin, PermissionMessageUsers) {
+		return errorsPkg.ErrPermissionDenied
+	}
+
+	return s.logAdminAction(admin, models.AdminActionSendMessage, userID, map[string]interface{}{"text": text})
+}
+
+// getMessageRecipient загружает адресата личного сообщения из админ-панели или ответа на отзыв.
+// Пользователям других платформ сообщение не доставить - ErrRecipientNotOnTelegram.
+func (s *BotService) getMessageRecipient(userID int) (*models.User, error) {
+	user, err := s.getAdminTarget(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.OnTelegram() {
+		return nil, errorsPkg.ErrRecipientNotOnTelegram
+	}
+
+	return user, nil
+}
+
+// getAdminTarget загружает пользователя по внутреннему ID; отсутствие пользователя - ErrUserNotFound.
+func (s *BotService) getAdminTarget(userID int) (*models.User, error) {
+	user, err := s.DB.GetUserByID(userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorsPkg.ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// logAdminAction пишет действие администратора в журнал аудита и в admin_action_logs.
+// Источник истины - журнал аудита; admin_action_logs только показывает историю в карточке пользователя.
+func (s *BotService) logAdminAction(admin *models.User, action string, targetID int, details map[string]interface{}) error {
+	entry := &models.AdminActionLog{
+		AdminID:    admin.ID,
+		Action:     action,
+		TargetType: models.AdminTargetUser,
+		TargetID:   targetID,
+		Details:    details,
+	}
+
+	s.RecordUserAudit(admin, adminAuditActions[action], audit.TargetUser, targetID, adminAuditDetails(action, details))
+
+	if err := s.DB.CreateAdminActionLog(entry); err != nil {
+		return fmt.Errorf("failed to log admin action: %w", err)
+	}
+
+	return nil
+}
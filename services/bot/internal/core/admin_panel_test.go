@@ -0,0 +1,132 @@
+package core
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestSearchUsersForAdmin тестирует нормализацию запроса и проверку права.
+func TestSearchUsersForAdmin(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	moderator := &models.User{ID: 1, Role: models.RoleModerator}
+
+	mockDB.On("SearchUsers", "alice", localization.AdminUserSearchLimit).Return([]*models.User{{ID: 7, Username: "alice"}}, nil)
+
+	users, err := service.SearchUsersForAdmin(moderator, "  @alice ")
+	require.NoError(t, err)
+	assert.Len(t, users, 1)
+
+	_, err = service.SearchUsersForAdmin(moderator, " @ ")
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidUserInput)
+
+	_, err = service.SearchUsersForAdmin(&models.User{ID: 2, Role: models.RoleUser}, "alice")
+	require.ErrorIs(t, err, errorsPkg.ErrPermissionDenied)
+
+	mockDB.AssertNumberOfCalls(t, "SearchUsers", 1)
+}
+
+// TestGetAdminUserCard тестирует сборку карточки и отсутствие пользователя.
+func TestGetAdminUserCard(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	admin := &models.User{ID: 1, Role: models.RoleAdmin}
+
+	feedback := make([]map[string]interface{}, localization.AdminCardFeedbackLimit+2)
+	mockDB.On("GetUserByID", 7).Return(&models.User{ID: 7}, nil)
+	mockDB.On("GetUserByID", 8).Return(nil, sql.ErrNoRows)
+	mockDB.On("GetUserFeedbackByUserID", 7).Return(feedback, nil)
+	mockDB.On("GetAdminActionLogs", models.AdminTargetUser, 7, localization.AdminCardActionLimit).
+		Return([]models.AdminActionLog{{Action: models.AdminActionChangeStatus}}, nil)
+
+	card, err := service.GetAdminUserCard(admin, 7)
+	require.NoError(t, err)
+	assert.Len(t, card.Feedback, localization.AdminCardFeedbackLimit)
+	assert.Len(t, card.Actions, 1)
+
+	_, err = service.GetAdminUserCard(admin, 8)
+	require.ErrorIs(t, err, errorsPkg.ErrUserNotFound)
+}
+
+// TestAdminChangeUserStatus тестирует смену статуса с записью в журнал.
+func TestAdminChangeUserStatus(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	admin := &models.User{ID: 1, TelegramID: 1001, Role: models.RoleAdmin}
+
+	mockDB.On("GetUserByID", 7).Return(&models.User{ID: 7, Status: models.StatusActive}, nil)
+	mockDB.On("UpdateUserStatus", 7, models.StatusPaused).Return(nil)
+	mockDB.On("EnsureAuditPseudonym", 1, mock.AnythingOfType("string")).Return("p_admin", nil)
+	mockDB.On("EnsureAuditPseudonym", 7, mock.AnythingOfType("string")).Return("p_target", nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.ActorType == models.AuditActorAdmin && event.ActorID == "p_admin" && event.Action == "user.status.change" &&
+			event.TargetType == audit.TargetUser && event.TargetID == "p_target" && event.Result == models.AuditResultSuccess
+	})).Return(nil)
+	mockDB.On("CreateAdminActionLog", mock.MatchedBy(func(entry *models.AdminActionLog) bool {
+		return entry.AdminID == 1 && entry.TargetID == 7 && entry.Action == models.AdminActionChangeStatus &&
+			entry.Details["from"] == models.StatusActive && entry.Details["to"] == models.StatusPaused
+	})).Return(nil)
+
+	user, err := service.AdminChangeUserStatus(admin, 7, models.StatusPaused)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusPaused, user.Status)
+	mockDB.AssertExpectations(t)
+}
+
+// TestAdminActions_Rejected тестирует отказ до изменения данных.
+func TestAdminActions_Rejected(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	admin := &models.User{ID: 1, Role: models.RoleAdmin}
+	moderator := &models.User{ID: 2, Role: models.RoleModerator}
+
+	_, err := service.AdminChangeUserStatus(moderator, 7, models.StatusPaused)
+	require.ErrorIs(t, err, errorsPkg.ErrPermissionDenied)
+
+	_, err = service.AdminResetUserProfile(moderator, 7)
+	require.ErrorIs(t, err, errorsPkg.ErrPermissionDenied)
+
+	_, err = service.AdminChangeUserStatus(admin, 7, "banned")
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidAdminAction)
+
+	_, err = service.AdminForceUserState(admin, 7, models.StateWaitingAdminMessage)
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidAdminAction)
+
+	mockDB.AssertNotCalled(t, "GetUserByID", mock.Anything)
+	mockDB.AssertNotCalled(t, "CreateAdminActionLog", mock.Anything)
+}
+
+// TestPrepareAdminMessage тестирует проверку текста, права модератора и платформы адресата.
+func TestPrepareAdminMessage(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	moderator := &models.User{ID: 2, Role: models.RoleModerator}
+
+	mockDB.On("GetUserByID", 7).Return(&models.User{ID: 7, TelegramID: 700}, nil)
+
+	target, text, err := service.PrepareAdminMessage(moderator, 7, "  hello  ")
+	require.NoError(t, err)
+	assert.Equal(t, int64(700), target.TelegramID)
+	assert.Equal(t, "hello", text)
+
+	_, _, err = service.PrepareAdminMessage(moderator, 7, "   ")
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidUserInput)
+
+	_, _, err = service.PrepareAdminMessage(&models.User{ID: 3, Role: models.RoleUser}, 7, "hello")
+	require.ErrorIs(t, err, errorsPkg.ErrPermissionDenied)
+
+	mockDB.On("GetUserByID", 8).Return(&models.User{ID: 8, TelegramID: -3, Platform: models.PlatformDiscord}, nil)
+
+	_, _, err = service.PrepareAdminMessage(moderator, 8, "hello")
+	require.ErrorIs(t, err, errorsPkg.ErrRecipientNotOnTelegram)
+}
@@ -0,0 +1,236 @@
+package core
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/auth"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// LoginAdmin открывает сессию admin API по данным Telegram Login Widget. Войти может
+// пользователь, у роли которого есть хотя бы одно право (модератор или администратор).
+func (s *BotService) LoginAdmin(loginFields map[string]string) (*models.AdminTokens, error) {
+	secret, err := s.adminJWTSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	telegramID, err := auth.VerifyTelegramLogin(loginFields, s.Config.TelegramToken, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.getUserForRole(telegramID)
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrUserNotFound) {
+			return nil, errorsPkg.ErrAdminAccessDenied
+		}
+
+		return nil, err
+	}
+
+	if len(RolePermissions(user.Role)) == 0 {
+		s.RecordAudit(&models.AuditEvent{
+			ActorType: models.AuditActorUser,
+			ActorID:   s.AuditPseudonym(user.ID),
+			Action:    audit.ActionAdminLogin,
+			Result:    models.AuditResultFailure,
+		})
+
+		return nil, errorsPkg.ErrAdminAccessDenied
+	}
+
+	session, refreshToken, err := s.newAdminSession(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.CreateAdminSession(session); err != nil {
+		return nil, fmt.Errorf("failed to create admin session: %w", err)
+	}
+
+	s.RecordUserAudit(user, audit.ActionAdminLogin, "", 0, map[string]interface{}{"sessionId": session.ID})
+
+	return s.issueAdminTokens(user, session, refreshToken, secret)
+}
+
+// RefreshAdminSession обменивает refresh-токен на новую пару токенов. Старый refresh-токен
+// отзывается, поэтому повторно его использовать нельзя. Роль перечитывается из БД:
+// понижение роли отзывает сессию при следующем обновлении.
+func (s *BotService) RefreshAdminSession(refreshToken string) (*models.AdminTokens, error) {
+	secret, err := s.adminJWTSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.getAdminSession(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.DB.GetUserByID(session.UserID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if user == nil || len(RolePermissions(user.Role)) == 0 {
+		if err := s.DB.RevokeAdminSession(session.ID); err != nil {
+			return nil, fmt.Errorf("failed to revoke admin session: %w", err)
+		}
+
+		return nil, errorsPkg.ErrAdminAccessDenied
+	}
+
+	newSession, newRefreshToken, err := s.newAdminSession(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.RotateAdminSession(session.ID, newSession); err != nil {
+		if errors.Is(err, errorsPkg.ErrAdminSessionNotFound) {
+			return nil, errorsPkg.ErrInvalidAdminToken
+		}
+
+		return nil, fmt.Errorf("failed to rotate admin session: %w", err)
+	}
+
+	return s.issueAdminTokens(user, newSession, newRefreshToken, secret)
+}
+
+// LogoutAdmin отзывает сессию по refresh-токену. Уже выданный access-токен действует
+// до истечения своего короткого срока.
+func (s *BotService) LogoutAdmin(refreshToken string) error {
+	session, err := s.getAdminSession(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	if err := s.DB.RevokeAdminSession(session.ID); err != nil {
+		return fmt.Errorf("failed to revoke admin session: %w", err)
+	}
+
+	return nil
+}
+
+// AuthenticateAdminToken проверяет access-токен локально, без обращения к БД:
+// подпись, издателя и срок действия. Неверный или истекший токен - ErrInvalidAdminToken.
+func (s *BotService) AuthenticateAdminToken(accessToken string) (*models.AdminClaims, error) {
+	secret, err := s.adminJWTSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	var claims models.AdminClaims
+	if err := auth.ParseJWT(accessToken, secret, &claims); err != nil {
+		return nil, err
+	}
+
+	if claims.Issuer != localization.AdminTokenIssuer || time.Now().Unix() >= claims.ExpiresAt {
+		return nil, errorsPkg.ErrInvalidAdminToken
+	}
+
+	return &claims, nil
+}
+
+// getAdminSession возвращает действующую сессию по refresh-токену.
+func (s *BotService) getAdminSession(refreshToken string) (*models.AdminSession, error) {
+	if !strings.HasPrefix(refreshToken, localization.AdminRefreshTokenPrefix) {
+		return nil, errorsPkg.ErrInvalidAdminToken
+	}
+
+	// Refresh-токен, как и ключ admin API, содержит 256 случайных бит и хешируется SHA-256
+	session, err := s.DB.GetAdminSessionByHash(HashAPIKey(refreshToken))
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrAdminSessionNotFound) {
+			return nil, errorsPkg.ErrInvalidAdminToken
+		}
+
+		return nil, fmt.Errorf("failed to get admin session: %w", err)
+	}
+
+	if !session.IsUsable(time.Now()) {
+		return nil, errorsPkg.ErrInvalidAdminToken
+	}
+
+	return session, nil
+}
+
+// newAdminSession генерирует refresh-токен и заполняет сессию для сохранения.
+func (s *BotService) newAdminSession(userID int) (*models.AdminSession, string, error) {
+	secret := make([]byte, localization.AdminRefreshTokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	refreshToken := localization.AdminRefreshTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	session := &models.AdminSession{
+		UserID:    userID,
+		TokenHash: HashAPIKey(refreshToken),
+		ExpiresAt: time.Now().AddDate(0, 0, s.Config.AdminRefreshTokenDays),
+	}
+
+	return session, refreshToken, nil
+}
+
+// issueAdminTokens подписывает access-токен с ролью и правами пользователя.
+func (s *BotService) issueAdminTokens(
+	user *models.User,
+	session *models.AdminSession,
+	refreshToken string,
+	secret []byte,
+) (*models.AdminTokens, error) {
+	permissions := RolePermissions(user.Role)
+
+	scopes := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		scopes = append(scopes, string(permission))
+	}
+
+	now := time.Now()
+	lifetime := time.Duration(s.Config.AdminAccessTokenMinutes) * time.Minute
+
+	accessToken, err := auth.SignJWT(models.AdminClaims{
+		Issuer:      localization.AdminTokenIssuer,
+		Subject:     strconv.FormatInt(user.TelegramID, 10),
+		UserID:      user.ID,
+		TelegramID:  user.TelegramID,
+		Role:        user.Role,
+		Permissions: scopes,
+		SessionID:   session.ID,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   now.Add(lifetime).Unix(),
+	}, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign access token: %w", err)
+	}
+
+	return &models.AdminTokens{
+		AccessToken:      accessToken,
+		TokenType:        localization.AdminTokenType,
+		ExpiresIn:        int(lifetime.Seconds()),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: session.ExpiresAt,
+		Role:             user.Role,
+		Permissions:      scopes,
+	}, nil
+}
+
+// adminJWTSecret возвращает ключ подписи access-токенов. Без ADMIN_JWT_SECRET вход по JWT отключен.
+func (s *BotService) adminJWTSecret() ([]byte, error) {
+	if s.Config == nil || s.Config.AdminJWTSecret == "" {
+		return nil, errorsPkg.ErrAdminSessionsDisabled
+	}
+
+	return []byte(s.Config.AdminJWTSecret), nil
+}
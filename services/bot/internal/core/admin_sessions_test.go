@@ -0,0 +1,143 @@
+package core
+
+import (
+	"database/sql"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/auth"
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+const testBotToken = "123456:test-token"
+
+// newAdminSessionService создает сервис с включенным входом по JWT.
+func newAdminSessionService(mockDB *MockDatabase) *BotService {
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	service.Config = &config.Config{
+		TelegramToken:           testBotToken,
+		AdminJWTSecret:          "0123456789abcdef0123456789abcdef",
+		AdminAccessTokenMinutes: 15,
+		AdminRefreshTokenDays:   30,
+	}
+
+	return service
+}
+
+// signedTelegramLogin возвращает подписанные данные Telegram Login Widget.
+func signedTelegramLogin(telegramID int64) map[string]string {
+	fields := map[string]string{
+		"id":         strconv.FormatInt(telegramID, 10),
+		"first_name": "Admin",
+		"auth_date":  strconv.FormatInt(time.Now().Unix(), 10),
+	}
+	fields["hash"] = auth.TelegramLoginHash(fields, testBotToken)
+
+	return fields
+}
+
+// TestLoginAdmin тестирует выдачу токенов с ролью и правами и отказ обычному пользователю.
+func TestLoginAdmin(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := newAdminSessionService(mockDB)
+
+	mockDB.On("GetUserByTelegramID", int64(1001)).Return(&models.User{ID: 1, TelegramID: 1001, Role: models.RoleModerator}, nil)
+	mockDB.On("GetUserByTelegramID", int64(1002)).Return(&models.User{ID: 2, TelegramID: 1002, Role: models.RoleUser}, nil)
+	mockDB.On("CreateAdminSession", mock.AnythingOfType("*models.AdminSession")).Run(func(args mock.Arguments) {
+		session, _ := args.Get(0).(*models.AdminSession)
+		session.ID = 7
+	}).Return(nil)
+	mockDB.On("EnsureAuditPseudonym", 1, mock.AnythingOfType("string")).Return("p_moderator", nil)
+	mockDB.On("EnsureAuditPseudonym", 2, mock.AnythingOfType("string")).Return("p_member", nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionAdminLogin && event.ActorID == "p_moderator" && event.Result == models.AuditResultSuccess
+	})).Return(nil).Once()
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionAdminLogin && event.ActorID == "p_member" && event.Result == models.AuditResultFailure
+	})).Return(nil).Once()
+
+	tokens, err := service.LoginAdmin(signedTelegramLogin(1001))
+	require.NoError(t, err)
+	assert.Equal(t, localization.AdminTokenType, tokens.TokenType)
+	assert.Equal(t, 15*60, tokens.ExpiresIn)
+
+	claims, err := service.AuthenticateAdminToken(tokens.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1001), claims.TelegramID)
+	assert.Equal(t, 7, claims.SessionID)
+	assert.Equal(t, models.RoleModerator, claims.Role)
+	assert.True(t, claims.HasPermission(string(PermissionViewFeedback)))
+	assert.False(t, claims.HasPermission(string(PermissionManageRoles)))
+
+	_, err = service.LoginAdmin(signedTelegramLogin(1002))
+	require.ErrorIs(t, err, errorsPkg.ErrAdminAccessDenied)
+
+	forged := signedTelegramLogin(1001)
+	forged["id"] = "1003"
+
+	_, err = service.LoginAdmin(forged)
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidTelegramLogin)
+
+	mockDB.AssertNumberOfCalls(t, "CreateAdminSession", 1)
+	mockDB.AssertExpectations(t)
+}
+
+// TestAuthenticateAdminToken тестирует отказ для истекшего токена и отключенного входа.
+func TestAuthenticateAdminToken(t *testing.T) {
+	service := newAdminSessionService(new(MockDatabase))
+	secret := []byte(service.Config.AdminJWTSecret)
+
+	expired, err := auth.SignJWT(models.AdminClaims{
+		Issuer:    localization.AdminTokenIssuer,
+		ExpiresAt: time.Now().Add(-time.Second).Unix(),
+	}, secret)
+	require.NoError(t, err)
+
+	_, err = service.AuthenticateAdminToken(expired)
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidAdminToken)
+
+	service.Config.AdminJWTSecret = ""
+
+	_, err = service.AuthenticateAdminToken(expired)
+	require.ErrorIs(t, err, errorsPkg.ErrAdminSessionsDisabled)
+}
+
+// TestRefreshAdminSession тестирует обмен refresh-токена и отзыв сессии пониженного пользователя.
+func TestRefreshAdminSession(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := newAdminSessionService(mockDB)
+	expiresAt := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Minute)
+
+	mockDB.On("GetAdminSessionByHash", HashAPIKey("lxr_active")).Return(&models.AdminSession{ID: 3, UserID: 1, ExpiresAt: expiresAt}, nil)
+	mockDB.On("GetAdminSessionByHash", HashAPIKey("lxr_demoted")).Return(&models.AdminSession{ID: 4, UserID: 2, ExpiresAt: expiresAt}, nil)
+	mockDB.On("GetAdminSessionByHash", HashAPIKey("lxr_used")).Return(&models.AdminSession{ID: 5, UserID: 1, ExpiresAt: expiresAt, RevokedAt: &past}, nil)
+	mockDB.On("GetUserByID", 1).Return(&models.User{ID: 1, TelegramID: 1001, Role: models.RoleAdmin}, nil)
+	mockDB.On("GetUserByID", 2).Return(nil, sql.ErrNoRows)
+	mockDB.On("RotateAdminSession", 3, mock.AnythingOfType("*models.AdminSession")).Return(nil)
+	mockDB.On("RevokeAdminSession", 4).Return(nil)
+
+	tokens, err := service.RefreshAdminSession("lxr_active")
+	require.NoError(t, err)
+	assert.NotEqual(t, "lxr_active", tokens.RefreshToken)
+	assert.Equal(t, models.RoleAdmin, tokens.Role)
+
+	_, err = service.RefreshAdminSession("lxr_demoted")
+	require.ErrorIs(t, err, errorsPkg.ErrAdminAccessDenied)
+
+	for _, refreshToken := range []string{"lxr_used", "not-a-refresh-token"} {
+		_, err = service.RefreshAdminSession(refreshToken)
+		require.ErrorIs(t, err, errorsPkg.ErrInvalidAdminToken, refreshToken)
+	}
+
+	mockDB.AssertExpectations(t)
+}
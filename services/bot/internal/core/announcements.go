@@ -0,0 +1,436 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// maxProfileCompletion - верхняя граница уровня заполненности профиля.
+const maxProfileCompletion = 100
+
+// AnnouncementSender отправляет текст анонса в чат мессенджера.
+// Реализация возвращает ErrRecipientBlocked, если пользователь заблокировал бота,
+// и *RetryAfterError, если мессенджер просит подождать.
+type AnnouncementSender interface {
+	SendAnnouncement(chatID int64, text string) error
+}
+
+// RetryAfterError - мессенджер ограничил частоту отправки и просит повторить позже.
+type RetryAfterError struct {
+	Delay time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return fmt.Sprintf("rate limited, retry after %s", e.Delay)
+}
+
+// CreateAnnouncement проверяет и сохраняет черновик анонса.
+// В RecipientsCount возвращается текущий размер сегмента.
+func (s *BotService) CreateAnnouncement(input models.AnnouncementInput, createdBy int) (*models.Announcement, error) {
+	announcement := &models.Announcement{
+		Title:     strings.TrimSpace(input.Title),
+		Message:   strings.TrimSpace(input.Message),
+		Segment:   input.Segment,
+		CreatedBy: createdBy,
+	}
+
+	if err := normalizeAnnouncement(announcement); err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.CreateAnnouncement(announcement); err != nil {
+		return nil, fmt.Errorf("failed to create announcement: %w", err)
+	}
+
+	recipients, err := s.DB.CountAnnouncementRecipients(announcement.Segment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count announcement recipients: %w", err)
+	}
+
+	announcement.RecipientsCount = recipients
+
+	return announcement, nil
+}
+
+// PreviewAnnouncement возвращает анонс с текстом в том виде, в каком его получат пользователи.
+// Для черновика RecipientsCount - текущий размер сегмента, после запуска - размер очереди.
+func (s *BotService) PreviewAnnouncement(announcementID int) (*models.Announcement, string, error) {
+	announcement, err := s.DB.GetAnnouncement(announcementID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if announcement.Status == models.AnnouncementStatusDraft {
+		recipients, err := s.DB.CountAnnouncementRecipients(announcement.Segment)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to count announcement recipients: %w", err)
+		}
+
+		announcement.RecipientsCount = recipients
+	}
+
+	return announcement, FormatAnnouncement(announcement), nil
+}
+
+// GetAnnouncements возвращает последние анонсы со счетчиками доставки.
+func (s *BotService) GetAnnouncements(limit int) ([]models.Announcement, error) {
+	return s.DB.GetAnnouncements(limit)
+}
+
+// GetAnnouncementDeliveries возвращает доставки анонса; пустой статус - все доставки.
+func (s *BotService) GetAnnouncementDeliveries(announcementID int, status string, limit int) ([]models.AnnouncementDelivery, error) {
+	if status != "" && !slices.Contains(announcementDeliveryStatuses, status) {
+		return nil, fmt.Errorf("%w: delivery status %q", errorsPkg.ErrInvalidAnnouncement, status)
+	}
+
+	if _, err := s.DB.GetAnnouncement(announcementID); err != nil {
+		return nil, err
+	}
+
+	return s.DB.GetAnnouncementDeliveries(announcementID, status, limit)
+}
+
+// CancelAnnouncement отменяет черновик или останавливает идущую рассылку.
+// Уже доставленные сообщения остаются у получателей.
+func (s *BotService) CancelAnnouncement(announcementID int) error {
+	if _, err := s.DB.GetAnnouncement(announcementID); err != nil {
+		return err
+	}
+
+	return s.DB.CancelAnnouncement(announcementID)
+}
+
+// FormatAnnouncement собирает текст сообщения из заголовка и текста анонса.
+func FormatAnnouncement(announcement *models.Announcement) string {
+	return announcement.Title + "\n\n" + announcement.Message
+}
+
+// announcementDeliveryStatuses - статусы, по которым можно фильтровать доставки.
+var announcementDeliveryStatuses = []string{
+	models.DeliveryStatusPending,
+	models.DeliveryStatusSent,
+	models.DeliveryStatusFailed,
+	models.DeliveryStatusBlocked,
+}
+
+// normalizeAnnouncement проверяет длину текста и нормализует фильтры сегмента.
+func normalizeAnnouncement(announcement *models.Announcement) error {
+	titleLength := utf8.RuneCountInString(announcement.Title)
+	if titleLength == 0 || titleLength > localization.MaxAnnouncementTitleLength {
+		return fmt.Errorf("%w: title must be 1-%d characters", errorsPkg.ErrInvalidAnnouncement, localization.MaxAnnouncementTitleLength)
+	}
+
+	messageLength := utf8.RuneCountInString(announcement.Message)
+	if messageLength == 0 || messageLength > localization.MaxAnnouncementLength {
+		return fmt.Errorf("%w: message must be 1-%d characters", errorsPkg.ErrInvalidAnnouncement, localization.MaxAnnouncementLength)
+	}
+
+	return normalizeSegment(&announcement.Segment, errorsPkg.ErrInvalidAnnouncement)
+}
+
+// normalizeSegment нормализует фильтры сегмента и проверяет их; ошибки оборачивают errInvalid.
+func normalizeSegment(segment *models.AnnouncementSegment, errInvalid error) error {
+	segment.InterfaceLanguages = normalizeSegmentValues(segment.InterfaceLanguages)
+	segment.TargetLanguages = normalizeSegmentValues(segment.TargetLanguages)
+	segment.Statuses = normalizeSegmentValues(segment.Statuses)
+
+	for _, status := range segment.Statuses {
+		if !slices.Contains(AdminAssignableStatuses, status) {
+			return fmt.Errorf("%w: status %q", errInvalid, status)
+		}
+	}
+
+	if segment.MinProfileCompletion < 0 || segment.MinProfileCompletion > maxProfileCompletion {
+		return fmt.Errorf("%w: minProfileCompletion must be 0-%d", errInvalid, maxProfileCompletion)
+	}
+
+	if maxCompletion := segment.MaxProfileCompletion; maxCompletion != nil &&
+		(*maxCompletion < segment.MinProfileCompletion || *maxCompletion > maxProfileCompletion) {
+		return fmt.Errorf("%w: maxProfileCompletion must be minProfileCompletion-%d", errInvalid, maxProfileCompletion)
+	}
+
+	return nil
+}
+
+// normalizeSegmentValues приводит значения фильтра к нижнему регистру и убирает пустые и повторы.
+func normalizeSegmentValues(values []string) []string {
+	var normalized []string
+
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value != "" && !slices.Contains(normalized, value) {
+			normalized = append(normalized, value)
+		}
+	}
+
+	return normalized
+}
+
+// AnnouncementDispatcher рассылает анонсы с учетом лимитов мессенджера.
+// Одновременно идет не больше одной рассылки каждого анонса.
+type AnnouncementDispatcher struct {
+	service  *BotService
+	sender   AnnouncementSender
+	throttle *announcementThrottle
+
+	mu      sync.Mutex
+	running map[int]bool
+	wg      sync.WaitGroup
+}
+
+// NewAnnouncementDispatcher создает диспетчер рассылки анонсов.
+func NewAnnouncementDispatcher(service *BotService, sender AnnouncementSender) *AnnouncementDispatcher {
+	return &AnnouncementDispatcher{
+		service:  service,
+		sender:   sender,
+		throttle: newAnnouncementThrottle(localization.AnnouncementGlobalInterval, localization.AnnouncementPerChatInterval),
+		running:  make(map[int]bool),
+	}
+}
+
+// TestSend отправляет анонс одному чату (обычно самому администратору) без записи доставок.
+func (d *AnnouncementDispatcher) TestSend(ctx context.Context, announcementID int, chatID int64) error {
+	announcement, err := d.service.DB.GetAnnouncement(announcementID)
+	if err != nil {
+		return err
+	}
+
+	return d.send(ctx, chatID, FormatAnnouncement(announcement))
+}
+
+// Send ставит получателей анонса в очередь и запускает рассылку в фоне.
+// Повторный вызов для анонса в статусе sending продолжает прерванную рассылку.
+// Возвращает количество получателей в очереди.
+func (d *AnnouncementDispatcher) Send(ctx context.Context, announcementID int) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.running[announcementID] {
+		return 0, errorsPkg.ErrAnnouncementNotSendable
+	}
+
+	recipients, err := d.service.DB.QueueAnnouncementDeliveries(announcementID)
+	if err != nil {
+		return 0, err
+	}
+
+	d.running[announcementID] = true
+	d.wg.Add(1)
+
+	go func() {
+		defer d.wg.Done()
+
+		d.deliver(ctx, announcementID)
+
+		d.mu.Lock()
+		delete(d.running, announcementID)
+		d.mu.Unlock()
+	}()
+
+	return recipients, nil
+}
+
+// Wait ждет завершения запущенных рассылок.
+func (d *AnnouncementDispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// deliver рассылает анонс пачками, пока очередь не опустеет, анонс не отменят или не отменят контекст.
+func (d *AnnouncementDispatcher) deliver(ctx context.Context, announcementID int) {
+	db := d.service.DB
+
+	for {
+		announcement, err := db.GetAnnouncement(announcementID)
+		if err != nil {
+			log.Printf("Failed to get announcement %d: %v", announcementID, err)
+
+			return
+		}
+
+		if announcement.Status != models.AnnouncementStatusSending {
+			log.Printf("Announcement %d delivery stopped: status %s", announcementID, announcement.Status)
+
+			return
+		}
+
+		recipients, err := db.GetPendingAnnouncementDeliveries(announcementID, localization.AnnouncementBatchSize)
+		if err != nil {
+			log.Printf("Failed to get pending deliveries for announcement %d: %v", announcementID, err)
+
+			return
+		}
+
+		if len(recipients) == 0 {
+			if err := db.FinishAnnouncement(announcementID); err != nil {
+				log.Printf("Failed to finish announcement %d: %v", announcementID, err)
+
+				return
+			}
+
+			log.Printf("Announcement %d delivered to %d recipients", announcementID, announcement.RecipientsCount)
+
+			return
+		}
+
+		text := FormatAnnouncement(announcement)
+
+		for _, recipient := range recipients {
+			if err := d.deliverTo(ctx, announcementID, recipient, text); err != nil {
+				log.Printf("Announcement %d delivery interrupted: %v", announcementID, err)
+
+				return
+			}
+		}
+	}
+}
+
+// deliverTo отправляет анонс одному получателю и записывает результат.
+// Ошибка возвращается, только если рассылку нужно прервать.
+func (d *AnnouncementDispatcher) deliverTo(ctx context.Context, announcementID int, recipient models.AnnouncementRecipient, text string) error {
+	db := d.service.DB
+
+	err := d.send(ctx, recipient.TelegramID, text)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	status, deliveryError := models.DeliveryStatusSent, ""
+
+	switch {
+	case err == nil:
+	case errors.Is(err, errorsPkg.ErrRecipientBlocked):
+		status, deliveryError = models.DeliveryStatusBlocked, err.Error()
+
+		d.service.deactivateBlockedRecipient(recipient.UserID, recipient.TelegramID)
+	default:
+		status, deliveryError = models.DeliveryStatusFailed, err.Error()
+	}
+
+	if err := db.RecordAnnouncementDelivery(announcementID, recipient.UserID, status, deliveryError); err != nil {
+		return fmt.Errorf("failed to record announcement delivery: %w", err)
+	}
+
+	return nil
+}
+
+// send отправляет сообщение с соблюдением лимитов и повторяет его после ответа retry_after.
+func (d *AnnouncementDispatcher) send(ctx context.Context, chatID int64, text string) error {
+	return d.throttle.send(ctx, chatID, func() error {
+		return d.sender.SendAnnouncement(chatID, text)
+	})
+}
+
+// deactivateBlockedRecipient отключает пользователя, заблокировавшего бота, чтобы рассылки его пропускали.
+func (s *BotService) deactivateBlockedRecipient(userID int, telegramID int64) {
+	if err := s.DB.SetUserActive(userID, false); err != nil {
+		log.Printf("Failed to deactivate user %d: %v", userID, err)
+	}
+
+	s.InvalidateUserCache(telegramID)
+}
+
+// announcementThrottle ограничивает частоту отправки: глобально и для каждого чата отдельно.
+type announcementThrottle struct {
+	mu       sync.Mutex
+	global   time.Duration
+	perChat  time.Duration
+	next     time.Time
+	chatNext map[int64]time.Time
+	// pruneAt - размер chatNext, при котором удаляются прошедшие слоты. После очистки порог
+	// удваивается от оставшегося размера, поэтому каждая отправка в среднем обходит O(1) слотов.
+	pruneAt int
+}
+
+func newAnnouncementThrottle(global, perChat time.Duration) *announcementThrottle {
+	return &announcementThrottle{
+		global:   global,
+		perChat:  perChat,
+		chatNext: make(map[int64]time.Time),
+		pruneAt:  localization.AnnouncementThrottlePrune,
+	}
+}
+
+// wait резервирует слот отправки в чат и ждет его наступления.
+func (t *announcementThrottle) wait(ctx context.Context, chatID int64) error {
+	t.mu.Lock()
+
+	now := time.Now()
+	slot := now
+
+	if t.next.After(slot) {
+		slot = t.next
+	}
+
+	if chatSlot := t.chatNext[chatID]; chatSlot.After(slot) {
+		slot = chatSlot
+	}
+
+	t.next = slot.Add(t.global)
+	t.chatNext[chatID] = slot.Add(t.perChat)
+
+	if len(t.chatNext) >= t.pruneAt {
+		t.prune(now)
+	}
+
+	t.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(slot))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// prune удаляет прошедшие слоты чатов: они больше не ограничивают отправку. Вызывается под t.mu.
+func (t *announcementThrottle) prune(now time.Time) {
+	for id, chatSlot := range t.chatNext {
+		if chatSlot.Before(now) {
+			delete(t.chatNext, id)
+		}
+	}
+
+	t.pruneAt = max(2*len(t.chatNext), localization.AnnouncementThrottlePrune)
+}
+
+// send отправляет сообщение через fn в слот чата и повторяет отправку после ответа retry_after.
+func (t *announcementThrottle) send(ctx context.Context, chatID int64, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		if err := t.wait(ctx, chatID); err != nil {
+			return err
+		}
+
+		err := fn()
+
+		var retryAfter *RetryAfterError
+		if !errors.As(err, &retryAfter) || attempt >= localization.AnnouncementMaxRetries {
+			return err
+		}
+
+		t.pause(retryAfter.Delay)
+	}
+}
+
+// pause откладывает все следующие отправки: retry_after действует на весь бот.
+func (t *announcementThrottle) pause(delay time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if resume := time.Now().Add(delay); resume.After(t.next) {
+		t.next = resume
+	}
+}
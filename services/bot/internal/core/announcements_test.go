@@ -0,0 +1,183 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// fakeAnnouncementSender возвращает заданные ошибки по чатам и запоминает отправки.
+type fakeAnnouncementSender struct {
+	mu     sync.Mutex
+	errors map[int64][]error
+	sent   []int64
+}
+
+func (f *fakeAnnouncementSender) SendAnnouncement(chatID int64, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if queued := f.errors[chatID]; len(queued) > 0 {
+		f.errors[chatID] = queued[1:]
+
+		return queued[0]
+	}
+
+	f.sent = append(f.sent, chatID)
+
+	return nil
+}
+
+// TestCreateAnnouncement_Validation тестирует отказ до записи в базу.
+func TestCreateAnnouncement_Validation(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	tooLow := 10
+
+	inputs := []models.AnnouncementInput{
+		{Title: "  ", Message: "text"},
+		{Title: "title", Message: ""},
+		{Title: "title", Message: "text", Segment: models.AnnouncementSegment{Statuses: []string{"banned"}}},
+		{Title: "title", Message: "text", Segment: models.AnnouncementSegment{MinProfileCompletion: 101}},
+		{Title: "title", Message: "text", Segment: models.AnnouncementSegment{MinProfileCompletion: 50, MaxProfileCompletion: &tooLow}},
+	}
+
+	for _, input := range inputs {
+		_, err := service.CreateAnnouncement(input, 1)
+		require.ErrorIs(t, err, errorsPkg.ErrInvalidAnnouncement)
+	}
+
+	mockDB.AssertNotCalled(t, "CreateAnnouncement", mock.Anything)
+}
+
+// TestCreateAnnouncement тестирует нормализацию сегмента и подсчет получателей.
+func TestCreateAnnouncement(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	segment := models.AnnouncementSegment{InterfaceLanguages: []string{"ru"}, Statuses: []string{models.StatusActive}}
+
+	mockDB.On("CreateAnnouncement", mock.MatchedBy(func(announcement *models.Announcement) bool {
+		return announcement.Title == "News" && announcement.CreatedBy == 5
+	})).Return(nil)
+	mockDB.On("CountAnnouncementRecipients", segment).Return(42, nil)
+
+	announcement, err := service.CreateAnnouncement(models.AnnouncementInput{
+		Title:   " News ",
+		Message: "Hello",
+		Segment: models.AnnouncementSegment{InterfaceLanguages: []string{" RU ", "ru", ""}, Statuses: []string{"Active"}},
+	}, 5)
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, announcement.RecipientsCount)
+	assert.Equal(t, "News\n\nHello", FormatAnnouncement(announcement))
+	mockDB.AssertExpectations(t)
+}
+
+// TestAnnouncementDispatcher_Send тестирует доставку: блокировка бота, повтор после 429 и ошибки отправки.
+func TestAnnouncementDispatcher_Send(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	sender := &fakeAnnouncementSender{errors: map[int64][]error{
+		200: {errorsPkg.ErrRecipientBlocked},
+		300: {&RetryAfterError{Delay: time.Millisecond}},
+		400: {errors.New("chat not found")},
+	}}
+
+	dispatcher := NewAnnouncementDispatcher(service, sender)
+	dispatcher.throttle = newAnnouncementThrottle(0, 0)
+
+	recipients := []models.AnnouncementRecipient{
+		{UserID: 1, TelegramID: 100},
+		{UserID: 2, TelegramID: 200},
+		{UserID: 3, TelegramID: 300},
+		{UserID: 4, TelegramID: 400},
+	}
+
+	mockDB.On("QueueAnnouncementDeliveries", 9).Return(len(recipients), nil)
+	mockDB.On("GetAnnouncement", 9).Return(&models.Announcement{ID: 9, Title: "News", Message: "Hello", Status: models.AnnouncementStatusSending}, nil)
+	mockDB.On("GetPendingAnnouncementDeliveries", 9, localization.AnnouncementBatchSize).Return(recipients, nil).Once()
+	mockDB.On("GetPendingAnnouncementDeliveries", 9, localization.AnnouncementBatchSize).Return([]models.AnnouncementRecipient{}, nil).Once()
+	mockDB.On("RecordAnnouncementDelivery", 9, 1, models.DeliveryStatusSent, "").Return(nil)
+	mockDB.On("RecordAnnouncementDelivery", 9, 2, models.DeliveryStatusBlocked, mock.Anything).Return(nil)
+	mockDB.On("RecordAnnouncementDelivery", 9, 3, models.DeliveryStatusSent, "").Return(nil)
+	mockDB.On("RecordAnnouncementDelivery", 9, 4, models.DeliveryStatusFailed, "chat not found").Return(nil)
+	mockDB.On("SetUserActive", 2, false).Return(nil)
+	mockDB.On("FinishAnnouncement", 9).Return(nil)
+
+	queued, err := dispatcher.Send(context.Background(), 9)
+	require.NoError(t, err)
+	assert.Equal(t, len(recipients), queued)
+
+	dispatcher.Wait()
+
+	assert.Equal(t, []int64{100, 300}, sender.sent)
+	mockDB.AssertExpectations(t)
+}
+
+// TestAnnouncementDispatcher_SendStopsWhenCancelled тестирует остановку рассылки после отмены анонса.
+func TestAnnouncementDispatcher_SendStopsWhenCancelled(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	sender := &fakeAnnouncementSender{}
+	dispatcher := NewAnnouncementDispatcher(service, sender)
+
+	mockDB.On("QueueAnnouncementDeliveries", 9).Return(3, nil)
+	mockDB.On("GetAnnouncement", 9).Return(&models.Announcement{ID: 9, Status: models.AnnouncementStatusCancelled}, nil)
+
+	_, err := dispatcher.Send(context.Background(), 9)
+	require.NoError(t, err)
+
+	dispatcher.Wait()
+
+	assert.Empty(t, sender.sent)
+	mockDB.AssertNotCalled(t, "GetPendingAnnouncementDeliveries", mock.Anything, mock.Anything)
+	mockDB.AssertNotCalled(t, "FinishAnnouncement", mock.Anything)
+}
+
+// TestAnnouncementThrottle тестирует интервал между сообщениями в один чат.
+func TestAnnouncementThrottle(t *testing.T) {
+	throttle := newAnnouncementThrottle(0, 20*time.Millisecond)
+	ctx := context.Background()
+
+	start := time.Now()
+
+	require.NoError(t, throttle.wait(ctx, 1))
+	require.NoError(t, throttle.wait(ctx, 2))
+	assert.Less(t, time.Since(start), 20*time.Millisecond)
+
+	require.NoError(t, throttle.wait(ctx, 1))
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	throttle.pause(time.Hour)
+	require.ErrorIs(t, throttle.wait(cancelled, 3), context.Canceled)
+}
+
+// TestAnnouncementThrottle_Prune тестирует очистку прошедших слотов только после порога.
+func TestAnnouncementThrottle_Prune(t *testing.T) {
+	throttle := newAnnouncementThrottle(0, 0)
+	ctx := context.Background()
+
+	for chatID := int64(1); chatID < localization.AnnouncementThrottlePrune; chatID++ {
+		require.NoError(t, throttle.wait(ctx, chatID))
+	}
+
+	assert.Len(t, throttle.chatNext, localization.AnnouncementThrottlePrune-1)
+
+	// Слот последнего чата еще не прошел, остальные удаляются
+	require.NoError(t, throttle.wait(ctx, localization.AnnouncementThrottlePrune))
+	assert.LessOrEqual(t, len(throttle.chatNext), 1)
+	assert.Equal(t, localization.AnnouncementThrottlePrune, throttle.pruneAt)
+}
@@ -0,0 +1,264 @@
+package core
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// CreateAPIKey выпускает ключ admin API. Открытое значение ключа возвращается только здесь:
+// в базе хранится его SHA-256. Ключ без служебной учетной записи привязывается к владельцу
+// input.OwnerTelegramID: от его имени ключ и действует.
+func (s *BotService) CreateAPIKey(input models.APIKeyInput, createdBy int) (*models.APIKey, string, error) {
+	name := strings.TrimSpace(input.Name)
+	if nameLength := utf8.RuneCountInString(name); nameLength == 0 || nameLength > localization.MaxAPIKeyNameLength {
+		return nil, "", fmt.Errorf("%w: name must be 1-%d characters", errorsPkg.ErrInvalidAPIKeyInput, localization.MaxAPIKeyNameLength)
+	}
+
+	scopes, err := normalizeAPIKeyScopes(input.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	lifetimeDays := s.apiKeyLifetimeDays()
+	if input.ExpiresInDays != nil {
+		if *input.ExpiresInDays < 0 {
+			return nil, "", fmt.Errorf("%w: expiresInDays must not be negative", errorsPkg.ErrInvalidAPIKeyInput)
+		}
+
+		lifetimeDays = *input.ExpiresInDays
+	}
+
+	key, rawKey, err := newAPIKey(name, scopes, lifetimeDays, createdBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key.ServiceAccount = input.ServiceAccount
+
+	if key.OwnerUserID, err = s.apiKeyOwnerID(input); err != nil {
+		return nil, "", err
+	}
+
+	if err := s.DB.CreateAPIKey(key); err != nil {
+		return nil, "", fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return key, rawKey, nil
+}
+
+// RotateAPIKey выпускает замену ключа с теми же именем, правами, типом учетной записи и владельцем. Старый ключ продолжает
+// действовать gracePeriodDays дней (nil - период из конфигурации), чтобы клиенты успели перейти.
+func (s *BotService) RotateAPIKey(keyID int, gracePeriodDays *int, createdBy int) (*models.APIKey, string, error) {
+	gracePeriod := s.apiKeyGracePeriodDays()
+	if gracePeriodDays != nil {
+		if *gracePeriodDays < 0 {
+			return nil, "", fmt.Errorf("%w: gracePeriodDays must not be negative", errorsPkg.ErrInvalidAPIKeyInput)
+		}
+
+		gracePeriod = *gracePeriodDays
+	}
+
+	oldKey, err := s.DB.GetAPIKey(keyID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if oldKey.RevokedAt != nil {
+		return nil, "", fmt.Errorf("%w: key %d is revoked", errorsPkg.ErrInvalidAPIKeyInput, keyID)
+	}
+
+	// Бессрочный ключ заменяется бессрочным, остальные получают срок по умолчанию
+	lifetimeDays := 0
+	if oldKey.ExpiresAt != nil {
+		lifetimeDays = s.apiKeyLifetimeDays()
+	}
+
+	key, rawKey, err := newAPIKey(oldKey.Name, oldKey.Scopes, lifetimeDays, createdBy)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key.ServiceAccount = oldKey.ServiceAccount
+	key.OwnerUserID = oldKey.OwnerUserID
+
+	if err := s.DB.RotateAPIKey(oldKey.ID, key, time.Now().AddDate(0, 0, gracePeriod)); err != nil {
+		return nil, "", fmt.Errorf("failed to rotate api key: %w", err)
+	}
+
+	return key, rawKey, nil
+}
+
+// RevokeAPIKey немедленно отзывает ключ.
+func (s *BotService) RevokeAPIKey(keyID int) error {
+	return s.DB.RevokeAPIKey(keyID)
+}
+
+// GetAPIKey возвращает ключ по ID без его значения.
+func (s *BotService) GetAPIKey(keyID int) (*models.APIKey, error) {
+	return s.DB.GetAPIKey(keyID)
+}
+
+// GetAPIKeys возвращает все ключи без их значений.
+func (s *BotService) GetAPIKeys() ([]models.APIKey, error) {
+	return s.DB.GetAPIKeys()
+}
+
+// AuthenticateAPIKey возвращает действующий ключ по его значению и отмечает его использование.
+// Неизвестный, отозванный или истекший ключ - ErrInvalidAPIKey.
+func (s *BotService) AuthenticateAPIKey(rawKey string) (*models.APIKey, error) {
+	if !strings.HasPrefix(rawKey, localization.APIKeyPrefix) {
+		return nil, errorsPkg.ErrInvalidAPIKey
+	}
+
+	key, err := s.DB.GetAPIKeyByHash(HashAPIKey(rawKey))
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrAPIKeyNotFound) {
+			return nil, errorsPkg.ErrInvalidAPIKey
+		}
+
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	if !key.IsUsable(time.Now()) {
+		return nil, errorsPkg.ErrInvalidAPIKey
+	}
+
+	if err := s.DB.TouchAPIKey(key.ID, localization.APIKeyLastUsedInterval); err != nil {
+		log.Printf("Failed to update last use of api key %d: %v", key.ID, err)
+	}
+
+	return key, nil
+}
+
+// GetAPIKeyOwner возвращает владельца ключа без служебной учетной записи в обход кэша, чтобы изменение
+// роли сразу действовало в admin API. Ключ, выпущенный до привязки к владельцу, - ErrAPIKeyOwnerRequired.
+func (s *BotService) GetAPIKeyOwner(key *models.APIKey) (*models.User, error) {
+	if key.OwnerUserID == 0 {
+		return nil, errorsPkg.ErrAPIKeyOwnerRequired
+	}
+
+	user, err := s.DB.GetUserByID(key.OwnerUserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorsPkg.ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get api key owner: %w", err)
+	}
+
+	return user, nil
+}
+
+// apiKeyOwnerID проверяет владельца выпускаемого ключа: он обязателен для ключа без служебной учетной
+// записи и не задается служебному ключу.
+func (s *BotService) apiKeyOwnerID(input models.APIKeyInput) (int, error) {
+	if input.ServiceAccount {
+		if input.OwnerTelegramID != 0 {
+			return 0, fmt.Errorf("%w: service account keys have no owner", errorsPkg.ErrInvalidAPIKeyInput)
+		}
+
+		return 0, nil
+	}
+
+	if input.OwnerTelegramID == 0 {
+		return 0, fmt.Errorf("%w: ownerTelegramId is required for a non-service key", errorsPkg.ErrInvalidAPIKeyInput)
+	}
+
+	owner, err := s.getUserForRole(input.OwnerTelegramID)
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrUserNotFound) {
+			return 0, fmt.Errorf("%w: owner %d is not registered", errorsPkg.ErrInvalidAPIKeyInput, input.OwnerTelegramID)
+		}
+
+		return 0, err
+	}
+
+	return owner.ID, nil
+}
+
+// HashAPIKey возвращает SHA-256 ключа в hex. Ключ содержит 256 случайных бит, поэтому
+// медленный хеш (bcrypt) не нужен, а детерминированный позволяет искать ключ по индексу.
+func HashAPIKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// newAPIKey генерирует значение ключа и заполняет модель для сохранения.
+func newAPIKey(name string, scopes []string, lifetimeDays, createdBy int) (*models.APIKey, string, error) {
+	secret := make([]byte, localization.APIKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	rawKey := localization.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	key := &models.APIKey{
+		Name:      name,
+		KeyPrefix: rawKey[:localization.APIKeyDisplayPrefixLength],
+		KeyHash:   HashAPIKey(rawKey),
+		Scopes:    scopes,
+		CreatedBy: createdBy,
+	}
+
+	if lifetimeDays > 0 {
+		expiresAt := time.Now().AddDate(0, 0, lifetimeDays)
+		key.ExpiresAt = &expiresAt
+	}
+
+	return key, rawKey, nil
+}
+
+// normalizeAPIKeyScopes проверяет права ключа и убирает повторы. Нужно хотя бы одно право.
+func normalizeAPIKeyScopes(scopes []string) ([]string, error) {
+	normalized := make([]string, 0, len(scopes))
+
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if scope != models.APIKeyScopeAll && !IsValidPermission(Permission(scope)) {
+			return nil, fmt.Errorf("%w: unknown scope %q", errorsPkg.ErrInvalidAPIKeyInput, scope)
+		}
+
+		if !slices.Contains(normalized, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+
+	if len(normalized) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", errorsPkg.ErrInvalidAPIKeyInput)
+	}
+
+	return normalized, nil
+}
+
+// apiKeyLifetimeDays возвращает срок действия новых ключей из конфигурации.
+func (s *BotService) apiKeyLifetimeDays() int {
+	if s.Config == nil {
+		return localization.DefaultAPIKeyLifetimeDays
+	}
+
+	return s.Config.APIKeyLifetimeDays
+}
+
+// apiKeyGracePeriodDays возвращает период перекрытия при ротации из конфигурации.
+func (s *BotService) apiKeyGracePeriodDays() int {
+	if s.Config == nil {
+		return localization.DefaultAPIKeyGracePeriodDays
+	}
+
+	return s.Config.APIKeyGracePeriodDays
+}
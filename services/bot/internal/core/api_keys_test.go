@@ -0,0 +1,143 @@
+package core
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestCreateAPIKey_Validation тестирует отказ до записи в базу.
+func TestCreateAPIKey_Validation(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	negative := -1
+
+	mockDB.On("GetUserByTelegramID", int64(404)).Return(nil, sql.ErrNoRows)
+
+	inputs := []models.APIKeyInput{
+		{Name: " ", Scopes: []string{models.APIKeyScopeAll}},
+		{Name: "ops"},
+		{Name: "ops", Scopes: []string{"users.delete"}},
+		{Name: "ops", Scopes: []string{models.APIKeyScopeAll}, ExpiresInDays: &negative},
+		{Name: "ops", Scopes: []string{models.APIKeyScopeAll}},
+		{Name: "ops", Scopes: []string{models.APIKeyScopeAll}, OwnerTelegramID: 404},
+		{Name: "ops", Scopes: []string{models.APIKeyScopeAll}, ServiceAccount: true, OwnerTelegramID: 101},
+	}
+
+	for _, input := range inputs {
+		_, _, err := service.CreateAPIKey(input, 0)
+		require.ErrorIs(t, err, errorsPkg.ErrInvalidAPIKeyInput)
+	}
+
+	mockDB.AssertNotCalled(t, "CreateAPIKey", mock.Anything)
+}
+
+// TestCreateAPIKey тестирует хранение хеша вместо значения и срок действия по умолчанию.
+func TestCreateAPIKey(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	service.Config = &config.Config{APIKeyLifetimeDays: 30}
+
+	var stored *models.APIKey
+
+	mockDB.On("CreateAPIKey", mock.AnythingOfType("*models.APIKey")).Run(func(args mock.Arguments) {
+		stored, _ = args.Get(0).(*models.APIKey)
+	}).Return(nil)
+	mockDB.On("GetUserByTelegramID", int64(101)).Return(&models.User{ID: 12, TelegramID: 101}, nil)
+
+	key, rawKey, err := service.CreateAPIKey(models.APIKeyInput{
+		Name:            " ops ",
+		Scopes:          []string{string(PermissionViewStats), string(PermissionViewStats)},
+		OwnerTelegramID: 101,
+	}, 7)
+	require.NoError(t, err)
+
+	assert.True(t, len(rawKey) > len(localization.APIKeyPrefix))
+	assert.Equal(t, stored, key)
+	assert.Equal(t, "ops", key.Name)
+	assert.Equal(t, []string{string(PermissionViewStats)}, key.Scopes)
+	assert.Equal(t, HashAPIKey(rawKey), key.KeyHash)
+	assert.NotContains(t, key.KeyHash, rawKey)
+	assert.Equal(t, rawKey[:localization.APIKeyDisplayPrefixLength], key.KeyPrefix)
+	assert.Equal(t, 12, key.OwnerUserID)
+	require.NotNil(t, key.ExpiresAt)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, 30), *key.ExpiresAt, time.Minute)
+}
+
+// TestRotateAPIKey тестирует период перекрытия: старый ключ истекает через grace period, новый наследует права.
+func TestRotateAPIKey(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	service.Config = &config.Config{APIKeyLifetimeDays: 90, APIKeyGracePeriodDays: 3}
+	expiresAt := time.Now().Add(time.Hour)
+
+	mockDB.On("GetAPIKey", 4).Return(&models.APIKey{ID: 4, Name: "ops", Scopes: []string{"*"}, ExpiresAt: &expiresAt, OwnerUserID: 12}, nil)
+	mockDB.On("RotateAPIKey", 4, mock.MatchedBy(func(key *models.APIKey) bool {
+		return key.Name == "ops" && key.ExpiresAt != nil && key.OwnerUserID == 12
+	}), mock.MatchedBy(func(oldExpiresAt time.Time) bool {
+		return oldExpiresAt.Sub(time.Now().AddDate(0, 0, 3)).Abs() < time.Minute
+	})).Return(nil)
+
+	key, rawKey, err := service.RotateAPIKey(4, nil, 0)
+	require.NoError(t, err)
+	assert.NotEmpty(t, rawKey)
+	assert.Equal(t, []string{"*"}, key.Scopes)
+	mockDB.AssertExpectations(t)
+
+	mockDB.On("GetAPIKey", 5).Return(&models.APIKey{ID: 5, RevokedAt: &expiresAt}, nil)
+
+	_, _, err = service.RotateAPIKey(5, nil, 0)
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidAPIKeyInput)
+}
+
+// TestAuthenticateAPIKey тестирует отказ для чужого формата, отозванного и истекшего ключа.
+func TestAuthenticateAPIKey(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	past := time.Now().Add(-time.Minute)
+
+	mockDB.On("GetAPIKeyByHash", HashAPIKey("lxb_active")).Return(&models.APIKey{ID: 1}, nil)
+	mockDB.On("GetAPIKeyByHash", HashAPIKey("lxb_revoked")).Return(&models.APIKey{ID: 2, RevokedAt: &past}, nil)
+	mockDB.On("GetAPIKeyByHash", HashAPIKey("lxb_expired")).Return(&models.APIKey{ID: 3, ExpiresAt: &past}, nil)
+	mockDB.On("GetAPIKeyByHash", HashAPIKey("lxb_unknown")).Return(nil, errorsPkg.ErrAPIKeyNotFound)
+	mockDB.On("TouchAPIKey", 1, localization.APIKeyLastUsedInterval).Return(nil)
+
+	key, err := service.AuthenticateAPIKey("lxb_active")
+	require.NoError(t, err)
+	assert.Equal(t, 1, key.ID)
+
+	for _, rawKey := range []string{"admin-secret-key", "lxb_revoked", "lxb_expired", "lxb_unknown"} {
+		_, err := service.AuthenticateAPIKey(rawKey)
+		require.ErrorIs(t, err, errorsPkg.ErrInvalidAPIKey, rawKey)
+	}
+
+	mockDB.AssertNumberOfCalls(t, "TouchAPIKey", 1)
+}
+
+// TestGetAPIKeyOwner тестирует владельца ключа: ключ без владельца и удаленный владелец не дают роли.
+func TestGetAPIKeyOwner(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetUserByID", 12).Return(&models.User{ID: 12, TelegramID: 101, Role: models.RoleModerator}, nil)
+	mockDB.On("GetUserByID", 13).Return(nil, sql.ErrNoRows)
+
+	owner, err := service.GetAPIKeyOwner(&models.APIKey{OwnerUserID: 12})
+	require.NoError(t, err)
+	assert.Equal(t, int64(101), owner.TelegramID)
+
+	_, err = service.GetAPIKeyOwner(&models.APIKey{})
+	require.ErrorIs(t, err, errorsPkg.ErrAPIKeyOwnerRequired)
+
+	_, err = service.GetAPIKeyOwner(&models.APIKey{OwnerUserID: 13})
+	require.ErrorIs(t, err, errorsPkg.ErrUserNotFound)
+}
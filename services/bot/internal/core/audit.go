@@ -0,0 +1,188 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strconv"
+	"unicode/utf8"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// adminAuditActions - действия истории admin_action_logs и соответствующие им действия аудита.
+var adminAuditActions = map[string]string{
+	models.AdminActionResetProfile: audit.ActionUserProfileReset,
+	models.AdminActionChangeStatus: "user.status.change",
+	models.AdminActionForceState:   "user.state.force",
+	models.AdminActionSendMessage:  "user.message",
+}
+
+// RecordAudit добавляет запись в журнал аудита. Ошибка записи только логируется:
+// сбой журнала не должен отменять уже выполненное действие.
+func (s *BotService) RecordAudit(event *models.AuditEvent) {
+	if event.Result == "" {
+		event.Result = models.AuditResultSuccess
+	}
+
+	if err := s.DB.AppendAuditEvent(event); err != nil {
+		log.Printf("Failed to record audit event %s by %s %s: %v", event.Action, event.ActorType, event.ActorID, err)
+	}
+}
+
+// RecordUserAudit записывает в журнал аудита действие пользователя бота над объектом targetType с ID targetID.
+// Пользователь с ролью, дающей права, записывается как администратор. Инициатор и целевой
+// пользователь (targetType user) записываются псевдонимами, а не Telegram ID.
+func (s *BotService) RecordUserAudit(actor *models.User, action, targetType string, targetID int, details map[string]interface{}) {
+	event := &models.AuditEvent{
+		ActorType:  AuditActorType(actor),
+		Action:     action,
+		TargetType: targetType,
+		Details:    details,
+	}
+
+	if actor != nil {
+		event.ActorID = s.AuditPseudonym(actor.ID)
+	}
+
+	switch {
+	case targetID == 0:
+	case targetType == audit.TargetUser:
+		event.TargetID = s.AuditPseudonym(targetID)
+	default:
+		event.TargetID = strconv.Itoa(targetID)
+	}
+
+	s.RecordAudit(event)
+}
+
+// AuditPseudonym возвращает псевдоним пользователя в журнале аудита, при первом вызове создавая его.
+// Журнал только дополняется, поэтому личные ID в него не пишутся: псевдоним удаляется вместе
+// с аккаунтом (/deleteme, очистка по срокам), и записи перестают указывать на человека.
+func (s *BotService) AuditPseudonym(userID int) string {
+	secret := make([]byte, localization.AuditPseudonymBytes)
+	if _, err := rand.Read(secret); err != nil {
+		log.Printf("Failed to generate audit pseudonym for user %d: %v", userID, err)
+
+		return models.AuditPseudonymUnknown
+	}
+
+	pseudonym, err := s.DB.EnsureAuditPseudonym(userID, models.AuditPseudonymPrefix+hex.EncodeToString(secret))
+	if err != nil {
+		log.Printf("Failed to get audit pseudonym for user %d: %v", userID, err)
+
+		return models.AuditPseudonymUnknown
+	}
+
+	return pseudonym
+}
+
+// AuditPseudonymByTelegramID возвращает псевдоним пользователя по Telegram ID для поиска в журнале аудита.
+func (s *BotService) AuditPseudonymByTelegramID(telegramID int64) (string, error) {
+	user, err := s.getUserForRole(telegramID)
+	if err != nil {
+		return "", err
+	}
+
+	return s.AuditPseudonym(user.ID), nil
+}
+
+// adminAuditDetails возвращает параметры действия администратора для журнала аудита:
+// текст личного сообщения в журнал не попадает, только его длина.
+func adminAuditDetails(action string, details map[string]interface{}) map[string]interface{} {
+	text, ok := details["text"].(string)
+	if action != models.AdminActionSendMessage || !ok {
+		return details
+	}
+
+	return map[string]interface{}{"length": utf8.RuneCountInString(text)}
+}
+
+// AuditActorType возвращает тип инициатора для пользователя: admin для ролей с правами, user для остальных.
+func AuditActorType(user *models.User) string {
+	if user != nil && len(RolePermissions(user.Role)) > 0 {
+		return models.AuditActorAdmin
+	}
+
+	return models.AuditActorUser
+}
+
+// GetAuditEvents возвращает записи аудита по фильтру, новые первыми. Лимит по умолчанию -
+// DefaultAuditListLimit, больше MaxAuditListLimit не отдается.
+func (s *BotService) GetAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	filter.Limit = clampAuditLimit(filter.Limit, localization.MaxAuditListLimit)
+
+	events, err := s.DB.GetAuditEvents(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit events: %w", err)
+	}
+
+	return events, nil
+}
+
+// ExportAuditEvents возвращает записи аудита по фильтру для выгрузки - до MaxAuditExportRows.
+func (s *BotService) ExportAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = localization.MaxAuditExportRows
+	}
+
+	filter.Limit = clampAuditLimit(filter.Limit, localization.MaxAuditExportRows)
+
+	events, err := s.DB.GetAuditEvents(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export audit events: %w", err)
+	}
+
+	return events, nil
+}
+
+// VerifyAuditChain проходит журнал аудита от первой записи и проверяет цепочку хешей.
+func (s *BotService) VerifyAuditChain() (*models.AuditVerification, error) {
+	result := &models.AuditVerification{Valid: true}
+	prevHash := audit.GenesisHash
+
+	var afterID int64
+
+	for {
+		events, err := s.DB.GetAuditChain(afterID, localization.AuditVerifyBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get audit chain: %w", err)
+		}
+
+		if len(events) == 0 {
+			return result, nil
+		}
+
+		lastHash, brokenAtID := audit.Verify(prevHash, events)
+		if brokenAtID != 0 {
+			for _, event := range events {
+				if event.ID == brokenAtID {
+					break
+				}
+
+				result.Checked++
+			}
+
+			result.Valid = false
+			result.BrokenAtID = brokenAtID
+
+			return result, nil
+		}
+
+		result.Checked += len(events)
+		prevHash = lastHash
+		afterID = events[len(events)-1].ID
+	}
+}
+
+// clampAuditLimit подставляет лимит по умолчанию и ограничивает его сверху.
+func clampAuditLimit(limit, maxLimit int) int {
+	if limit <= 0 {
+		return localization.DefaultAuditListLimit
+	}
+
+	return min(limit, maxLimit)
+}
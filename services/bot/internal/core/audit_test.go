@@ -0,0 +1,86 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// auditChain строит цепочку из count записей с заполненными хешами.
+func auditChain(t *testing.T, count int) []models.AuditEvent {
+	t.Helper()
+
+	events := make([]models.AuditEvent, count)
+	prevHash := audit.GenesisHash
+
+	for i := range events {
+		events[i] = models.AuditEvent{
+			ID:        int64(i + 1),
+			CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+			ActorType: models.AuditActorSystem,
+			Action:    audit.ActionAPIRequest,
+			Result:    models.AuditResultSuccess,
+			PrevHash:  prevHash,
+		}
+
+		hash, err := audit.ChainHash(prevHash, &events[i])
+		require.NoError(t, err)
+
+		events[i].Hash = hash
+		prevHash = hash
+	}
+
+	return events
+}
+
+// TestVerifyAuditChain тестирует проверку цепочки по частям и обнаружение удаленной записи на стыке частей.
+func TestVerifyAuditChain(t *testing.T) {
+	batch := localization.AuditVerifyBatchSize
+	events := auditChain(t, batch+2)
+
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetAuditChain", int64(0), batch).Return(events[:batch], nil)
+	mockDB.On("GetAuditChain", int64(batch), batch).Return(events[batch:], nil)
+	mockDB.On("GetAuditChain", int64(batch+2), batch).Return([]models.AuditEvent{}, nil)
+
+	verification, err := service.VerifyAuditChain()
+	require.NoError(t, err)
+	assert.Equal(t, &models.AuditVerification{Valid: true, Checked: batch + 2}, verification)
+
+	mockDB = new(MockDatabase)
+	service = NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetAuditChain", int64(0), batch).Return(events[:batch], nil)
+	mockDB.On("GetAuditChain", int64(batch), batch).Return(events[batch+1:], nil)
+
+	verification, err = service.VerifyAuditChain()
+	require.NoError(t, err)
+	assert.Equal(t, &models.AuditVerification{Valid: false, Checked: batch, BrokenAtID: int64(batch + 2)}, verification)
+}
+
+// TestRecordUserAudit тестирует тип инициатора и то, что сбой журнала не возвращается вызывающему.
+func TestRecordUserAudit(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("EnsureAuditPseudonym", 5, mock.AnythingOfType("string")).Return("p_user", nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.ActorType == models.AuditActorUser && event.ActorID == "p_user" &&
+			event.TargetType == audit.TargetUser && event.TargetID == "p_user" && event.Result == models.AuditResultSuccess
+	})).Return(errors.New("connection refused"))
+
+	service.RecordUserAudit(&models.User{ID: 5, TelegramID: 100, Role: models.RoleUser}, audit.ActionUserProfileReset, audit.TargetUser, 5, nil)
+
+	mockDB.AssertExpectations(t)
+	assert.Equal(t, models.AuditActorAdmin, AuditActorType(&models.User{Role: models.RoleModerator}))
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"fmt"
+	"log"
+	"slices"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// feedbackAttachmentKinds - типы файлов, которые принимаются вложениями отзыва.
+var feedbackAttachmentKinds = []string{
+	models.FeedbackAttachmentPhoto, models.FeedbackAttachmentDocument, models.FeedbackAttachmentVoice,
+}
+
+// validateFeedbackWithAttachments проверяет отзыв: без вложений действует обычная проверка длины,
+// с вложениями текст может быть коротким или пустым. Вложений не больше MaxFeedbackAttachments.
+func (s *BotService) validateFeedbackWithAttachments(feedbackText string, attachments []models.FeedbackAttachment) error {
+	if len(attachments) == 0 {
+		return s.ValidateFeedback(feedbackText)
+	}
+
+	if len([]rune(feedbackText)) > localization.MaxFeedbackLength {
+		return errorsPkg.ErrFeedbackTooLong
+	}
+
+	if len(attachments) > localization.MaxFeedbackAttachments {
+		return errorsPkg.ErrInvalidUserInput
+	}
+
+	for _, attachment := range attachments {
+		if attachment.FileID == "" || !slices.Contains(feedbackAttachmentKinds, attachment.Kind) {
+			return errorsPkg.ErrInvalidUserInput
+		}
+	}
+
+	return nil
+}
+
+// saveFeedbackAttachments сохраняет вложения нового отзыва и возвращает сохраненные.
+// Ошибка не мешает сохранению отзыва: администраторы получат его без вложений.
+func (s *BotService) saveFeedbackAttachments(feedbackID int, attachments []models.FeedbackAttachment) []models.FeedbackAttachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	if err := s.DB.AddFeedbackAttachments(feedbackID, attachments); err != nil {
+		log.Printf("Failed to save attachments for feedback %d: %v", feedbackID, err)
+
+		return nil
+	}
+
+	return attachments
+}
+
+// GetFeedbackAttachments возвращает вложения отзыва в порядке отправки.
+func (s *BotService) GetFeedbackAttachments(feedbackID int) ([]models.FeedbackAttachment, error) {
+	attachments, err := s.DB.GetFeedbackAttachments(feedbackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback attachments: %w", err)
+	}
+
+	return attachments, nil
+}
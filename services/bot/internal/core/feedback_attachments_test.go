@@ -0,0 +1,69 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestValidateFeedbackWithAttachments тестирует проверку отзыва: с вложениями текст можно не писать,
+// но число и тип вложений ограничены.
+func TestValidateFeedbackWithAttachments(t *testing.T) {
+	service := NewBotServiceWithInterface(new(MockDatabase), &localization.Localizer{})
+	screenshot := models.FeedbackAttachment{Kind: models.FeedbackAttachmentPhoto, FileID: "photo-1"}
+
+	require.ErrorIs(t, service.validateFeedbackWithAttachments("", nil), errorsPkg.ErrFeedbackTooShort)
+	require.NoError(t, service.validateFeedbackWithAttachments("", []models.FeedbackAttachment{screenshot}))
+	require.ErrorIs(t, service.validateFeedbackWithAttachments(
+		strings.Repeat("a", localization.MaxFeedbackLength+1), []models.FeedbackAttachment{screenshot},
+	), errorsPkg.ErrFeedbackTooLong)
+
+	tooMany := make([]models.FeedbackAttachment, localization.MaxFeedbackAttachments+1)
+	for i := range tooMany {
+		tooMany[i] = screenshot
+	}
+
+	require.ErrorIs(t, service.validateFeedbackWithAttachments("", tooMany), errorsPkg.ErrInvalidUserInput)
+	require.ErrorIs(t, service.validateFeedbackWithAttachments("", []models.FeedbackAttachment{{Kind: "video", FileID: "video-1"}}),
+		errorsPkg.ErrInvalidUserInput)
+	require.ErrorIs(t, service.validateFeedbackWithAttachments("", []models.FeedbackAttachment{{Kind: models.FeedbackAttachmentVoice}}),
+		errorsPkg.ErrInvalidUserInput)
+}
+
+// TestSaveFeedbackAttachments тестирует, что ошибка сохранения вложений не теряет отзыв:
+// уведомление уходит без вложений.
+func TestSaveFeedbackAttachments(t *testing.T) {
+	attachments := []models.FeedbackAttachment{{Kind: models.FeedbackAttachmentDocument, FileID: "doc-1", FileName: "log.txt"}}
+
+	t.Run("saved", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+		mockDB.On("AddFeedbackAttachments", 7, attachments).Return(nil)
+
+		assert.Equal(t, attachments, service.saveFeedbackAttachments(7, attachments))
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+		mockDB.On("AddFeedbackAttachments", 7, attachments).Return(errors.New("db unavailable"))
+
+		assert.Empty(t, service.saveFeedbackAttachments(7, attachments))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+		assert.Empty(t, service.saveFeedbackAttachments(7, nil))
+		mockDB.AssertNotCalled(t, "AddFeedbackAttachments")
+	})
+}
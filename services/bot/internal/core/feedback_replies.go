@@ -0,0 +1,144 @@
+package core
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// GetFeedbackThread возвращает отзыв и переписку по нему.
+func (s *BotService) GetFeedbackThread(feedbackID int) (*models.FeedbackThread, error) {
+	thread, err := s.DB.GetFeedbackThread(feedbackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback thread: %w", err)
+	}
+
+	return thread, nil
+}
+
+// ReplyToFeedback сохраняет ответ администратора на отзыв и переносит отзыв в обработанные.
+// Доставку автору выполняет адаптер мессенджера: ответ остается в переписке, даже если доставить его не удалось.
+func (s *BotService) ReplyToFeedback(admin *models.User, feedbackID int, text string) (*models.FeedbackReply, error) {
+	if !s.UserHasPermission(admin, PermissionManageFeedback) {
+		return nil, errorsPkg.ErrPermissionDenied
+	}
+
+	text, err := normalizeFeedbackReply(text)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err := s.GetFeedbackThread(feedbackID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := s.getMessageRecipient(thread.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	message := models.FeedbackMessage{
+		FeedbackID: feedbackID,
+		AuthorType: models.FeedbackAuthorAdmin,
+		AuthorID:   admin.ID,
+		Text:       text,
+	}
+
+	if err := s.DB.AddFeedbackMessage(&message); err != nil {
+		return nil, fmt.Errorf("failed to add feedback reply: %w", err)
+	}
+
+	s.RecordUserAudit(admin, audit.ActionFeedbackReply, audit.TargetFeedback, feedbackID, map[string]interface{}{"user": s.AuditPseudonym(recipient.ID)})
+
+	return &models.FeedbackReply{Recipient: recipient, FeedbackText: thread.FeedbackText, Message: message}, nil
+}
+
+// AddFeedbackAuthorReply сохраняет ответ автора отзыва на ответ администратора, возвращает отзыв
+// в активные и уведомляет администраторов. Отвечать можно только на свой отзыв, на который уже ответили.
+func (s *BotService) AddFeedbackAuthorReply(user *models.User, feedbackID int, text string) error {
+	text, err := normalizeFeedbackReply(text)
+	if err != nil {
+		return err
+	}
+
+	thread, err := s.GetFeedbackThread(feedbackID)
+	if err != nil {
+		return err
+	}
+
+	if thread.UserID != user.ID {
+		return errorsPkg.ErrFeedbackNotFound
+	}
+
+	if !hasAdminReply(thread) {
+		return errorsPkg.ErrPermissionDenied
+	}
+
+	message := models.FeedbackMessage{
+		FeedbackID: feedbackID,
+		AuthorType: models.FeedbackAuthorUser,
+		AuthorID:   user.ID,
+		Text:       text,
+	}
+
+	if err := s.DB.AddFeedbackMessage(&message); err != nil {
+		return fmt.Errorf("failed to add feedback reply: %w", err)
+	}
+
+	s.RecordUserAudit(user, audit.ActionFeedbackUserReply, audit.TargetFeedback, feedbackID, nil)
+	s.notifyFeedbackAuthorReply(user, feedbackID, text)
+
+	return nil
+}
+
+// notifyFeedbackAuthorReply отправляет администраторам ответ автора отзыва тем же каналом,
+// что и новые отзывы; reply_to отличает ответ от нового отзыва.
+func (s *BotService) notifyFeedbackAuthorReply(user *models.User, feedbackID int, text string) {
+	if s.FeedbackNotificationFunc == nil {
+		return
+	}
+
+	data := map[string]interface{}{
+		"telegram_id":   user.TelegramID,
+		"first_name":    user.FirstName,
+		"feedback_text": text,
+		"reply_to":      feedbackID,
+	}
+
+	if user.Username != "" {
+		username := user.Username
+		data["username"] = &username
+	}
+
+	if err := s.FeedbackNotificationFunc(data); err != nil {
+		log.Printf("Failed to notify admins about reply to feedback %d: %v", feedbackID, err)
+	}
+}
+
+// normalizeFeedbackReply обрезает пробелы и проверяет длину ответа.
+func normalizeFeedbackReply(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > localization.MaxFeedbackReplyLength {
+		return "", errorsPkg.ErrInvalidUserInput
+	}
+
+	return text, nil
+}
+
+// hasAdminReply сообщает, есть ли в переписке ответ администратора.
+func hasAdminReply(thread *models.FeedbackThread) bool {
+	for _, message := range thread.Messages {
+		if message.AuthorType == models.FeedbackAuthorAdmin {
+			return true
+		}
+	}
+
+	return false
+}
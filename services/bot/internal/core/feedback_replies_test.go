@@ -0,0 +1,104 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestReplyToFeedback тестирует сохранение ответа администратора и данные для доставки автору.
+func TestReplyToFeedback(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	admin := &models.User{ID: 1, TelegramID: 1001, Role: models.RoleModerator}
+	author := &models.User{ID: 7, TelegramID: 7007, InterfaceLanguageCode: "es"}
+
+	mockDB.On("GetFeedbackThread", 3).Return(&models.FeedbackThread{FeedbackID: 3, UserID: 7, FeedbackText: "Не работает поиск"}, nil)
+	mockDB.On("GetUserByID", 7).Return(author, nil)
+	mockDB.On("AddFeedbackMessage", mock.MatchedBy(func(message *models.FeedbackMessage) bool {
+		return message.FeedbackID == 3 && message.AuthorType == models.FeedbackAuthorAdmin &&
+			message.AuthorID == 1 && message.Text == "Исправили, спасибо!"
+	})).Return(nil)
+	mockDB.On("EnsureAuditPseudonym", 1, mock.AnythingOfType("string")).Return("p_admin", nil)
+	mockDB.On("EnsureAuditPseudonym", 7, mock.AnythingOfType("string")).Return("p_author", nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionFeedbackReply && event.ActorType == models.AuditActorAdmin && event.ActorID == "p_admin" &&
+			event.TargetType == audit.TargetFeedback && event.TargetID == "3" && event.Details["user"] == "p_author"
+	})).Return(nil)
+
+	reply, err := service.ReplyToFeedback(admin, 3, "  Исправили, спасибо!  ")
+
+	require.NoError(t, err)
+	assert.Equal(t, author, reply.Recipient)
+	assert.Equal(t, "Не работает поиск", reply.FeedbackText)
+	assert.Equal(t, "Исправили, спасибо!", reply.Message.Text)
+	mockDB.AssertExpectations(t)
+}
+
+// TestReplyToFeedback_Rejected тестирует отказ без права, с пустым ответом и автору с другой платформы.
+func TestReplyToFeedback_Rejected(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	_, err := service.ReplyToFeedback(&models.User{ID: 2, Role: models.RoleUser}, 3, "Ответ")
+	require.ErrorIs(t, err, errorsPkg.ErrPermissionDenied)
+
+	_, err = service.ReplyToFeedback(&models.User{ID: 1, Role: models.RoleAdmin}, 3, "   ")
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidUserInput)
+
+	// Автору из Discord ответ не доставить: он не сохраняется
+	mockDB.On("GetFeedbackThread", 4).Return(&models.FeedbackThread{FeedbackID: 4, UserID: 9}, nil)
+	mockDB.On("GetUserByID", 9).Return(&models.User{ID: 9, TelegramID: -5, Platform: models.PlatformDiscord}, nil)
+
+	_, err = service.ReplyToFeedback(&models.User{ID: 1, Role: models.RoleAdmin}, 4, "Ответ")
+	require.ErrorIs(t, err, errorsPkg.ErrRecipientNotOnTelegram)
+
+	mockDB.AssertNotCalled(t, "AddFeedbackMessage", mock.Anything)
+}
+
+// TestAddFeedbackAuthorReply тестирует ответ автора: только на свой отзыв с ответом администратора,
+// с уведомлением администраторов.
+func TestAddFeedbackAuthorReply(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	author := &models.User{ID: 7, TelegramID: 7007, FirstName: "Ana", Role: models.RoleUser}
+
+	answered := &models.FeedbackThread{FeedbackID: 3, UserID: 7, Messages: []models.FeedbackMessage{
+		{FeedbackID: 3, AuthorType: models.FeedbackAuthorAdmin, AuthorID: 1, Text: "Исправили"},
+	}}
+
+	mockDB.On("GetFeedbackThread", 3).Return(answered, nil)
+	mockDB.On("GetFeedbackThread", 4).Return(&models.FeedbackThread{FeedbackID: 4, UserID: 8}, nil)
+	mockDB.On("GetFeedbackThread", 5).Return(&models.FeedbackThread{FeedbackID: 5, UserID: 7}, nil)
+	mockDB.On("AddFeedbackMessage", mock.MatchedBy(func(message *models.FeedbackMessage) bool {
+		return message.FeedbackID == 3 && message.AuthorType == models.FeedbackAuthorUser && message.AuthorID == 7
+	})).Return(nil).Once()
+	mockDB.On("EnsureAuditPseudonym", 7, mock.AnythingOfType("string")).Return("p_author", nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionFeedbackUserReply && event.ActorType == models.AuditActorUser && event.TargetID == "3"
+	})).Return(nil)
+
+	var notified map[string]interface{}
+
+	service.SetFeedbackNotificationFunc(func(data map[string]interface{}) error {
+		notified = data
+
+		return nil
+	})
+
+	require.NoError(t, service.AddFeedbackAuthorReply(author, 3, "Теперь работает"))
+	assert.Equal(t, 3, notified["reply_to"])
+	assert.Equal(t, "Теперь работает", notified["feedback_text"])
+	assert.Equal(t, int64(7007), notified["telegram_id"])
+
+	require.ErrorIs(t, service.AddFeedbackAuthorReply(author, 4, "Чужой отзыв"), errorsPkg.ErrFeedbackNotFound)
+	require.ErrorIs(t, service.AddFeedbackAuthorReply(author, 5, "Без ответа"), errorsPkg.ErrPermissionDenied)
+	mockDB.AssertExpectations(t)
+}
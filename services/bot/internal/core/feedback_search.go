@@ -0,0 +1,141 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/export"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// SearchFeedback ищет отзывы по тексту, самые релевантные первыми. Лимит по умолчанию -
+// DefaultFeedbackSearchLimit, больше MaxFeedbackSearchLimit не отдается.
+func (s *BotService) SearchFeedback(query models.FeedbackQuery) ([]models.FeedbackRecord, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" || utf8.RuneCountInString(query.Text) > localization.MaxFeedbackSearchQueryLength {
+		return nil, errorsPkg.ErrInvalidUserInput
+	}
+
+	if err := validateFeedbackQuery(query); err != nil {
+		return nil, err
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = localization.DefaultFeedbackSearchLimit
+	}
+
+	query.Limit = min(query.Limit, localization.MaxFeedbackSearchLimit)
+
+	records, err := s.DB.SearchFeedback(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search feedback: %w", err)
+	}
+
+	return records, nil
+}
+
+// ExportFeedback возвращает отзывы для выгрузки, новые первыми - до MaxFeedbackExportRows.
+// Текст запроса необязателен: с ним выгружаются только найденные отзывы.
+func (s *BotService) ExportFeedback(query models.FeedbackQuery) ([]models.FeedbackRecord, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if utf8.RuneCountInString(query.Text) > localization.MaxFeedbackSearchQueryLength {
+		return nil, errorsPkg.ErrInvalidUserInput
+	}
+
+	if err := validateFeedbackQuery(query); err != nil {
+		return nil, err
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = localization.MaxFeedbackExportRows
+	}
+
+	query.Limit = min(query.Limit, localization.MaxFeedbackExportRows)
+
+	records, err := s.DB.SearchFeedback(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export feedback: %w", err)
+	}
+
+	return records, nil
+}
+
+// ExportFeedbackFile выгружает отзывы в файл формата format от имени администратора,
+// записывает выгрузку в журнал аудита и возвращает содержимое файла и число отзывов.
+func (s *BotService) ExportFeedbackFile(admin *models.User, query models.FeedbackQuery, format string) ([]byte, int, error) {
+	if !s.UserHasPermission(admin, PermissionViewFeedback) {
+		return nil, 0, errorsPkg.ErrPermissionDenied
+	}
+
+	if format != models.FeedbackExportCSV && format != models.FeedbackExportJSONL {
+		return nil, 0, errorsPkg.ErrInvalidUserInput
+	}
+
+	records, err := s.ExportFeedback(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var buf bytes.Buffer
+	if err := export.WriteFeedback(&buf, format, records); err != nil {
+		return nil, 0, err
+	}
+
+	s.RecordUserAudit(admin, audit.ActionFeedbackExport, audit.TargetFeedback, 0, FeedbackExportAuditDetails(query, format, len(records)))
+
+	return buf.Bytes(), len(records), nil
+}
+
+// FeedbackExportAuditDetails возвращает условия выгрузки отзывов для журнала аудита.
+func FeedbackExportAuditDetails(query models.FeedbackQuery, format string, count int) map[string]interface{} {
+	details := map[string]interface{}{
+		"format": format,
+		"count":  count,
+	}
+
+	if query.Text != "" {
+		details["query"] = query.Text
+	}
+
+	if query.Language != "" {
+		details["language"] = query.Language
+	}
+
+	if query.Status != "" {
+		details["status"] = query.Status
+	}
+
+	if query.From != nil {
+		details["from"] = query.From.UTC().Format(time.RFC3339)
+	}
+
+	if query.To != nil {
+		details["to"] = query.To.UTC().Format(time.RFC3339)
+	}
+
+	return details
+}
+
+// validateFeedbackQuery проверяет язык, статус и период поиска отзывов.
+func validateFeedbackQuery(query models.FeedbackQuery) error {
+	if query.Language != "" && !slices.Contains(models.FeedbackSearchLanguages, query.Language) {
+		return errorsPkg.ErrInvalidUserInput
+	}
+
+	if query.Status != "" && query.Status != models.FeedbackStatusActive && query.Status != models.FeedbackStatusProcessed {
+		return errorsPkg.ErrInvalidUserInput
+	}
+
+	if query.From != nil && query.To != nil && !query.From.Before(*query.To) {
+		return errorsPkg.ErrInvalidUserInput
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestSearchFeedback тестирует поиск: запрос обрезается, лимит ограничивается,
+// недопустимые запрос, язык, статус и период отклоняются до обращения к базе.
+func TestSearchFeedback(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	records := []models.FeedbackRecord{{ID: 3, Text: "Бот не работает"}}
+
+	mockDB.On("SearchFeedback", models.FeedbackQuery{
+		Text: "не работает", Language: "ru", Limit: localization.MaxFeedbackSearchLimit,
+	}).Return(records, nil)
+
+	result, err := service.SearchFeedback(models.FeedbackQuery{Text: "  не работает ", Language: "ru", Limit: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, records, result)
+
+	from := time.Now()
+	to := from.Add(-time.Hour)
+
+	for _, query := range []models.FeedbackQuery{
+		{Text: "   "},
+		{Text: strings.Repeat("a", localization.MaxFeedbackSearchQueryLength+1)},
+		{Text: "bug", Language: "fr"},
+		{Text: "bug", Status: "deleted"},
+		{Text: "bug", From: &from, To: &to},
+	} {
+		_, err := service.SearchFeedback(query)
+		require.ErrorIs(t, err, errorsPkg.ErrInvalidUserInput)
+	}
+
+	mockDB.AssertNumberOfCalls(t, "SearchFeedback", 1)
+}
+
+// TestExportFeedbackFile тестирует выгрузку из Telegram: права, формат и запись в аудит.
+func TestExportFeedbackFile(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	admin := &models.User{ID: 1, TelegramID: 1001, Role: models.RoleModerator}
+
+	_, _, err := service.ExportFeedbackFile(&models.User{ID: 2, Role: models.RoleUser}, models.FeedbackQuery{}, models.FeedbackExportCSV)
+	require.ErrorIs(t, err, errorsPkg.ErrPermissionDenied)
+
+	_, _, err = service.ExportFeedbackFile(admin, models.FeedbackQuery{}, "xlsx")
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidUserInput)
+
+	mockDB.On("SearchFeedback", models.FeedbackQuery{
+		Status: models.FeedbackStatusActive, Limit: localization.MaxFeedbackExportRows,
+	}).Return([]models.FeedbackRecord{{ID: 3, Text: "Отзыв"}, {ID: 4, Text: "Еще отзыв"}}, nil)
+	mockDB.On("EnsureAuditPseudonym", 1, mock.AnythingOfType("string")).Return("p_admin", nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionFeedbackExport && event.ActorID == "p_admin" &&
+			event.Details["format"] == models.FeedbackExportJSONL && event.Details["count"] == 2 &&
+			event.Details["status"] == models.FeedbackStatusActive
+	})).Return(nil)
+
+	data, count, err := service.ExportFeedbackFile(admin, models.FeedbackQuery{Status: models.FeedbackStatusActive}, models.FeedbackExportJSONL)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, 2, strings.Count(string(data), "\n"))
+	mockDB.AssertExpectations(t)
+}
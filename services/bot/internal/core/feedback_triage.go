@@ -0,0 +1,241 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+	"language-exchange-bot/internal/triage"
+)
+
+// feedbackSuggester предлагает категорию новых отзывов по ключевым словам.
+var feedbackSuggester = triage.NewDefaultSuggester()
+
+// FeedbackPrioritySLA возвращает срок обработки отзыва с приоритетом priority.
+func FeedbackPrioritySLA(priority string) time.Duration {
+	switch priority {
+	case models.FeedbackPriorityUrgent:
+		return localization.FeedbackSLAUrgent
+	case models.FeedbackPriorityHigh:
+		return localization.FeedbackSLAHigh
+	case models.FeedbackPriorityLow:
+		return localization.FeedbackSLALow
+	default:
+		return localization.FeedbackSLANormal
+	}
+}
+
+// DefaultFeedbackPriority возвращает приоритет по умолчанию для категории:
+// ошибки и жалобы разбираются раньше предложений, благодарности - в последнюю очередь.
+func DefaultFeedbackPriority(category string) string {
+	switch category {
+	case models.FeedbackCategoryBug, models.FeedbackCategoryComplaint:
+		return models.FeedbackPriorityHigh
+	case models.FeedbackCategoryPraise:
+		return models.FeedbackPriorityLow
+	default:
+		return models.FeedbackPriorityNormal
+	}
+}
+
+// SuggestFeedbackTriage предлагает разметку нового отзыва по его тексту и языку интерфейса автора.
+func SuggestFeedbackTriage(text, lang string) models.FeedbackTriage {
+	category := feedbackSuggester.Suggest(text, lang)
+
+	return models.FeedbackTriage{
+		Category:     category,
+		CategoryAuto: category != "",
+		Priority:     DefaultFeedbackPriority(category),
+	}
+}
+
+// applySuggestedTriage размечает новый отзыв и назначает срок обработки.
+// Ошибка не мешает сохранению отзыва: он останется с обычным приоритетом.
+func (s *BotService) applySuggestedTriage(feedbackID int, text, lang string) *models.FeedbackTriage {
+	suggested := SuggestFeedbackTriage(text, lang)
+	suggested.FeedbackID = feedbackID
+
+	if err := s.DB.UpdateFeedbackTriage(&suggested, FeedbackPrioritySLA(suggested.Priority)); err != nil {
+		log.Printf("Failed to set triage for feedback %d: %v", feedbackID, err)
+
+		return nil
+	}
+
+	return &suggested
+}
+
+// GetFeedbackTriage возвращает разметку отзыва.
+func (s *BotService) GetFeedbackTriage(feedbackID int) (*models.FeedbackTriage, error) {
+	result, err := s.DB.GetFeedbackTriage(feedbackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback triage: %w", err)
+	}
+
+	return result, nil
+}
+
+// UpdateFeedbackTriage меняет категорию, приоритет или ответственного отзыва от имени администратора
+// и записывает изменение в журнал аудита.
+func (s *BotService) UpdateFeedbackTriage(admin *models.User, feedbackID int, update models.FeedbackTriageUpdate) (*models.FeedbackTriage, error) {
+	if !s.UserHasPermission(admin, PermissionManageFeedback) {
+		return nil, errorsPkg.ErrPermissionDenied
+	}
+
+	result, err := s.ApplyFeedbackTriage(feedbackID, update)
+	if err != nil {
+		return nil, err
+	}
+
+	s.RecordUserAudit(admin, audit.ActionFeedbackTriage, audit.TargetFeedback, feedbackID, FeedbackTriageAuditDetails(update))
+
+	return result, nil
+}
+
+// ApplyFeedbackTriage меняет разметку отзыва без проверки прав; admin API проверяет права
+// на маршруте и пишет аудит запроса сам. Категория, выбранная вручную, больше не считается
+// предложенной автоматически; смена приоритета пересчитывает срок от времени создания отзыва.
+// Ответственным можно назначить только пользователя с правом просмотра отзывов.
+func (s *BotService) ApplyFeedbackTriage(feedbackID int, update models.FeedbackTriageUpdate) (*models.FeedbackTriage, error) {
+	current, err := s.GetFeedbackTriage(feedbackID)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.Category != nil {
+		if *update.Category != "" && !slices.Contains(models.FeedbackCategories, *update.Category) {
+			return nil, errorsPkg.ErrInvalidUserInput
+		}
+
+		current.Category = *update.Category
+		current.CategoryAuto = false
+	}
+
+	if update.Priority != nil {
+		if !slices.Contains(models.FeedbackPriorities, *update.Priority) {
+			return nil, errorsPkg.ErrInvalidUserInput
+		}
+
+		current.Priority = *update.Priority
+	}
+
+	if update.AssigneeID != nil {
+		if err := s.validateFeedbackAssignee(*update.AssigneeID); err != nil {
+			return nil, err
+		}
+
+		current.AssigneeID = *update.AssigneeID
+	}
+
+	if err := s.DB.UpdateFeedbackTriage(current, FeedbackPrioritySLA(current.Priority)); err != nil {
+		return nil, fmt.Errorf("failed to update feedback triage: %w", err)
+	}
+
+	return current, nil
+}
+
+// FeedbackTriageAuditDetails возвращает измененные поля разметки для журнала аудита.
+func FeedbackTriageAuditDetails(update models.FeedbackTriageUpdate) map[string]interface{} {
+	details := map[string]interface{}{}
+
+	if update.Category != nil {
+		details["category"] = *update.Category
+	}
+
+	if update.Priority != nil {
+		details["priority"] = *update.Priority
+	}
+
+	if update.AssigneeID != nil {
+		details["assigneeId"] = *update.AssigneeID
+	}
+
+	return details
+}
+
+// validateFeedbackAssignee проверяет, что отзыв можно назначить пользователю; 0 снимает назначение.
+func (s *BotService) validateFeedbackAssignee(assigneeID int) error {
+	if assigneeID == 0 {
+		return nil
+	}
+
+	assignee, err := s.getAdminTarget(assigneeID)
+	if err != nil {
+		return err
+	}
+
+	if !s.UserHasPermission(assignee, PermissionViewFeedback) {
+		return errorsPkg.ErrInvalidUserInput
+	}
+
+	return nil
+}
+
+// CheckFeedbackSLA уведомляет о необработанных отзывах с истекшим сроком и возвращает их количество.
+// Отзыв попадает в уведомление один раз, пока администратор не сменит его срок.
+func (s *BotService) CheckFeedbackSLA() (int, error) {
+	if s.FeedbackSLAAlertFunc == nil {
+		return 0, nil
+	}
+
+	breaches, err := s.DB.GetFeedbackSLABreaches(localization.FeedbackSLAAlertBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get feedback SLA breaches: %w", err)
+	}
+
+	if len(breaches) == 0 {
+		return 0, nil
+	}
+
+	if err := s.FeedbackSLAAlertFunc(breaches); err != nil {
+		return 0, fmt.Errorf("failed to send feedback SLA alert: %w", err)
+	}
+
+	feedbackIDs := make([]int, 0, len(breaches))
+	for _, breach := range breaches {
+		feedbackIDs = append(feedbackIDs, breach.FeedbackID)
+	}
+
+	if err := s.DB.MarkFeedbackSLAAlerted(feedbackIDs); err != nil {
+		return 0, fmt.Errorf("failed to mark feedback SLA alerted: %w", err)
+	}
+
+	return len(breaches), nil
+}
+
+// StartFeedbackSLAMonitor запускает фоновую проверку сроков обработки отзывов.
+// Работает до отмены контекста.
+func (s *BotService) StartFeedbackSLAMonitor(ctx context.Context) {
+	ticker := time.NewTicker(localization.FeedbackSLACheckInterval)
+	defer ticker.Stop()
+
+	s.runFeedbackSLACheck()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.runFeedbackSLACheck()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runFeedbackSLACheck выполняет одну проверку сроков.
+func (s *BotService) runFeedbackSLACheck() {
+	alerted, err := s.CheckFeedbackSLA()
+	if err != nil {
+		log.Printf("Failed to check feedback SLA: %v", err)
+
+		return
+	}
+
+	if alerted > 0 {
+		log.Printf("Alerted about %d feedback items past their SLA", alerted)
+	}
+}
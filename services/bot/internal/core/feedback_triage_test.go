@@ -0,0 +1,151 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestSuggestFeedbackTriage тестирует предложенную категорию и приоритет по умолчанию.
+func TestSuggestFeedbackTriage(t *testing.T) {
+	bug := SuggestFeedbackTriage("Бот не работает после обновления", "ru")
+	assert.Equal(t, models.FeedbackCategoryBug, bug.Category)
+	assert.True(t, bug.CategoryAuto)
+	assert.Equal(t, models.FeedbackPriorityHigh, bug.Priority)
+
+	praise := SuggestFeedbackTriage("Thank you, great job!", "en")
+	assert.Equal(t, models.FeedbackCategoryPraise, praise.Category)
+	assert.Equal(t, models.FeedbackPriorityLow, praise.Priority)
+
+	unknown := SuggestFeedbackTriage("Просто сообщение", "ru")
+	assert.Empty(t, unknown.Category)
+	assert.False(t, unknown.CategoryAuto)
+	assert.Equal(t, models.FeedbackPriorityNormal, unknown.Priority)
+
+	assert.Equal(t, localization.FeedbackSLAUrgent, FeedbackPrioritySLA(models.FeedbackPriorityUrgent))
+	assert.Equal(t, localization.FeedbackSLANormal, FeedbackPrioritySLA("unknown"))
+}
+
+// TestUpdateFeedbackTriage тестирует ручную разметку: категория перестает быть автоматической,
+// срок пересчитывается по новому приоритету, изменение пишется в аудит.
+func TestUpdateFeedbackTriage(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	admin := &models.User{ID: 1, TelegramID: 1001, Role: models.RoleModerator}
+	moderator := &models.User{ID: 2, Role: models.RoleModerator}
+
+	mockDB.On("GetFeedbackTriage", 3).Return(&models.FeedbackTriage{
+		FeedbackID: 3, Category: models.FeedbackCategoryPraise, CategoryAuto: true, Priority: models.FeedbackPriorityLow,
+	}, nil)
+	mockDB.On("GetUserByID", 2).Return(moderator, nil)
+	mockDB.On("UpdateFeedbackTriage", mock.MatchedBy(func(triage *models.FeedbackTriage) bool {
+		return triage.FeedbackID == 3 && triage.Category == models.FeedbackCategoryComplaint && !triage.CategoryAuto &&
+			triage.Priority == models.FeedbackPriorityUrgent && triage.AssigneeID == 2
+	}), localization.FeedbackSLAUrgent).Return(nil)
+	mockDB.On("EnsureAuditPseudonym", 1, mock.AnythingOfType("string")).Return("p_admin", nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionFeedbackTriage && event.TargetID == "3" &&
+			event.Details["priority"] == models.FeedbackPriorityUrgent
+	})).Return(nil)
+
+	category, priority, assigneeID := models.FeedbackCategoryComplaint, models.FeedbackPriorityUrgent, 2
+
+	result, err := service.UpdateFeedbackTriage(admin, 3, models.FeedbackTriageUpdate{
+		Category: &category, Priority: &priority, AssigneeID: &assigneeID,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.AssigneeID)
+	mockDB.AssertExpectations(t)
+}
+
+// TestUpdateFeedbackTriage_Rejected тестирует отказ без права, с неизвестным приоритетом
+// и при назначении пользователя без доступа к отзывам.
+func TestUpdateFeedbackTriage_Rejected(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	admin := &models.User{ID: 1, Role: models.RoleAdmin}
+
+	priority := "whenever"
+	_, err := service.UpdateFeedbackTriage(&models.User{ID: 5, Role: models.RoleUser}, 3, models.FeedbackTriageUpdate{Priority: &priority})
+	require.ErrorIs(t, err, errorsPkg.ErrPermissionDenied)
+
+	mockDB.On("GetFeedbackTriage", 3).Return(&models.FeedbackTriage{FeedbackID: 3, Priority: models.FeedbackPriorityNormal}, nil)
+	mockDB.On("GetUserByID", 5).Return(&models.User{ID: 5, Role: models.RoleUser}, nil)
+
+	_, err = service.UpdateFeedbackTriage(admin, 3, models.FeedbackTriageUpdate{Priority: &priority})
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidUserInput)
+
+	assigneeID := 5
+	_, err = service.UpdateFeedbackTriage(admin, 3, models.FeedbackTriageUpdate{AssigneeID: &assigneeID})
+	require.ErrorIs(t, err, errorsPkg.ErrInvalidUserInput)
+
+	mockDB.AssertNotCalled(t, "UpdateFeedbackTriage", mock.Anything, mock.Anything)
+}
+
+// TestCheckFeedbackSLA тестирует уведомление о просроченных отзывах: отзывы отмечаются только
+// после успешного уведомления.
+func TestCheckFeedbackSLA(t *testing.T) {
+	breaches := []models.FeedbackSLABreach{
+		{FeedbackID: 3, Priority: models.FeedbackPriorityHigh, SLADueAt: time.Now().Add(-time.Hour)},
+		{FeedbackID: 4, Priority: models.FeedbackPriorityNormal, SLADueAt: time.Now().Add(-time.Minute), AssigneeTelegramID: 2002},
+	}
+
+	t.Run("alerted", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+		var alerted []models.FeedbackSLABreach
+
+		service.SetFeedbackSLAAlertFunc(func(items []models.FeedbackSLABreach) error {
+			alerted = items
+
+			return nil
+		})
+
+		mockDB.On("GetFeedbackSLABreaches", localization.FeedbackSLAAlertBatchSize).Return(breaches, nil)
+		mockDB.On("MarkFeedbackSLAAlerted", []int{3, 4}).Return(nil)
+
+		count, err := service.CheckFeedbackSLA()
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, breaches, alerted)
+		mockDB.AssertExpectations(t)
+	})
+
+	t.Run("alert failed", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+		service.SetFeedbackSLAAlertFunc(func([]models.FeedbackSLABreach) error {
+			return errors.New("telegram unavailable")
+		})
+
+		mockDB.On("GetFeedbackSLABreaches", localization.FeedbackSLAAlertBatchSize).Return(breaches, nil)
+
+		_, err := service.CheckFeedbackSLA()
+
+		require.Error(t, err)
+		mockDB.AssertNotCalled(t, "MarkFeedbackSLAAlerted", mock.Anything)
+	})
+
+	t.Run("no alert func", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+		count, err := service.CheckFeedbackSLA()
+
+		require.NoError(t, err)
+		assert.Zero(t, count)
+		mockDB.AssertNotCalled(t, "GetFeedbackSLABreaches", mock.Anything)
+	})
+}
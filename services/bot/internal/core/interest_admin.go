@@ -0,0 +1,209 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// GetInterestCategoryItems возвращает категории интересов с переводами названий.
+func (s *BotService) GetInterestCategoryItems() ([]models.InterestCategoryItem, error) {
+	return s.DB.GetInterestCategoryItems()
+}
+
+// GetInterestCatalogItems возвращает интересы с переводами; пустой categoryKey - все категории.
+func (s *BotService) GetInterestCatalogItems(categoryKey string) ([]models.InterestCatalogItem, error) {
+	catalog, err := s.DB.GetInterestCatalog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest catalog: %w", err)
+	}
+
+	if categoryKey == "" {
+		return catalog.Interests, nil
+	}
+
+	interests := make([]models.InterestCatalogItem, 0, len(catalog.Interests))
+
+	for _, interest := range catalog.Interests {
+		if interest.CategoryKey == categoryKey {
+			interests = append(interests, interest)
+		}
+	}
+
+	return interests, nil
+}
+
+// CreateInterestCategory создает категорию интересов. Нужны ключ и хотя бы одно название.
+func (s *BotService) CreateInterestCategory(input models.InterestCategoryInput) (int, error) {
+	if input.KeyName == nil {
+		return 0, fmt.Errorf("%w: key_name is required", errorsPkg.ErrInvalidInterestCategory)
+	}
+
+	if err := normalizeCatalogInput(input.KeyName, input.DisplayOrder, input.Translations, errorsPkg.ErrInvalidInterestCategory); err != nil {
+		return 0, err
+	}
+
+	if !hasCatalogName(input.Translations) {
+		return 0, fmt.Errorf("%w: at least one translation is required", errorsPkg.ErrInvalidInterestCategory)
+	}
+
+	categoryID, err := s.DB.CreateInterestCategory(input)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create interest category: %w", err)
+	}
+
+	s.InvalidateStaticDataCache()
+
+	return categoryID, nil
+}
+
+// UpdateInterestCategory переименовывает категорию, меняет ее порядок или переводы названия.
+func (s *BotService) UpdateInterestCategory(categoryID int, input models.InterestCategoryInput) error {
+	if input.KeyName == nil && input.DisplayOrder == nil && len(input.Translations) == 0 {
+		return fmt.Errorf("%w: nothing to update", errorsPkg.ErrInvalidInterestCategory)
+	}
+
+	if err := normalizeCatalogInput(input.KeyName, input.DisplayOrder, input.Translations, errorsPkg.ErrInvalidInterestCategory); err != nil {
+		return err
+	}
+
+	if err := s.DB.UpdateInterestCategory(categoryID, input); err != nil {
+		return fmt.Errorf("failed to update interest category: %w", err)
+	}
+
+	s.InvalidateStaticDataCache()
+
+	return nil
+}
+
+// CreateInterest создает интерес в категории. Нужны ключ, категория и хотя бы одно название.
+func (s *BotService) CreateInterest(input models.InterestInput) (int, error) {
+	if input.KeyName == nil || input.CategoryKey == nil {
+		return 0, fmt.Errorf("%w: key_name and category_key are required", errorsPkg.ErrInvalidInterestData)
+	}
+
+	if err := normalizeInterestInput(&input); err != nil {
+		return 0, err
+	}
+
+	if !hasCatalogName(input.Translations) {
+		return 0, fmt.Errorf("%w: at least one translation is required", errorsPkg.ErrInvalidInterestData)
+	}
+
+	interestID, err := s.DB.CreateInterest(input)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create interest: %w", err)
+	}
+
+	s.InvalidateStaticDataCache()
+
+	return interestID, nil
+}
+
+// UpdateInterest переименовывает интерес, переносит его в другую категорию, меняет порядок или переводы.
+// Выборы пользователей сохраняются: они ссылаются на интерес по ID.
+func (s *BotService) UpdateInterest(interestID int, input models.InterestInput) error {
+	if input.KeyName == nil && input.CategoryKey == nil && input.DisplayOrder == nil && len(input.Translations) == 0 {
+		return fmt.Errorf("%w: nothing to update", errorsPkg.ErrInvalidInterestData)
+	}
+
+	if err := normalizeInterestInput(&input); err != nil {
+		return err
+	}
+
+	if err := s.DB.UpdateInterest(interestID, input); err != nil {
+		return fmt.Errorf("failed to update interest: %w", err)
+	}
+
+	s.InvalidateStaticDataCache()
+
+	return nil
+}
+
+// normalizeInterestInput проверяет изменения интереса и обрезает пробелы.
+func normalizeInterestInput(input *models.InterestInput) error {
+	if input.CategoryKey != nil {
+		*input.CategoryKey = strings.TrimSpace(*input.CategoryKey)
+		if *input.CategoryKey == "" {
+			return fmt.Errorf("%w: category_key is empty", errorsPkg.ErrInvalidInterestData)
+		}
+	}
+
+	return normalizeCatalogInput(input.KeyName, input.DisplayOrder, input.Translations, errorsPkg.ErrInvalidInterestData)
+}
+
+// normalizeCatalogInput проверяет ключ и порядок, обрезает пробелы в ключе и названиях.
+// Пустое название остается в карте: оно означает удаление перевода.
+func normalizeCatalogInput(keyName *string, displayOrder *int, translations map[string]string, invalid error) error {
+	if keyName != nil {
+		*keyName = strings.TrimSpace(*keyName)
+		if !interestKeyPattern.MatchString(*keyName) {
+			return fmt.Errorf("%w: key_name must match %s", invalid, interestKeyPattern)
+		}
+	}
+
+	if displayOrder != nil && *displayOrder < 0 {
+		return fmt.Errorf("%w: display_order must not be negative", invalid)
+	}
+
+	for languageCode, name := range translations {
+		if strings.TrimSpace(languageCode) != languageCode || languageCode == "" {
+			return fmt.Errorf("%w: invalid language code %q", invalid, languageCode)
+		}
+
+		translations[languageCode] = strings.TrimSpace(name)
+	}
+
+	return nil
+}
+
+// hasCatalogName сообщает, есть ли среди переводов хотя бы одно непустое название.
+func hasCatalogName(translations map[string]string) bool {
+	for _, name := range translations {
+		if name != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InterestName возвращает название интереса: из файлов локализации, затем перевод из БД, затем ключ.
+// Так интересы, созданные или переименованные через admin API, не показываются как interest_<key>.
+func (s *BotService) InterestName(lang string, interestID int, keyName string) string {
+	localeKey := "interest_" + keyName
+	if s.Localizer.Has(lang, localeKey) {
+		return s.Localizer.Get(lang, localeKey)
+	}
+
+	// Без перевода на этот язык БД возвращает key_name, тогда лучше английское название из файлов
+	if names, err := s.Localizer.GetInterests(lang); err == nil && names[interestID] != "" && names[interestID] != keyName {
+		return names[interestID]
+	}
+
+	if name := s.Localizer.Get(lang, localeKey); name != localeKey {
+		return name
+	}
+
+	return keyName
+}
+
+// InterestCategoryName возвращает название категории: из файлов локализации, затем перевод из БД, затем ключ.
+func (s *BotService) InterestCategoryName(lang, categoryKey string) string {
+	localeKey := "category_" + categoryKey
+	if s.Localizer.Has(lang, localeKey) {
+		return s.Localizer.Get(lang, localeKey)
+	}
+
+	if names, err := s.Localizer.GetInterestCategoryNames(lang); err == nil && names[categoryKey] != "" {
+		return names[categoryKey]
+	}
+
+	if name := s.Localizer.Get(lang, localeKey); name != localeKey {
+		return name
+	}
+
+	return categoryKey
+}
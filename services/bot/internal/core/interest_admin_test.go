@@ -0,0 +1,135 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+func stringPtr(value string) *string { return &value }
+
+func intPtr(value int) *int { return &value }
+
+// TestCreateInterest тестирует нормализацию данных перед созданием интереса.
+func TestCreateInterest(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	expected := models.InterestInput{
+		KeyName:      stringPtr("board_games"),
+		CategoryKey:  stringPtr("entertainment"),
+		Translations: map[string]string{"en": "Board games", "ru": ""},
+	}
+	mockDB.On("CreateInterest", expected).Return(42, nil)
+
+	interestID, err := service.CreateInterest(models.InterestInput{
+		KeyName:      stringPtr(" board_games "),
+		CategoryKey:  stringPtr("entertainment "),
+		Translations: map[string]string{"en": " Board games ", "ru": "  "},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, interestID)
+	mockDB.AssertExpectations(t)
+}
+
+// TestCreateInterest_Validation тестирует отказ до обращения к БД.
+func TestCreateInterest_Validation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input models.InterestInput
+	}{
+		{name: "missing key", input: models.InterestInput{CategoryKey: stringPtr("active"), Translations: map[string]string{"en": "Chess"}}},
+		{name: "missing category", input: models.InterestInput{KeyName: stringPtr("chess"), Translations: map[string]string{"en": "Chess"}}},
+		{name: "invalid key", input: models.InterestInput{KeyName: stringPtr("Chess!"), CategoryKey: stringPtr("active"), Translations: map[string]string{"en": "Chess"}}},
+		{name: "no names", input: models.InterestInput{KeyName: stringPtr("chess"), CategoryKey: stringPtr("active"), Translations: map[string]string{"en": " "}}},
+		{
+			name:  "negative order",
+			input: models.InterestInput{KeyName: stringPtr("chess"), CategoryKey: stringPtr("active"), DisplayOrder: intPtr(-1), Translations: map[string]string{"en": "Chess"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := new(MockDatabase)
+			service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+			_, err := service.CreateInterest(tt.input)
+
+			assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestData)
+			mockDB.AssertNotCalled(t, "CreateInterest", mock.Anything)
+		})
+	}
+}
+
+// TestUpdateInterest тестирует перенос интереса в другую категорию.
+func TestUpdateInterest(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	input := models.InterestInput{CategoryKey: stringPtr("creative")}
+	mockDB.On("UpdateInterest", 7, input).Return(nil)
+
+	require.NoError(t, service.UpdateInterest(7, input))
+	mockDB.AssertExpectations(t)
+
+	err := service.UpdateInterest(7, models.InterestInput{})
+	assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestData)
+}
+
+// TestUpdateInterestCategory_NotFound тестирует проброс ошибки БД.
+func TestUpdateInterestCategory_NotFound(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	input := models.InterestCategoryInput{DisplayOrder: intPtr(3)}
+	mockDB.On("UpdateInterestCategory", 99, input).Return(errorsPkg.ErrInterestCategoryNotFound)
+
+	err := service.UpdateInterestCategory(99, input)
+
+	assert.ErrorIs(t, err, errorsPkg.ErrInterestCategoryNotFound)
+}
+
+// TestCreateInterestCategory_Validation тестирует обязательные ключ и название категории.
+func TestCreateInterestCategory_Validation(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	_, err := service.CreateInterestCategory(models.InterestCategoryInput{Translations: map[string]string{"en": "Games"}})
+	assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestCategory)
+
+	_, err = service.CreateInterestCategory(models.InterestCategoryInput{KeyName: stringPtr("games")})
+	assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestCategory)
+
+	mockDB.AssertNotCalled(t, "CreateInterestCategory", mock.Anything)
+}
+
+// TestGetInterestCatalogItems_FilterByCategory тестирует фильтр интересов по категории.
+func TestGetInterestCatalogItems_FilterByCategory(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetInterestCatalog").Return(&models.InterestCatalog{Interests: []models.InterestCatalogItem{
+		{ID: 1, KeyName: "music", CategoryKey: "entertainment"},
+		{ID: 2, KeyName: "sports", CategoryKey: "active"},
+	}}, nil)
+
+	interests, err := service.GetInterestCatalogItems("active")
+
+	require.NoError(t, err)
+	require.Len(t, interests, 1)
+	assert.Equal(t, "sports", interests[0].KeyName)
+}
+
+// TestInterestCategoryName_Fallback тестирует название категории без ключа в файлах локализации.
+func TestInterestCategoryName_Fallback(t *testing.T) {
+	service := NewBotServiceWithInterface(new(MockDatabase), &localization.Localizer{})
+
+	assert.Equal(t, "board_games", service.InterestCategoryName("en", "board_games"))
+}
@@ -0,0 +1,371 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// catalogActionOrder - порядок действий в плане; в том же порядке план применяется к БД.
+var catalogActionOrder = map[string]int{
+	models.InterestCatalogRename: 0,
+	models.InterestCatalogInsert: 1,
+	models.InterestCatalogUpdate: 2,
+	models.InterestCatalogRemove: 3,
+}
+
+// PlanInterestCatalogSync сравнивает каталог из interests.json со снимком БД и строит план изменений.
+// migrations задает, куда перенести выборы пользователей удаляемых интересов (старый ключ -> новый ключ);
+// удалить выбранный пользователями интерес без переноса нельзя.
+func PlanInterestCatalogSync(
+	cfg *config.InterestsConfig, current *models.InterestCatalog, migrations map[string]string,
+) (*models.InterestCatalogPlan, error) {
+	if len(cfg.Interests) == 0 {
+		return nil, errorsPkg.ErrInterestCatalogEmpty
+	}
+
+	if err := validateInterestCatalog(cfg); err != nil {
+		return nil, err
+	}
+
+	plan := &models.InterestCatalogPlan{Categories: planCatalogCategories(cfg, current)}
+
+	dbByKey := make(map[string]models.InterestCatalogItem, len(current.Interests))
+	for _, item := range current.Interests {
+		dbByKey[item.KeyName] = item
+	}
+
+	matched := make(map[string]bool, len(current.Interests))
+
+	for _, key := range sortedCatalogKeys(cfg.Interests) {
+		entry := cfg.Interests[key]
+		target := models.InterestCatalogItem{
+			KeyName:      key,
+			CategoryKey:  entry.Category,
+			DisplayOrder: entry.DisplayOrder,
+			Translations: entry.Translations,
+		}
+
+		if existing, ok := dbByKey[key]; ok {
+			matched[key] = true
+
+			// Интерес из предложения или admin API, добавленный в файл, переходит под управление каталога
+			if !catalogItemsEqual(existing, target) || !existing.IsCatalogManaged() {
+				target.ID = existing.ID
+				plan.Changes = append(plan.Changes, catalogChange(models.InterestCatalogUpdate, &existing, &target))
+			}
+
+			continue
+		}
+
+		previous, err := findRenamedInterest(key, entry, dbByKey, matched)
+		if err != nil {
+			return nil, err
+		}
+
+		if previous != nil {
+			matched[previous.KeyName] = true
+			target.ID = previous.ID
+			plan.Changes = append(plan.Changes, catalogChange(models.InterestCatalogRename, previous, &target))
+
+			continue
+		}
+
+		plan.Changes = append(plan.Changes, catalogChange(models.InterestCatalogInsert, nil, &target))
+	}
+
+	removals, err := planCatalogRemovals(cfg, current, matched, migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.Changes = append(plan.Changes, removals...)
+
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		return catalogActionOrder[plan.Changes[i].Action] < catalogActionOrder[plan.Changes[j].Action]
+	})
+
+	return plan, nil
+}
+
+// validateInterestCatalog проверяет ключи, категории и прежние ключи каталога.
+func validateInterestCatalog(cfg *config.InterestsConfig) error {
+	previousOwner := make(map[string]string)
+
+	for _, key := range sortedCatalogKeys(cfg.Interests) {
+		entry := cfg.Interests[key]
+
+		if !interestKeyPattern.MatchString(key) {
+			return fmt.Errorf("%w: invalid interest key %q", errorsPkg.ErrInvalidInterestCatalog, key)
+		}
+
+		if _, ok := cfg.Categories[entry.Category]; !ok {
+			return fmt.Errorf("%w: interest %q uses unknown category %q", errorsPkg.ErrInterestCategoryNotFound, key, entry.Category)
+		}
+
+		if len(entry.Translations) == 0 {
+			return fmt.Errorf("%w: interest %q has no translations", errorsPkg.ErrInvalidInterestCatalog, key)
+		}
+
+		for _, previous := range entry.PreviousKeys {
+			if _, ok := cfg.Interests[previous]; ok {
+				return fmt.Errorf("%w: previous key %q of %q is still in the catalog", errorsPkg.ErrInvalidInterestCatalog, previous, key)
+			}
+
+			if owner, ok := previousOwner[previous]; ok {
+				return fmt.Errorf("%w: previous key %q is claimed by %q and %q", errorsPkg.ErrInvalidInterestCatalog, previous, owner, key)
+			}
+
+			previousOwner[previous] = key
+		}
+	}
+
+	return nil
+}
+
+// planCatalogCategories возвращает категории, которых нет в БД или у которых изменился порядок.
+// Категории не удаляются: лишняя категория в БД просто остается без интересов.
+func planCatalogCategories(cfg *config.InterestsConfig, current *models.InterestCatalog) []models.InterestCategory {
+	dbOrder := make(map[string]int, len(current.Categories))
+	for _, category := range current.Categories {
+		dbOrder[category.KeyName] = category.DisplayOrder
+	}
+
+	keys := make([]string, 0, len(cfg.Categories))
+	for key := range cfg.Categories {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var categories []models.InterestCategory
+
+	for _, key := range keys {
+		order := cfg.Categories[key].DisplayOrder
+		if existing, ok := dbOrder[key]; ok && existing == order {
+			continue
+		}
+
+		categories = append(categories, models.InterestCategory{KeyName: key, DisplayOrder: order})
+	}
+
+	return categories
+}
+
+// findRenamedInterest ищет в БД интерес под одним из прежних ключей записи каталога.
+func findRenamedInterest(
+	key string, entry config.InterestCatalogEntry, dbByKey map[string]models.InterestCatalogItem, matched map[string]bool,
+) (*models.InterestCatalogItem, error) {
+	var found *models.InterestCatalogItem
+
+	for _, previous := range entry.PreviousKeys {
+		existing, ok := dbByKey[previous]
+		if !ok || matched[previous] {
+			continue
+		}
+
+		if found != nil {
+			return nil, fmt.Errorf("%w: several previous keys of %q exist in the database", errorsPkg.ErrInvalidInterestCatalog, key)
+		}
+
+		item := existing
+		found = &item
+	}
+
+	return found, nil
+}
+
+// planCatalogRemovals строит удаления интересов, которых нет в файле.
+// Интересы, одобренные из предложений или созданные через admin API, файлу не принадлежат
+// и не удаляются, пока их не внесут в interests.json.
+func planCatalogRemovals(
+	cfg *config.InterestsConfig, current *models.InterestCatalog, matched map[string]bool, migrations map[string]string,
+) ([]models.InterestCatalogChange, error) {
+	var removals []models.InterestCatalogChange
+
+	removed := make(map[string]bool)
+
+	for _, item := range current.Interests {
+		if matched[item.KeyName] || !item.IsCatalogManaged() {
+			continue
+		}
+
+		removed[item.KeyName] = true
+		before := item
+		change := catalogChange(models.InterestCatalogRemove, &before, nil)
+
+		if target, ok := migrations[item.KeyName]; ok {
+			if _, exists := cfg.Interests[target]; !exists {
+				return nil, fmt.Errorf("%w: %q -> %q", errorsPkg.ErrInvalidInterestMigration, item.KeyName, target)
+			}
+
+			change.MigrateTo = target
+		} else if item.SelectionsCount > 0 {
+			return nil, fmt.Errorf("%w: %q is selected by %d users", errorsPkg.ErrInterestMigrationRequired, item.KeyName, item.SelectionsCount)
+		}
+
+		removals = append(removals, change)
+	}
+
+	// Перенос для интереса, который не удаляется, почти наверняка опечатка
+	for source := range migrations {
+		if !removed[source] {
+			return nil, fmt.Errorf("%w: %q is not removed by this sync", errorsPkg.ErrInvalidInterestMigration, source)
+		}
+	}
+
+	sort.Slice(removals, func(i, j int) bool {
+		return removals[i].Before.KeyName < removals[j].Before.KeyName
+	})
+
+	return removals, nil
+}
+
+// CheckInterestCatalogLocales находит интересы каталога без ключа interest_<key> в файлах локализации:
+// без него редактор интересов показывает пользователю технический ключ вместо названия.
+func CheckInterestCatalogLocales(cfg *config.InterestsConfig, localizer *localization.Localizer) []string {
+	var warnings []string
+
+	for _, key := range sortedCatalogKeys(cfg.Interests) {
+		languages := make([]string, 0, len(cfg.Interests[key].Translations))
+		for lang := range cfg.Interests[key].Translations {
+			languages = append(languages, lang)
+		}
+
+		sort.Strings(languages)
+
+		for _, lang := range languages {
+			if !localizer.Has(lang, "interest_"+key) {
+				warnings = append(warnings, fmt.Sprintf("locales/%s.json has no interest_%s", lang, key))
+			}
+		}
+	}
+
+	return warnings
+}
+
+// FormatInterestCatalogPlan форматирует план синхронизации для вывода в консоль.
+func FormatInterestCatalogPlan(plan *models.InterestCatalogPlan) string {
+	if plan.IsEmpty() {
+		return "Interest catalog is in sync, nothing to do.\n"
+	}
+
+	var text strings.Builder
+
+	for _, category := range plan.Categories {
+		fmt.Fprintf(&text, "* category %s: display order %d\n", category.KeyName, category.DisplayOrder)
+	}
+
+	for _, change := range plan.Changes {
+		switch change.Action {
+		case models.InterestCatalogInsert:
+			fmt.Fprintf(&text, "+ insert %s [%s #%d]\n", change.After.KeyName, change.After.CategoryKey, change.After.DisplayOrder)
+			writeTranslationsDiff(&text, nil, change.After.Translations)
+		case models.InterestCatalogRename:
+			fmt.Fprintf(&text, "~ rename %s -> %s (%d users keep their selection)\n",
+				change.Before.KeyName, change.After.KeyName, change.Before.SelectionsCount)
+			writeCatalogItemDiff(&text, change.Before, change.After)
+		case models.InterestCatalogUpdate:
+			fmt.Fprintf(&text, "~ update %s\n", change.After.KeyName)
+			writeCatalogItemDiff(&text, change.Before, change.After)
+		case models.InterestCatalogRemove:
+			fmt.Fprintf(&text, "- remove %s (selected by %d users", change.Before.KeyName, change.Before.SelectionsCount)
+
+			if change.MigrateTo != "" {
+				fmt.Fprintf(&text, ", migrate to %s", change.MigrateTo)
+			}
+
+			text.WriteString(")\n")
+			writeTranslationsDiff(&text, change.Before.Translations, nil)
+		}
+	}
+
+	return text.String()
+}
+
+// writeCatalogItemDiff выводит изменившиеся поля интереса.
+func writeCatalogItemDiff(text *strings.Builder, before, after *models.InterestCatalogItem) {
+	if !before.IsCatalogManaged() {
+		fmt.Fprintf(text, "    source: %s -> %s\n", before.Source, models.InterestSourceCatalog)
+	}
+
+	if before.CategoryKey != after.CategoryKey {
+		fmt.Fprintf(text, "    category: %s -> %s\n", before.CategoryKey, after.CategoryKey)
+	}
+
+	if before.DisplayOrder != after.DisplayOrder {
+		fmt.Fprintf(text, "    display order: %d -> %d\n", before.DisplayOrder, after.DisplayOrder)
+	}
+
+	writeTranslationsDiff(text, before.Translations, after.Translations)
+}
+
+// writeTranslationsDiff выводит добавленные, измененные и удаленные переводы.
+func writeTranslationsDiff(text *strings.Builder, before, after map[string]string) {
+	languages := make(map[string]bool, len(before)+len(after))
+	for lang := range before {
+		languages[lang] = true
+	}
+
+	for lang := range after {
+		languages[lang] = true
+	}
+
+	codes := make([]string, 0, len(languages))
+	for lang := range languages {
+		codes = append(codes, lang)
+	}
+
+	sort.Strings(codes)
+
+	for _, lang := range codes {
+		oldName, hadOld := before[lang]
+		newName, hasNew := after[lang]
+
+		switch {
+		case !hadOld:
+			fmt.Fprintf(text, "    %s: + %q\n", lang, newName)
+		case !hasNew:
+			fmt.Fprintf(text, "    %s: - %q\n", lang, oldName)
+		case oldName != newName:
+			fmt.Fprintf(text, "    %s: %q -> %q\n", lang, oldName, newName)
+		}
+	}
+}
+
+// catalogChange создает изменение каталога.
+func catalogChange(action string, before, after *models.InterestCatalogItem) models.InterestCatalogChange {
+	return models.InterestCatalogChange{Action: action, Before: before, After: after}
+}
+
+// catalogItemsEqual сравнивает интерес из БД с записью файла (без ID и числа выборов).
+func catalogItemsEqual(a, b models.InterestCatalogItem) bool {
+	if a.CategoryKey != b.CategoryKey || a.DisplayOrder != b.DisplayOrder || len(a.Translations) != len(b.Translations) {
+		return false
+	}
+
+	for lang, name := range a.Translations {
+		if b.Translations[lang] != name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortedCatalogKeys возвращает ключи каталога в алфавитном порядке.
+func sortedCatalogKeys(interests map[string]config.InterestCatalogEntry) []string {
+	keys := make([]string, 0, len(interests))
+	for key := range interests {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,178 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// testCatalogConfig создает файл каталога из двух категорий.
+func testCatalogConfig(interests map[string]config.InterestCatalogEntry) *config.InterestsConfig {
+	return &config.InterestsConfig{
+		Categories: map[string]config.CategoryConfig{
+			"entertainment": {DisplayOrder: 1},
+			"active":        {DisplayOrder: 2},
+		},
+		Interests: interests,
+	}
+}
+
+// testCatalogSnapshot создает снимок БД с интересами music и sports.
+func testCatalogSnapshot() *models.InterestCatalog {
+	return &models.InterestCatalog{
+		Categories: []models.InterestCategory{
+			{ID: 1, KeyName: "entertainment", DisplayOrder: 1},
+			{ID: 2, KeyName: "active", DisplayOrder: 2},
+		},
+		Interests: []models.InterestCatalogItem{
+			{ID: 10, KeyName: "music", CategoryKey: "entertainment", DisplayOrder: 1, Translations: map[string]string{"en": "Music", "ru": "Музыка"}},
+			{ID: 11, KeyName: "sports", CategoryKey: "active", DisplayOrder: 1, Translations: map[string]string{"en": "Sports"}, SelectionsCount: 4},
+		},
+	}
+}
+
+// TestPlanInterestCatalogSync тестирует построение плана вставок, переименований, обновлений и удалений.
+func TestPlanInterestCatalogSync(t *testing.T) {
+	cfg := testCatalogConfig(map[string]config.InterestCatalogEntry{
+		"music":        {Category: "entertainment", DisplayOrder: 1, Translations: map[string]string{"en": "Music", "ru": "Музыка 🎵"}},
+		"sport_events": {Category: "active", DisplayOrder: 1, PreviousKeys: []string{"sports"}, Translations: map[string]string{"en": "Sports"}},
+		"anime":        {Category: "entertainment", DisplayOrder: 2, Translations: map[string]string{"en": "Anime"}},
+	})
+
+	plan, err := PlanInterestCatalogSync(cfg, testCatalogSnapshot(), nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, plan.Categories)
+	require.Len(t, plan.Changes, 3)
+
+	assert.Equal(t, models.InterestCatalogRename, plan.Changes[0].Action)
+	assert.Equal(t, "sports", plan.Changes[0].Before.KeyName)
+	assert.Equal(t, "sport_events", plan.Changes[0].After.KeyName)
+
+	assert.Equal(t, models.InterestCatalogInsert, plan.Changes[1].Action)
+	assert.Equal(t, "anime", plan.Changes[1].After.KeyName)
+
+	assert.Equal(t, models.InterestCatalogUpdate, plan.Changes[2].Action)
+	assert.Equal(t, 10, plan.Changes[2].After.ID)
+
+	text := FormatInterestCatalogPlan(plan)
+	assert.Contains(t, text, "~ rename sports -> sport_events (4 users keep their selection)")
+	assert.Contains(t, text, `ru: "Музыка" -> "Музыка 🎵"`)
+	assert.Contains(t, text, "+ insert anime [entertainment #2]")
+}
+
+// TestPlanInterestCatalogSync_InSync тестирует пустой план для совпадающего каталога.
+func TestPlanInterestCatalogSync_InSync(t *testing.T) {
+	cfg := testCatalogConfig(map[string]config.InterestCatalogEntry{
+		"music":  {Category: "entertainment", DisplayOrder: 1, Translations: map[string]string{"en": "Music", "ru": "Музыка"}},
+		"sports": {Category: "active", DisplayOrder: 1, Translations: map[string]string{"en": "Sports"}},
+	})
+
+	plan, err := PlanInterestCatalogSync(cfg, testCatalogSnapshot(), nil)
+
+	require.NoError(t, err)
+	assert.True(t, plan.IsEmpty())
+}
+
+// TestPlanInterestCatalogSync_Removal тестирует удаление выбранного интереса только с переносом.
+func TestPlanInterestCatalogSync_Removal(t *testing.T) {
+	cfg := testCatalogConfig(map[string]config.InterestCatalogEntry{
+		"music": {Category: "entertainment", DisplayOrder: 1, Translations: map[string]string{"en": "Music", "ru": "Музыка"}},
+	})
+
+	_, err := PlanInterestCatalogSync(cfg, testCatalogSnapshot(), nil)
+	assert.ErrorIs(t, err, errorsPkg.ErrInterestMigrationRequired)
+
+	_, err = PlanInterestCatalogSync(cfg, testCatalogSnapshot(), map[string]string{"sports": "fitness"})
+	assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestMigration)
+
+	_, err = PlanInterestCatalogSync(cfg, testCatalogSnapshot(), map[string]string{"sports": "music", "music": "sports"})
+	assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestMigration)
+
+	plan, err := PlanInterestCatalogSync(cfg, testCatalogSnapshot(), map[string]string{"sports": "music"})
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, models.InterestCatalogRemove, plan.Changes[0].Action)
+	assert.Equal(t, "music", plan.Changes[0].MigrateTo)
+}
+
+// TestPlanInterestCatalogSync_DatabaseInterests тестирует, что интересы из предложений и admin API
+// не удаляются синхронизацией, а внесенные в файл переходят под управление каталога.
+func TestPlanInterestCatalogSync_DatabaseInterests(t *testing.T) {
+	snapshot := testCatalogSnapshot()
+	snapshot.Interests = append(snapshot.Interests,
+		models.InterestCatalogItem{ID: 12, KeyName: "chess", CategoryKey: "active", DisplayOrder: 2,
+			Translations: map[string]string{"en": "Chess"}, SelectionsCount: 3, Source: models.InterestSourceSuggestion},
+		models.InterestCatalogItem{ID: 13, KeyName: "karaoke", CategoryKey: "entertainment", DisplayOrder: 2,
+			Translations: map[string]string{"en": "Karaoke"}, Source: models.InterestSourceAdmin},
+	)
+
+	cfg := testCatalogConfig(map[string]config.InterestCatalogEntry{
+		"music":   {Category: "entertainment", DisplayOrder: 1, Translations: map[string]string{"en": "Music", "ru": "Музыка"}},
+		"sports":  {Category: "active", DisplayOrder: 1, Translations: map[string]string{"en": "Sports"}},
+		"karaoke": {Category: "entertainment", DisplayOrder: 2, Translations: map[string]string{"en": "Karaoke"}},
+	})
+
+	plan, err := PlanInterestCatalogSync(cfg, snapshot, nil)
+
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	assert.Equal(t, models.InterestCatalogUpdate, plan.Changes[0].Action)
+	assert.Equal(t, "karaoke", plan.Changes[0].After.KeyName)
+	assert.Contains(t, FormatInterestCatalogPlan(plan), "source: admin -> catalog")
+
+	_, err = PlanInterestCatalogSync(cfg, snapshot, map[string]string{"chess": "sports"})
+	assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestMigration)
+}
+
+// TestPlanInterestCatalogSync_Validation тестирует отказ на некорректном файле каталога.
+func TestPlanInterestCatalogSync_Validation(t *testing.T) {
+	tests := []struct {
+		name      string
+		interests map[string]config.InterestCatalogEntry
+		wantErr   error
+	}{
+		{name: "empty catalog", wantErr: errorsPkg.ErrInterestCatalogEmpty},
+		{
+			name:      "unknown category",
+			interests: map[string]config.InterestCatalogEntry{"chess": {Category: "board", Translations: map[string]string{"en": "Chess"}}},
+			wantErr:   errorsPkg.ErrInterestCategoryNotFound,
+		},
+		{
+			name:      "invalid key",
+			interests: map[string]config.InterestCatalogEntry{"Chess!": {Category: "active", Translations: map[string]string{"en": "Chess"}}},
+			wantErr:   errorsPkg.ErrInvalidInterestCatalog,
+		},
+		{
+			name: "previous key still in catalog",
+			interests: map[string]config.InterestCatalogEntry{
+				"music":  {Category: "entertainment", Translations: map[string]string{"en": "Music"}},
+				"sounds": {Category: "entertainment", PreviousKeys: []string{"music"}, Translations: map[string]string{"en": "Sounds"}},
+			},
+			wantErr: errorsPkg.ErrInvalidInterestCatalog,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := PlanInterestCatalogSync(testCatalogConfig(tt.interests), testCatalogSnapshot(), nil)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestInterestCatalogFile проверяет, что каталог в config/interests.json проходит валидацию.
+func TestInterestCatalogFile(t *testing.T) {
+	cfg, err := config.LoadInterestsConfigFile("../../config/interests.json")
+	require.NoError(t, err)
+
+	plan, err := PlanInterestCatalogSync(cfg, &models.InterestCatalog{}, nil)
+	require.NoError(t, err)
+	assert.Len(t, plan.Changes, len(cfg.Interests))
+}
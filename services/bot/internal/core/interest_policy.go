@@ -0,0 +1,159 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Правила политики выбора основных интересов.
+const (
+	PrimaryPolicyRuleTotal    = "total"    // Превышен общий лимит основных интересов
+	PrimaryPolicyRuleCategory = "category" // Превышен лимит основных интересов в категории
+)
+
+// PrimaryPolicyViolation описывает, почему интерес нельзя отметить основным.
+// Через Unwrap сравнивается с ErrMaxPrimaryInterestsReached или ErrMaxPrimaryPerCategoryReached.
+type PrimaryPolicyViolation struct {
+	Rule        string
+	CategoryKey string // Заполняется только для PrimaryPolicyRuleCategory
+	Limit       int
+}
+
+// Error реализует интерфейс error.
+func (v *PrimaryPolicyViolation) Error() string {
+	if v.Rule == PrimaryPolicyRuleCategory {
+		return fmt.Sprintf("primary interests limit for category %q reached (max: %d)", v.CategoryKey, v.Limit)
+	}
+
+	return fmt.Sprintf("primary interests limit reached (max: %d)", v.Limit)
+}
+
+// Unwrap возвращает статическую ошибку валидации для errors.Is/errors.As.
+func (v *PrimaryPolicyViolation) Unwrap() error {
+	if v.Rule == PrimaryPolicyRuleCategory {
+		return errorsPkg.ErrMaxPrimaryPerCategoryReached
+	}
+
+	return errorsPkg.ErrMaxPrimaryInterestsReached
+}
+
+// PrimarySelectionPolicy - лимиты основных интересов: общий и по категориям из interests.json.
+// Категория без записи в конфигурации (или с нулевым лимитом) ограничена только общим лимитом.
+type PrimarySelectionPolicy struct {
+	MaxPrimaryInterests   int
+	MaxPrimaryPerCategory map[string]int
+}
+
+// NewPrimarySelectionPolicy собирает политику из конфигурации интересов.
+func NewPrimarySelectionPolicy(cfg *config.InterestsConfig) *PrimarySelectionPolicy {
+	policy := &PrimarySelectionPolicy{
+		MaxPrimaryInterests:   cfg.InterestLimits.MaxPrimaryInterests,
+		MaxPrimaryPerCategory: make(map[string]int, len(cfg.Categories)),
+	}
+
+	for key, category := range cfg.Categories {
+		if category.MaxPrimaryPerCategory > 0 {
+			policy.MaxPrimaryPerCategory[key] = category.MaxPrimaryPerCategory
+		}
+	}
+
+	return policy
+}
+
+// CheckMark проверяет, можно ли отметить основным еще один интерес категории categoryKey,
+// если у пользователя уже totalPrimary основных интересов, из них categoryPrimary в этой категории.
+func (p *PrimarySelectionPolicy) CheckMark(totalPrimary, categoryPrimary int, categoryKey string) error {
+	if p.MaxPrimaryInterests > 0 && totalPrimary >= p.MaxPrimaryInterests {
+		return &PrimaryPolicyViolation{Rule: PrimaryPolicyRuleTotal, Limit: p.MaxPrimaryInterests}
+	}
+
+	if limit, ok := p.MaxPrimaryPerCategory[categoryKey]; ok && categoryPrimary >= limit {
+		return &PrimaryPolicyViolation{Rule: PrimaryPolicyRuleCategory, CategoryKey: categoryKey, Limit: limit}
+	}
+
+	return nil
+}
+
+// CheckMarkInSelections проверяет отметку интереса interestID основным в наборе selections.
+// Сам интерес в подсчете не участвует, поэтому повторная отметка не считается превышением.
+func (p *PrimarySelectionPolicy) CheckMarkInSelections(
+	selections []models.InterestSelection, categoryOf map[int]string, interestID int,
+) error {
+	categoryKey := categoryOf[interestID]
+	totalPrimary, categoryPrimary := 0, 0
+
+	for _, selection := range selections {
+		if !selection.IsPrimary || selection.InterestID == interestID {
+			continue
+		}
+
+		totalPrimary++
+
+		if categoryOf[selection.InterestID] == categoryKey {
+			categoryPrimary++
+		}
+	}
+
+	return p.CheckMark(totalPrimary, categoryPrimary, categoryKey)
+}
+
+// Validate проверяет итоговый набор выборов целиком (пакетное сохранение).
+// Категории проверяются в алфавитном порядке, чтобы ответ был детерминированным.
+func (p *PrimarySelectionPolicy) Validate(selections []models.InterestSelection, categoryOf map[int]string) error {
+	totalPrimary := 0
+	categoryPrimary := make(map[string]int)
+
+	for _, selection := range selections {
+		if !selection.IsPrimary {
+			continue
+		}
+
+		totalPrimary++
+		categoryPrimary[categoryOf[selection.InterestID]]++
+	}
+
+	if p.MaxPrimaryInterests > 0 && totalPrimary > p.MaxPrimaryInterests {
+		return &PrimaryPolicyViolation{Rule: PrimaryPolicyRuleTotal, Limit: p.MaxPrimaryInterests}
+	}
+
+	categoryKeys := make([]string, 0, len(categoryPrimary))
+	for key := range categoryPrimary {
+		categoryKeys = append(categoryKeys, key)
+	}
+
+	sort.Strings(categoryKeys)
+
+	for _, key := range categoryKeys {
+		if limit, ok := p.MaxPrimaryPerCategory[key]; ok && categoryPrimary[key] > limit {
+			return &PrimaryPolicyViolation{Rule: PrimaryPolicyRuleCategory, CategoryKey: key, Limit: limit}
+		}
+	}
+
+	return nil
+}
+
+// PrimaryPolicyErrorMessage возвращает локализованное объяснение отказа в отметке основного интереса.
+// Для ошибок, не связанных с политикой, возвращает пустую строку.
+func (s *BotService) PrimaryPolicyErrorMessage(err error, lang string) string {
+	var violation *PrimaryPolicyViolation
+	if !errors.As(err, &violation) {
+		return ""
+	}
+
+	if violation.Rule == PrimaryPolicyRuleCategory {
+		return s.Localizer.GetWithParams(lang, localization.LocaleErrorPrimaryLimitCategory, map[string]string{
+			"category": s.InterestCategoryName(lang, violation.CategoryKey),
+			"max":      fmt.Sprintf("%d", violation.Limit),
+		})
+	}
+
+	return s.Localizer.GetWithParams(lang, localization.LocaleErrorPrimaryLimitTotal, map[string]string{
+		"max": fmt.Sprintf("%d", violation.Limit),
+	})
+}
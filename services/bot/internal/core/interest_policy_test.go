@@ -0,0 +1,142 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+func testPrimaryPolicy() *PrimarySelectionPolicy {
+	return NewPrimarySelectionPolicy(&config.InterestsConfig{
+		InterestLimits: config.InterestLimitsConfig{MaxPrimaryInterests: 3},
+		Categories: map[string]config.CategoryConfig{
+			"entertainment": {MaxPrimaryPerCategory: 2},
+			"social":        {MaxPrimaryPerCategory: 0},
+		},
+	})
+}
+
+// TestPrimarySelectionPolicy_CheckMark тестирует проверку отметки одного интереса.
+func TestPrimarySelectionPolicy_CheckMark(t *testing.T) {
+	policy := testPrimaryPolicy()
+
+	tests := []struct {
+		name            string
+		totalPrimary    int
+		categoryPrimary int
+		categoryKey     string
+		wantErr         error
+	}{
+		{name: "within limits", totalPrimary: 1, categoryPrimary: 1, categoryKey: "entertainment"},
+		{name: "category full", totalPrimary: 2, categoryPrimary: 2, categoryKey: "entertainment", wantErr: errorsPkg.ErrMaxPrimaryPerCategoryReached},
+		{name: "total full", totalPrimary: 3, categoryPrimary: 0, categoryKey: "education", wantErr: errorsPkg.ErrMaxPrimaryInterestsReached},
+		{name: "zero limit means no category limit", totalPrimary: 2, categoryPrimary: 2, categoryKey: "social"},
+		{name: "category missing in config", totalPrimary: 2, categoryPrimary: 2, categoryKey: "education"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.CheckMark(tt.totalPrimary, tt.categoryPrimary, tt.categoryKey)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestPrimarySelectionPolicy_CheckMarkInSelections тестирует проверку по несохраненному набору.
+func TestPrimarySelectionPolicy_CheckMarkInSelections(t *testing.T) {
+	policy := testPrimaryPolicy()
+	categoryOf := map[int]string{1: "entertainment", 2: "entertainment", 3: "entertainment", 4: "education"}
+	selections := []models.InterestSelection{
+		{InterestID: 1, IsPrimary: true},
+		{InterestID: 2, IsPrimary: true},
+		{InterestID: 3},
+		{InterestID: 4},
+	}
+
+	var violation *PrimaryPolicyViolation
+
+	err := policy.CheckMarkInSelections(selections, categoryOf, 3)
+	require.True(t, errors.As(err, &violation))
+	assert.Equal(t, PrimaryPolicyRuleCategory, violation.Rule)
+	assert.Equal(t, "entertainment", violation.CategoryKey)
+	assert.Equal(t, 2, violation.Limit)
+
+	// Интерес другой категории отметить можно, повторная отметка не считается превышением
+	assert.NoError(t, policy.CheckMarkInSelections(selections, categoryOf, 4))
+	assert.NoError(t, policy.CheckMarkInSelections(selections, categoryOf, 1))
+}
+
+// TestPrimarySelectionPolicy_Validate тестирует проверку итогового набора при пакетном сохранении.
+func TestPrimarySelectionPolicy_Validate(t *testing.T) {
+	policy := testPrimaryPolicy()
+	categoryOf := map[int]string{1: "entertainment", 2: "entertainment", 3: "entertainment", 4: "social", 5: "social"}
+
+	tests := []struct {
+		name       string
+		selections []models.InterestSelection
+		wantErr    error
+	}{
+		{
+			name: "valid",
+			selections: []models.InterestSelection{
+				{InterestID: 1, IsPrimary: true}, {InterestID: 2, IsPrimary: true}, {InterestID: 3},
+			},
+		},
+		{
+			name: "category exceeded",
+			selections: []models.InterestSelection{
+				{InterestID: 1, IsPrimary: true}, {InterestID: 2, IsPrimary: true}, {InterestID: 3, IsPrimary: true},
+			},
+			wantErr: errorsPkg.ErrMaxPrimaryPerCategoryReached,
+		},
+		{
+			name: "total exceeded",
+			selections: []models.InterestSelection{
+				{InterestID: 1, IsPrimary: true}, {InterestID: 2, IsPrimary: true},
+				{InterestID: 4, IsPrimary: true}, {InterestID: 5, IsPrimary: true},
+			},
+			wantErr: errorsPkg.ErrMaxPrimaryInterestsReached,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.selections, categoryOf)
+
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// TestPrimaryPolicyErrorMessage тестирует выбор локализованного объяснения отказа.
+func TestPrimaryPolicyErrorMessage(t *testing.T) {
+	service := NewBotServiceWithInterface(new(MockDatabase), &localization.Localizer{})
+
+	assert.Equal(t, localization.LocaleErrorPrimaryLimitCategory, service.PrimaryPolicyErrorMessage(
+		&PrimaryPolicyViolation{Rule: PrimaryPolicyRuleCategory, CategoryKey: "social", Limit: 2}, "en",
+	))
+	assert.Equal(t, localization.LocaleErrorPrimaryLimitTotal, service.PrimaryPolicyErrorMessage(
+		&PrimaryPolicyViolation{Rule: PrimaryPolicyRuleTotal, Limit: 5}, "en",
+	))
+	assert.Empty(t, service.PrimaryPolicyErrorMessage(errorsPkg.ErrInterestNotFound, "en"))
+}
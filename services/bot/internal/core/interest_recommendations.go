@@ -0,0 +1,75 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// RefreshInterestCooccurrences пересчитывает статистику «выбравшие X также выбрали Y».
+func (s *BotService) RefreshInterestCooccurrences() (int64, error) {
+	pairs, err := s.DB.RefreshInterestCooccurrences(localization.MinInterestCooccurrenceUsers)
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh interest cooccurrences: %w", err)
+	}
+
+	return pairs, nil
+}
+
+// StartInterestCooccurrenceRefresh запускает периодический пересчет рекомендаций интересов.
+// Работает до отмены контекста.
+func (s *BotService) StartInterestCooccurrenceRefresh(ctx context.Context) {
+	ticker := time.NewTicker(localization.InterestCooccurrenceRefreshInterval)
+	defer ticker.Stop()
+
+	s.runInterestCooccurrenceRefresh()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.runInterestCooccurrenceRefresh()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runInterestCooccurrenceRefresh выполняет одну итерацию пересчета.
+func (s *BotService) runInterestCooccurrenceRefresh() {
+	pairs, err := s.RefreshInterestCooccurrences()
+	if err != nil {
+		log.Printf("Failed to refresh interest cooccurrences: %v", err)
+
+		return
+	}
+
+	log.Printf("Interest cooccurrences refreshed: %d pairs", pairs)
+}
+
+// GetSuggestedInterests возвращает рекомендации по текущему (возможно, несохраненному) выбору.
+// Без выбранных интересов рекомендовать не от чего, поэтому возвращается пустой список.
+func (s *BotService) GetSuggestedInterests(selectedInterestIDs []int) ([]models.InterestRecommendation, error) {
+	if len(selectedInterestIDs) == 0 {
+		return nil, nil
+	}
+
+	recommendations, err := s.DB.GetInterestRecommendations(selectedInterestIDs, localization.MaxSuggestedInterests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest recommendations: %w", err)
+	}
+
+	return recommendations, nil
+}
+
+// GetTopInterestCooccurrences возвращает самые частые пары интересов для админов.
+func (s *BotService) GetTopInterestCooccurrences(limit int) ([]models.InterestCooccurrence, error) {
+	if limit <= 0 {
+		limit = localization.DefaultInterestCooccurrenceLimit
+	}
+
+	return s.DB.GetTopInterestCooccurrences(limit)
+}
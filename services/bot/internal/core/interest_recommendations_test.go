@@ -0,0 +1,67 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestGetSuggestedInterests тестирует рекомендации по текущему выбору.
+func TestGetSuggestedInterests(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	recommendations := []models.InterestRecommendation{{InterestID: 7, KeyName: "manga", CategoryKey: "entertainment", Score: 0.8}}
+	mockDB.On("GetInterestRecommendations", []int{1, 2}, localization.MaxSuggestedInterests).Return(recommendations, nil)
+
+	result, err := service.GetSuggestedInterests([]int{1, 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, recommendations, result)
+	mockDB.AssertExpectations(t)
+}
+
+// TestGetSuggestedInterests_NoSelections тестирует, что без выбора рекомендации не запрашиваются.
+func TestGetSuggestedInterests_NoSelections(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	result, err := service.GetSuggestedInterests(nil)
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	mockDB.AssertNotCalled(t, "GetInterestRecommendations", mock.Anything, mock.Anything)
+}
+
+// TestRefreshInterestCooccurrences тестирует пересчет с порогом минимальной поддержки.
+func TestRefreshInterestCooccurrences(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("RefreshInterestCooccurrences", localization.MinInterestCooccurrenceUsers).Return(int64(12), nil)
+
+	pairs, err := service.RefreshInterestCooccurrences()
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), pairs)
+	mockDB.AssertExpectations(t)
+}
+
+// TestGetTopInterestCooccurrences_DefaultLimit тестирует размер выборки по умолчанию.
+func TestGetTopInterestCooccurrences_DefaultLimit(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetTopInterestCooccurrences", localization.DefaultInterestCooccurrenceLimit).
+		Return([]models.InterestCooccurrence{}, nil)
+
+	_, err := service.GetTopInterestCooccurrences(0)
+
+	require.NoError(t, err)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// GetInterestRelations возвращает граф связей между интересами.
+func (s *BotService) GetInterestRelations() ([]models.InterestRelation, error) {
+	return s.DB.GetInterestRelations()
+}
+
+// SaveInterestRelation добавляет связь между интересами или меняет ее тип.
+// Для связи parent первым указывается общий интерес, вторым - его частный случай.
+func (s *BotService) SaveInterestRelation(interestKey, relatedKey, relationType string) (*models.InterestRelation, error) {
+	interestKey = strings.TrimSpace(interestKey)
+	relatedKey = strings.TrimSpace(relatedKey)
+
+	if interestKey == "" || relatedKey == "" || interestKey == relatedKey {
+		return nil, errorsPkg.ErrInvalidInterestRelation
+	}
+
+	if relationType != models.InterestRelationRelated && relationType != models.InterestRelationParent {
+		return nil, errorsPkg.ErrInvalidInterestRelation
+	}
+
+	relation := &models.InterestRelation{
+		InterestKey:        interestKey,
+		RelatedInterestKey: relatedKey,
+		RelationType:       relationType,
+	}
+
+	if err := s.DB.SaveInterestRelation(relation); err != nil {
+		return nil, fmt.Errorf("failed to save interest relation: %w", err)
+	}
+
+	return relation, nil
+}
+
+// DeleteInterestRelation удаляет связь между интересами.
+func (s *BotService) DeleteInterestRelation(relationID int) error {
+	if err := s.DB.DeleteInterestRelation(relationID); err != nil {
+		return fmt.Errorf("failed to delete interest relation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// interestMaps создает карты интересов из списка ID (без основных интересов).
+func interestMaps(ids ...int) *UserInterestMaps {
+	maps := &UserInterestMaps{AllInterests: map[int]bool{}, PrimaryInterests: map[int]bool{}}
+	for _, id := range ids {
+		maps.AllInterests[id] = true
+	}
+
+	return maps
+}
+
+// TestCalculateRelatedInterestsScore тестирует частичные баллы за связанные интересы.
+func TestCalculateRelatedInterestsScore(t *testing.T) {
+	cfg := &config.MatchingConfig{RelatedInterestScore: 1, ParentChildInterestScore: 2}
+	graph := interestRelationGraph{
+		relationKey(1, 2): models.InterestRelationRelated, // anime - tv_shows
+		relationKey(3, 4): models.InterestRelationParent,  // sports - fitness
+		relationKey(1, 5): models.InterestRelationParent,
+	}
+
+	tests := []struct {
+		name  string
+		user1 *UserInterestMaps
+		user2 *UserInterestMaps
+		want  int
+	}{
+		{name: "no relations between sets", user1: interestMaps(1), user2: interestMaps(7), want: 0},
+		{name: "related pair", user1: interestMaps(1), user2: interestMaps(2), want: 1},
+		{name: "relation is symmetric", user1: interestMaps(4), user2: interestMaps(3), want: 2},
+		{name: "exact matches are not double counted", user1: interestMaps(1, 2), user2: interestMaps(1, 2), want: 0},
+		{name: "best pair wins and each interest is used once", user1: interestMaps(1), user2: interestMaps(2, 5), want: 2},
+		{name: "several pairs", user1: interestMaps(1, 3), user2: interestMaps(2, 4), want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, calculateRelatedInterestsScore(tt.user1, tt.user2, graph, cfg))
+		})
+	}
+}
+
+// TestSaveInterestRelation_Validation тестирует проверку связи до обращения к БД.
+func TestSaveInterestRelation_Validation(t *testing.T) {
+	tests := []struct {
+		name         string
+		interestKey  string
+		relatedKey   string
+		relationType string
+	}{
+		{name: "same interest", interestKey: "anime", relatedKey: "anime", relationType: models.InterestRelationRelated},
+		{name: "empty key", interestKey: "anime", relatedKey: " ", relationType: models.InterestRelationRelated},
+		{name: "unknown type", interestKey: "anime", relatedKey: "tv_shows", relationType: "sibling"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := new(MockDatabase)
+			service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+			_, err := service.SaveInterestRelation(tt.interestKey, tt.relatedKey, tt.relationType)
+
+			assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestRelation)
+			mockDB.AssertNotCalled(t, "SaveInterestRelation", mock.Anything)
+		})
+	}
+}
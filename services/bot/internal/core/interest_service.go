@@ -3,21 +3,82 @@ package core
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"sort"
 
 	"language-exchange-bot/internal/config"
 	errorsPkg "language-exchange-bot/internal/errors"
 	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/logging"
 	"language-exchange-bot/internal/models"
+
+	"github.com/lib/pq"
 )
 
 // Константы для SQL запросов.
 const (
-	// countPrimaryInterestsQuery - запрос для подсчета основных интересов пользователя.
-	countPrimaryInterestsQuery = `SELECT COUNT(*) FROM user_interest_selections WHERE user_id = $1 AND is_primary = true`
+	// primaryMarkContextQuery - категория интереса и число уже отмеченных основных интересов
+	// пользователя (всего и в этой категории), не считая сам интерес.
+	primaryMarkContextQuery = `
+		SELECT c.key_name,
+			(SELECT COUNT(*) FROM user_interest_selections
+				WHERE user_id = $1 AND is_primary = true AND interest_id <> $2),
+			(SELECT COUNT(*) FROM user_interest_selections uis
+				JOIN interests oi ON oi.id = uis.interest_id
+				WHERE uis.user_id = $1 AND uis.is_primary = true AND uis.interest_id <> $2
+					AND oi.category_id = i.category_id)
+		FROM interests i
+		JOIN interest_categories c ON c.id = i.category_id
+		WHERE i.id = $2`
+
+	// interestCategoryKeysQuery - ключи категорий для набора интересов.
+	interestCategoryKeysQuery = `
+		SELECT i.id, c.key_name
+		FROM interests i
+		JOIN interest_categories c ON c.id = i.category_id
+		WHERE i.id = ANY($1)`
+
+	// activeUnavailabilityQuery - запрос для проверки, находится ли пользователь сейчас в отпуске.
+	activeUnavailabilityQuery = `SELECT EXISTS(
+		SELECT 1 FROM user_unavailability_periods
+		WHERE user_id = $1 AND CURRENT_DATE BETWEEN start_date AND end_date
+	)`
+
+	// userLearningGoalsQuery - запрос целей изучения языка пользователя.
+	userLearningGoalsQuery = `SELECT goal FROM user_learning_goals WHERE user_id = $1`
+
+	// interestRelationsQuery - запрос связей между интересами из заданного набора.
+	// Граф читается при каждом расчете, поэтому правки через admin API применяются сразу.
+	interestRelationsQuery = `SELECT interest_id, related_interest_id, relation_type
+		FROM interest_relations
+		WHERE interest_id = ANY($1) AND related_interest_id = ANY($1)`
 )
 
+// interestRelationGraph - связи между интересами: пара ID (меньший, больший) -> тип связи.
+type interestRelationGraph map[[2]int]string
+
+// relationKey возвращает ключ пары интересов независимо от порядка.
+func relationKey(a, b int) [2]int {
+	if a > b {
+		a, b = b, a
+	}
+
+	return [2]int{a, b}
+}
+
+// credit возвращает частичный балл за пару разных интересов с учетом типа связи.
+func (g interestRelationGraph) credit(a, b int, config *config.MatchingConfig) int {
+	switch g[relationKey(a, b)] {
+	case models.InterestRelationParent:
+		return config.ParentChildInterestScore
+	case models.InterestRelationRelated:
+		return config.RelatedInterestScore
+	default:
+		return 0
+	}
+}
+
 // Interest service constants are now defined in localization/constants.go
 
 // InterestService handles user interest management and matching.
@@ -145,25 +206,10 @@ func (s *InterestService) AddUserInterestSelection(userID, interestID int, isPri
 		return fmt.Errorf("operation failed: %w", err)
 	}
 
-	// Если это основной интерес, проверяем лимиты
+	// Если это основной интерес, проверяем общий лимит и лимит категории
 	if isPrimary {
-		var primaryCount int
-
-		countQuery := countPrimaryInterestsQuery
-
-		err = s.db.QueryRowContext(context.Background(), countQuery, userID).Scan(&primaryCount)
-		if err != nil {
-			return fmt.Errorf("operation failed: %w", err)
-		}
-
-		// Получаем конфигурацию лимитов
-		limits, limitsErr := s.GetInterestLimitsConfig()
-		if limitsErr != nil {
-			return limitsErr
-		}
-
-		if primaryCount >= limits.MaxPrimaryInterests {
-			return errorsPkg.ErrMaxPrimaryInterestsReached
+		if err = s.checkPrimaryMark(userID, interestID); err != nil {
+			return err
 		}
 	}
 
@@ -195,24 +241,10 @@ func (s *InterestService) RemoveUserInterestSelection(userID, interestID int) er
 
 // SetPrimaryInterest устанавливает интерес как основной.
 func (s *InterestService) SetPrimaryInterest(userID, interestID int, isPrimary bool) error {
-	// Проверяем лимиты основных интересов
+	// Проверяем общий лимит основных интересов и лимит категории
 	if isPrimary {
-		var primaryCount int
-
-		countQuery := countPrimaryInterestsQuery
-
-		err := s.db.QueryRowContext(context.Background(), countQuery, userID).Scan(&primaryCount)
-		if err != nil {
-			return fmt.Errorf("operation failed: %w", err)
-		}
-
-		limits, err := s.GetInterestLimitsConfig()
-		if err != nil {
-			return fmt.Errorf("operation failed: %w", err)
-		}
-
-		if primaryCount >= limits.MaxPrimaryInterests {
-			return errorsPkg.ErrMaxPrimaryInterestsReached
+		if err := s.checkPrimaryMark(userID, interestID); err != nil {
+			return err
 		}
 	}
 
@@ -236,6 +268,132 @@ func (s *InterestService) GetInterestLimitsConfig() (*config.InterestLimitsConfi
 	return &interestsConfig.InterestLimits, nil
 }
 
+// GetPrimarySelectionPolicy возвращает политику выбора основных интересов из interests.json.
+func (s *InterestService) GetPrimarySelectionPolicy() (*PrimarySelectionPolicy, error) {
+	interestsConfig, err := config.LoadInterestsConfig()
+	if err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return NewPrimarySelectionPolicy(interestsConfig), nil
+}
+
+// CheckPrimaryMarkInSelections проверяет отметку interestID основным в несохраненном наборе
+// (например, в сессии редактора) по той же политике, что и сохранение в БД.
+func (s *InterestService) CheckPrimaryMarkInSelections(selections []models.InterestSelection, interestID int) error {
+	policy, err := s.GetPrimarySelectionPolicy()
+	if err != nil {
+		return err
+	}
+
+	interestIDs := make([]int, 0, len(selections)+1)
+	interestIDs = append(interestIDs, interestID)
+
+	for _, selection := range selections {
+		interestIDs = append(interestIDs, selection.InterestID)
+	}
+
+	categoryOf, err := s.getInterestCategoryKeys(interestIDs)
+	if err != nil {
+		return err
+	}
+
+	return policy.CheckMarkInSelections(selections, categoryOf, interestID)
+}
+
+// checkPrimaryMark проверяет политику перед отметкой сохраненного интереса основным.
+func (s *InterestService) checkPrimaryMark(userID, interestID int) error {
+	policy, err := s.GetPrimarySelectionPolicy()
+	if err != nil {
+		return err
+	}
+
+	var (
+		categoryKey     string
+		totalPrimary    int
+		categoryPrimary int
+	)
+
+	err = s.db.QueryRowContext(context.Background(), primaryMarkContextQuery, userID, interestID).Scan(
+		&categoryKey, &totalPrimary, &categoryPrimary,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return errorsPkg.ErrInterestNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("operation failed: %w", err)
+	}
+
+	return policy.CheckMark(totalPrimary, categoryPrimary, categoryKey)
+}
+
+// validatePrimarySelections проверяет итоговый набор выборов по политике основных интересов.
+func (s *InterestService) validatePrimarySelections(selections []models.InterestSelection) error {
+	interestIDs := make([]int, 0, len(selections))
+
+	for _, selection := range selections {
+		if selection.IsPrimary {
+			interestIDs = append(interestIDs, selection.InterestID)
+		}
+	}
+
+	if len(interestIDs) == 0 {
+		return nil
+	}
+
+	policy, err := s.GetPrimarySelectionPolicy()
+	if err != nil {
+		return err
+	}
+
+	categoryOf, err := s.getInterestCategoryKeys(interestIDs)
+	if err != nil {
+		return err
+	}
+
+	return policy.Validate(selections, categoryOf)
+}
+
+// getInterestCategoryKeys возвращает ключи категорий для указанных интересов.
+func (s *InterestService) getInterestCategoryKeys(interestIDs []int) (map[int]string, error) {
+	rows, err := s.db.QueryContext(context.Background(), interestCategoryKeysQuery, pq.Array(interestIDs))
+	if err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			s.logger.ErrorWithContext(
+				"Failed to close database rows",
+				"", 0, 0, "DatabaseOperation",
+				map[string]interface{}{"error": closeErr.Error()},
+			)
+		}
+	}()
+
+	categoryOf := make(map[int]string, len(interestIDs))
+
+	for rows.Next() {
+		var (
+			interestID  int
+			categoryKey string
+		)
+
+		if err := rows.Scan(&interestID, &categoryKey); err != nil {
+			return nil, fmt.Errorf("operation failed: %w", err)
+		}
+
+		categoryOf[interestID] = categoryKey
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return categoryOf, nil
+}
+
 // GetMatchingConfig возвращает конфигурацию для алгоритма сопоставления из файла.
 func (s *InterestService) GetMatchingConfig() (*config.MatchingConfig, error) {
 	interestsConfig, err := config.LoadInterestsConfig()
@@ -247,12 +405,24 @@ func (s *InterestService) GetMatchingConfig() (*config.MatchingConfig, error) {
 }
 
 // CalculateCompatibilityScore вычисляет балл совместимости между пользователями.
+// Если кто-то из пользователей сейчас в отпуске, совместимость равна нулю — такие пары не подбираются.
 func (s *InterestService) CalculateCompatibilityScore(user1ID, user2ID int) (int, error) {
 	matchingConfig, err := s.GetMatchingConfig()
 	if err != nil {
 		return 0, err
 	}
 
+	for _, userID := range []int{user1ID, user2ID} {
+		unavailable, err := s.isUserUnavailable(userID)
+		if err != nil {
+			return 0, err
+		}
+
+		if unavailable {
+			return 0, nil
+		}
+	}
+
 	user1Maps, err := s.buildUserInterestMaps(user1ID)
 	if err != nil {
 		return 0, err
@@ -265,6 +435,27 @@ func (s *InterestService) CalculateCompatibilityScore(user1ID, user2ID int) (int
 
 	score := s.calculateCompatibilityScore(user1Maps, user2Maps, matchingConfig)
 
+	// Частичные баллы за связанные интересы (anime и tv_shows, sports и fitness)
+	graph, err := s.getInterestRelationGraph(user1Maps, user2Maps)
+	if err != nil {
+		return 0, err
+	}
+
+	score += calculateRelatedInterestsScore(user1Maps, user2Maps, graph, matchingConfig)
+
+	// Общие цели изучения — мягкий сигнал: добавляют баллы, но не отсекают пары
+	user1Goals, err := s.getUserLearningGoals(user1ID)
+	if err != nil {
+		return 0, err
+	}
+
+	user2Goals, err := s.getUserLearningGoals(user2ID)
+	if err != nil {
+		return 0, err
+	}
+
+	score += calculateLearningGoalScore(user1Goals, user2Goals, matchingConfig)
+
 	return score, nil
 }
 
@@ -415,6 +606,172 @@ func (s *InterestService) GetInterestCategoryByID(categoryID int) (*models.Inter
 	return &category, nil
 }
 
+// isUserUnavailable проверяет, попадает ли текущая дата в период недоступности пользователя.
+func (s *InterestService) isUserUnavailable(userID int) (bool, error) {
+	var unavailable bool
+
+	err := s.db.QueryRowContext(context.Background(), activeUnavailabilityQuery, userID).Scan(&unavailable)
+	if err != nil {
+		return false, fmt.Errorf("failed to check user unavailability: %w", err)
+	}
+
+	return unavailable, nil
+}
+
+// getUserLearningGoals получает цели изучения языка пользователя.
+func (s *InterestService) getUserLearningGoals(userID int) ([]string, error) {
+	rows, err := s.db.QueryContext(context.Background(), userLearningGoalsQuery, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learning goals: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			s.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var goals []string
+
+	for rows.Next() {
+		var goal string
+		if err := rows.Scan(&goal); err != nil {
+			return nil, fmt.Errorf("failed to scan learning goal: %w", err)
+		}
+
+		goals = append(goals, goal)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return goals, nil
+}
+
+// calculateLearningGoalScore начисляет LearningGoalScore за каждую общую цель изучения языка.
+func calculateLearningGoalScore(goals1, goals2 []string, config *config.MatchingConfig) int {
+	if len(goals1) == 0 || len(goals2) == 0 {
+		return 0
+	}
+
+	set := make(map[string]bool, len(goals1))
+	for _, goal := range goals1 {
+		set[goal] = true
+	}
+
+	score := 0
+
+	for _, goal := range goals2 {
+		if set[goal] {
+			score += config.LearningGoalScore
+			delete(set, goal)
+		}
+	}
+
+	return score
+}
+
+// getInterestRelationGraph загружает связи между интересами двух пользователей.
+func (s *InterestService) getInterestRelationGraph(user1Maps, user2Maps *UserInterestMaps) (interestRelationGraph, error) {
+	interestIDs := make([]int64, 0, len(user1Maps.AllInterests)+len(user2Maps.AllInterests))
+
+	for _, maps := range []*UserInterestMaps{user1Maps, user2Maps} {
+		for interestID := range maps.AllInterests {
+			interestIDs = append(interestIDs, int64(interestID))
+		}
+	}
+
+	graph := make(interestRelationGraph)
+	if len(interestIDs) == 0 {
+		return graph, nil
+	}
+
+	rows, err := s.db.QueryContext(context.Background(), interestRelationsQuery, pq.Array(interestIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest relations: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			s.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			interestID, relatedID int
+			relationType          string
+		)
+
+		if err := rows.Scan(&interestID, &relatedID, &relationType); err != nil {
+			return nil, fmt.Errorf("failed to scan interest relation: %w", err)
+		}
+
+		graph[relationKey(interestID, relatedID)] = relationType
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return graph, nil
+}
+
+// calculateRelatedInterestsScore начисляет частичные баллы за связанные интересы.
+// Учитываются только интересы, которых нет у второго пользователя (точные совпадения уже оценены),
+// и каждый интерес участвует не более чем в одной паре — выбирается пара с наибольшим баллом.
+func calculateRelatedInterestsScore(
+	user1Maps, user2Maps *UserInterestMaps,
+	graph interestRelationGraph,
+	config *config.MatchingConfig,
+) int {
+	if len(graph) == 0 {
+		return 0
+	}
+
+	only1 := exclusiveInterests(user1Maps.AllInterests, user2Maps.AllInterests)
+	only2 := exclusiveInterests(user2Maps.AllInterests, user1Maps.AllInterests)
+	used := make(map[int]bool, len(only2))
+	score := 0
+
+	for _, interestID := range only1 {
+		bestID, bestCredit := 0, 0
+
+		for _, candidateID := range only2 {
+			if used[candidateID] {
+				continue
+			}
+
+			if credit := graph.credit(interestID, candidateID, config); credit > bestCredit {
+				bestID, bestCredit = candidateID, credit
+			}
+		}
+
+		if bestCredit > 0 {
+			used[bestID] = true
+			score += bestCredit
+		}
+	}
+
+	return score
+}
+
+// exclusiveInterests возвращает отсортированные интересы из own, которых нет в other.
+func exclusiveInterests(own, other map[int]bool) []int {
+	result := make([]int, 0, len(own))
+
+	for interestID := range own {
+		if !other[interestID] {
+			result = append(result, interestID)
+		}
+	}
+
+	sort.Ints(result)
+
+	return result
+}
+
 // buildUserInterestMaps создает карты интересов пользователя.
 func (s *InterestService) buildUserInterestMaps(userID int) (*UserInterestMaps, error) {
 	interests, err := s.GetUserInterestSelections(userID)
@@ -628,6 +985,11 @@ func (s *InterestService) GetInterestsByCategoryKey(categoryKey string) ([]model
 
 // BatchUpdateUserInterests обновляет интересы пользователя батчем.
 func (s *InterestService) BatchUpdateUserInterests(userID int, selections []models.InterestSelection) error {
+	// Итоговый набор должен соблюдать ту же политику, что и поштучная отметка
+	if err := s.validatePrimarySelections(selections); err != nil {
+		return err
+	}
+
 	// Начинаем транзакцию
 	tx, err := s.db.BeginTx(context.Background(), nil)
 	if err != nil {
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// interestKeyPattern - допустимый формат ключа интереса (как в seed-данных: movies_tv, board_games).
+var interestKeyPattern = regexp.MustCompile(`^[a-z0-9_]{2,50}$`)
+
+// SuggestInterest ставит предложенный пользователем интерес в очередь модерации.
+func (s *BotService) SuggestInterest(userID int, text, lang string) (*models.InterestSuggestion, error) {
+	text = strings.Join(strings.Fields(text), " ")
+
+	switch length := utf8.RuneCountInString(text); {
+	case length < localization.MinInterestSuggestionLength:
+		return nil, errorsPkg.ErrInterestSuggestionTooShort
+	case length > localization.MaxInterestSuggestionLength:
+		return nil, errorsPkg.ErrInterestSuggestionTooLong
+	}
+
+	pending, err := s.DB.CountPendingInterestSuggestions(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count interest suggestions: %w", err)
+	}
+
+	if pending >= localization.MaxPendingInterestSuggestions {
+		return nil, errorsPkg.ErrTooManyInterestSuggestions
+	}
+
+	suggestion := &models.InterestSuggestion{
+		UserID:        userID,
+		SuggestedText: text,
+		LanguageCode:  lang,
+	}
+
+	if err := s.DB.CreateInterestSuggestion(suggestion); err != nil {
+		return nil, fmt.Errorf("failed to create interest suggestion: %w", err)
+	}
+
+	return suggestion, nil
+}
+
+// InterestSuggestionErrorMessage возвращает локализованный текст ошибки ввода предложения.
+func (s *BotService) InterestSuggestionErrorMessage(err error, lang string) string {
+	switch {
+	case errors.Is(err, errorsPkg.ErrInterestSuggestionTooLong):
+		return s.Localizer.GetWithParams(lang, localization.LocaleErrorInterestSuggestLong, map[string]string{
+			"max": fmt.Sprintf("%d", localization.MaxInterestSuggestionLength),
+		})
+	case errors.Is(err, errorsPkg.ErrTooManyInterestSuggestions):
+		return s.Localizer.GetWithParams(lang, localization.LocaleErrorInterestSuggestTooMany, map[string]string{
+			"max": fmt.Sprintf("%d", localization.MaxPendingInterestSuggestions),
+		})
+	default:
+		return s.Localizer.GetWithParams(lang, localization.LocaleErrorInterestSuggestShort, map[string]string{
+			"min": fmt.Sprintf("%d", localization.MinInterestSuggestionLength),
+		})
+	}
+}
+
+// GetInterestSuggestions возвращает очередь предложений с указанным статусом.
+func (s *BotService) GetInterestSuggestions(status string, limit int) ([]models.InterestSuggestion, error) {
+	if limit <= 0 {
+		limit = localization.DefaultInterestSuggestionLimit
+	}
+
+	return s.DB.GetInterestSuggestions(status, limit)
+}
+
+// ApproveInterestSuggestion создает из предложения локализованный интерес в выбранной категории
+// и выбирает его для автора. Нужен хотя бы один непустой перевод.
+func (s *BotService) ApproveInterestSuggestion(suggestionID int, approval models.InterestSuggestionApproval) (int, error) {
+	approval.KeyName = strings.TrimSpace(approval.KeyName)
+	approval.CategoryKey = strings.TrimSpace(approval.CategoryKey)
+
+	if !interestKeyPattern.MatchString(approval.KeyName) || approval.CategoryKey == "" {
+		return 0, errorsPkg.ErrInvalidInterestApproval
+	}
+
+	translations := make(map[string]string, len(approval.Translations))
+
+	for languageCode, name := range approval.Translations {
+		if name = strings.TrimSpace(name); name != "" {
+			translations[languageCode] = name
+		}
+	}
+
+	if len(translations) == 0 {
+		return 0, errorsPkg.ErrInvalidInterestApproval
+	}
+
+	approval.Translations = translations
+
+	interestID, err := s.DB.ApproveInterestSuggestion(suggestionID, approval)
+	if err != nil {
+		return 0, fmt.Errorf("failed to approve interest suggestion: %w", err)
+	}
+
+	// Новый интерес должен сразу появиться в списках без перезапуска
+	s.InvalidateStaticDataCache()
+
+	return interestID, nil
+}
+
+// RejectInterestSuggestion отклоняет предложение интереса.
+func (s *BotService) RejectInterestSuggestion(suggestionID int, note string) error {
+	if err := s.DB.RejectInterestSuggestion(suggestionID, strings.TrimSpace(note)); err != nil {
+		return fmt.Errorf("failed to reject interest suggestion: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestSuggestInterest тестирует постановку предложения в очередь модерации.
+func TestSuggestInterest(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("CountPendingInterestSuggestions", 1).Return(0, nil)
+	mockDB.On("CreateInterestSuggestion", mock.MatchedBy(func(s *models.InterestSuggestion) bool {
+		return s.UserID == 1 && s.SuggestedText == "Board games" && s.LanguageCode == "en"
+	})).Return(nil)
+
+	suggestion, err := service.SuggestInterest(1, "  Board \n games ", "en")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Board games", suggestion.SuggestedText)
+	mockDB.AssertExpectations(t)
+}
+
+// TestSuggestInterest_Validation тестирует проверки длины и лимита предложений.
+func TestSuggestInterest_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		pending int
+		wantErr error
+	}{
+		{name: "too short", text: " я ", wantErr: errorsPkg.ErrInterestSuggestionTooShort},
+		{name: "too long", text: strings.Repeat("ы", localization.MaxInterestSuggestionLength+1), wantErr: errorsPkg.ErrInterestSuggestionTooLong},
+		{name: "too many pending", text: "Шахматы", pending: localization.MaxPendingInterestSuggestions, wantErr: errorsPkg.ErrTooManyInterestSuggestions},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := new(MockDatabase)
+			service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+			mockDB.On("CountPendingInterestSuggestions", 1).Return(tt.pending, nil)
+
+			_, err := service.SuggestInterest(1, tt.text, "ru")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockDB.AssertNotCalled(t, "CreateInterestSuggestion", mock.Anything)
+		})
+	}
+}
+
+// TestApproveInterestSuggestion тестирует нормализацию и проверку данных одобрения.
+func TestApproveInterestSuggestion(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("ApproveInterestSuggestion", 7, models.InterestSuggestionApproval{
+		CategoryKey:  "entertainment",
+		KeyName:      "board_games",
+		Translations: map[string]string{"en": "Board games", "ru": "Настольные игры"},
+	}).Return(42, nil)
+
+	interestID, err := service.ApproveInterestSuggestion(7, models.InterestSuggestionApproval{
+		CategoryKey:  " entertainment ",
+		KeyName:      "board_games",
+		Translations: map[string]string{"en": " Board games ", "ru": "Настольные игры", "es": "  "},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, interestID)
+	mockDB.AssertExpectations(t)
+}
+
+// TestApproveInterestSuggestion_Invalid тестирует отказ при некорректных данных одобрения.
+func TestApproveInterestSuggestion_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		approval models.InterestSuggestionApproval
+	}{
+		{name: "bad key", approval: models.InterestSuggestionApproval{CategoryKey: "social", KeyName: "Board Games", Translations: map[string]string{"en": "Board games"}}},
+		{name: "no category", approval: models.InterestSuggestionApproval{KeyName: "board_games", Translations: map[string]string{"en": "Board games"}}},
+		{name: "no translations", approval: models.InterestSuggestionApproval{CategoryKey: "social", KeyName: "board_games", Translations: map[string]string{"en": " "}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDB := new(MockDatabase)
+			service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+			_, err := service.ApproveInterestSuggestion(1, tt.approval)
+
+			assert.ErrorIs(t, err, errorsPkg.ErrInvalidInterestApproval)
+			mockDB.AssertNotCalled(t, "ApproveInterestSuggestion", mock.Anything, mock.Anything)
+		})
+	}
+}
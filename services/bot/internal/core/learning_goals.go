@@ -0,0 +1,84 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// GetLearningGoals получает цели изучения языка пользователя.
+func (s *BotService) GetLearningGoals(userID int) ([]string, error) {
+	return s.DB.GetUserLearningGoals(userID)
+}
+
+// ToggleLearningGoal включает или выключает цель изучения языка и возвращает обновленный список целей.
+func (s *BotService) ToggleLearningGoal(userID int, goal string) ([]string, error) {
+	if !models.IsValidLearningGoal(goal) {
+		return nil, errorsPkg.ErrInvalidLearningGoal
+	}
+
+	current, err := s.DB.GetUserLearningGoals(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learning goals: %w", err)
+	}
+
+	selected := make(map[string]bool, len(current)+1)
+	for _, g := range current {
+		selected[g] = true
+	}
+
+	selected[goal] = !selected[goal]
+
+	// Сохраняем в порядке отображения, чтобы профиль выглядел стабильно
+	goals := make([]string, 0, len(selected))
+
+	for _, g := range models.LearningGoals {
+		if selected[g] {
+			goals = append(goals, g)
+		}
+	}
+
+	if err := s.DB.SaveUserLearningGoals(userID, goals); err != nil {
+		return nil, fmt.Errorf("failed to save learning goals: %w", err)
+	}
+
+	return goals, nil
+}
+
+// GetLearningGoalDistribution возвращает распределение целей по изучаемым языкам:
+// код языка -> цель -> количество пользователей.
+func (s *BotService) GetLearningGoalDistribution() (map[string]map[string]int, error) {
+	stats, err := s.DB.GetLearningGoalStats()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learning goal stats: %w", err)
+	}
+
+	distribution := make(map[string]map[string]int)
+
+	for _, stat := range stats {
+		if distribution[stat.TargetLanguageCode] == nil {
+			distribution[stat.TargetLanguageCode] = make(map[string]int)
+		}
+
+		distribution[stat.TargetLanguageCode][stat.Goal] += stat.UsersCount
+	}
+
+	return distribution, nil
+}
+
+// formatLearningGoals форматирует цели изучения языка для профиля.
+func (s *BotService) formatLearningGoals(goals []string, lang string) string {
+	if len(goals) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(goals))
+	for _, goal := range goals {
+		names = append(names, s.Localizer.Get(lang, localization.LocaleLearningGoalPrefix+goal))
+	}
+
+	return strings.Join(names, ", ")
+}
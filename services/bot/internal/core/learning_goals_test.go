@@ -0,0 +1,96 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestToggleLearningGoal тестирует включение и выключение целей изучения.
+func TestToggleLearningGoal(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetUserLearningGoals", 1).Return([]string{models.LearningGoalCasual, models.LearningGoalTravel}, nil)
+	mockDB.On("SaveUserLearningGoals", 1, []string{models.LearningGoalTravel, models.LearningGoalExamHSK, models.LearningGoalCasual}).Return(nil)
+	mockDB.On("SaveUserLearningGoals", 1, []string{models.LearningGoalCasual}).Return(nil)
+
+	goals, err := service.ToggleLearningGoal(1, models.LearningGoalExamHSK)
+	require.NoError(t, err)
+	assert.Equal(t, []string{models.LearningGoalTravel, models.LearningGoalExamHSK, models.LearningGoalCasual}, goals)
+
+	goals, err = service.ToggleLearningGoal(1, models.LearningGoalTravel)
+	require.NoError(t, err)
+	assert.Equal(t, []string{models.LearningGoalCasual}, goals)
+}
+
+// TestToggleLearningGoal_Invalid тестирует отказ для неизвестной цели.
+func TestToggleLearningGoal_Invalid(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	_, err := service.ToggleLearningGoal(1, "exam_toefl")
+
+	assert.ErrorIs(t, err, errorsPkg.ErrInvalidLearningGoal)
+	mockDB.AssertNotCalled(t, "SaveUserLearningGoals")
+}
+
+// TestGetLearningGoalDistribution тестирует группировку статистики целей по языкам.
+func TestGetLearningGoalDistribution(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetLearningGoalStats").Return([]models.LearningGoalStat{
+		{TargetLanguageCode: "en", Goal: models.LearningGoalExamIELTS, UsersCount: 7},
+		{TargetLanguageCode: "en", Goal: models.LearningGoalTravel, UsersCount: 3},
+		{TargetLanguageCode: "zh", Goal: models.LearningGoalExamHSK, UsersCount: 2},
+	}, nil)
+
+	distribution, err := service.GetLearningGoalDistribution()
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]map[string]int{
+		"en": {models.LearningGoalExamIELTS: 7, models.LearningGoalTravel: 3},
+		"zh": {models.LearningGoalExamHSK: 2},
+	}, distribution)
+}
+
+// TestCalculateLearningGoalScore тестирует начисление баллов за общие цели.
+func TestCalculateLearningGoalScore(t *testing.T) {
+	cfg := &config.MatchingConfig{LearningGoalScore: 2}
+
+	tests := []struct {
+		name   string
+		goals1 []string
+		goals2 []string
+		want   int
+	}{
+		{name: "no goals", want: 0},
+		{name: "one side empty", goals1: []string{models.LearningGoalTravel}, want: 0},
+		{name: "no overlap", goals1: []string{models.LearningGoalTravel}, goals2: []string{models.LearningGoalBusiness}, want: 0},
+		{
+			name:   "two shared goals",
+			goals1: []string{models.LearningGoalTravel, models.LearningGoalExamDELE, models.LearningGoalCasual},
+			goals2: []string{models.LearningGoalExamDELE, models.LearningGoalTravel},
+			want:   4,
+		},
+		{
+			name:   "duplicates counted once",
+			goals1: []string{models.LearningGoalRelocation},
+			goals2: []string{models.LearningGoalRelocation, models.LearningGoalRelocation},
+			want:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, calculateLearningGoalScore(tt.goals1, tt.goals2, cfg))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"language-exchange-bot/internal/localization"
+)
+
+// ReencryptPersonalData переводит персональные данные на активный ключ шифрования пачками
+// по ReencryptionBatchSize значений, пока не останется значений на прежних ключах или
+// открытым текстом. Возвращает число перезаписанных значений.
+func (s *BotService) ReencryptPersonalData() (int, error) {
+	total := 0
+
+	for {
+		rewritten, err := s.DB.ReencryptPersonalData(localization.ReencryptionBatchSize)
+		total += rewritten
+
+		if err != nil {
+			return total, fmt.Errorf("failed to re-encrypt personal data: %w", err)
+		}
+
+		if rewritten < localization.ReencryptionBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// StartPersonalDataReencryption раз в ReencryptionInterval переводит персональные данные
+// на активный ключ. После ротации ключей старые значения перешифровываются в фоне,
+// и прежний ключ можно убрать, когда в журнале перестанут появляться перезаписи.
+// Работает до отмены контекста.
+func (s *BotService) StartPersonalDataReencryption(ctx context.Context) {
+	ticker := time.NewTicker(localization.ReencryptionInterval)
+	defer ticker.Stop()
+
+	s.runPersonalDataReencryption()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.runPersonalDataReencryption()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runPersonalDataReencryption выполняет одну итерацию перешифрования.
+func (s *BotService) runPersonalDataReencryption() {
+	rewritten, err := s.ReencryptPersonalData()
+	if err != nil {
+		log.Printf("Failed to re-encrypt personal data: %v", err)
+	}
+
+	if rewritten > 0 {
+		log.Printf("Re-encrypted %d personal data values with the active key", rewritten)
+	}
+}
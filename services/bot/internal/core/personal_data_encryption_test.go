@@ -0,0 +1,39 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/localization"
+)
+
+// TestReencryptPersonalData тестирует перешифрование пачками до первой неполной пачки.
+func TestReencryptPersonalData(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("ReencryptPersonalData", localization.ReencryptionBatchSize).Return(localization.ReencryptionBatchSize, nil).Once()
+	mockDB.On("ReencryptPersonalData", localization.ReencryptionBatchSize).Return(3, nil).Once()
+
+	rewritten, err := service.ReencryptPersonalData()
+
+	require.NoError(t, err)
+	assert.Equal(t, localization.ReencryptionBatchSize+3, rewritten)
+	mockDB.AssertExpectations(t)
+}
+
+// TestReencryptPersonalData_Error тестирует, что ошибка прерывает перешифрование.
+func TestReencryptPersonalData_Error(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("ReencryptPersonalData", localization.ReencryptionBatchSize).Return(0, errors.New("key not found")).Once()
+
+	_, err := service.ReencryptPersonalData()
+
+	require.Error(t, err)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,200 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// retentionAuditTargets - тип объекта журнала аудита для каждого типа данных.
+var retentionAuditTargets = map[string]string{
+	models.RetentionFeedback:      audit.TargetFeedback,
+	models.RetentionInactiveUsers: audit.TargetUser,
+	models.RetentionMatches:       audit.TargetMatch,
+}
+
+// RetentionPolicies возвращает сроки хранения данных из конфигурации.
+// Сам журнал аудита под очистку не попадает: он только дополняется.
+func (s *BotService) RetentionPolicies() []models.RetentionPolicy {
+	feedback := localization.DefaultRetentionFeedbackMonths
+	users := localization.DefaultRetentionInactiveUserMonths
+	matches := localization.DefaultRetentionMatchMonths
+
+	if s.Config != nil {
+		feedback = s.Config.RetentionFeedbackMonths
+		users = s.Config.RetentionInactiveUserMonths
+		matches = s.Config.RetentionMatchMonths
+	}
+
+	return []models.RetentionPolicy{
+		{DataType: models.RetentionFeedback, Months: feedback},
+		{DataType: models.RetentionInactiveUsers, Months: users},
+		{DataType: models.RetentionMatches, Months: matches},
+	}
+}
+
+// RetentionReport возвращает пробный отчет: сколько строк каждого типа было бы удалено
+// очисткой, запущенной в момент now. Данные не изменяются.
+func (s *BotService) RetentionReport(now time.Time) (*models.RetentionReport, error) {
+	report := &models.RetentionReport{GeneratedAt: now, DryRun: true, Items: []models.RetentionReportItem{}}
+
+	for _, policy := range s.RetentionPolicies() {
+		item := models.RetentionReportItem{RetentionPolicy: policy, Enabled: policy.Months > 0}
+
+		if item.Enabled {
+			cutoff := policy.PurgeBefore(now)
+			item.Cutoff = &cutoff
+
+			count, err := s.DB.CountRetentionCandidates(policy.DataType, cutoff)
+			if err != nil {
+				return nil, fmt.Errorf("failed to build retention report: %w", err)
+			}
+
+			item.Count = count
+		}
+
+		report.Items = append(report.Items, item)
+	}
+
+	return report, nil
+}
+
+// RunRetention удаляет данные с истекшим на момент now сроком хранения пачками по
+// RetentionBatchSize строк. Каждая непустая очистка записывается в журнал аудита.
+// При ошибке возвращает отчет о том, что успело удалиться.
+func (s *BotService) RunRetention(now time.Time) (*models.RetentionReport, error) {
+	report := &models.RetentionReport{GeneratedAt: now, Items: []models.RetentionReportItem{}}
+
+	for _, policy := range s.RetentionPolicies() {
+		item := models.RetentionReportItem{RetentionPolicy: policy, Enabled: policy.Months > 0}
+		if !item.Enabled {
+			report.Items = append(report.Items, item)
+
+			continue
+		}
+
+		cutoff := policy.PurgeBefore(now)
+		item.Cutoff = &cutoff
+
+		purged, err := s.purgeRetention(policy, cutoff)
+		item.Count = purged
+		report.Items = append(report.Items, item)
+
+		s.recordRetentionPurge(policy, cutoff, purged)
+
+		if err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// purgeRetention удаляет устаревшие строки одного типа, пока очередная пачка не окажется неполной.
+func (s *BotService) purgeRetention(policy models.RetentionPolicy, cutoff time.Time) (int64, error) {
+	if policy.DataType == models.RetentionInactiveUsers {
+		return s.purgeInactiveUsers(cutoff)
+	}
+
+	var total int64
+
+	for {
+		purged, err := s.DB.PurgeRetentionBatch(policy.DataType, cutoff, localization.RetentionBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to purge %s: %w", policy.DataType, err)
+		}
+
+		total += purged
+
+		if purged < localization.RetentionBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// purgeInactiveUsers удаляет неактивных пользователей по одному тем же путем, что и /deleteme:
+// с отменой совпадений, уведомлением собеседников и очисткой кэша и черновиков.
+func (s *BotService) purgeInactiveUsers(cutoff time.Time) (int64, error) {
+	var total int64
+
+	for {
+		users, err := s.DB.GetRetentionUsers(cutoff, localization.RetentionBatchSize)
+		if err != nil {
+			return total, fmt.Errorf("failed to get %s: %w", models.RetentionInactiveUsers, err)
+		}
+
+		for _, user := range users {
+			if _, err := s.deleteAccount(user); err != nil {
+				if errors.Is(err, errorsPkg.ErrUserNotFound) {
+					continue
+				}
+
+				return total, fmt.Errorf("failed to purge %s: %w", models.RetentionInactiveUsers, err)
+			}
+
+			total++
+		}
+
+		if len(users) < localization.RetentionBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// recordRetentionPurge записывает очистку в журнал аудита, если что-то было удалено.
+func (s *BotService) recordRetentionPurge(policy models.RetentionPolicy, cutoff time.Time, purged int64) {
+	if purged == 0 {
+		return
+	}
+
+	s.RecordAudit(&models.AuditEvent{
+		ActorType:  models.AuditActorSystem,
+		Action:     audit.ActionRetentionPurge,
+		TargetType: retentionAuditTargets[policy.DataType],
+		Details: map[string]interface{}{
+			"data_type": policy.DataType,
+			"months":    policy.Months,
+			"cutoff":    cutoff.UTC().Format(time.RFC3339),
+			"count":     purged,
+		},
+	})
+}
+
+// StartRetentionScheduler раз в RetentionInterval удаляет данные с истекшим сроком хранения.
+// Работает до отмены контекста.
+func (s *BotService) StartRetentionScheduler(ctx context.Context) {
+	ticker := time.NewTicker(localization.RetentionInterval)
+	defer ticker.Stop()
+
+	s.runRetention()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.runRetention()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runRetention выполняет одну очистку по срокам хранения.
+func (s *BotService) runRetention() {
+	report, err := s.RunRetention(time.Now())
+	if err != nil {
+		log.Printf("Failed to enforce data retention: %v", err)
+	}
+
+	for _, item := range report.Items {
+		if item.Count > 0 {
+			log.Printf("Retention purged %d %s older than %d months", item.Count, item.DataType, item.Months)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestRetentionPolicies тестирует сроки хранения по умолчанию и из конфигурации.
+func TestRetentionPolicies(t *testing.T) {
+	service := NewBotServiceWithInterface(new(MockDatabase), &localization.Localizer{})
+
+	policies := service.RetentionPolicies()
+	require.Len(t, policies, 3)
+	assert.Equal(t, localization.DefaultRetentionFeedbackMonths, policies[0].Months)
+	assert.Zero(t, policies[0].Months, "retention must be opt-in")
+
+	service.Config = &config.Config{RetentionFeedbackMonths: 6, RetentionMatchMonths: 3}
+	policies = service.RetentionPolicies()
+
+	assert.Equal(t, models.RetentionPolicy{DataType: models.RetentionFeedback, Months: 6}, policies[0])
+	assert.Equal(t, 0, policies[1].Months)
+	assert.Equal(t, 3, policies[2].Months)
+}
+
+// TestRetentionReport тестирует пробный отчет: выключенные политики не считаются, данные не удаляются.
+func TestRetentionReport(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	service.Config = &config.Config{RetentionFeedbackMonths: 6, RetentionMatchMonths: 3}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	mockDB.On("CountRetentionCandidates", models.RetentionFeedback, now.AddDate(0, -6, 0)).Return(int64(12), nil)
+	mockDB.On("CountRetentionCandidates", models.RetentionMatches, now.AddDate(0, -3, 0)).Return(int64(40), nil)
+
+	report, err := service.RetentionReport(now)
+
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+	require.Len(t, report.Items, 3)
+	assert.Equal(t, int64(12), report.Items[0].Count)
+	assert.False(t, report.Items[1].Enabled)
+	assert.Nil(t, report.Items[1].Cutoff)
+	assert.Equal(t, int64(40), report.Items[2].Count)
+	mockDB.AssertNotCalled(t, "PurgeRetentionBatch", mock.Anything, mock.Anything, mock.Anything)
+	mockDB.AssertExpectations(t)
+}
+
+// TestRunRetention тестирует очистку пачками и запись в журнал аудита только непустых очисток.
+func TestRunRetention(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	service.Config = &config.Config{RetentionFeedbackMonths: 6, RetentionInactiveUserMonths: 24}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	feedbackCutoff := now.AddDate(0, -6, 0)
+
+	mockDB.On("PurgeRetentionBatch", models.RetentionFeedback, feedbackCutoff, localization.RetentionBatchSize).
+		Return(int64(localization.RetentionBatchSize), nil).Once()
+	mockDB.On("PurgeRetentionBatch", models.RetentionFeedback, feedbackCutoff, localization.RetentionBatchSize).
+		Return(int64(7), nil).Once()
+	mockDB.On("GetRetentionUsers", now.AddDate(0, -24, 0), localization.RetentionBatchSize).
+		Return([]*models.User{}, nil).Once()
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionRetentionPurge && event.ActorType == models.AuditActorSystem &&
+			event.TargetType == audit.TargetFeedback && event.Details["count"] == int64(localization.RetentionBatchSize+7)
+	})).Return(nil).Once()
+
+	report, err := service.RunRetention(now)
+
+	require.NoError(t, err)
+	assert.False(t, report.DryRun)
+	require.Len(t, report.Items, 3)
+	assert.Equal(t, int64(localization.RetentionBatchSize+7), report.Items[0].Count)
+	assert.Equal(t, int64(0), report.Items[1].Count)
+	assert.False(t, report.Items[2].Enabled)
+	mockDB.AssertExpectations(t)
+}
+
+// TestRunRetention_Error тестирует, что удаленное до ошибки попадает в аудит, а очистка прерывается.
+func TestRunRetention_Error(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	service.Config = &config.Config{RetentionFeedbackMonths: 6, RetentionMatchMonths: 3}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	mockDB.On("PurgeRetentionBatch", models.RetentionFeedback, mock.Anything, localization.RetentionBatchSize).
+		Return(int64(localization.RetentionBatchSize), nil).Once()
+	mockDB.On("PurgeRetentionBatch", models.RetentionFeedback, mock.Anything, localization.RetentionBatchSize).
+		Return(int64(0), errors.New("db down")).Once()
+	mockDB.On("AppendAuditEvent", mock.Anything).Return(nil).Once()
+
+	report, err := service.RunRetention(now)
+
+	require.Error(t, err)
+	require.Len(t, report.Items, 1)
+	assert.Equal(t, int64(localization.RetentionBatchSize), report.Items[0].Count)
+	mockDB.AssertNotCalled(t, "PurgeRetentionBatch", models.RetentionMatches, mock.Anything, mock.Anything)
+	mockDB.AssertExpectations(t)
+}
+
+// TestRunRetention_InactiveUsers тестирует удаление неактивных пользователей по одному с уведомлением собеседников.
+func TestRunRetention_InactiveUsers(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	service.Config = &config.Config{RetentionInactiveUserMonths: 24}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	partner := models.MatchPartner{UserID: 9, TelegramID: 1009, InterfaceLanguageCode: "en"}
+
+	var notified []models.MatchPartner
+
+	service.SetAccountDeletionNoticeFunc(func(partners []models.MatchPartner) error {
+		notified = append(notified, partners...)
+
+		return nil
+	})
+
+	mockDB.On("GetRetentionUsers", now.AddDate(0, -24, 0), localization.RetentionBatchSize).
+		Return([]*models.User{{ID: 7, TelegramID: 1007}, {ID: 8, TelegramID: 1008}}, nil).Once()
+	mockDB.On("DeleteUserAccount", 7).
+		Return(&models.AccountDeletionResult{Partners: []models.MatchPartner{partner}}, nil).Once()
+	mockDB.On("DeleteUserAccount", 8).Return(nil, errorsPkg.ErrUserNotFound).Once()
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionRetentionPurge && event.TargetType == audit.TargetUser && event.Details["count"] == int64(1)
+	})).Return(nil).Once()
+
+	report, err := service.RunRetention(now)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), report.Items[1].Count)
+	assert.Equal(t, []models.MatchPartner{partner}, notified)
+	mockDB.AssertNotCalled(t, "PurgeRetentionBatch", models.RetentionInactiveUsers, mock.Anything, mock.Anything)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,174 @@
+package core
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// Permission - право на действие в админских функциях бота и admin API.
+type Permission string
+
+// Права, которые проверяются в Telegram callback'ах и в admin API.
+const (
+	PermissionViewFeedback        Permission = "feedback.view"
+	PermissionManageFeedback      Permission = "feedback.manage"
+	PermissionModerateInterests   Permission = "interests.moderate"
+	PermissionManageInterests     Permission = "interests.manage"
+	PermissionViewUsers           Permission = "users.view"
+	PermissionManageUsers         Permission = "users.manage"
+	PermissionMessageUsers        Permission = "users.message"
+	PermissionManageAnnouncements Permission = "announcements.manage"
+	PermissionManageRoles         Permission = "roles.manage"
+	PermissionViewStats           Permission = "stats.view"
+	PermissionManageSystem        Permission = "system.manage"
+	PermissionManageAPIKeys       Permission = "apikeys.manage"
+	PermissionViewAudit           Permission = "audit.view"
+)
+
+// rolePermissions - матрица прав по ролям.
+// Модератор разбирает отзывы и предложения интересов и может написать пользователю,
+// администратор дополнительно меняет профили, каталог интересов, роли, рассылает анонсы
+// управляет настройками системы и ключами admin API и читает журнал аудита.
+var rolePermissions = map[string][]Permission{
+	models.RoleUser: {},
+	models.RoleModerator: {
+		PermissionViewFeedback,
+		PermissionManageFeedback,
+		PermissionModerateInterests,
+		PermissionViewUsers,
+		PermissionMessageUsers,
+		PermissionViewStats,
+	},
+	models.RoleAdmin: {
+		PermissionViewFeedback,
+		PermissionManageFeedback,
+		PermissionModerateInterests,
+		PermissionManageInterests,
+		PermissionViewUsers,
+		PermissionManageUsers,
+		PermissionMessageUsers,
+		PermissionManageAnnouncements,
+		PermissionManageRoles,
+		PermissionViewStats,
+		PermissionManageSystem,
+		PermissionManageAPIKeys,
+		PermissionViewAudit,
+	},
+}
+
+// IsValidRole сообщает, известна ли роль.
+func IsValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+
+	return ok
+}
+
+// IsValidPermission сообщает, известно ли право. Роль admin обладает всеми правами.
+func IsValidPermission(permission Permission) bool {
+	return HasPermission(models.RoleAdmin, permission)
+}
+
+// RolePermissions возвращает права роли. Неизвестная роль не имеет прав.
+func RolePermissions(role string) []Permission {
+	return slices.Clone(rolePermissions[role])
+}
+
+// HasPermission сообщает, есть ли у роли право. Неизвестная роль не имеет прав.
+func HasPermission(role string, permission Permission) bool {
+	return slices.Contains(rolePermissions[role], permission)
+}
+
+// UserHasPermission проверяет право пользователя по его роли.
+func (s *BotService) UserHasPermission(user *models.User, permission Permission) bool {
+	return user != nil && HasPermission(user.Role, permission)
+}
+
+// GetUserRole возвращает роль пользователя по Telegram ID в обход кэша,
+// чтобы изменение роли сразу действовало в admin API.
+func (s *BotService) GetUserRole(telegramID int64) (string, error) {
+	user, err := s.getUserForRole(telegramID)
+	if err != nil {
+		return "", err
+	}
+
+	return user.Role, nil
+}
+
+// UpdateUserRole меняет роль пользователя по Telegram ID и возвращает обновленного пользователя.
+func (s *BotService) UpdateUserRole(telegramID int64, role string) (*models.User, error) {
+	if !IsValidRole(role) {
+		return nil, fmt.Errorf("%w: %q", errorsPkg.ErrInvalidUserRole, role)
+	}
+
+	user, err := s.getUserForRole(telegramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.UpdateUserRole(user.ID, role); err != nil {
+		return nil, fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	user.Role = role
+	s.InvalidateUserCache(telegramID)
+
+	return user, nil
+}
+
+// getUserForRole загружает пользователя из БД; отсутствие пользователя - ErrUserNotFound.
+func (s *BotService) getUserForRole(telegramID int64) (*models.User, error) {
+	user, err := s.DB.GetUserByTelegramID(telegramID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errorsPkg.ErrUserNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user, nil
+}
+
+// BootstrapAdminRoles назначает роль admin уже зарегистрированным пользователям
+// из ADMIN_CHAT_IDS и ADMIN_USERNAMES. Вызывается при запуске; новые пользователи
+// из этих списков получают роль при регистрации.
+func (s *BotService) BootstrapAdminRoles() (int, error) {
+	if s.Config == nil {
+		return 0, nil
+	}
+
+	assigned, err := s.DB.AssignAdminRoles(s.Config.AdminChatIDs, s.Config.AdminUsernames)
+	if err != nil {
+		return 0, fmt.Errorf("failed to bootstrap admin roles: %w", err)
+	}
+
+	return assigned, nil
+}
+
+// applyConfiguredAdminRole назначает роль admin пользователю из списков конфигурации.
+// Роль только повышается: понизить администратора можно через UpdateUserRole.
+func (s *BotService) applyConfiguredAdminRole(user *models.User) {
+	if s.Config == nil || user.Role == models.RoleAdmin {
+		return
+	}
+
+	configured := slices.Contains(s.Config.AdminChatIDs, user.TelegramID) ||
+		user.Username != "" && slices.Contains(s.Config.AdminUsernames, user.Username)
+	if !configured {
+		return
+	}
+
+	if err := s.DB.UpdateUserRole(user.ID, models.RoleAdmin); err != nil {
+		log.Printf("Failed to assign admin role to user %d: %v", user.ID, err)
+
+		return
+	}
+
+	user.Role = models.RoleAdmin
+}
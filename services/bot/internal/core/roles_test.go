@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/config"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestHasPermission тестирует матрицу прав по ролям.
+func TestHasPermission(t *testing.T) {
+	assert.False(t, HasPermission(models.RoleUser, PermissionViewFeedback))
+	assert.True(t, HasPermission(models.RoleModerator, PermissionManageFeedback))
+	assert.True(t, HasPermission(models.RoleModerator, PermissionModerateInterests))
+	assert.False(t, HasPermission(models.RoleModerator, PermissionManageInterests))
+	assert.False(t, HasPermission(models.RoleModerator, PermissionManageRoles))
+	assert.True(t, HasPermission(models.RoleAdmin, PermissionManageRoles))
+	assert.True(t, HasPermission(models.RoleAdmin, PermissionManageSystem))
+	assert.False(t, HasPermission("", PermissionViewStats))
+	assert.False(t, HasPermission("owner", PermissionViewStats))
+}
+
+// TestUpdateUserRole_InvalidRole тестирует отказ до обращения к БД.
+func TestUpdateUserRole_InvalidRole(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	_, err := service.UpdateUserRole(12345, "owner")
+
+	assert.ErrorIs(t, err, errorsPkg.ErrInvalidUserRole)
+	mockDB.AssertNotCalled(t, "GetUserByTelegramID", mock.Anything)
+}
+
+// TestUpdateUserRole тестирует смену роли по Telegram ID.
+func TestUpdateUserRole(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetUserByTelegramID", int64(12345)).Return(&models.User{ID: 7, TelegramID: 12345, Role: models.RoleUser}, nil)
+	mockDB.On("UpdateUserRole", 7, models.RoleModerator).Return(nil)
+
+	user, err := service.UpdateUserRole(12345, models.RoleModerator)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.RoleModerator, user.Role)
+	mockDB.AssertExpectations(t)
+}
+
+// TestHandleUserRegistration_ConfiguredAdmin тестирует назначение роли admin из конфигурации.
+func TestHandleUserRegistration_ConfiguredAdmin(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	service.Config = &config.Config{AdminUsernames: []string{"owner"}}
+
+	mockDB.On("FindOrCreateUser", int64(12345), "owner", "Owner").Return(&models.User{
+		ID: 1, TelegramID: 12345, Username: "owner", InterfaceLanguageCode: "en", Status: models.StatusActive, Role: models.RoleUser,
+	}, nil)
+	mockDB.On("UpdateUserRole", 1, models.RoleAdmin).Return(nil)
+
+	user, err := service.HandleUserRegistration(12345, "owner", "Owner", "en")
+
+	require.NoError(t, err)
+	assert.Equal(t, models.RoleAdmin, user.Role)
+	assert.True(t, service.UserHasPermission(user, PermissionManageRoles))
+	mockDB.AssertExpectations(t)
+}
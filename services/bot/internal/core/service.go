@@ -15,7 +15,10 @@ import (
 	"language-exchange-bot/internal/models"
 	"language-exchange-bot/internal/validation"
 	"log"
+	"sort"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -60,6 +63,17 @@ type BotService struct {
 	// It should handle sending notifications to administrators
 	FeedbackNotificationFunc func(data map[string]interface{}) error
 
+	// FeedbackSLAAlertFunc is called with feedback items that breached their SLA
+	// It should alert administrators and the assignees
+	FeedbackSLAAlertFunc func(breaches []models.FeedbackSLABreach) error
+
+	// AccountDeletionNoticeFunc is called with the current match partners of a deleted account
+	// It should tell them the partner is no longer available
+	AccountDeletionNoticeFunc func(partners []models.MatchPartner) error
+
+	// surveyDispatcher sends scheduled surveys; nil until a messenger is connected
+	surveyDispatcher atomic.Pointer[SurveyDispatcher]
+
 	// Config contains application configuration
 	Config *config.Config
 
@@ -127,6 +141,7 @@ func NewBotService(db *database.DB, errorHandler interface{}) *BotService {
 		Service:                  validationService,
 		LoggingService:           loggingService,
 		FeedbackNotificationFunc: nil,
+		FeedbackSLAAlertFunc:     nil,
 		Config:                   cfg,
 		TelegramCircuitBreaker:   telegramCB,
 		DatabaseCircuitBreaker:   databaseCB,
@@ -221,6 +236,7 @@ func NewBotServiceWithRedis(
 		LoggingService:           loggingService,
 		Config:                   cfg,
 		FeedbackNotificationFunc: nil,
+		FeedbackSLAAlertFunc:     nil,
 		TelegramCircuitBreaker:   telegramCB,
 		DatabaseCircuitBreaker:   databaseCB,
 		RedisCircuitBreaker:      redisCB,
@@ -418,13 +434,13 @@ func (a *databaseAdapter) GetInterestByID(interestID int) (*models.Interest, err
 	return interest, nil
 }
 
-func (a *databaseAdapter) SaveUserFeedback(userID int, feedbackText string, contactInfo *string) error {
-	err := a.db.SaveUserFeedback(userID, feedbackText, contactInfo)
+func (a *databaseAdapter) SaveUserFeedback(userID int, feedbackText string, contactInfo *string) (int, error) {
+	feedbackID, err := a.db.SaveUserFeedback(userID, feedbackText, contactInfo)
 	if err != nil {
-		return fmt.Errorf("failed to save user feedback: %w", err)
+		return 0, fmt.Errorf("failed to save user feedback: %w", err)
 	}
 
-	return nil
+	return feedbackID, nil
 }
 
 func (a *databaseAdapter) GetUnprocessedFeedback() ([]map[string]interface{}, error) {
@@ -470,6 +486,7 @@ func NewBotServiceWithInterface(db database.Database, localizer *localization.Lo
 		Service:                  nil,
 		LoggingService:           nil,
 		FeedbackNotificationFunc: nil,
+		FeedbackSLAAlertFunc:     nil,
 	}
 }
 
@@ -478,6 +495,16 @@ func (s *BotService) SetFeedbackNotificationFunc(fn func(map[string]interface{})
 	s.FeedbackNotificationFunc = fn
 }
 
+// SetFeedbackSLAAlertFunc устанавливает функцию для уведомлений о нарушении сроков обработки отзывов.
+func (s *BotService) SetFeedbackSLAAlertFunc(fn func([]models.FeedbackSLABreach) error) {
+	s.FeedbackSLAAlertFunc = fn
+}
+
+// SetAccountDeletionNoticeFunc устанавливает функцию для уведомления собеседников удаленного аккаунта.
+func (s *BotService) SetAccountDeletionNoticeFunc(fn func([]models.MatchPartner) error) {
+	s.AccountDeletionNoticeFunc = fn
+}
+
 // DetectLanguage определяет язык интерфейса по коду языка Telegram.
 func (s *BotService) DetectLanguage(telegramLangCode string) string {
 	switch telegramLangCode {
@@ -502,10 +529,46 @@ func (s *BotService) HandleUserRegistration(
 		return nil, fmt.Errorf("operation failed: %w", err)
 	}
 
-	detected := s.DetectLanguage(telegramLangCode)
-	// Определяем начальный язык интерфейса только для новых пользователей
+	s.applyConfiguredAdminRole(user)
+	s.initInterfaceLanguage(user, telegramLangCode)
+
+	return user, nil
+}
+
+// HandlePlatformUserRegistration регистрирует пользователя любой платформы по ID с указанием
+// платформы (см. models.QualifiedUserID). langCode - язык из настроек клиента пользователя.
+func (s *BotService) HandlePlatformUserRegistration(
+	qualifiedID, username, firstName, langCode string,
+) (*models.User, error) {
+	platform, externalID, ok := models.ParseQualifiedUserID(qualifiedID)
+	if !ok {
+		return nil, fmt.Errorf("invalid platform user id %q", qualifiedID)
+	}
+
+	if platform == models.PlatformTelegram {
+		telegramID, err := strconv.ParseInt(externalID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid telegram user id %q: %w", externalID, err)
+		}
+
+		return s.HandleUserRegistration(telegramID, username, firstName, langCode)
+	}
+
+	user, err := s.DB.FindOrCreatePlatformUser(platform, externalID, username, firstName)
+	if err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	s.initInterfaceLanguage(user, langCode)
+
+	return user, nil
+}
+
+// initInterfaceLanguage определяет начальный язык интерфейса по языку клиента, только для новых пользователей.
+func (s *BotService) initInterfaceLanguage(user *models.User, clientLangCode string) {
+	detected := s.DetectLanguage(clientLangCode)
 	if user.Status == models.StatusNew || user.InterfaceLanguageCode == "" {
-		// Для новых пользователей устанавливаем язык интерфейса по настройкам Telegram
+		// Для новых пользователей устанавливаем язык интерфейса по настройкам клиента
 		// Если язык не определен, используем русский как дефолт для проекта
 		if detected == "" {
 			user.InterfaceLanguageCode = "ru"
@@ -517,8 +580,6 @@ func (s *BotService) HandleUserRegistration(
 			log.Printf("Failed to update interface language for new user %d to %s: %v", user.ID, user.InterfaceLanguageCode, err)
 		}
 	}
-
-	return user, nil
 }
 
 // GetWelcomeMessage возвращает приветственное сообщение для пользователя.
@@ -591,9 +652,25 @@ func (s *BotService) BuildProfileSummary(user *models.User) (string, error) {
 		log.Printf("DEBUG BuildProfileSummary: Loaded friendshipPreferences for user %d: %+v", user.ID, friendshipPreferences)
 	}
 
+	unavailabilityPeriods, err := s.GetUnavailabilityPeriods(user.ID)
+	if err != nil {
+		// Не критичная ошибка, продолжаем без периодов недоступности
+		log.Printf("DEBUG BuildProfileSummary: Error loading unavailability periods for user %d: %v", user.ID, err)
+		unavailabilityPeriods = nil
+	}
+
+	learningGoals, err := s.GetLearningGoals(user.ID)
+	if err != nil {
+		// Не критичная ошибка, продолжаем без целей изучения
+		log.Printf("DEBUG BuildProfileSummary: Error loading learning goals for user %d: %v", user.ID, err)
+		learningGoals = nil
+	}
+
 	// Временно устанавливаем данные в объект пользователя для совместимости
 	user.TimeAvailability = timeAvailability
 	user.FriendshipPreferences = friendshipPreferences
+	user.UnavailabilityPeriods = unavailabilityPeriods
+	user.LearningGoals = learningGoals
 
 	// Получаем основную информацию
 	basicInfo := s.buildBasicProfileInfo(user, lang)
@@ -702,17 +779,7 @@ func (s *BotService) buildInterestsProfileInfo(user *models.User, lang string) s
 		}
 
 		// Получаем локализованное название
-		interestName := s.Localizer.Get(lang, "interest_"+interest.KeyName)
-		if interestName == "interest_"+interest.KeyName {
-			// Fallback: пытаемся получить из кэша интересов
-			allInterestsMap, _ := s.Localizer.GetInterests(lang)
-			if cachedName, exists := allInterestsMap[interest.ID]; exists {
-				interestName = cachedName
-			} else {
-				// Последний fallback на key_name
-				interestName = interest.KeyName
-			}
-		}
+		interestName := s.InterestName(lang, interest.ID, interest.KeyName)
 
 		if selection.IsPrimary {
 			allInterests = append(allInterests, "⭐ "+interestName)
@@ -749,6 +816,19 @@ func (s *BotService) buildAdditionalProfileInfo(user *models.User, lang string)
 	communicationText := s.formatCommunicationPreferences(user.FriendshipPreferences, lang)
 	lines = append(lines, fmt.Sprintf("💬 %s: %s", s.Localizer.Get(lang, "profile_field_communication"), communicationText))
 
+	// Цели изучения языка (показываются только если заданы)
+	if goalsText := s.formatLearningGoals(user.LearningGoals, lang); goalsText != "" {
+		lines = append(lines, fmt.Sprintf("🎯 %s: %s", s.Localizer.Get(lang, localization.LocaleLearningGoalsProfileField), goalsText))
+	}
+
+	// Отпуск / периоды недоступности (показываются только если заданы)
+	if vacationText := s.formatUnavailabilityPeriods(user.UnavailabilityPeriods, lang); vacationText != "" {
+		lines = append(lines, fmt.Sprintf("🏖 %s: %s", s.Localizer.Get(lang, localization.LocaleVacationProfileField), vacationText))
+	}
+
+	// Отпуск собеседников, с которыми пользователь уже познакомлен
+	lines = append(lines, s.buildPartnerUnavailabilityInfo(user, lang)...)
+
 	// Статус и время в системе
 	statusText := s.formatUserStatus(user, lang)
 	memberSinceText := s.formatMemberSince(user.CreatedAt, lang)
@@ -1002,20 +1082,26 @@ func (s *BotService) ValidateFeedback(feedbackText string) error {
 	return nil
 }
 
-// SaveUserFeedback сохраняет отзыв пользователя и отправляет уведомления.
-func (s *BotService) SaveUserFeedback(userID int, feedbackText string, contactInfo *string, admins []int64) error {
+// SaveUserFeedback сохраняет отзыв пользователя с вложениями, предлагает его разметку по ключевым
+// словам языка интерфейса автора и отправляет уведомления. К отзыву с вложениями текст можно
+// не писать: скриншот часто говорит сам за себя.
+func (s *BotService) SaveUserFeedback(user *models.User, feedbackText string, contactInfo *string, attachments []models.FeedbackAttachment, admins []int64) error {
 	// Валидируем отзыв
-	if err := s.ValidateFeedback(feedbackText); err != nil {
+	if err := s.validateFeedbackWithAttachments(feedbackText, attachments); err != nil {
 		return fmt.Errorf("operation failed: %w", err)
 	}
 
 	// Сохраняем в базу данных
-	if err := s.DB.SaveUserFeedback(userID, feedbackText, contactInfo); err != nil {
+	feedbackID, err := s.DB.SaveUserFeedback(user.ID, feedbackText, contactInfo)
+	if err != nil {
 		return fmt.Errorf("ошибка сохранения отзыва в базу данных: %w", err)
 	}
 
+	suggested := s.applySuggestedTriage(feedbackID, feedbackText, user.InterfaceLanguageCode)
+	attachments = s.saveFeedbackAttachments(feedbackID, attachments)
+
 	// Получаем данные пользователя для уведомления администраторов
-	userData, err := s.GetUserDataForFeedback(userID)
+	userData, err := s.GetUserDataForFeedback(user.ID)
 	if err != nil {
 		log.Printf("Не удалось получить данные пользователя для уведомления: %v", err)
 
@@ -1026,11 +1112,21 @@ func (s *BotService) SaveUserFeedback(userID int, feedbackText string, contactIn
 	fbData := userData
 
 	fbData["feedback_text"] = feedbackText
+	fbData["feedback_id"] = feedbackID
+
+	if suggested != nil {
+		fbData["category"] = suggested.Category
+		fbData["priority"] = suggested.Priority
+	}
 
 	if contactInfo != nil {
 		fbData["contact_info"] = contactInfo
 	}
 
+	if len(attachments) > 0 {
+		fbData["attachments"] = attachments
+	}
+
 	// Отправляем уведомление администраторам
 	if err := s.SendFeedbackNotification(fbData, admins); err != nil {
 		log.Printf("Ошибка отправки уведомления администраторам: %v", err)
@@ -1056,7 +1152,7 @@ func (s *BotService) GetUserDataForFeedback(userID int) (map[string]interface{},
 	var username, firstName string
 
 	err := s.DB.GetConnection().QueryRowContext(context.Background(), `
-		SELECT telegram_id, username, first_name
+		SELECT COALESCE(telegram_id, 0), username, first_name
 		FROM users WHERE id = $1
 	`, userID).Scan(&telegramID, &username, &firstName)
 	if err != nil {
@@ -1087,102 +1183,14 @@ func (s *BotService) GetAllUnprocessedFeedback() ([]map[string]interface{}, erro
 
 // GetAllFeedback получает все отзывы для администратора.
 func (s *BotService) GetAllFeedback() ([]map[string]interface{}, error) {
-	query := getFeedbackQuery()
-
-	rows, err := s.DB.GetConnection().QueryContext(context.Background(), query)
+	feedback, err := s.DB.GetAllFeedback()
 	if err != nil {
-		return nil, fmt.Errorf("operation failed: %w", err)
-	}
-
-	defer func() {
-		if closeErr := rows.Close(); closeErr != nil {
-			s.LoggingService.Database().ErrorWithContext(
-				"Failed to close database rows",
-				"", 0, 0, "GetAllFeedback",
-				map[string]interface{}{
-					"error": closeErr.Error(),
-				},
-			)
-		}
-	}()
-
-	return s.processFeedbackRows(rows), nil
-}
-
-// getFeedbackQuery возвращает SQL запрос для получения всех отзывов.
-func getFeedbackQuery() string {
-	return `
-        SELECT uf.id, uf.feedback_text, uf.contact_info, uf.created_at,
-               uf.is_processed, u.username, u.telegram_id, u.first_name,
-               uf.admin_response
-        FROM user_feedback uf
-        JOIN users u ON uf.user_id = u.id
-        ORDER BY uf.created_at DESC
-    `
-}
-
-// processFeedbackRows обрабатывает строки результата запроса отзывов.
-func (s *BotService) processFeedbackRows(rows *sql.Rows) []map[string]interface{} {
-	var feedbacks []map[string]interface{}
-
-	for rows.Next() {
-		feedback, err := s.scanFeedbackRow(rows)
-		if err != nil {
-			continue // Пропускаем ошибочные записи
-		}
-
-		feedbacks = append(feedbacks, feedback)
-	}
-
-	return feedbacks
-}
-
-// scanFeedbackRow сканирует одну строку результата запроса отзывов.
-func (s *BotService) scanFeedbackRow(rows *sql.Rows) (map[string]interface{}, error) {
-	var (
-		feedbackID   int
-		feedbackText string
-		contactInfo  sql.NullString
-		createdAt    sql.NullTime
-		isProcessed  bool
-		username     sql.NullString
-		telegramID   int64
-		firstName    string
-		adminResp    sql.NullString
-	)
-
-	err := rows.Scan(&feedbackID, &feedbackText, &contactInfo, &createdAt, &isProcessed,
-		&username, &telegramID, &firstName, &adminResp)
-	if err != nil {
-		return nil, fmt.Errorf("operation failed: %w", err)
-	}
-
-	feedback := map[string]interface{}{
-		"id":            feedbackID,
-		"feedback_text": feedbackText,
-		"created_at":    createdAt.Time,
-		"telegram_id":   telegramID,
-		"first_name":    firstName,
-		"is_processed":  isProcessed,
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
 	}
 
-	// Добавляем опциональные поля
-	feedback["username"] = getStringValue(username)
-	feedback["contact_info"] = getStringValue(contactInfo)
-	feedback["admin_response"] = getStringValue(adminResp)
-
 	return feedback, nil
 }
 
-// getStringValue возвращает строковое значение из sql.NullString.
-func getStringValue(nullStr sql.NullString) interface{} {
-	if nullStr.Valid {
-		return nullStr.String
-	}
-
-	return nil
-}
-
 // UpdateFeedbackStatus обновляет статус отзыва (обработан/не обработан).
 func (s *BotService) UpdateFeedbackStatus(feedbackID int, isProcessed bool) error {
 	query := `
@@ -1310,6 +1318,16 @@ func (s *BotService) UnarchiveFeedback(feedbackID int) error {
 
 // GetCachedLanguages получает языки из кэша или загружает из БД.
 func (s *BotService) GetCachedLanguages(lang string) ([]*models.Language, error) {
+	// Сервис без кэша читает справочник напрямую из БД
+	if s.Cache == nil {
+		languages, err := s.DB.GetLanguages()
+		if err != nil {
+			return nil, fmt.Errorf("operation failed: %w", err)
+		}
+
+		return languages, nil
+	}
+
 	start := time.Now()
 
 	defer func() {
@@ -1335,6 +1353,61 @@ func (s *BotService) GetCachedLanguages(lang string) ([]*models.Language, error)
 	return languages, nil
 }
 
+// GetCachedInterestCategories получает категории интересов из кэша или загружает из БД
+// в порядке display_order. Название категории - на языке lang.
+func (s *BotService) GetCachedInterestCategories(lang string) ([]*models.InterestCategory, error) {
+	// Сервис без кэша читает справочник напрямую из БД
+	if s.Cache == nil {
+		return s.loadInterestCategories(lang)
+	}
+
+	start := time.Now()
+
+	defer func() {
+		s.MetricsService.RecordRequest(time.Since(start), true)
+	}()
+
+	// Пытаемся получить из кэша
+	if categories, found := s.Cache.GetInterestCategories(context.Background(), lang); found {
+		return categories, nil
+	}
+
+	// Загружаем из БД
+	categories, err := s.loadInterestCategories(lang)
+	if err != nil {
+		s.MetricsService.RecordError()
+
+		return nil, err
+	}
+
+	// Сохраняем в кэш
+	s.Cache.SetInterestCategories(context.Background(), lang, categories)
+
+	return categories, nil
+}
+
+// loadInterestCategories загружает категории интересов из БД с названиями на языке lang.
+func (s *BotService) loadInterestCategories(lang string) ([]*models.InterestCategory, error) {
+	items, err := s.DB.GetInterestCategoryItems()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest categories: %w", err)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool { return items[i].DisplayOrder < items[j].DisplayOrder })
+
+	categories := make([]*models.InterestCategory, 0, len(items))
+	for _, item := range items {
+		categories = append(categories, &models.InterestCategory{
+			ID:           item.ID,
+			KeyName:      item.KeyName,
+			DisplayOrder: item.DisplayOrder,
+			Name:         s.InterestCategoryName(lang, item.KeyName),
+		})
+	}
+
+	return categories, nil
+}
+
 // GetCachedInterests получает интересы из кэша или загружает из БД.
 func (s *BotService) GetCachedInterests(lang string) (map[int]string, error) {
 	start := time.Now()
@@ -1429,11 +1502,21 @@ func (s *BotService) UpdateCachedUser(user *models.User) error {
 
 // InvalidateUserCache инвалидирует кэш пользователя.
 func (s *BotService) InvalidateUserCache(userID int64) {
+	// Кэш пользователей ключуется по telegram_id; пользователей других платформ (0) в нем нет
+	if s.InvalidationService == nil || userID == 0 {
+		return
+	}
+
 	s.InvalidationService.InvalidateUserData(userID)
 }
 
 // InvalidateStaticDataCache инвалидирует кэш статических данных.
 func (s *BotService) InvalidateStaticDataCache() {
+	// В сервисе, созданном через NewBotServiceWithInterface, кэша нет
+	if s.InvalidationService == nil {
+		return
+	}
+
 	s.InvalidationService.InvalidateStaticData()
 }
 
@@ -1899,6 +1982,404 @@ func (a *databaseAdapter) GetFriendshipPreferences(userID int) (*models.Friendsh
 	return a.db.GetFriendshipPreferences(userID)
 }
 
+// SaveUnavailabilityPeriod сохраняет период недоступности пользователя.
+func (a *databaseAdapter) SaveUnavailabilityPeriod(userID int, period *models.UnavailabilityPeriod) error {
+	return a.db.SaveUnavailabilityPeriod(userID, period)
+}
+
+// GetUnavailabilityPeriods получает актуальные периоды недоступности пользователя.
+func (a *databaseAdapter) GetUnavailabilityPeriods(userID int) ([]models.UnavailabilityPeriod, error) {
+	return a.db.GetUnavailabilityPeriods(userID)
+}
+
+// DeleteUnavailabilityPeriod удаляет период недоступности пользователя.
+func (a *databaseAdapter) DeleteUnavailabilityPeriod(userID, periodID int) error {
+	return a.db.DeleteUnavailabilityPeriod(userID, periodID)
+}
+
+// DeleteExpiredUnavailabilityPeriods удаляет завершившиеся периоды недоступности.
+func (a *databaseAdapter) DeleteExpiredUnavailabilityPeriods() (int64, error) {
+	return a.db.DeleteExpiredUnavailabilityPeriods()
+}
+
+// CancelPendingMatches отменяет еще не отправленные совпадения пользователя.
+func (a *databaseAdapter) CancelPendingMatches(userID int) (int64, error) {
+	return a.db.CancelPendingMatches(userID)
+}
+
+// CancelPendingMatchesOfUnavailableUsers отменяет еще не отправленные совпадения собеседников в отпуске.
+func (a *databaseAdapter) CancelPendingMatchesOfUnavailableUsers() (int64, error) {
+	return a.db.CancelPendingMatchesOfUnavailableUsers()
+}
+
+// GetUserLearningGoals получает цели изучения языка пользователя.
+func (a *databaseAdapter) GetUserLearningGoals(userID int) ([]string, error) {
+	return a.db.GetUserLearningGoals(userID)
+}
+
+// SaveUserLearningGoals сохраняет цели изучения языка пользователя.
+func (a *databaseAdapter) SaveUserLearningGoals(userID int, goals []string) error {
+	return a.db.SaveUserLearningGoals(userID, goals)
+}
+
+// GetLearningGoalStats получает распределение целей по изучаемым языкам.
+func (a *databaseAdapter) GetLearningGoalStats() ([]models.LearningGoalStat, error) {
+	return a.db.GetLearningGoalStats()
+}
+
+// CreateInterestSuggestion добавляет предложение интереса в очередь модерации.
+func (a *databaseAdapter) CreateInterestSuggestion(suggestion *models.InterestSuggestion) error {
+	return a.db.CreateInterestSuggestion(suggestion)
+}
+
+// CountPendingInterestSuggestions возвращает количество предложений пользователя на модерации.
+func (a *databaseAdapter) CountPendingInterestSuggestions(userID int) (int, error) {
+	return a.db.CountPendingInterestSuggestions(userID)
+}
+
+// GetInterestSuggestions возвращает предложения интересов с указанным статусом.
+func (a *databaseAdapter) GetInterestSuggestions(status string, limit int) ([]models.InterestSuggestion, error) {
+	return a.db.GetInterestSuggestions(status, limit)
+}
+
+// ApproveInterestSuggestion создает интерес из предложения.
+func (a *databaseAdapter) ApproveInterestSuggestion(suggestionID int, approval models.InterestSuggestionApproval) (int, error) {
+	return a.db.ApproveInterestSuggestion(suggestionID, approval)
+}
+
+// RejectInterestSuggestion отклоняет предложение интереса.
+func (a *databaseAdapter) RejectInterestSuggestion(suggestionID int, note string) error {
+	return a.db.RejectInterestSuggestion(suggestionID, note)
+}
+
+// GetInterestRelations возвращает граф связей между интересами.
+func (a *databaseAdapter) GetInterestRelations() ([]models.InterestRelation, error) {
+	return a.db.GetInterestRelations()
+}
+
+// SaveInterestRelation сохраняет связь между интересами.
+func (a *databaseAdapter) SaveInterestRelation(relation *models.InterestRelation) error {
+	return a.db.SaveInterestRelation(relation)
+}
+
+// DeleteInterestRelation удаляет связь между интересами.
+func (a *databaseAdapter) DeleteInterestRelation(relationID int) error {
+	return a.db.DeleteInterestRelation(relationID)
+}
+
+// RefreshInterestCooccurrences пересчитывает статистику совместного выбора интересов.
+func (a *databaseAdapter) RefreshInterestCooccurrences(minUsers int) (int64, error) {
+	return a.db.RefreshInterestCooccurrences(minUsers)
+}
+
+// GetTopInterestCooccurrences возвращает самые частые пары интересов.
+func (a *databaseAdapter) GetTopInterestCooccurrences(limit int) ([]models.InterestCooccurrence, error) {
+	return a.db.GetTopInterestCooccurrences(limit)
+}
+
+// GetInterestRecommendations возвращает интересы, которые часто выбирают вместе с указанными.
+func (a *databaseAdapter) GetInterestRecommendations(interestIDs []int, limit int) ([]models.InterestRecommendation, error) {
+	return a.db.GetInterestRecommendations(interestIDs, limit)
+}
+
+// GetInterestCatalog возвращает интересы с категориями и переводами.
+func (a *databaseAdapter) GetInterestCatalog() (*models.InterestCatalog, error) {
+	return a.db.GetInterestCatalog()
+}
+
+// GetInterestCategoryItems возвращает категории интересов с переводами.
+func (a *databaseAdapter) GetInterestCategoryItems() ([]models.InterestCategoryItem, error) {
+	return a.db.GetInterestCategoryItems()
+}
+
+// CreateInterestCategory создает категорию интересов.
+func (a *databaseAdapter) CreateInterestCategory(input models.InterestCategoryInput) (int, error) {
+	return a.db.CreateInterestCategory(input)
+}
+
+// UpdateInterestCategory изменяет категорию интересов.
+func (a *databaseAdapter) UpdateInterestCategory(categoryID int, input models.InterestCategoryInput) error {
+	return a.db.UpdateInterestCategory(categoryID, input)
+}
+
+// CreateInterest создает интерес.
+func (a *databaseAdapter) CreateInterest(input models.InterestInput) (int, error) {
+	return a.db.CreateInterest(input)
+}
+
+// UpdateInterest изменяет интерес.
+func (a *databaseAdapter) UpdateInterest(interestID int, input models.InterestInput) error {
+	return a.db.UpdateInterest(interestID, input)
+}
+
+func (a *databaseAdapter) UpdateUserRole(userID int, role string) error {
+	return a.db.UpdateUserRole(userID, role)
+}
+
+func (a *databaseAdapter) AssignAdminRoles(telegramIDs []int64, usernames []string) (int, error) {
+	return a.db.AssignAdminRoles(telegramIDs, usernames)
+}
+
+func (a *databaseAdapter) GetUserByID(userID int) (*models.User, error) {
+	return a.db.GetUserByID(userID)
+}
+
+func (a *databaseAdapter) SearchUsers(query string, limit int) ([]*models.User, error) {
+	return a.db.SearchUsers(query, limit)
+}
+
+func (a *databaseAdapter) GetUserFeedbackByUserID(userID int) ([]map[string]interface{}, error) {
+	return a.db.GetUserFeedbackByUserID(userID)
+}
+
+func (a *databaseAdapter) CreateAdminActionLog(entry *models.AdminActionLog) error {
+	return a.db.CreateAdminActionLog(entry)
+}
+
+func (a *databaseAdapter) GetAdminActionLogs(targetType string, targetID int, limit int) ([]models.AdminActionLog, error) {
+	return a.db.GetAdminActionLogs(targetType, targetID, limit)
+}
+
+func (a *databaseAdapter) CreateAnnouncement(announcement *models.Announcement) error {
+	return a.db.CreateAnnouncement(announcement)
+}
+
+func (a *databaseAdapter) GetAnnouncement(announcementID int) (*models.Announcement, error) {
+	return a.db.GetAnnouncement(announcementID)
+}
+
+func (a *databaseAdapter) GetAnnouncements(limit int) ([]models.Announcement, error) {
+	return a.db.GetAnnouncements(limit)
+}
+
+func (a *databaseAdapter) CountAnnouncementRecipients(segment models.AnnouncementSegment) (int, error) {
+	return a.db.CountAnnouncementRecipients(segment)
+}
+
+func (a *databaseAdapter) QueueAnnouncementDeliveries(announcementID int) (int, error) {
+	return a.db.QueueAnnouncementDeliveries(announcementID)
+}
+
+func (a *databaseAdapter) GetPendingAnnouncementDeliveries(announcementID int, limit int) ([]models.AnnouncementRecipient, error) {
+	return a.db.GetPendingAnnouncementDeliveries(announcementID, limit)
+}
+
+func (a *databaseAdapter) RecordAnnouncementDelivery(announcementID, userID int, status, deliveryError string) error {
+	return a.db.RecordAnnouncementDelivery(announcementID, userID, status, deliveryError)
+}
+
+func (a *databaseAdapter) GetAnnouncementDeliveries(announcementID int, status string, limit int) ([]models.AnnouncementDelivery, error) {
+	return a.db.GetAnnouncementDeliveries(announcementID, status, limit)
+}
+
+func (a *databaseAdapter) FinishAnnouncement(announcementID int) error {
+	return a.db.FinishAnnouncement(announcementID)
+}
+
+func (a *databaseAdapter) CancelAnnouncement(announcementID int) error {
+	return a.db.CancelAnnouncement(announcementID)
+}
+
+func (a *databaseAdapter) SetUserActive(userID int, active bool) error {
+	return a.db.SetUserActive(userID, active)
+}
+
+func (a *databaseAdapter) CreateAPIKey(key *models.APIKey) error {
+	return a.db.CreateAPIKey(key)
+}
+
+func (a *databaseAdapter) GetAPIKey(keyID int) (*models.APIKey, error) {
+	return a.db.GetAPIKey(keyID)
+}
+
+func (a *databaseAdapter) GetAPIKeyByHash(keyHash string) (*models.APIKey, error) {
+	return a.db.GetAPIKeyByHash(keyHash)
+}
+
+func (a *databaseAdapter) GetAPIKeys() ([]models.APIKey, error) {
+	return a.db.GetAPIKeys()
+}
+
+func (a *databaseAdapter) RotateAPIKey(oldKeyID int, newKey *models.APIKey, oldExpiresAt time.Time) error {
+	return a.db.RotateAPIKey(oldKeyID, newKey, oldExpiresAt)
+}
+
+func (a *databaseAdapter) RevokeAPIKey(keyID int) error {
+	return a.db.RevokeAPIKey(keyID)
+}
+
+func (a *databaseAdapter) TouchAPIKey(keyID int, interval time.Duration) error {
+	return a.db.TouchAPIKey(keyID, interval)
+}
+
+func (a *databaseAdapter) CreateAdminSession(session *models.AdminSession) error {
+	return a.db.CreateAdminSession(session)
+}
+
+func (a *databaseAdapter) GetAdminSessionByHash(tokenHash string) (*models.AdminSession, error) {
+	return a.db.GetAdminSessionByHash(tokenHash)
+}
+
+func (a *databaseAdapter) RotateAdminSession(oldSessionID int, newSession *models.AdminSession) error {
+	return a.db.RotateAdminSession(oldSessionID, newSession)
+}
+
+func (a *databaseAdapter) RevokeAdminSession(sessionID int) error {
+	return a.db.RevokeAdminSession(sessionID)
+}
+
+func (a *databaseAdapter) AppendAuditEvent(event *models.AuditEvent) error {
+	return a.db.AppendAuditEvent(event)
+}
+
+func (a *databaseAdapter) GetAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	return a.db.GetAuditEvents(filter)
+}
+
+func (a *databaseAdapter) GetAuditChain(afterID int64, limit int) ([]models.AuditEvent, error) {
+	return a.db.GetAuditChain(afterID, limit)
+}
+
+func (a *databaseAdapter) EnsureAuditPseudonym(userID int, candidate string) (string, error) {
+	return a.db.EnsureAuditPseudonym(userID, candidate)
+}
+
+func (a *databaseAdapter) AddFeedbackMessage(message *models.FeedbackMessage) error {
+	return a.db.AddFeedbackMessage(message)
+}
+
+func (a *databaseAdapter) GetFeedbackThread(feedbackID int) (*models.FeedbackThread, error) {
+	return a.db.GetFeedbackThread(feedbackID)
+}
+
+func (a *databaseAdapter) GetFeedbackTriage(feedbackID int) (*models.FeedbackTriage, error) {
+	return a.db.GetFeedbackTriage(feedbackID)
+}
+
+func (a *databaseAdapter) UpdateFeedbackTriage(triage *models.FeedbackTriage, sla time.Duration) error {
+	return a.db.UpdateFeedbackTriage(triage, sla)
+}
+
+func (a *databaseAdapter) GetFeedbackSLABreaches(limit int) ([]models.FeedbackSLABreach, error) {
+	return a.db.GetFeedbackSLABreaches(limit)
+}
+
+func (a *databaseAdapter) MarkFeedbackSLAAlerted(feedbackIDs []int) error {
+	return a.db.MarkFeedbackSLAAlerted(feedbackIDs)
+}
+
+func (a *databaseAdapter) SearchFeedback(query models.FeedbackQuery) ([]models.FeedbackRecord, error) {
+	return a.db.SearchFeedback(query)
+}
+
+func (a *databaseAdapter) AddFeedbackAttachments(feedbackID int, attachments []models.FeedbackAttachment) error {
+	return a.db.AddFeedbackAttachments(feedbackID, attachments)
+}
+
+func (a *databaseAdapter) GetFeedbackAttachments(feedbackID int) ([]models.FeedbackAttachment, error) {
+	return a.db.GetFeedbackAttachments(feedbackID)
+}
+
+func (a *databaseAdapter) CreateSurvey(survey *models.Survey) error {
+	return a.db.CreateSurvey(survey)
+}
+
+func (a *databaseAdapter) GetSurvey(surveyID int) (*models.Survey, error) {
+	return a.db.GetSurvey(surveyID)
+}
+
+func (a *databaseAdapter) GetSurveys(limit int) ([]models.Survey, error) {
+	return a.db.GetSurveys(limit)
+}
+
+func (a *databaseAdapter) CountSurveyRecipients(segment models.AnnouncementSegment, frequency models.SurveyFrequency) (int, error) {
+	return a.db.CountSurveyRecipients(segment, frequency)
+}
+
+func (a *databaseAdapter) QueueSurveyDeliveries(surveyID int, frequency models.SurveyFrequency) (int, error) {
+	return a.db.QueueSurveyDeliveries(surveyID, frequency)
+}
+
+func (a *databaseAdapter) GetPendingSurveyDeliveries(surveyID int, limit int) ([]models.SurveyRecipient, error) {
+	return a.db.GetPendingSurveyDeliveries(surveyID, limit)
+}
+
+func (a *databaseAdapter) RecordSurveyDelivery(surveyID, userID int, status, deliveryError string) error {
+	return a.db.RecordSurveyDelivery(surveyID, userID, status, deliveryError)
+}
+
+func (a *databaseAdapter) FinishSurvey(surveyID int) error {
+	return a.db.FinishSurvey(surveyID)
+}
+
+func (a *databaseAdapter) CancelSurvey(surveyID int) error {
+	return a.db.CancelSurvey(surveyID)
+}
+
+func (a *databaseAdapter) GetDueSurveyIDs(now time.Time) ([]int, error) {
+	return a.db.GetDueSurveyIDs(now)
+}
+
+func (a *databaseAdapter) IsSurveyRecipient(surveyID, userID int) (bool, error) {
+	return a.db.IsSurveyRecipient(surveyID, userID)
+}
+
+func (a *databaseAdapter) SaveSurveyAnswer(answer *models.SurveyAnswer) error {
+	return a.db.SaveSurveyAnswer(answer)
+}
+
+func (a *databaseAdapter) GetSurveyAnswerCounts(surveyID int) ([]models.SurveyAnswerCount, error) {
+	return a.db.GetSurveyAnswerCounts(surveyID)
+}
+
+func (a *databaseAdapter) GetSurveyParticipation(surveyID, questionCount int) (models.SurveyParticipation, error) {
+	return a.db.GetSurveyParticipation(surveyID, questionCount)
+}
+
+func (a *databaseAdapter) GetUserLanguagePairs(userID int) ([]models.UserLanguagePair, error) {
+	return a.db.GetUserLanguagePairs(userID)
+}
+
+func (a *databaseAdapter) GetUserMatches(userID int) ([]models.UserMatch, error) {
+	return a.db.GetUserMatches(userID)
+}
+
+func (a *databaseAdapter) GetUserSurveyAnswers(userID int) ([]models.SurveyAnswer, error) {
+	return a.db.GetUserSurveyAnswers(userID)
+}
+
+func (a *databaseAdapter) DeleteUserAccount(userID int) (*models.AccountDeletionResult, error) {
+	return a.db.DeleteUserAccount(userID)
+}
+
+func (a *databaseAdapter) GetAccountDeletionStats(since time.Time) (models.AccountDeletionStats, error) {
+	return a.db.GetAccountDeletionStats(since)
+}
+
+func (a *databaseAdapter) CountRetentionCandidates(dataType string, cutoff time.Time) (int64, error) {
+	return a.db.CountRetentionCandidates(dataType, cutoff)
+}
+
+func (a *databaseAdapter) PurgeRetentionBatch(dataType string, cutoff time.Time, limit int) (int64, error) {
+	return a.db.PurgeRetentionBatch(dataType, cutoff, limit)
+}
+
+func (a *databaseAdapter) GetRetentionUsers(cutoff time.Time, limit int) ([]*models.User, error) {
+	return a.db.GetRetentionUsers(cutoff, limit)
+}
+
+func (a *databaseAdapter) FindOrCreatePlatformUser(platform, externalID, username, firstName string) (*models.User, error) {
+	return a.db.FindOrCreatePlatformUser(platform, externalID, username, firstName)
+}
+
+func (a *databaseAdapter) GetAllFeedback() ([]map[string]interface{}, error) {
+	return a.db.GetAllFeedback()
+}
+
+func (a *databaseAdapter) ReencryptPersonalData(limit int) (int, error) {
+	return a.db.ReencryptPersonalData(limit)
+}
+
 // DataLoader implementation для cache warming
 
 // LoadLanguages loads all available languages from the database.
@@ -156,10 +156,10 @@ func (m *MockDatabase) GetInterestByID(interestID int) (*models.Interest, error)
 	return args.Get(0).(*models.Interest), args.Error(1)
 }
 
-func (m *MockDatabase) SaveUserFeedback(userID int, feedbackText string, contactInfo *string) error {
+func (m *MockDatabase) SaveUserFeedback(userID int, feedbackText string, contactInfo *string) (int, error) {
 	args := m.Called(userID, feedbackText, contactInfo)
 
-	return args.Error(0)
+	return args.Int(0), args.Error(1)
 }
 
 func (m *MockDatabase) GetUnprocessedFeedback() ([]map[string]interface{}, error) {
@@ -220,6 +220,636 @@ func (m *MockDatabase) GetFriendshipPreferences(userID int) (*models.FriendshipP
 	return args.Get(0).(*models.FriendshipPreferences), args.Error(1)
 }
 
+// Методы для работы с периодами недоступности.
+func (m *MockDatabase) SaveUnavailabilityPeriod(userID int, period *models.UnavailabilityPeriod) error {
+	args := m.Called(userID, period)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetUnavailabilityPeriods(userID int) ([]models.UnavailabilityPeriod, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.UnavailabilityPeriod), args.Error(1)
+}
+
+func (m *MockDatabase) DeleteUnavailabilityPeriod(userID, periodID int) error {
+	args := m.Called(userID, periodID)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) DeleteExpiredUnavailabilityPeriods() (int64, error) {
+	args := m.Called()
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDatabase) CancelPendingMatches(userID int) (int64, error) {
+	args := m.Called(userID)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDatabase) CancelPendingMatchesOfUnavailableUsers() (int64, error) {
+	args := m.Called()
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// Методы для работы с целями изучения языка.
+func (m *MockDatabase) GetUserLearningGoals(userID int) ([]string, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockDatabase) SaveUserLearningGoals(userID int, goals []string) error {
+	args := m.Called(userID, goals)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetLearningGoalStats() ([]models.LearningGoalStat, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.LearningGoalStat), args.Error(1)
+}
+
+// Методы для работы с предложениями интересов.
+func (m *MockDatabase) CreateInterestSuggestion(suggestion *models.InterestSuggestion) error {
+	args := m.Called(suggestion)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) CountPendingInterestSuggestions(userID int) (int, error) {
+	args := m.Called(userID)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) GetInterestSuggestions(status string, limit int) ([]models.InterestSuggestion, error) {
+	args := m.Called(status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.InterestSuggestion), args.Error(1)
+}
+
+func (m *MockDatabase) ApproveInterestSuggestion(suggestionID int, approval models.InterestSuggestionApproval) (int, error) {
+	args := m.Called(suggestionID, approval)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) RejectInterestSuggestion(suggestionID int, note string) error {
+	args := m.Called(suggestionID, note)
+
+	return args.Error(0)
+}
+
+// Методы для работы с графом связей интересов.
+func (m *MockDatabase) GetInterestRelations() ([]models.InterestRelation, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.InterestRelation), args.Error(1)
+}
+
+func (m *MockDatabase) SaveInterestRelation(relation *models.InterestRelation) error {
+	args := m.Called(relation)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) DeleteInterestRelation(relationID int) error {
+	args := m.Called(relationID)
+
+	return args.Error(0)
+}
+
+// Методы для рекомендаций интересов.
+func (m *MockDatabase) RefreshInterestCooccurrences(minUsers int) (int64, error) {
+	args := m.Called(minUsers)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockDatabase) GetTopInterestCooccurrences(limit int) ([]models.InterestCooccurrence, error) {
+	args := m.Called(limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.InterestCooccurrence), args.Error(1)
+}
+
+func (m *MockDatabase) GetInterestRecommendations(interestIDs []int, limit int) ([]models.InterestRecommendation, error) {
+	args := m.Called(interestIDs, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.InterestRecommendation), args.Error(1)
+}
+
+// Методы для управления каталогом интересов.
+func (m *MockDatabase) GetInterestCatalog() (*models.InterestCatalog, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*models.InterestCatalog), args.Error(1)
+}
+
+func (m *MockDatabase) GetInterestCategoryItems() ([]models.InterestCategoryItem, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]models.InterestCategoryItem), args.Error(1)
+}
+
+func (m *MockDatabase) CreateInterestCategory(input models.InterestCategoryInput) (int, error) {
+	args := m.Called(input)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) UpdateInterestCategory(categoryID int, input models.InterestCategoryInput) error {
+	args := m.Called(categoryID, input)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) CreateInterest(input models.InterestInput) (int, error) {
+	args := m.Called(input)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) UpdateInterest(interestID int, input models.InterestInput) error {
+	args := m.Called(interestID, input)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) UpdateUserRole(userID int, role string) error {
+	args := m.Called(userID, role)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) AssignAdminRoles(telegramIDs []int64, usernames []string) (int, error) {
+	args := m.Called(telegramIDs, usernames)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) GetUserByID(userID int) (*models.User, error) {
+	args := m.Called(userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	user, _ := args.Get(0).(*models.User)
+
+	return user, args.Error(1)
+}
+
+func (m *MockDatabase) SearchUsers(query string, limit int) ([]*models.User, error) {
+	args := m.Called(query, limit)
+	users, _ := args.Get(0).([]*models.User)
+
+	return users, args.Error(1)
+}
+
+func (m *MockDatabase) GetUserFeedbackByUserID(userID int) ([]map[string]interface{}, error) {
+	args := m.Called(userID)
+	feedback, _ := args.Get(0).([]map[string]interface{})
+
+	return feedback, args.Error(1)
+}
+
+func (m *MockDatabase) CreateAdminActionLog(entry *models.AdminActionLog) error {
+	args := m.Called(entry)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetAdminActionLogs(targetType string, targetID int, limit int) ([]models.AdminActionLog, error) {
+	args := m.Called(targetType, targetID, limit)
+	entries, _ := args.Get(0).([]models.AdminActionLog)
+
+	return entries, args.Error(1)
+}
+
+func (m *MockDatabase) CreateAnnouncement(announcement *models.Announcement) error {
+	args := m.Called(announcement)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetAnnouncement(announcementID int) (*models.Announcement, error) {
+	args := m.Called(announcementID)
+	result, _ := args.Get(0).(*models.Announcement)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetAnnouncements(limit int) ([]models.Announcement, error) {
+	args := m.Called(limit)
+	result, _ := args.Get(0).([]models.Announcement)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) CountAnnouncementRecipients(segment models.AnnouncementSegment) (int, error) {
+	args := m.Called(segment)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) QueueAnnouncementDeliveries(announcementID int) (int, error) {
+	args := m.Called(announcementID)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) GetPendingAnnouncementDeliveries(announcementID int, limit int) ([]models.AnnouncementRecipient, error) {
+	args := m.Called(announcementID, limit)
+	result, _ := args.Get(0).([]models.AnnouncementRecipient)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) RecordAnnouncementDelivery(announcementID, userID int, status, deliveryError string) error {
+	args := m.Called(announcementID, userID, status, deliveryError)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetAnnouncementDeliveries(announcementID int, status string, limit int) ([]models.AnnouncementDelivery, error) {
+	args := m.Called(announcementID, status, limit)
+	result, _ := args.Get(0).([]models.AnnouncementDelivery)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) FinishAnnouncement(announcementID int) error {
+	args := m.Called(announcementID)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) CancelAnnouncement(announcementID int) error {
+	args := m.Called(announcementID)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) SetUserActive(userID int, active bool) error {
+	args := m.Called(userID, active)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) CreateAPIKey(key *models.APIKey) error {
+	args := m.Called(key)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetAPIKey(keyID int) (*models.APIKey, error) {
+	args := m.Called(keyID)
+	result, _ := args.Get(0).(*models.APIKey)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetAPIKeyByHash(keyHash string) (*models.APIKey, error) {
+	args := m.Called(keyHash)
+	result, _ := args.Get(0).(*models.APIKey)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetAPIKeys() ([]models.APIKey, error) {
+	args := m.Called()
+	result, _ := args.Get(0).([]models.APIKey)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) RotateAPIKey(oldKeyID int, newKey *models.APIKey, oldExpiresAt time.Time) error {
+	args := m.Called(oldKeyID, newKey, oldExpiresAt)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) RevokeAPIKey(keyID int) error {
+	args := m.Called(keyID)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) TouchAPIKey(keyID int, interval time.Duration) error {
+	args := m.Called(keyID, interval)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) CreateAdminSession(session *models.AdminSession) error {
+	args := m.Called(session)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetAdminSessionByHash(tokenHash string) (*models.AdminSession, error) {
+	args := m.Called(tokenHash)
+	result, _ := args.Get(0).(*models.AdminSession)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) RotateAdminSession(oldSessionID int, newSession *models.AdminSession) error {
+	args := m.Called(oldSessionID, newSession)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) RevokeAdminSession(sessionID int) error {
+	args := m.Called(sessionID)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) AppendAuditEvent(event *models.AuditEvent) error {
+	args := m.Called(event)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	args := m.Called(filter)
+	result, _ := args.Get(0).([]models.AuditEvent)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetAuditChain(afterID int64, limit int) ([]models.AuditEvent, error) {
+	args := m.Called(afterID, limit)
+	result, _ := args.Get(0).([]models.AuditEvent)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) EnsureAuditPseudonym(userID int, candidate string) (string, error) {
+	args := m.Called(userID, candidate)
+
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockDatabase) AddFeedbackMessage(message *models.FeedbackMessage) error {
+	args := m.Called(message)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetFeedbackThread(feedbackID int) (*models.FeedbackThread, error) {
+	args := m.Called(feedbackID)
+	result, _ := args.Get(0).(*models.FeedbackThread)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetFeedbackTriage(feedbackID int) (*models.FeedbackTriage, error) {
+	args := m.Called(feedbackID)
+	result, _ := args.Get(0).(*models.FeedbackTriage)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) UpdateFeedbackTriage(triage *models.FeedbackTriage, sla time.Duration) error {
+	args := m.Called(triage, sla)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetFeedbackSLABreaches(limit int) ([]models.FeedbackSLABreach, error) {
+	args := m.Called(limit)
+	result, _ := args.Get(0).([]models.FeedbackSLABreach)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) MarkFeedbackSLAAlerted(feedbackIDs []int) error {
+	args := m.Called(feedbackIDs)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) SearchFeedback(query models.FeedbackQuery) ([]models.FeedbackRecord, error) {
+	args := m.Called(query)
+	result, _ := args.Get(0).([]models.FeedbackRecord)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) AddFeedbackAttachments(feedbackID int, attachments []models.FeedbackAttachment) error {
+	args := m.Called(feedbackID, attachments)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetFeedbackAttachments(feedbackID int) ([]models.FeedbackAttachment, error) {
+	args := m.Called(feedbackID)
+	result, _ := args.Get(0).([]models.FeedbackAttachment)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) CreateSurvey(survey *models.Survey) error {
+	args := m.Called(survey)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetSurvey(surveyID int) (*models.Survey, error) {
+	args := m.Called(surveyID)
+	result, _ := args.Get(0).(*models.Survey)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetSurveys(limit int) ([]models.Survey, error) {
+	args := m.Called(limit)
+	result, _ := args.Get(0).([]models.Survey)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) CountSurveyRecipients(segment models.AnnouncementSegment, frequency models.SurveyFrequency) (int, error) {
+	args := m.Called(segment, frequency)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) QueueSurveyDeliveries(surveyID int, frequency models.SurveyFrequency) (int, error) {
+	args := m.Called(surveyID, frequency)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDatabase) GetPendingSurveyDeliveries(surveyID int, limit int) ([]models.SurveyRecipient, error) {
+	args := m.Called(surveyID, limit)
+	result, _ := args.Get(0).([]models.SurveyRecipient)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) RecordSurveyDelivery(surveyID, userID int, status, deliveryError string) error {
+	args := m.Called(surveyID, userID, status, deliveryError)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) FinishSurvey(surveyID int) error {
+	args := m.Called(surveyID)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) CancelSurvey(surveyID int) error {
+	args := m.Called(surveyID)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetDueSurveyIDs(now time.Time) ([]int, error) {
+	args := m.Called(now)
+	result, _ := args.Get(0).([]int)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) IsSurveyRecipient(surveyID, userID int) (bool, error) {
+	args := m.Called(surveyID, userID)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDatabase) SaveSurveyAnswer(answer *models.SurveyAnswer) error {
+	args := m.Called(answer)
+
+	return args.Error(0)
+}
+
+func (m *MockDatabase) GetSurveyAnswerCounts(surveyID int) ([]models.SurveyAnswerCount, error) {
+	args := m.Called(surveyID)
+	result, _ := args.Get(0).([]models.SurveyAnswerCount)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetSurveyParticipation(surveyID, questionCount int) (models.SurveyParticipation, error) {
+	args := m.Called(surveyID, questionCount)
+	result, _ := args.Get(0).(models.SurveyParticipation)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetUserLanguagePairs(userID int) ([]models.UserLanguagePair, error) {
+	args := m.Called(userID)
+	result, _ := args.Get(0).([]models.UserLanguagePair)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetUserMatches(userID int) ([]models.UserMatch, error) {
+	args := m.Called(userID)
+	result, _ := args.Get(0).([]models.UserMatch)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetUserSurveyAnswers(userID int) ([]models.SurveyAnswer, error) {
+	args := m.Called(userID)
+	result, _ := args.Get(0).([]models.SurveyAnswer)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) DeleteUserAccount(userID int) (*models.AccountDeletionResult, error) {
+	args := m.Called(userID)
+	result, _ := args.Get(0).(*models.AccountDeletionResult)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetAccountDeletionStats(since time.Time) (models.AccountDeletionStats, error) {
+	args := m.Called(since)
+	result, _ := args.Get(0).(models.AccountDeletionStats)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) CountRetentionCandidates(dataType string, cutoff time.Time) (int64, error) {
+	args := m.Called(dataType, cutoff)
+	result, _ := args.Get(0).(int64)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) PurgeRetentionBatch(dataType string, cutoff time.Time, limit int) (int64, error) {
+	args := m.Called(dataType, cutoff, limit)
+	result, _ := args.Get(0).(int64)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetRetentionUsers(cutoff time.Time, limit int) ([]*models.User, error) {
+	args := m.Called(cutoff, limit)
+	users, _ := args.Get(0).([]*models.User)
+
+	return users, args.Error(1)
+}
+
+func (m *MockDatabase) FindOrCreatePlatformUser(platform, externalID, username, firstName string) (*models.User, error) {
+	args := m.Called(platform, externalID, username, firstName)
+	result, _ := args.Get(0).(*models.User)
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) GetAllFeedback() ([]map[string]interface{}, error) {
+	args := m.Called()
+	result, _ := args.Get(0).([]map[string]interface{})
+
+	return result, args.Error(1)
+}
+
+func (m *MockDatabase) ReencryptPersonalData(limit int) (int, error) {
+	args := m.Called(limit)
+
+	return args.Int(0), args.Error(1)
+}
+
 func TestHandleUserRegistration(t *testing.T) {
 	mockDB := new(MockDatabase)
 	mockLocalizer := &localization.Localizer{}
@@ -254,6 +884,32 @@ func TestHandleUserRegistration(t *testing.T) {
 	mockDB.AssertExpectations(t)
 }
 
+// TestHandlePlatformUserRegistration тестирует регистрацию по ID с указанием платформы:
+// Telegram идет прежним путем, остальные платформы - через user_identities.
+func TestHandlePlatformUserRegistration(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	discordUser := &models.User{ID: 7, TelegramID: -1, Status: models.StatusNew}
+	mockDB.On("FindOrCreatePlatformUser", models.PlatformDiscord, "80351110224678912", "anna", "Anna").Return(discordUser, nil)
+	mockDB.On("UpdateUserInterfaceLanguage", 7, "es").Return(nil)
+
+	user, err := service.HandlePlatformUserRegistration("discord:80351110224678912", "anna", "Anna", "es-ES")
+	assert.NoError(t, err)
+	assert.Equal(t, "es", user.InterfaceLanguageCode)
+
+	telegramUser := &models.User{ID: 8, TelegramID: 12345, InterfaceLanguageCode: "ru", Status: models.StatusActive}
+	mockDB.On("FindOrCreateUser", int64(12345), "ivan", "Ivan").Return(telegramUser, nil)
+
+	user, err = service.HandlePlatformUserRegistration("telegram:12345", "ivan", "Ivan", "en")
+	assert.NoError(t, err)
+	assert.Equal(t, "ru", user.InterfaceLanguageCode)
+
+	_, err = service.HandlePlatformUserRegistration("12345", "ivan", "Ivan", "en")
+	assert.Error(t, err)
+	mockDB.AssertExpectations(t)
+}
+
 func TestDetectLanguage(t *testing.T) {
 	tests := []struct {
 		name             string
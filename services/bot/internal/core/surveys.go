@@ -0,0 +1,613 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// Границы ответов на вопросы опроса.
+const (
+	npsMaxScore       = 10
+	npsPromoterScore  = 9 // 9-10 - промоутеры
+	npsDetractorScore = 6 // 0-6 - критики, 7-8 - нейтральные
+	ratingMinScore    = 1
+	ratingMaxScore    = 5
+)
+
+// SurveySender отправляет вопрос опроса с кнопками ответа в чат мессенджера.
+// Ошибки те же, что у AnnouncementSender.
+type SurveySender interface {
+	SendSurveyPrompt(chatID int64, prompt models.SurveyPrompt) error
+}
+
+// SurveyFrequencyRules возвращает правила, ограничивающие частоту опросов одного пользователя.
+func SurveyFrequencyRules() models.SurveyFrequency {
+	return models.SurveyFrequency{
+		Cooldown:    localization.SurveyCooldown,
+		Window:      localization.SurveyFrequencyWindow,
+		MaxInWindow: localization.SurveyMaxPerWindow,
+	}
+}
+
+// CreateSurvey проверяет и сохраняет опрос. С временем запуска опрос становится запланированным,
+// без него остается черновиком. В RecipientsCount возвращается число пользователей сегмента,
+// которым опрос можно отправить с учетом правил частоты.
+func (s *BotService) CreateSurvey(input models.SurveyInput, createdBy int) (*models.Survey, error) {
+	survey := &models.Survey{
+		Title:       strings.TrimSpace(input.Title),
+		Kind:        strings.TrimSpace(input.Kind),
+		Questions:   input.Questions,
+		Segment:     input.Segment,
+		Status:      models.SurveyStatusDraft,
+		ScheduledAt: input.ScheduledAt,
+		CreatedBy:   createdBy,
+	}
+
+	if err := normalizeSurvey(survey, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if survey.ScheduledAt != nil {
+		survey.Status = models.SurveyStatusScheduled
+	}
+
+	if err := s.DB.CreateSurvey(survey); err != nil {
+		return nil, fmt.Errorf("failed to create survey: %w", err)
+	}
+
+	recipients, err := s.DB.CountSurveyRecipients(survey.Segment, SurveyFrequencyRules())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count survey recipients: %w", err)
+	}
+
+	survey.RecipientsCount = recipients
+
+	return survey, nil
+}
+
+// GetSurveys возвращает последние опросы со счетчиками доставки и ответов.
+func (s *BotService) GetSurveys(limit int) ([]models.Survey, error) {
+	return s.DB.GetSurveys(limit)
+}
+
+// CancelSurvey отменяет черновик, запланированный опрос или останавливает рассылку.
+// Получившие опрос пользователи больше не могут на него ответить.
+func (s *BotService) CancelSurvey(surveyID int) error {
+	if _, err := s.DB.GetSurvey(surveyID); err != nil {
+		return err
+	}
+
+	return s.DB.CancelSurvey(surveyID)
+}
+
+// SurveyPrompt возвращает вопрос опроса на языке lang: перевод на язык пользователя, английский
+// или любой имеющийся. Вопрос NPS без текста получает стандартную формулировку.
+func (s *BotService) SurveyPrompt(survey *models.Survey, questionIndex int, lang string) models.SurveyPrompt {
+	question := survey.Questions[questionIndex]
+
+	prompt := models.SurveyPrompt{
+		SurveyID:      survey.ID,
+		QuestionIndex: questionIndex,
+		QuestionCount: len(survey.Questions),
+		Kind:          question.Kind,
+		Language:      lang,
+		Text:          localizeSurveyText(question.Text, lang),
+	}
+
+	if prompt.Text == "" && question.Kind == models.SurveyQuestionNPS {
+		prompt.Text = s.Localizer.Get(lang, localization.LocaleSurveyNPSQuestion)
+	}
+
+	for _, option := range question.Options {
+		prompt.Options = append(prompt.Options, localizeSurveyText(option, lang))
+	}
+
+	return prompt
+}
+
+// AnswerSurvey сохраняет ответ пользователя и возвращает следующий вопрос или nil, если вопросы кончились.
+// Отвечать можно только на доставленный пользователю опрос, пока его не отменили.
+func (s *BotService) AnswerSurvey(user *models.User, surveyID, questionIndex, value int) (*models.SurveyPrompt, error) {
+	survey, err := s.DB.GetSurvey(surveyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if survey.Status != models.SurveyStatusSending && survey.Status != models.SurveyStatusSent {
+		return nil, errorsPkg.ErrSurveyClosed
+	}
+
+	if questionIndex < 0 || questionIndex >= len(survey.Questions) {
+		return nil, fmt.Errorf("%w: question %d", errorsPkg.ErrInvalidSurvey, questionIndex)
+	}
+
+	if !validSurveyAnswer(survey.Questions[questionIndex], value) {
+		return nil, fmt.Errorf("%w: answer %d", errorsPkg.ErrInvalidSurvey, value)
+	}
+
+	recipient, err := s.DB.IsSurveyRecipient(surveyID, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check survey recipient: %w", err)
+	}
+
+	if !recipient {
+		return nil, errorsPkg.ErrSurveyClosed
+	}
+
+	answer := &models.SurveyAnswer{SurveyID: surveyID, UserID: user.ID, QuestionIndex: questionIndex, Value: value}
+	if err := s.DB.SaveSurveyAnswer(answer); err != nil {
+		return nil, fmt.Errorf("failed to save survey answer: %w", err)
+	}
+
+	if questionIndex+1 == len(survey.Questions) {
+		return nil, nil
+	}
+
+	prompt := s.SurveyPrompt(survey, questionIndex+1, user.InterfaceLanguageCode)
+
+	return &prompt, nil
+}
+
+// GetSurveyResults возвращает распределение ответов по вопросам, средние оценки, NPS и охват опроса.
+func (s *BotService) GetSurveyResults(surveyID int) (*models.SurveyResults, error) {
+	survey, err := s.DB.GetSurvey(surveyID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.DB.GetSurveyAnswerCounts(surveyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get survey answers: %w", err)
+	}
+
+	participation, err := s.DB.GetSurveyParticipation(surveyID, len(survey.Questions))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get survey participation: %w", err)
+	}
+
+	results := &models.SurveyResults{
+		Survey:        *survey,
+		Participation: participation,
+		Questions:     make([]models.SurveyQuestionResult, len(survey.Questions)),
+	}
+
+	if participation.Sent > 0 {
+		results.ResponseRate = roundSurveyValue(float64(participation.Responded)/float64(participation.Sent), 4)
+	}
+
+	for i := range survey.Questions {
+		prompt := s.SurveyPrompt(survey, i, localization.SurveyDefaultLanguage)
+		results.Questions[i] = models.SurveyQuestionResult{
+			Index:        i,
+			Kind:         prompt.Kind,
+			Text:         prompt.Text,
+			Distribution: map[int]int{},
+			Options:      prompt.Options,
+		}
+	}
+
+	for _, count := range counts {
+		if count.QuestionIndex < 0 || count.QuestionIndex >= len(results.Questions) {
+			continue
+		}
+
+		question := &results.Questions[count.QuestionIndex]
+		question.Distribution[count.Value] += count.Count
+		question.Answers += count.Count
+	}
+
+	for i := range results.Questions {
+		summarizeSurveyQuestion(&results.Questions[i])
+	}
+
+	return results, nil
+}
+
+// GetRecentSurveyResults возвращает результаты последних запущенных опросов для статистики.
+func (s *BotService) GetRecentSurveyResults(limit int) ([]models.SurveyResults, error) {
+	surveys, err := s.DB.GetSurveys(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []models.SurveyResults{}
+
+	for _, survey := range surveys {
+		if survey.Status != models.SurveyStatusSending && survey.Status != models.SurveyStatusSent {
+			continue
+		}
+
+		surveyResults, err := s.GetSurveyResults(survey.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, *surveyResults)
+	}
+
+	return results, nil
+}
+
+// SetSurveyDispatcher задает диспетчер, через который планировщик запускает опросы.
+func (s *BotService) SetSurveyDispatcher(dispatcher *SurveyDispatcher) {
+	s.surveyDispatcher.Store(dispatcher)
+}
+
+// StartSurveyScheduler раз в SurveySchedulerInterval запускает запланированные опросы,
+// время которых наступило. Работает до отмены контекста.
+func (s *BotService) StartSurveyScheduler(ctx context.Context) {
+	ticker := time.NewTicker(localization.SurveySchedulerInterval)
+	defer ticker.Stop()
+
+	s.runDueSurveys(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			s.runDueSurveys(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runDueSurveys запускает рассылку опросов, время которых наступило.
+func (s *BotService) runDueSurveys(ctx context.Context) {
+	dispatcher := s.surveyDispatcher.Load()
+	if dispatcher == nil {
+		return
+	}
+
+	surveyIDs, err := s.DB.GetDueSurveyIDs(time.Now())
+	if err != nil {
+		log.Printf("Failed to get due surveys: %v", err)
+
+		return
+	}
+
+	for _, surveyID := range surveyIDs {
+		recipients, err := dispatcher.Send(ctx, surveyID)
+		if err != nil {
+			log.Printf("Failed to start survey %d: %v", surveyID, err)
+
+			continue
+		}
+
+		log.Printf("Survey %d started for %d recipients", surveyID, recipients)
+	}
+}
+
+// normalizeSurvey проверяет опрос и нормализует вопросы и фильтры сегмента.
+func normalizeSurvey(survey *models.Survey, now time.Time) error {
+	titleLength := utf8.RuneCountInString(survey.Title)
+	if titleLength == 0 || titleLength > localization.MaxSurveyTitleLength {
+		return fmt.Errorf("%w: title must be 1-%d characters", errorsPkg.ErrInvalidSurvey, localization.MaxSurveyTitleLength)
+	}
+
+	switch survey.Kind {
+	case models.SurveyKindNPS:
+		if len(survey.Questions) == 0 {
+			survey.Questions = []models.SurveyQuestion{{Kind: models.SurveyQuestionNPS}}
+		}
+
+		if len(survey.Questions) != 1 || survey.Questions[0].Kind != models.SurveyQuestionNPS {
+			return fmt.Errorf("%w: nps survey must have a single nps question", errorsPkg.ErrInvalidSurvey)
+		}
+	case models.SurveyKindQuestionnaire:
+		if len(survey.Questions) == 0 || len(survey.Questions) > localization.MaxSurveyQuestions {
+			return fmt.Errorf("%w: questionnaire must have 1-%d questions", errorsPkg.ErrInvalidSurvey, localization.MaxSurveyQuestions)
+		}
+	default:
+		return fmt.Errorf("%w: kind %q", errorsPkg.ErrInvalidSurvey, survey.Kind)
+	}
+
+	for i := range survey.Questions {
+		if err := normalizeSurveyQuestion(&survey.Questions[i]); err != nil {
+			return fmt.Errorf("%w (question %d)", err, i+1)
+		}
+	}
+
+	if survey.ScheduledAt != nil && !survey.ScheduledAt.After(now) {
+		return fmt.Errorf("%w: scheduledAt must be in the future", errorsPkg.ErrInvalidSurvey)
+	}
+
+	return normalizeSegment(&survey.Segment, errorsPkg.ErrInvalidSurvey)
+}
+
+// normalizeSurveyQuestion проверяет вид вопроса, переводы текста и варианты ответа.
+func normalizeSurveyQuestion(question *models.SurveyQuestion) error {
+	question.Kind = strings.TrimSpace(question.Kind)
+
+	text, err := normalizeSurveyText(question.Text, localization.MaxSurveyQuestionLength)
+	if err != nil {
+		return err
+	}
+
+	question.Text = text
+
+	switch question.Kind {
+	case models.SurveyQuestionNPS, models.SurveyQuestionRating:
+		if len(question.Options) > 0 {
+			return fmt.Errorf("%w: options are allowed only for choice questions", errorsPkg.ErrInvalidSurvey)
+		}
+	case models.SurveyQuestionChoice:
+		if len(question.Options) < localization.MinSurveyOptions || len(question.Options) > localization.MaxSurveyOptions {
+			return fmt.Errorf("%w: choice question must have %d-%d options", errorsPkg.ErrInvalidSurvey,
+				localization.MinSurveyOptions, localization.MaxSurveyOptions)
+		}
+
+		for i, option := range question.Options {
+			normalized, err := normalizeSurveyText(option, localization.MaxSurveyOptionLength)
+			if err != nil {
+				return err
+			}
+
+			if len(normalized) == 0 {
+				return fmt.Errorf("%w: option text is required", errorsPkg.ErrInvalidSurvey)
+			}
+
+			question.Options[i] = normalized
+		}
+	default:
+		return fmt.Errorf("%w: question kind %q", errorsPkg.ErrInvalidSurvey, question.Kind)
+	}
+
+	// Текст необязателен только у NPS: для него есть стандартная формулировка
+	if len(question.Text) == 0 && question.Kind != models.SurveyQuestionNPS {
+		return fmt.Errorf("%w: question text is required", errorsPkg.ErrInvalidSurvey)
+	}
+
+	return nil
+}
+
+// normalizeSurveyText приводит коды языков к нижнему регистру, убирает пустые переводы
+// и проверяет длину текста.
+func normalizeSurveyText(text models.SurveyText, maxLength int) (models.SurveyText, error) {
+	normalized := models.SurveyText{}
+
+	for lang, value := range text {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		value = strings.TrimSpace(value)
+
+		if lang == "" || value == "" {
+			continue
+		}
+
+		if utf8.RuneCountInString(value) > maxLength {
+			return nil, fmt.Errorf("%w: text must be at most %d characters", errorsPkg.ErrInvalidSurvey, maxLength)
+		}
+
+		normalized[lang] = value
+	}
+
+	return normalized, nil
+}
+
+// localizeSurveyText выбирает перевод: язык пользователя, язык по умолчанию или первый по коду языка.
+func localizeSurveyText(text models.SurveyText, lang string) string {
+	if value, ok := text[lang]; ok {
+		return value
+	}
+
+	if value, ok := text[localization.SurveyDefaultLanguage]; ok {
+		return value
+	}
+
+	langs := make([]string, 0, len(text))
+	for code := range text {
+		langs = append(langs, code)
+	}
+
+	if len(langs) == 0 {
+		return ""
+	}
+
+	sort.Strings(langs)
+
+	return text[langs[0]]
+}
+
+// validSurveyAnswer сообщает, допустим ли ответ value на вопрос.
+func validSurveyAnswer(question models.SurveyQuestion, value int) bool {
+	switch question.Kind {
+	case models.SurveyQuestionNPS:
+		return value >= 0 && value <= npsMaxScore
+	case models.SurveyQuestionRating:
+		return value >= ratingMinScore && value <= ratingMaxScore
+	case models.SurveyQuestionChoice:
+		return value >= 0 && value < len(question.Options)
+	default:
+		return false
+	}
+}
+
+// summarizeSurveyQuestion считает среднюю оценку и NPS по распределению ответов.
+func summarizeSurveyQuestion(question *models.SurveyQuestionResult) {
+	if question.Answers == 0 || !slices.Contains([]string{models.SurveyQuestionNPS, models.SurveyQuestionRating}, question.Kind) {
+		return
+	}
+
+	total := 0
+
+	for value, count := range question.Distribution {
+		total += value * count
+
+		if question.Kind != models.SurveyQuestionNPS {
+			continue
+		}
+
+		switch {
+		case value >= npsPromoterScore:
+			question.Promoters += count
+		case value <= npsDetractorScore:
+			question.Detractors += count
+		default:
+			question.Passives += count
+		}
+	}
+
+	average := roundSurveyValue(float64(total)/float64(question.Answers), 2)
+	question.Average = &average
+
+	if question.Kind == models.SurveyQuestionNPS {
+		nps := roundSurveyValue(float64(question.Promoters-question.Detractors)*100/float64(question.Answers), 1)
+		question.NPS = &nps
+	}
+}
+
+// roundSurveyValue округляет значение до digits знаков после запятой.
+func roundSurveyValue(value float64, digits int) float64 {
+	scale := math.Pow(10, float64(digits))
+
+	return math.Round(value*scale) / scale
+}
+
+// SurveyDispatcher рассылает первый вопрос опроса получателям сегмента. Лимиты отправки
+// общие с рассылкой анонсов: оба диспетчера пишут в один и тот же бот.
+type SurveyDispatcher struct {
+	service  *BotService
+	sender   SurveySender
+	throttle *announcementThrottle
+
+	mu      sync.Mutex
+	running map[int]bool
+	wg      sync.WaitGroup
+}
+
+// NewSurveyDispatcher создает диспетчер опросов, использующий лимиты отправки диспетчера анонсов.
+func NewSurveyDispatcher(announcements *AnnouncementDispatcher, sender SurveySender) *SurveyDispatcher {
+	return &SurveyDispatcher{
+		service:  announcements.service,
+		sender:   sender,
+		throttle: announcements.throttle,
+		running:  make(map[int]bool),
+	}
+}
+
+// Send ставит в очередь получателей, которых не ограничивают правила частоты, и запускает
+// рассылку в фоне. Повторный вызов для опроса в статусе sending продолжает прерванную рассылку.
+// Возвращает количество получателей в очереди.
+func (d *SurveyDispatcher) Send(ctx context.Context, surveyID int) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.running[surveyID] {
+		return 0, errorsPkg.ErrSurveyNotSendable
+	}
+
+	recipients, err := d.service.DB.QueueSurveyDeliveries(surveyID, SurveyFrequencyRules())
+	if err != nil {
+		return 0, err
+	}
+
+	d.running[surveyID] = true
+	d.wg.Add(1)
+
+	go func() {
+		defer d.wg.Done()
+
+		d.deliver(ctx, surveyID)
+
+		d.mu.Lock()
+		delete(d.running, surveyID)
+		d.mu.Unlock()
+	}()
+
+	return recipients, nil
+}
+
+// Wait ждет завершения запущенных рассылок.
+func (d *SurveyDispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// deliver рассылает опрос пачками, пока очередь не опустеет, опрос не отменят или не отменят контекст.
+func (d *SurveyDispatcher) deliver(ctx context.Context, surveyID int) {
+	db := d.service.DB
+
+	for {
+		survey, err := db.GetSurvey(surveyID)
+		if err != nil {
+			log.Printf("Failed to get survey %d: %v", surveyID, err)
+
+			return
+		}
+
+		if survey.Status != models.SurveyStatusSending {
+			log.Printf("Survey %d delivery stopped: status %s", surveyID, survey.Status)
+
+			return
+		}
+
+		recipients, err := db.GetPendingSurveyDeliveries(surveyID, localization.AnnouncementBatchSize)
+		if err != nil {
+			log.Printf("Failed to get pending deliveries for survey %d: %v", surveyID, err)
+
+			return
+		}
+
+		if len(recipients) == 0 {
+			if err := db.FinishSurvey(surveyID); err != nil {
+				log.Printf("Failed to finish survey %d: %v", surveyID, err)
+
+				return
+			}
+
+			log.Printf("Survey %d delivered to %d recipients", surveyID, survey.RecipientsCount)
+
+			return
+		}
+
+		for _, recipient := range recipients {
+			if err := d.deliverTo(ctx, survey, recipient); err != nil {
+				log.Printf("Survey %d delivery interrupted: %v", surveyID, err)
+
+				return
+			}
+		}
+	}
+}
+
+// deliverTo отправляет первый вопрос опроса получателю на его языке и записывает результат.
+// Ошибка возвращается, только если рассылку нужно прервать.
+func (d *SurveyDispatcher) deliverTo(ctx context.Context, survey *models.Survey, recipient models.SurveyRecipient) error {
+	prompt := d.service.SurveyPrompt(survey, 0, recipient.Language)
+
+	err := d.throttle.send(ctx, recipient.TelegramID, func() error {
+		return d.sender.SendSurveyPrompt(recipient.TelegramID, prompt)
+	})
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	status, deliveryError := models.DeliveryStatusSent, ""
+
+	switch {
+	case err == nil:
+	case errors.Is(err, errorsPkg.ErrRecipientBlocked):
+		status, deliveryError = models.DeliveryStatusBlocked, err.Error()
+
+		d.service.deactivateBlockedRecipient(recipient.UserID, recipient.TelegramID)
+	default:
+		status, deliveryError = models.DeliveryStatusFailed, err.Error()
+	}
+
+	if err := d.service.DB.RecordSurveyDelivery(survey.ID, recipient.UserID, status, deliveryError); err != nil {
+		return fmt.Errorf("failed to record survey delivery: %w", err)
+	}
+
+	return nil
+}
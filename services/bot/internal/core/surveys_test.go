@@ -0,0 +1,305 @@
+package core
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// fakeSurveySender запоминает отправленные вопросы и возвращает заданные ошибки по чатам.
+type fakeSurveySender struct {
+	mu      sync.Mutex
+	errors  map[int64]error
+	prompts map[int64]models.SurveyPrompt
+}
+
+func (f *fakeSurveySender) SendSurveyPrompt(chatID int64, prompt models.SurveyPrompt) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors[chatID]; err != nil {
+		return err
+	}
+
+	f.prompts[chatID] = prompt
+
+	return nil
+}
+
+// testQuestionnaire возвращает запущенный опрос из оценки и вопроса с выбором.
+func testQuestionnaire() *models.Survey {
+	return &models.Survey{
+		ID:     3,
+		Kind:   models.SurveyKindQuestionnaire,
+		Status: models.SurveyStatusSending,
+		Questions: []models.SurveyQuestion{
+			{Kind: models.SurveyQuestionRating, Text: models.SurveyText{"en": "Rate matching", "ru": "Оцените подбор"}},
+			{Kind: models.SurveyQuestionChoice, Text: models.SurveyText{"es": "¿Qué mejorar?"}, Options: []models.SurveyText{
+				{"es": "Perfiles"}, {"es": "Búsqueda"},
+			}},
+		},
+	}
+}
+
+// TestCreateSurvey_Validation тестирует отказ до записи в базу.
+func TestCreateSurvey_Validation(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	past := time.Now().Add(-time.Hour)
+	rating := models.SurveyQuestion{Kind: models.SurveyQuestionRating, Text: models.SurveyText{"en": "Rate us"}}
+
+	tooMany := make([]models.SurveyQuestion, localization.MaxSurveyQuestions+1)
+	for i := range tooMany {
+		tooMany[i] = rating
+	}
+
+	inputs := []models.SurveyInput{
+		{Title: " ", Kind: models.SurveyKindNPS},
+		{Title: "Survey", Kind: "poll"},
+		{Title: "Survey", Kind: models.SurveyKindNPS, Questions: []models.SurveyQuestion{rating}},
+		{Title: "Survey", Kind: models.SurveyKindQuestionnaire},
+		{Title: "Survey", Kind: models.SurveyKindQuestionnaire, Questions: tooMany},
+		{Title: "Survey", Kind: models.SurveyKindQuestionnaire, Questions: []models.SurveyQuestion{{Kind: models.SurveyQuestionRating}}},
+		{Title: "Survey", Kind: models.SurveyKindQuestionnaire, Questions: []models.SurveyQuestion{
+			{Kind: models.SurveyQuestionChoice, Text: models.SurveyText{"en": "Pick"}, Options: []models.SurveyText{{"en": "Only"}}},
+		}},
+		{Title: "Survey", Kind: models.SurveyKindQuestionnaire, Questions: []models.SurveyQuestion{
+			{Kind: models.SurveyQuestionChoice, Text: models.SurveyText{"en": "Pick"}, Options: []models.SurveyText{
+				{"en": "A"}, {"en": strings.Repeat("b", localization.MaxSurveyOptionLength+1)},
+			}},
+		}},
+		{Title: "Survey", Kind: models.SurveyKindNPS, ScheduledAt: &past},
+		{Title: "Survey", Kind: models.SurveyKindNPS, Segment: models.AnnouncementSegment{Statuses: []string{"banned"}}},
+	}
+
+	for _, input := range inputs {
+		_, err := service.CreateSurvey(input, 1)
+		require.ErrorIs(t, err, errorsPkg.ErrInvalidSurvey)
+	}
+
+	mockDB.AssertNotCalled(t, "CreateSurvey", mock.Anything)
+}
+
+// TestCreateSurvey тестирует стандартный вопрос NPS, планирование и подсчет получателей с правилами частоты.
+func TestCreateSurvey(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	startAt := time.Now().Add(24 * time.Hour)
+	segment := models.AnnouncementSegment{InterfaceLanguages: []string{"ru"}}
+
+	mockDB.On("CreateSurvey", mock.MatchedBy(func(survey *models.Survey) bool {
+		return survey.Title == "NPS Q3" && survey.Status == models.SurveyStatusScheduled &&
+			len(survey.Questions) == 1 && survey.Questions[0].Kind == models.SurveyQuestionNPS
+	})).Return(nil)
+	mockDB.On("CountSurveyRecipients", segment, SurveyFrequencyRules()).Return(12, nil)
+
+	survey, err := service.CreateSurvey(models.SurveyInput{
+		Title:       " NPS Q3 ",
+		Kind:        models.SurveyKindNPS,
+		Segment:     models.AnnouncementSegment{InterfaceLanguages: []string{"RU"}},
+		ScheduledAt: &startAt,
+	}, 5)
+
+	require.NoError(t, err)
+	assert.Equal(t, 12, survey.RecipientsCount)
+	assert.Equal(t, 5, survey.CreatedBy)
+	mockDB.AssertExpectations(t)
+}
+
+// TestSurveyPrompt тестирует выбор перевода и стандартную формулировку NPS.
+func TestSurveyPrompt(t *testing.T) {
+	service := NewBotServiceWithInterface(new(MockDatabase), &localization.Localizer{})
+	survey := testQuestionnaire()
+
+	assert.Equal(t, "Оцените подбор", service.SurveyPrompt(survey, 0, "ru").Text)
+	assert.Equal(t, "Rate matching", service.SurveyPrompt(survey, 0, "zh").Text)
+
+	choice := service.SurveyPrompt(survey, 1, "ru")
+	assert.Equal(t, "¿Qué mejorar?", choice.Text)
+	assert.Equal(t, []string{"Perfiles", "Búsqueda"}, choice.Options)
+	assert.Equal(t, 2, choice.QuestionCount)
+	assert.Equal(t, "ru", choice.Language)
+
+	nps := &models.Survey{Questions: []models.SurveyQuestion{{Kind: models.SurveyQuestionNPS}}}
+	assert.Equal(t, localization.LocaleSurveyNPSQuestion, service.SurveyPrompt(nps, 0, "en").Text)
+}
+
+// TestAnswerSurvey тестирует проверку ответа, переход к следующему вопросу и закрытые опросы.
+func TestAnswerSurvey(t *testing.T) {
+	user := &models.User{ID: 7, InterfaceLanguageCode: "ru"}
+
+	t.Run("next question", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+		mockDB.On("GetSurvey", 3).Return(testQuestionnaire(), nil)
+		mockDB.On("IsSurveyRecipient", 3, 7).Return(true, nil)
+		mockDB.On("SaveSurveyAnswer", &models.SurveyAnswer{SurveyID: 3, UserID: 7, QuestionIndex: 0, Value: 4}).Return(nil)
+
+		next, err := service.AnswerSurvey(user, 3, 0, 4)
+		require.NoError(t, err)
+		require.NotNil(t, next)
+		assert.Equal(t, 1, next.QuestionIndex)
+	})
+
+	t.Run("last question", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+		mockDB.On("GetSurvey", 3).Return(testQuestionnaire(), nil)
+		mockDB.On("IsSurveyRecipient", 3, 7).Return(true, nil)
+		mockDB.On("SaveSurveyAnswer", mock.Anything).Return(nil)
+
+		next, err := service.AnswerSurvey(user, 3, 1, 1)
+		require.NoError(t, err)
+		assert.Nil(t, next)
+	})
+
+	t.Run("invalid answers", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+		mockDB.On("GetSurvey", 3).Return(testQuestionnaire(), nil)
+
+		for _, answer := range [][2]int{{0, 0}, {0, 6}, {1, 2}, {2, 1}} {
+			_, err := service.AnswerSurvey(user, 3, answer[0], answer[1])
+			require.ErrorIs(t, err, errorsPkg.ErrInvalidSurvey)
+		}
+
+		mockDB.AssertNotCalled(t, "SaveSurveyAnswer", mock.Anything)
+	})
+
+	t.Run("not a recipient", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+		mockDB.On("GetSurvey", 3).Return(testQuestionnaire(), nil)
+		mockDB.On("IsSurveyRecipient", 3, 7).Return(false, nil)
+
+		_, err := service.AnswerSurvey(user, 3, 0, 4)
+		require.ErrorIs(t, err, errorsPkg.ErrSurveyClosed)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		mockDB := new(MockDatabase)
+		service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+		survey := testQuestionnaire()
+		survey.Status = models.SurveyStatusCancelled
+
+		mockDB.On("GetSurvey", 3).Return(survey, nil)
+
+		_, err := service.AnswerSurvey(user, 3, 0, 4)
+		require.ErrorIs(t, err, errorsPkg.ErrSurveyClosed)
+		mockDB.AssertNotCalled(t, "IsSurveyRecipient", mock.Anything, mock.Anything)
+	})
+}
+
+// TestGetSurveyResults тестирует распределение ответов, среднюю оценку, NPS и долю ответивших.
+func TestGetSurveyResults(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	survey := &models.Survey{
+		ID:        4,
+		Kind:      models.SurveyKindNPS,
+		Status:    models.SurveyStatusSent,
+		Questions: []models.SurveyQuestion{{Kind: models.SurveyQuestionNPS, Text: models.SurveyText{"en": "Recommend us?"}}},
+	}
+
+	mockDB.On("GetSurvey", 4).Return(survey, nil)
+	mockDB.On("GetSurveyAnswerCounts", 4).Return([]models.SurveyAnswerCount{
+		{QuestionIndex: 0, Value: 2, Count: 1},
+		{QuestionIndex: 0, Value: 8, Count: 1},
+		{QuestionIndex: 0, Value: 9, Count: 1},
+		{QuestionIndex: 0, Value: 10, Count: 2},
+	}, nil)
+	mockDB.On("GetSurveyParticipation", 4, 1).Return(models.SurveyParticipation{Sent: 20, Responded: 5, Completed: 5}, nil)
+
+	results, err := service.GetSurveyResults(4)
+	require.NoError(t, err)
+
+	assert.InDelta(t, 0.25, results.ResponseRate, 0.0001)
+	require.Len(t, results.Questions, 1)
+
+	question := results.Questions[0]
+	assert.Equal(t, "Recommend us?", question.Text)
+	assert.Equal(t, 5, question.Answers)
+	assert.Equal(t, map[int]int{2: 1, 8: 1, 9: 1, 10: 2}, question.Distribution)
+	assert.Equal(t, 3, question.Promoters)
+	assert.Equal(t, 1, question.Passives)
+	assert.Equal(t, 1, question.Detractors)
+	require.NotNil(t, question.Average)
+	assert.InDelta(t, 7.8, *question.Average, 0.0001)
+	require.NotNil(t, question.NPS)
+	assert.InDelta(t, 40.0, *question.NPS, 0.0001)
+}
+
+// TestSurveyDispatcher_Send тестирует доставку первого вопроса на языке получателя и блокировку бота.
+func TestSurveyDispatcher_Send(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	sender := &fakeSurveySender{
+		errors:  map[int64]error{200: errorsPkg.ErrRecipientBlocked},
+		prompts: map[int64]models.SurveyPrompt{},
+	}
+
+	announcements := NewAnnouncementDispatcher(service, &fakeAnnouncementSender{})
+	announcements.throttle = newAnnouncementThrottle(0, 0)
+	dispatcher := NewSurveyDispatcher(announcements, sender)
+
+	recipients := []models.SurveyRecipient{
+		{UserID: 1, TelegramID: 100, Language: "ru"},
+		{UserID: 2, TelegramID: 200, Language: "en"},
+	}
+
+	mockDB.On("QueueSurveyDeliveries", 3, SurveyFrequencyRules()).Return(len(recipients), nil)
+	mockDB.On("GetSurvey", 3).Return(testQuestionnaire(), nil)
+	mockDB.On("GetPendingSurveyDeliveries", 3, localization.AnnouncementBatchSize).Return(recipients, nil).Once()
+	mockDB.On("GetPendingSurveyDeliveries", 3, localization.AnnouncementBatchSize).Return([]models.SurveyRecipient{}, nil).Once()
+	mockDB.On("RecordSurveyDelivery", 3, 1, models.DeliveryStatusSent, "").Return(nil)
+	mockDB.On("RecordSurveyDelivery", 3, 2, models.DeliveryStatusBlocked, mock.Anything).Return(nil)
+	mockDB.On("SetUserActive", 2, false).Return(nil)
+	mockDB.On("FinishSurvey", 3).Return(nil)
+
+	queued, err := dispatcher.Send(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, len(recipients), queued)
+
+	dispatcher.Wait()
+
+	require.Len(t, sender.prompts, 1)
+	assert.Equal(t, "Оцените подбор", sender.prompts[100].Text)
+	assert.Equal(t, 0, sender.prompts[100].QuestionIndex)
+	mockDB.AssertExpectations(t)
+}
+
+// TestRunDueSurveys тестирует запуск запланированных опросов, только когда подключен мессенджер.
+func TestRunDueSurveys(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	service.runDueSurveys(context.Background())
+	mockDB.AssertNotCalled(t, "GetDueSurveyIDs", mock.Anything)
+
+	announcements := NewAnnouncementDispatcher(service, &fakeAnnouncementSender{})
+	dispatcher := NewSurveyDispatcher(announcements, &fakeSurveySender{prompts: map[int64]models.SurveyPrompt{}})
+	service.SetSurveyDispatcher(dispatcher)
+
+	mockDB.On("GetDueSurveyIDs", mock.Anything).Return([]int{5}, nil)
+	mockDB.On("QueueSurveyDeliveries", 5, SurveyFrequencyRules()).Return(0, nil)
+	mockDB.On("GetSurvey", 5).Return(&models.Survey{ID: 5, Status: models.SurveyStatusCancelled}, nil)
+
+	service.runDueSurveys(context.Background())
+	dispatcher.Wait()
+
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,289 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// dateRangeSeparators - допустимые разделители дат в периоде недоступности.
+var dateRangeSeparators = []string{" - ", "—", "–", "-"}
+
+// ParseUnavailabilityPeriod разбирает ввод пользователя вида "ДД.ММ.ГГГГ - ДД.ММ.ГГГГ"
+// (или одну дату "ДД.ММ.ГГГГ" для однодневного периода) и проверяет его относительно today.
+func ParseUnavailabilityPeriod(input string, today time.Time) (*models.UnavailabilityPeriod, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, errorsPkg.ErrInvalidDateRangeFormat
+	}
+
+	startText, endText := input, input
+
+	for _, separator := range dateRangeSeparators {
+		if parts := strings.SplitN(input, separator, 2); len(parts) == 2 {
+			startText, endText = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+			break
+		}
+	}
+
+	start, err := time.Parse(localization.UnavailabilityDateLayout, startText)
+	if err != nil {
+		return nil, errorsPkg.ErrInvalidDateRangeFormat
+	}
+
+	end, err := time.Parse(localization.UnavailabilityDateLayout, endText)
+	if err != nil {
+		return nil, errorsPkg.ErrInvalidDateRangeFormat
+	}
+
+	if end.Before(start) {
+		return nil, errorsPkg.ErrDateRangeEndBeforeStart
+	}
+
+	period := &models.UnavailabilityPeriod{
+		StartDate: start,
+		EndDate:   end,
+		Reason:    models.UnavailabilityReasonVacation,
+	}
+
+	if period.IsExpired(today) {
+		return nil, errorsPkg.ErrDateRangeInPast
+	}
+
+	if end.Sub(start) > localization.MaxUnavailabilityPeriodDays*24*time.Hour {
+		return nil, errorsPkg.ErrDateRangeTooLong
+	}
+
+	return period, nil
+}
+
+// FormatUnavailabilityPeriod форматирует период для отображения пользователю.
+func FormatUnavailabilityPeriod(period models.UnavailabilityPeriod) string {
+	start := period.StartDate.Format(localization.UnavailabilityDateLayout)
+	end := period.EndDate.Format(localization.UnavailabilityDateLayout)
+
+	if start == end {
+		return start
+	}
+
+	return start + " – " + end
+}
+
+// UnavailabilityErrorMessage возвращает локализованный текст ошибки ввода периода недоступности.
+func (s *BotService) UnavailabilityErrorMessage(err error, lang string) string {
+	switch {
+	case errors.Is(err, errorsPkg.ErrDateRangeEndBeforeStart):
+		return s.Localizer.Get(lang, localization.LocaleErrorVacationOrder)
+	case errors.Is(err, errorsPkg.ErrDateRangeInPast):
+		return s.Localizer.Get(lang, localization.LocaleErrorVacationPast)
+	case errors.Is(err, errorsPkg.ErrDateRangeTooLong):
+		return s.Localizer.GetWithParams(lang, localization.LocaleErrorVacationTooLong, map[string]string{
+			"days": fmt.Sprintf("%d", localization.MaxUnavailabilityPeriodDays),
+		})
+	case errors.Is(err, errorsPkg.ErrTooManyUnavailabilityPeriods):
+		return s.Localizer.GetWithParams(lang, localization.LocaleErrorVacationTooMany, map[string]string{
+			"max": fmt.Sprintf("%d", localization.MaxUnavailabilityPeriods),
+		})
+	default:
+		return s.Localizer.Get(lang, localization.LocaleErrorVacationFormat)
+	}
+}
+
+// GetUnavailabilityPeriods получает актуальные периоды недоступности пользователя.
+func (s *BotService) GetUnavailabilityPeriods(userID int) ([]models.UnavailabilityPeriod, error) {
+	return s.DB.GetUnavailabilityPeriods(userID)
+}
+
+// AddUnavailabilityPeriod добавляет период недоступности с учетом лимита на количество периодов.
+func (s *BotService) AddUnavailabilityPeriod(userID int, period *models.UnavailabilityPeriod) error {
+	existing, err := s.DB.GetUnavailabilityPeriods(userID)
+	if err != nil {
+		return fmt.Errorf("failed to get unavailability periods: %w", err)
+	}
+
+	if len(existing) >= localization.MaxUnavailabilityPeriods {
+		return errorsPkg.ErrTooManyUnavailabilityPeriods
+	}
+
+	if err := s.DB.SaveUnavailabilityPeriod(userID, period); err != nil {
+		return fmt.Errorf("failed to save unavailability period: %w", err)
+	}
+
+	// Отпуск уже начался: еще не отправленные совпадения не должны дойти до собеседников.
+	// Ошибку не возвращаем: период сохранен, а фоновая проверка повторит отмену.
+	unavailable, err := s.IsUserUnavailable(userID, time.Now())
+	if err != nil {
+		log.Printf("Failed to check unavailability of user %d: %v", userID, err)
+
+		return nil
+	}
+
+	if unavailable {
+		if _, err := s.DB.CancelPendingMatches(userID); err != nil {
+			log.Printf("Failed to cancel pending matches of unavailable user %d: %v", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteUnavailabilityPeriod удаляет период недоступности пользователя.
+func (s *BotService) DeleteUnavailabilityPeriod(userID, periodID int) error {
+	return s.DB.DeleteUnavailabilityPeriod(userID, periodID)
+}
+
+// IsUserUnavailable проверяет, находится ли пользователь в отпуске на указанный момент.
+// Такие пользователи исключаются из подбора партнеров, а их еще не отправленные совпадения отменяются.
+func (s *BotService) IsUserUnavailable(userID int, at time.Time) (bool, error) {
+	periods, err := s.DB.GetUnavailabilityPeriods(userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get unavailability periods: %w", err)
+	}
+
+	for _, period := range periods {
+		if period.IsActiveOn(at) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CancelUnavailableMatches отменяет еще не отправленные совпадения, в которых собеседник
+// сегодня в отпуске: такие пары не предлагаются партнерам. Проверка повторяется по расписанию,
+// потому что отпуск может начаться позже, чем был добавлен.
+func (s *BotService) CancelUnavailableMatches() (int64, error) {
+	cancelled, err := s.DB.CancelPendingMatchesOfUnavailableUsers()
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel matches of unavailable users: %w", err)
+	}
+
+	return cancelled, nil
+}
+
+// CleanupExpiredUnavailabilityPeriods удаляет завершившиеся периоды недоступности.
+func (s *BotService) CleanupExpiredUnavailabilityPeriods() (int64, error) {
+	deleted, err := s.DB.DeleteExpiredUnavailabilityPeriods()
+	if err != nil {
+		return 0, fmt.Errorf("failed to cleanup unavailability periods: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// StartUnavailabilityCleanup запускает фоновое удаление завершившихся периодов недоступности
+// и отмену совпадений собеседников в отпуске.
+// Работает до отмены контекста.
+func (s *BotService) StartUnavailabilityCleanup(ctx context.Context) {
+	ticker := time.NewTicker(localization.UnavailabilityCleanupInterval)
+	defer ticker.Stop()
+
+	s.runUnavailabilityCleanup()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.runUnavailabilityCleanup()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runUnavailabilityCleanup выполняет одну итерацию очистки и отменяет совпадения собеседников в отпуске.
+func (s *BotService) runUnavailabilityCleanup() {
+	cancelled, err := s.CancelUnavailableMatches()
+	if err != nil {
+		log.Printf("Failed to cancel matches of unavailable users: %v", err)
+	} else if cancelled > 0 {
+		log.Printf("Cancelled %d pending matches of unavailable users", cancelled)
+	}
+
+	deleted, err := s.CleanupExpiredUnavailabilityPeriods()
+	if err != nil {
+		log.Printf("Failed to cleanup expired unavailability periods: %v", err)
+
+		return
+	}
+
+	if deleted > 0 {
+		log.Printf("Removed %d expired unavailability periods", deleted)
+	}
+}
+
+// formatUnavailabilityPeriods форматирует периоды недоступности для профиля.
+func (s *BotService) formatUnavailabilityPeriods(periods []models.UnavailabilityPeriod, lang string) string {
+	if len(periods) == 0 {
+		return ""
+	}
+
+	formatted := make([]string, 0, len(periods))
+	unavailableNow := false
+
+	for _, period := range periods {
+		formatted = append(formatted, FormatUnavailabilityPeriod(period))
+
+		if period.IsActiveOn(time.Now()) {
+			unavailableNow = true
+		}
+	}
+
+	text := strings.Join(formatted, ", ")
+	if unavailableNow {
+		text += " (" + s.Localizer.Get(lang, localization.LocaleVacationUnavailableNow) + ")"
+	}
+
+	return text
+}
+
+// buildPartnerUnavailabilityInfo возвращает строки профиля о текущих и предстоящих
+// периодах недоступности собеседников, которым уже отправлено совпадение с пользователем.
+func (s *BotService) buildPartnerUnavailabilityInfo(user *models.User, lang string) []string {
+	matches, err := s.DB.GetUserMatches(user.ID)
+	if err != nil {
+		log.Printf("Failed to load matches for partner unavailability of user %d: %v", user.ID, err)
+
+		return nil
+	}
+
+	var lines []string
+
+	for _, match := range matches {
+		if match.Status != models.MatchStatusSent || match.PartnerUserID == 0 {
+			continue
+		}
+
+		periods, err := s.DB.GetUnavailabilityPeriods(match.PartnerUserID)
+		if err != nil {
+			log.Printf("Failed to load unavailability periods of partner %d: %v", match.PartnerUserID, err)
+
+			continue
+		}
+
+		if len(periods) == 0 {
+			continue
+		}
+
+		partner, err := s.DB.GetUserByID(match.PartnerUserID)
+		if err != nil || partner == nil {
+			log.Printf("Failed to load partner %d: %v", match.PartnerUserID, err)
+
+			continue
+		}
+
+		title := s.Localizer.GetWithParams(lang, localization.LocaleVacationPartnerField, map[string]string{
+			"name": partner.FirstName,
+		})
+		lines = append(lines, fmt.Sprintf("🏖 %s: %s", title, s.formatUnavailabilityPeriods(periods, lang)))
+	}
+
+	return lines
+}
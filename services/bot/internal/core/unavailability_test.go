@@ -0,0 +1,166 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestParseUnavailabilityPeriod тестирует разбор периода недоступности из текста.
+func TestParseUnavailabilityPeriod(t *testing.T) {
+	today := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		wantStart string
+		wantEnd   string
+		wantErr   error
+	}{
+		{name: "range with spaced hyphen", input: "01.07.2026 - 14.07.2026", wantStart: "01.07.2026", wantEnd: "14.07.2026"},
+		{name: "range with en dash", input: "01.07.2026–14.07.2026", wantStart: "01.07.2026", wantEnd: "14.07.2026"},
+		{name: "range with plain hyphen", input: " 01.07.2026-02.07.2026 ", wantStart: "01.07.2026", wantEnd: "02.07.2026"},
+		{name: "single day", input: "20.06.2026", wantStart: "20.06.2026", wantEnd: "20.06.2026"},
+		{name: "ongoing period", input: "10.06.2026 - 15.06.2026", wantStart: "10.06.2026", wantEnd: "15.06.2026"},
+		{name: "empty", input: "  ", wantErr: errorsPkg.ErrInvalidDateRangeFormat},
+		{name: "garbage", input: "next week", wantErr: errorsPkg.ErrInvalidDateRangeFormat},
+		{name: "invalid date", input: "31.02.2026 - 05.03.2026", wantErr: errorsPkg.ErrInvalidDateRangeFormat},
+		{name: "end before start", input: "14.07.2026 - 01.07.2026", wantErr: errorsPkg.ErrDateRangeEndBeforeStart},
+		{name: "already ended", input: "01.06.2026 - 14.06.2026", wantErr: errorsPkg.ErrDateRangeInPast},
+		{name: "too long", input: "01.07.2026 - 02.07.2027", wantErr: errorsPkg.ErrDateRangeTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, err := ParseUnavailabilityPeriod(tt.input, today)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, period)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStart, period.StartDate.Format(localization.UnavailabilityDateLayout))
+			assert.Equal(t, tt.wantEnd, period.EndDate.Format(localization.UnavailabilityDateLayout))
+			assert.Equal(t, models.UnavailabilityReasonVacation, period.Reason)
+		})
+	}
+}
+
+// TestFormatUnavailabilityPeriod тестирует форматирование периода для отображения.
+func TestFormatUnavailabilityPeriod(t *testing.T) {
+	day := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "01.07.2026", FormatUnavailabilityPeriod(models.UnavailabilityPeriod{StartDate: day, EndDate: day}))
+	assert.Equal(t, "01.07.2026 – 14.07.2026", FormatUnavailabilityPeriod(models.UnavailabilityPeriod{
+		StartDate: day,
+		EndDate:   day.AddDate(0, 0, 13),
+	}))
+}
+
+// TestAddUnavailabilityPeriod_Limit тестирует ограничение на количество периодов.
+func TestAddUnavailabilityPeriod_Limit(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	existing := make([]models.UnavailabilityPeriod, localization.MaxUnavailabilityPeriods)
+	mockDB.On("GetUnavailabilityPeriods", 1).Return(existing, nil)
+
+	err := service.AddUnavailabilityPeriod(1, &models.UnavailabilityPeriod{})
+
+	assert.ErrorIs(t, err, errorsPkg.ErrTooManyUnavailabilityPeriods)
+	mockDB.AssertNotCalled(t, "SaveUnavailabilityPeriod")
+}
+
+// TestAddUnavailabilityPeriod_CancelsPendingMatches тестирует отмену еще не отправленных
+// совпадений, только если отпуск уже начался.
+func TestAddUnavailabilityPeriod_CancelsPendingMatches(t *testing.T) {
+	now := time.Now()
+	active := models.UnavailabilityPeriod{StartDate: now.AddDate(0, 0, -1), EndDate: now.AddDate(0, 0, 5)}
+	upcoming := models.UnavailabilityPeriod{StartDate: now.AddDate(0, 0, 10), EndDate: now.AddDate(0, 0, 20)}
+
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetUnavailabilityPeriods", 1).Return([]models.UnavailabilityPeriod{}, nil).Once()
+	mockDB.On("SaveUnavailabilityPeriod", 1, &active).Return(nil)
+	mockDB.On("GetUnavailabilityPeriods", 1).Return([]models.UnavailabilityPeriod{active}, nil).Once()
+	mockDB.On("CancelPendingMatches", 1).Return(int64(2), nil)
+
+	require.NoError(t, service.AddUnavailabilityPeriod(1, &active))
+	mockDB.AssertCalled(t, "CancelPendingMatches", 1)
+
+	mockDB.On("GetUnavailabilityPeriods", 2).Return([]models.UnavailabilityPeriod{}, nil).Once()
+	mockDB.On("SaveUnavailabilityPeriod", 2, &upcoming).Return(nil)
+	mockDB.On("GetUnavailabilityPeriods", 2).Return([]models.UnavailabilityPeriod{upcoming}, nil).Once()
+
+	require.NoError(t, service.AddUnavailabilityPeriod(2, &upcoming))
+	mockDB.AssertNotCalled(t, "CancelPendingMatches", 2)
+}
+
+// TestRunUnavailabilityCleanup тестирует отмену совпадений собеседников в отпуске по расписанию.
+func TestRunUnavailabilityCleanup(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("CancelPendingMatchesOfUnavailableUsers").Return(int64(1), nil)
+	mockDB.On("DeleteExpiredUnavailabilityPeriods").Return(int64(0), nil)
+
+	service.runUnavailabilityCleanup()
+
+	mockDB.AssertExpectations(t)
+}
+
+// TestIsUserUnavailable тестирует проверку текущей недоступности пользователя.
+func TestIsUserUnavailable(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	now := time.Date(2026, 7, 5, 10, 0, 0, 0, time.UTC)
+	mockDB.On("GetUnavailabilityPeriods", 1).Return([]models.UnavailabilityPeriod{
+		{StartDate: now.AddDate(0, 0, -2), EndDate: now.AddDate(0, 0, 3)},
+	}, nil)
+	mockDB.On("GetUnavailabilityPeriods", 2).Return([]models.UnavailabilityPeriod{
+		{StartDate: now.AddDate(0, 0, 10), EndDate: now.AddDate(0, 0, 20)},
+	}, nil)
+
+	unavailable, err := service.IsUserUnavailable(1, now)
+	require.NoError(t, err)
+	assert.True(t, unavailable)
+
+	unavailable, err = service.IsUserUnavailable(2, now)
+	require.NoError(t, err)
+	assert.False(t, unavailable)
+}
+
+// TestBuildPartnerUnavailabilityInfo тестирует показ отпуска только знакомых собеседников.
+func TestBuildPartnerUnavailabilityInfo(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, localization.NewLocalizer(nil))
+
+	start := time.Now().AddDate(0, 0, 5)
+	mockDB.On("GetUserMatches", 1).Return([]models.UserMatch{
+		{ID: 1, PartnerUserID: 2, Status: models.MatchStatusSent},
+		{ID: 2, PartnerUserID: 3, Status: models.MatchStatusPending},
+		{ID: 3, PartnerUserID: 4, Status: models.MatchStatusSent},
+	}, nil)
+	mockDB.On("GetUnavailabilityPeriods", 2).Return([]models.UnavailabilityPeriod{
+		{StartDate: start, EndDate: start},
+	}, nil)
+	mockDB.On("GetUnavailabilityPeriods", 4).Return([]models.UnavailabilityPeriod{}, nil)
+	mockDB.On("GetUserByID", 2).Return(&models.User{ID: 2, FirstName: "Ana"}, nil)
+
+	lines := service.buildPartnerUnavailabilityInfo(&models.User{ID: 1}, "en")
+
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], FormatUnavailabilityPeriod(models.UnavailabilityPeriod{StartDate: start, EndDate: start}))
+	mockDB.AssertNotCalled(t, "GetUnavailabilityPeriods", 3)
+	mockDB.AssertNotCalled(t, "GetUserByID", 4)
+}
@@ -0,0 +1,184 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// ExportUserData собирает все, что бот хранит о пользователе: профиль, языки, интересы,
+// доступность, предпочтения, отзывы с перепиской, найденных собеседников и ответы на опросы.
+// Выгрузка записывается в журнал аудита.
+func (s *BotService) ExportUserData(user *models.User) (*models.UserDataExport, error) {
+	data := &models.UserDataExport{
+		SchemaVersion: models.UserDataSchemaVersion,
+		ExportedAt:    time.Now().UTC(),
+		Profile:       userDataProfile(user),
+	}
+
+	var err error
+
+	if data.LanguagePairs, err = s.DB.GetUserLanguagePairs(user.ID); err != nil {
+		return nil, fmt.Errorf("failed to export language pairs: %w", err)
+	}
+
+	if data.Interests, err = s.userDataInterests(user); err != nil {
+		return nil, err
+	}
+
+	if data.LearningGoals, err = s.DB.GetUserLearningGoals(user.ID); err != nil {
+		return nil, fmt.Errorf("failed to export learning goals: %w", err)
+	}
+
+	if data.Availability, err = s.DB.GetTimeAvailability(user.ID); err != nil {
+		return nil, fmt.Errorf("failed to export availability: %w", err)
+	}
+
+	if data.UnavailabilityPeriods, err = s.DB.GetUnavailabilityPeriods(user.ID); err != nil {
+		return nil, fmt.Errorf("failed to export unavailability periods: %w", err)
+	}
+
+	if data.Preferences, err = s.DB.GetFriendshipPreferences(user.ID); err != nil {
+		return nil, fmt.Errorf("failed to export preferences: %w", err)
+	}
+
+	if data.Feedback, err = s.userDataFeedback(user.ID); err != nil {
+		return nil, err
+	}
+
+	if data.Matches, err = s.DB.GetUserMatches(user.ID); err != nil {
+		return nil, fmt.Errorf("failed to export matches: %w", err)
+	}
+
+	if data.SurveyAnswers, err = s.DB.GetUserSurveyAnswers(user.ID); err != nil {
+		return nil, fmt.Errorf("failed to export survey answers: %w", err)
+	}
+
+	fillUserDataDefaults(data)
+
+	s.RecordUserAudit(user, audit.ActionUserDataExport, audit.TargetUser, user.ID, map[string]interface{}{
+		"schema_version": data.SchemaVersion,
+		"feedback":       len(data.Feedback),
+		"matches":        len(data.Matches),
+	})
+
+	return data, nil
+}
+
+// userDataProfile возвращает профиль пользователя для выгрузки.
+func userDataProfile(user *models.User) models.UserDataProfile {
+	return models.UserDataProfile{
+		ID:                     user.ID,
+		TelegramID:             user.TelegramID,
+		Username:               user.Username,
+		FirstName:              user.FirstName,
+		NativeLanguageCode:     user.NativeLanguageCode,
+		TargetLanguageCode:     user.TargetLanguageCode,
+		TargetLanguageLevel:    user.TargetLanguageLevel,
+		InterfaceLanguageCode:  user.InterfaceLanguageCode,
+		Status:                 user.Status,
+		Role:                   user.Role,
+		ProfileCompletionLevel: user.ProfileCompletionLevel,
+		IsActive:               user.IsActive,
+		CreatedAt:              user.CreatedAt,
+		UpdatedAt:              user.UpdatedAt,
+	}
+}
+
+// userDataInterests возвращает выбранные интересы с названиями на языке интерфейса пользователя.
+func (s *BotService) userDataInterests(user *models.User) ([]models.UserDataInterest, error) {
+	selections, err := s.DB.GetUserInterestSelections(user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export interests: %w", err)
+	}
+
+	names, err := s.GetLocalizedInterests(user.InterfaceLanguageCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export interests: %w", err)
+	}
+
+	interests := make([]models.UserDataInterest, 0, len(selections))
+	for _, selection := range selections {
+		interests = append(interests, models.UserDataInterest{
+			ID:         selection.InterestID,
+			Name:       names[selection.InterestID],
+			IsPrimary:  selection.IsPrimary,
+			SelectedAt: selection.CreatedAt,
+		})
+	}
+
+	return interests, nil
+}
+
+// userDataFeedback возвращает отзывы пользователя, новые первыми, с перепиской и вложениями.
+func (s *BotService) userDataFeedback(userID int) ([]models.UserDataFeedback, error) {
+	records, err := s.DB.SearchFeedback(models.FeedbackQuery{UserID: userID, Limit: localization.MaxUserDataFeedback})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export feedback: %w", err)
+	}
+
+	feedback := make([]models.UserDataFeedback, 0, len(records))
+
+	for i := range records {
+		record := &records[i]
+
+		thread, err := s.DB.GetFeedbackThread(record.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export feedback messages: %w", err)
+		}
+
+		attachments, err := s.DB.GetFeedbackAttachments(record.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to export feedback attachments: %w", err)
+		}
+
+		item := models.UserDataFeedback{
+			ID:            record.ID,
+			Text:          record.Text,
+			ContactInfo:   record.ContactInfo,
+			IsProcessed:   record.IsProcessed,
+			AdminResponse: record.AdminResponse,
+			CreatedAt:     record.CreatedAt,
+			Messages:      thread.Messages,
+			Attachments:   attachments,
+		}
+
+		if item.Messages == nil {
+			item.Messages = []models.FeedbackMessage{}
+		}
+
+		if item.Attachments == nil {
+			item.Attachments = []models.FeedbackAttachment{}
+		}
+
+		feedback = append(feedback, item)
+	}
+
+	return feedback, nil
+}
+
+// fillUserDataDefaults заменяет пустые списки выгрузки на [] вместо null.
+func fillUserDataDefaults(data *models.UserDataExport) {
+	if data.LanguagePairs == nil {
+		data.LanguagePairs = []models.UserLanguagePair{}
+	}
+
+	if data.LearningGoals == nil {
+		data.LearningGoals = []string{}
+	}
+
+	if data.UnavailabilityPeriods == nil {
+		data.UnavailabilityPeriods = []models.UnavailabilityPeriod{}
+	}
+
+	if data.Matches == nil {
+		data.Matches = []models.UserMatch{}
+	}
+
+	if data.SurveyAnswers == nil {
+		data.SurveyAnswers = []models.SurveyAnswer{}
+	}
+}
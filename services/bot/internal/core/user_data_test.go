@@ -0,0 +1,72 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+)
+
+// TestExportUserData тестирует сбор данных пользователя, пустые списки и запись в журнал аудита.
+func TestExportUserData(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+	user := &models.User{ID: 7, TelegramID: 1007, FirstName: "Anna", InterfaceLanguageCode: "en", Role: models.RoleUser}
+	createdAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	mockDB.On("GetUserLanguagePairs", 7).Return([]models.UserLanguagePair{{NativeLanguageCode: "ru", TargetLanguageCode: "en"}}, nil)
+	mockDB.On("GetUserInterestSelections", 7).Return([]models.InterestSelection{{InterestID: 1, IsPrimary: true, CreatedAt: createdAt}}, nil)
+	mockDB.On("GetUserLearningGoals", 7).Return(nil, nil)
+	mockDB.On("GetTimeAvailability", 7).Return(nil, nil)
+	mockDB.On("GetUnavailabilityPeriods", 7).Return(nil, nil)
+	mockDB.On("GetFriendshipPreferences", 7).Return(&models.FriendshipPreferences{ActivityType: "movies"}, nil)
+	mockDB.On("SearchFeedback", models.FeedbackQuery{UserID: 7, Limit: localization.MaxUserDataFeedback}).
+		Return([]models.FeedbackRecord{{ID: 3, UserID: 7, Text: "Отзыв", CreatedAt: createdAt}}, nil)
+	mockDB.On("GetFeedbackThread", 3).Return(&models.FeedbackThread{FeedbackID: 3}, nil)
+	mockDB.On("GetFeedbackAttachments", 3).Return(nil, nil)
+	mockDB.On("GetUserMatches", 7).Return([]models.UserMatch{{ID: 5, PartnerUserID: 8}}, nil)
+	mockDB.On("GetUserSurveyAnswers", 7).Return(nil, nil)
+	mockDB.On("EnsureAuditPseudonym", 7, mock.AnythingOfType("string")).Return("p_user", nil)
+	mockDB.On("AppendAuditEvent", mock.MatchedBy(func(event *models.AuditEvent) bool {
+		return event.Action == audit.ActionUserDataExport && event.ActorID == "p_user" && event.TargetID == "p_user" &&
+			event.Details["feedback"] == 1 && event.Details["matches"] == 1
+	})).Return(nil)
+
+	data, err := service.ExportUserData(user)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.UserDataSchemaVersion, data.SchemaVersion)
+	assert.Equal(t, "Anna", data.Profile.FirstName)
+	assert.Equal(t, []models.UserDataInterest{{ID: 1, Name: "Movies", IsPrimary: true, SelectedAt: createdAt}}, data.Interests)
+	assert.Equal(t, "movies", data.Preferences.ActivityType)
+	assert.Nil(t, data.Availability)
+	assert.NotNil(t, data.LearningGoals)
+	assert.NotNil(t, data.UnavailabilityPeriods)
+	assert.NotNil(t, data.SurveyAnswers)
+	require.Len(t, data.Feedback, 1)
+	assert.Equal(t, "Отзыв", data.Feedback[0].Text)
+	assert.NotNil(t, data.Feedback[0].Messages)
+	assert.NotNil(t, data.Feedback[0].Attachments)
+	assert.Equal(t, 8, data.Matches[0].PartnerUserID)
+	mockDB.AssertExpectations(t)
+}
+
+// TestExportUserData_Error тестирует, что ошибка базы прерывает выгрузку без записи в журнал.
+func TestExportUserData_Error(t *testing.T) {
+	mockDB := new(MockDatabase)
+	service := NewBotServiceWithInterface(mockDB, &localization.Localizer{})
+
+	mockDB.On("GetUserLanguagePairs", 7).Return(nil, errors.New("db down"))
+
+	_, err := service.ExportUserData(&models.User{ID: 7})
+
+	require.Error(t, err)
+	mockDB.AssertNotCalled(t, "AppendAuditEvent", mock.Anything)
+}
@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// DeleteUserAccount удаляет аккаунт пользователя в одной транзакции. Строки пользователя
+// удаляются каскадно, ожидающие и текущие совпадения отменяются и теряют ссылку на него,
+// записи админ-панели о нем обезличиваются. Вместо аккаунта остается обезличенная запись
+// в account_deletions; текущие собеседники возвращаются для уведомления.
+func (db *DB) DeleteUserAccount(userID int) (*models.AccountDeletionResult, error) {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	result := &models.AccountDeletionResult{Partners: []models.MatchPartner{}}
+
+	err = transaction.QueryRowContext(context.Background(), `
+		SELECT GREATEST(EXTRACT(DAY FROM NOW() - COALESCE(created_at, NOW())), 0)::int
+		FROM users WHERE id = $1 FOR UPDATE
+	`, userID).Scan(&result.Deletion.AccountAgeDays)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.ErrUserNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock user: %w", err)
+	}
+
+	if result.Partners, err = db.currentMatchPartners(transaction, userID); err != nil {
+		return nil, err
+	}
+
+	cancelled, err := transaction.ExecContext(context.Background(), `
+		UPDATE match_queue
+		SET status = 'cancelled', user1_id = NULLIF(user1_id, $1), user2_id = NULLIF(user2_id, $1)
+		WHERE (user1_id = $1 OR user2_id = $1) AND status IN ('pending', 'sent')
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel matches: %w", err)
+	}
+
+	matchesCancelled, err := cancelled.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cancelled matches: %w", err)
+	}
+
+	result.Deletion.MatchesCancelled = int(matchesCancelled)
+
+	err = transaction.QueryRowContext(context.Background(), `
+		SELECT COUNT(*) FROM user_feedback WHERE user_id = $1
+	`, userID).Scan(&result.Deletion.FeedbackRemoved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count user feedback: %w", err)
+	}
+
+	if _, err := transaction.ExecContext(context.Background(), `
+		UPDATE admin_action_logs SET target_id = NULL, details = '{}'
+		WHERE target_type = $1 AND target_id = $2
+	`, models.AdminTargetUser, userID); err != nil {
+		return nil, fmt.Errorf("failed to anonymize admin action logs: %w", err)
+	}
+
+	if _, err := transaction.ExecContext(context.Background(), `DELETE FROM users WHERE id = $1`, userID); err != nil {
+		return nil, fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	result.Deletion.PartnersNotified = len(result.Partners)
+
+	err = transaction.QueryRowContext(context.Background(), `
+		INSERT INTO account_deletions (account_age_days, feedback_removed, matches_cancelled, partners_notified)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, deleted_at
+	`, result.Deletion.AccountAgeDays, result.Deletion.FeedbackRemoved, result.Deletion.MatchesCancelled,
+		result.Deletion.PartnersNotified).Scan(&result.Deletion.ID, &result.Deletion.DeletedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record account deletion: %w", err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// currentMatchPartners возвращает собеседников, которым уже отправлено совпадение с пользователем.
+func (db *DB) currentMatchPartners(transaction *sql.Tx, userID int) ([]models.MatchPartner, error) {
+	rows, err := transaction.QueryContext(context.Background(), `
+		SELECT u.id, COALESCE(u.telegram_id, 0), COALESCE(u.interface_language_code, 'en'), u.platform
+		FROM match_queue m
+		JOIN users u ON u.id = CASE WHEN m.user1_id = $1 THEN m.user2_id ELSE m.user1_id END
+		WHERE (m.user1_id = $1 OR m.user2_id = $1) AND m.status = 'sent'
+		ORDER BY u.id
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match partners: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	partners := []models.MatchPartner{}
+
+	for rows.Next() {
+		var partner models.MatchPartner
+		if err := rows.Scan(&partner.UserID, &partner.TelegramID, &partner.InterfaceLanguageCode, &partner.Platform); err != nil {
+			return nil, fmt.Errorf("failed to scan match partner: %w", err)
+		}
+
+		partners = append(partners, partner)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return partners, nil
+}
+
+// GetAccountDeletionStats возвращает число удаленных аккаунтов всего и начиная с since.
+func (db *DB) GetAccountDeletionStats(since time.Time) (models.AccountDeletionStats, error) {
+	var stats models.AccountDeletionStats
+
+	err := db.conn.QueryRowContext(context.Background(), `
+		SELECT COUNT(*),
+		       COUNT(*) FILTER (WHERE deleted_at >= $1),
+		       COALESCE(SUM(feedback_removed), 0),
+		       COALESCE(SUM(matches_cancelled), 0)
+		FROM account_deletions
+	`, since).Scan(&stats.Total, &stats.Recent, &stats.FeedbackRemoved, &stats.MatchesCancelled)
+	if err != nil {
+		return stats, fmt.Errorf("failed to get account deletion stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,190 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"language-exchange-bot/internal/models"
+)
+
+// adminUserColumns - поля пользователя для поиска и карточки в админ-панели.
+const adminUserColumns = `
+	id, COALESCE(telegram_id, 0) as telegram_id, COALESCE(username, '') as username, first_name,
+	COALESCE(native_language_code, '') as native_language_code,
+	COALESCE(target_language_code, '') as target_language_code,
+	COALESCE(target_language_level, '') as target_language_level,
+	interface_language_code, created_at, updated_at, state,
+	profile_completion_level, status, role, is_active, platform`
+
+// rowScanner - общий интерфейс sql.Row и sql.Rows для сканирования строки.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanAdminUser сканирует пользователя, выбранного с полями adminUserColumns.
+func scanAdminUser(row rowScanner) (*models.User, error) {
+	user := &models.User{Interests: []int{}}
+
+	err := row.Scan(
+		&user.ID, &user.TelegramID, &user.Username, &user.FirstName,
+		&user.NativeLanguageCode, &user.TargetLanguageCode, &user.TargetLanguageLevel,
+		&user.InterfaceLanguageCode, &user.CreatedAt, &user.UpdatedAt,
+		&user.State, &user.ProfileCompletionLevel, &user.Status, &user.Role, &user.IsActive, &user.Platform,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan user: %w", err)
+	}
+
+	return user, nil
+}
+
+// GetUserByID возвращает пользователя по внутреннему ID.
+func (db *DB) GetUserByID(userID int) (*models.User, error) {
+	row := db.conn.QueryRowContext(context.Background(), `SELECT `+adminUserColumns+` FROM users WHERE id = $1`, userID)
+
+	return scanAdminUser(row)
+}
+
+// SearchUsers ищет пользователей для админ-панели. Число ищется среди Telegram ID и внутренних ID,
+// строка - как начало username без учета регистра.
+func (db *DB) SearchUsers(query string, limit int) ([]*models.User, error) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+
+	if number, ok := parseSearchNumber(query); ok {
+		rows, err = db.conn.QueryContext(context.Background(), `
+			SELECT `+adminUserColumns+`
+			FROM users
+			WHERE telegram_id = $1 OR id = $1
+			ORDER BY id
+			LIMIT $2
+		`, number, limit)
+	} else {
+		rows, err = db.conn.QueryContext(context.Background(), `
+			SELECT `+adminUserColumns+`
+			FROM users
+			WHERE LOWER(username) LIKE $1 ESCAPE '\'
+			ORDER BY LOWER(username) = $2 DESC, username
+			LIMIT $3
+		`, escapeLikePattern(strings.ToLower(query))+"%", strings.ToLower(query), limit)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var users []*models.User
+
+	for rows.Next() {
+		user, err := scanAdminUser(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return users, nil
+}
+
+// CreateAdminActionLog записывает действие администратора в историю карточки пользователя.
+// Журнал аудита ведется отдельно (AppendAuditEvent).
+func (db *DB) CreateAdminActionLog(entry *models.AdminActionLog) error {
+	details := entry.Details
+	if details == nil {
+		details = map[string]interface{}{}
+	}
+
+	detailsJSON, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to marshal admin action details: %w", err)
+	}
+
+	err = db.conn.QueryRowContext(context.Background(), `
+		INSERT INTO admin_action_logs (admin_id, action, target_type, target_id, details)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`, entry.AdminID, entry.Action, entry.TargetType, entry.TargetID, string(detailsJSON)).Scan(&entry.ID, &entry.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create admin action log: %w", err)
+	}
+
+	return nil
+}
+
+// GetAdminActionLogs возвращает последние действия администраторов над объектом.
+func (db *DB) GetAdminActionLogs(targetType string, targetID int, limit int) ([]models.AdminActionLog, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT id, COALESCE(admin_id, 0), action, target_type, COALESCE(target_id, 0), details, created_at
+		FROM admin_action_logs
+		WHERE target_type = $1 AND target_id = $2
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3
+	`, targetType, targetID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin action logs: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var entries []models.AdminActionLog
+
+	for rows.Next() {
+		var (
+			entry       models.AdminActionLog
+			detailsJSON []byte
+		)
+
+		err := rows.Scan(&entry.ID, &entry.AdminID, &entry.Action, &entry.TargetType, &entry.TargetID, &detailsJSON, &entry.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan admin action log: %w", err)
+		}
+
+		if err := json.Unmarshal(detailsJSON, &entry.Details); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal admin action details: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return entries, nil
+}
+
+// parseSearchNumber разбирает поисковый запрос как Telegram ID или внутренний ID.
+func parseSearchNumber(query string) (int64, bool) {
+	number, err := strconv.ParseUint(query, 10, 63)
+	if err != nil {
+		return 0, false
+	}
+
+	return int64(number), true
+}
+
+// escapeLikePattern экранирует спецсимволы LIKE, чтобы "_" в username искался буквально.
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,119 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// CreateAdminSession сохраняет новую сессию admin API.
+func (db *DB) CreateAdminSession(session *models.AdminSession) error {
+	err := db.conn.QueryRowContext(context.Background(), `
+		INSERT INTO admin_sessions (user_id, token_hash, expires_at)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at
+	`, session.UserID, session.TokenHash, session.ExpiresAt).Scan(&session.ID, &session.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create admin session: %w", err)
+	}
+
+	return nil
+}
+
+// GetAdminSessionByHash возвращает сессию admin API по SHA-256 от refresh-токена.
+func (db *DB) GetAdminSessionByHash(tokenHash string) (*models.AdminSession, error) {
+	var (
+		session   models.AdminSession
+		revokedAt sql.NullTime
+	)
+
+	err := db.conn.QueryRowContext(context.Background(), `
+		SELECT id, user_id, token_hash, created_at, expires_at, revoked_at, COALESCE(rotated_from, 0)
+		FROM admin_sessions
+		WHERE token_hash = $1
+	`, tokenHash).Scan(
+		&session.ID, &session.UserID, &session.TokenHash, &session.CreatedAt, &session.ExpiresAt, &revokedAt, &session.RotatedFrom,
+	)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.ErrAdminSessionNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin session: %w", err)
+	}
+
+	session.RevokedAt = nullTimePtr(revokedAt)
+
+	return &session, nil
+}
+
+// RotateAdminSession отзывает сессию oldSessionID и сохраняет newSession на ее смену.
+// Если сессия уже отозвана (refresh-токен использован повторно), возвращает ErrAdminSessionNotFound.
+func (db *DB) RotateAdminSession(oldSessionID int, newSession *models.AdminSession) error {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	result, err := transaction.ExecContext(context.Background(), `
+		UPDATE admin_sessions SET revoked_at = NOW() WHERE id = $1 AND revoked_at IS NULL
+	`, oldSessionID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke rotated admin session: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rotated admin session: %w", err)
+	}
+
+	if affected == 0 {
+		return errors.ErrAdminSessionNotFound
+	}
+
+	err = transaction.QueryRowContext(context.Background(), `
+		INSERT INTO admin_sessions (user_id, token_hash, expires_at, rotated_from)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`, newSession.UserID, newSession.TokenHash, newSession.ExpiresAt, oldSessionID).Scan(&newSession.ID, &newSession.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create rotated admin session: %w", err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return fmt.Errorf("failed to commit admin session rotation: %w", err)
+	}
+
+	newSession.RotatedFrom = oldSessionID
+
+	return nil
+}
+
+// RevokeAdminSession отзывает сессию admin API. Повторный отзыв не меняет время первого.
+func (db *DB) RevokeAdminSession(sessionID int) error {
+	result, err := db.conn.ExecContext(context.Background(), `
+		UPDATE admin_sessions SET revoked_at = COALESCE(revoked_at, NOW()) WHERE id = $1
+	`, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke admin session: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check revoked admin session: %w", err)
+	}
+
+	if affected == 0 {
+		return errors.ErrAdminSessionNotFound
+	}
+
+	return nil
+}
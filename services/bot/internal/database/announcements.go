@@ -0,0 +1,392 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"strings"
+
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// announcementColumns - поля анонса вместе со счетчиками доставки.
+const announcementColumns = `
+	a.id, a.title, a.message, a.segment, a.status, a.recipients_count,
+	COALESCE(a.created_by, 0), a.created_at, a.sent_at,
+	COUNT(d.user_id) FILTER (WHERE d.status = 'sent'),
+	COUNT(d.user_id) FILTER (WHERE d.status = 'failed'),
+	COUNT(d.user_id) FILTER (WHERE d.status = 'blocked')`
+
+// scanAnnouncement сканирует анонс, выбранный с полями announcementColumns.
+func scanAnnouncement(row rowScanner) (*models.Announcement, error) {
+	var (
+		announcement models.Announcement
+		segmentJSON  []byte
+		sentAt       sql.NullTime
+	)
+
+	err := row.Scan(
+		&announcement.ID, &announcement.Title, &announcement.Message, &segmentJSON, &announcement.Status,
+		&announcement.RecipientsCount, &announcement.CreatedBy, &announcement.CreatedAt, &sentAt,
+		&announcement.SentCount, &announcement.FailedCount, &announcement.BlockedCount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan announcement: %w", err)
+	}
+
+	if err := json.Unmarshal(segmentJSON, &announcement.Segment); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal announcement segment: %w", err)
+	}
+
+	if sentAt.Valid {
+		announcement.SentAt = &sentAt.Time
+	}
+
+	return &announcement, nil
+}
+
+// CreateAnnouncement сохраняет черновик анонса.
+func (db *DB) CreateAnnouncement(announcement *models.Announcement) error {
+	segmentJSON, err := json.Marshal(announcement.Segment)
+	if err != nil {
+		return fmt.Errorf("failed to marshal announcement segment: %w", err)
+	}
+
+	err = db.conn.QueryRowContext(context.Background(), `
+		INSERT INTO announcements (title, message, segment, created_by)
+		VALUES ($1, $2, $3, NULLIF($4, 0))
+		RETURNING id, status, created_at
+	`, announcement.Title, announcement.Message, string(segmentJSON), announcement.CreatedBy).Scan(
+		&announcement.ID, &announcement.Status, &announcement.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create announcement: %w", err)
+	}
+
+	return nil
+}
+
+// GetAnnouncement возвращает анонс со счетчиками доставки.
+func (db *DB) GetAnnouncement(announcementID int) (*models.Announcement, error) {
+	row := db.conn.QueryRowContext(context.Background(), `
+		SELECT `+announcementColumns+`
+		FROM announcements a
+		LEFT JOIN announcement_deliveries d ON d.announcement_id = a.id
+		WHERE a.id = $1
+		GROUP BY a.id
+	`, announcementID)
+
+	announcement, err := scanAnnouncement(row)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.ErrAnnouncementNotFound
+	}
+
+	return announcement, err
+}
+
+// GetAnnouncements возвращает последние анонсы, новые первыми.
+func (db *DB) GetAnnouncements(limit int) ([]models.Announcement, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT `+announcementColumns+`
+		FROM announcements a
+		LEFT JOIN announcement_deliveries d ON d.announcement_id = a.id
+		GROUP BY a.id
+		ORDER BY a.created_at DESC, a.id DESC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcements: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	announcements := []models.Announcement{}
+
+	for rows.Next() {
+		announcement, err := scanAnnouncement(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		announcements = append(announcements, *announcement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return announcements, nil
+}
+
+// CountAnnouncementRecipients считает активных пользователей, попадающих в сегмент.
+func (db *DB) CountAnnouncementRecipients(segment models.AnnouncementSegment) (int, error) {
+	where, args := announcementSegmentFilter(segment)
+
+	var count int
+	if err := db.conn.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM users WHERE `+where, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count announcement recipients: %w", err)
+	}
+
+	return count, nil
+}
+
+// QueueAnnouncementDeliveries переводит анонс в статус sending и создает записи доставки
+// для получателей сегмента. Для уже рассылаемого анонса очередь не пересобирается,
+// чтобы после перезапуска рассылка продолжилась с недоставленных получателей.
+// Возвращает число получателей.
+func (db *DB) QueueAnnouncementDeliveries(announcementID int) (int, error) {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	var (
+		status      string
+		segmentJSON []byte
+	)
+
+	err = transaction.QueryRowContext(context.Background(), `
+		SELECT status, segment FROM announcements WHERE id = $1 FOR UPDATE
+	`, announcementID).Scan(&status, &segmentJSON)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return 0, errors.ErrAnnouncementNotFound
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock announcement: %w", err)
+	}
+
+	switch status {
+	case models.AnnouncementStatusDraft:
+		var segment models.AnnouncementSegment
+		if err := json.Unmarshal(segmentJSON, &segment); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal announcement segment: %w", err)
+		}
+
+		where, args := announcementSegmentFilter(segment, announcementID)
+		if _, err := transaction.ExecContext(context.Background(), `
+			INSERT INTO announcement_deliveries (announcement_id, user_id)
+			SELECT $1, id FROM users WHERE `+where+`
+			ON CONFLICT DO NOTHING
+		`, args...); err != nil {
+			return 0, fmt.Errorf("failed to queue announcement deliveries: %w", err)
+		}
+	case models.AnnouncementStatusSending:
+		// Продолжение прерванной рассылки: очередь уже создана
+	default:
+		return 0, errors.ErrAnnouncementNotSendable
+	}
+
+	var recipients int
+
+	err = transaction.QueryRowContext(context.Background(), `
+		UPDATE announcements
+		SET status = $2,
+		    recipients_count = (SELECT COUNT(*) FROM announcement_deliveries WHERE announcement_id = $1)
+		WHERE id = $1
+		RETURNING recipients_count
+	`, announcementID, models.AnnouncementStatusSending).Scan(&recipients)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start announcement: %w", err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// GetPendingAnnouncementDeliveries возвращает получателей, которым анонс еще не отправлялся.
+func (db *DB) GetPendingAnnouncementDeliveries(announcementID int, limit int) ([]models.AnnouncementRecipient, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT d.user_id, COALESCE(u.telegram_id, 0)
+		FROM announcement_deliveries d
+		JOIN users u ON u.id = d.user_id
+		WHERE d.announcement_id = $1 AND d.status = $2
+		ORDER BY d.user_id
+		LIMIT $3
+	`, announcementID, models.DeliveryStatusPending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending announcement deliveries: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var recipients []models.AnnouncementRecipient
+
+	for rows.Next() {
+		var recipient models.AnnouncementRecipient
+		if err := rows.Scan(&recipient.UserID, &recipient.TelegramID); err != nil {
+			return nil, fmt.Errorf("failed to scan announcement recipient: %w", err)
+		}
+
+		recipients = append(recipients, recipient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// RecordAnnouncementDelivery сохраняет результат доставки анонса получателю.
+func (db *DB) RecordAnnouncementDelivery(announcementID, userID int, status, deliveryError string) error {
+	_, err := db.conn.ExecContext(context.Background(), `
+		UPDATE announcement_deliveries
+		SET status = $3, error = NULLIF($4, ''), attempted_at = NOW()
+		WHERE announcement_id = $1 AND user_id = $2
+	`, announcementID, userID, status, deliveryError)
+	if err != nil {
+		return fmt.Errorf("failed to record announcement delivery: %w", err)
+	}
+
+	return nil
+}
+
+// GetAnnouncementDeliveries возвращает результаты доставки анонса; пустой status - все записи.
+func (db *DB) GetAnnouncementDeliveries(announcementID int, status string, limit int) ([]models.AnnouncementDelivery, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT d.announcement_id, d.user_id, COALESCE(u.telegram_id, 0), d.status, COALESCE(d.error, ''), d.attempted_at
+		FROM announcement_deliveries d
+		JOIN users u ON u.id = d.user_id
+		WHERE d.announcement_id = $1 AND ($2 = '' OR d.status = $2)
+		ORDER BY d.attempted_at DESC NULLS LAST, d.user_id
+		LIMIT $3
+	`, announcementID, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get announcement deliveries: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	deliveries := []models.AnnouncementDelivery{}
+
+	for rows.Next() {
+		var (
+			delivery    models.AnnouncementDelivery
+			attemptedAt sql.NullTime
+		)
+
+		err := rows.Scan(&delivery.AnnouncementID, &delivery.UserID, &delivery.TelegramID,
+			&delivery.Status, &delivery.Error, &attemptedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan announcement delivery: %w", err)
+		}
+
+		if attemptedAt.Valid {
+			delivery.AttemptedAt = &attemptedAt.Time
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// FinishAnnouncement помечает рассылку завершенной.
+func (db *DB) FinishAnnouncement(announcementID int) error {
+	_, err := db.conn.ExecContext(context.Background(), `
+		UPDATE announcements SET status = $2, sent_at = NOW()
+		WHERE id = $1 AND status = $3
+	`, announcementID, models.AnnouncementStatusSent, models.AnnouncementStatusSending)
+	if err != nil {
+		return fmt.Errorf("failed to finish announcement: %w", err)
+	}
+
+	return nil
+}
+
+// CancelAnnouncement отменяет черновик или идущую рассылку; недоставленные получатели остаются pending.
+func (db *DB) CancelAnnouncement(announcementID int) error {
+	result, err := db.conn.ExecContext(context.Background(), `
+		UPDATE announcements SET status = $2
+		WHERE id = $1 AND status IN ($3, $4)
+	`, announcementID, models.AnnouncementStatusCancelled, models.AnnouncementStatusDraft, models.AnnouncementStatusSending)
+	if err != nil {
+		return fmt.Errorf("failed to cancel announcement: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check cancelled announcement: %w", err)
+	}
+
+	if affected == 0 {
+		return errors.ErrAnnouncementNotSendable
+	}
+
+	return nil
+}
+
+// SetUserActive помечает пользователя активным или заблокировавшим бота.
+func (db *DB) SetUserActive(userID int, active bool) error {
+	_, err := db.conn.ExecContext(context.Background(), `
+		UPDATE users SET is_active = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2
+	`, active, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update user activity: %w", err)
+	}
+
+	return nil
+}
+
+// announcementSegmentFilter строит условие WHERE по таблице users для сегмента.
+// Параметры сегмента нумеруются после переданных args. Анонсы и опросы доставляются
+// только в Telegram, поэтому пользователи других платформ в сегмент не попадают.
+func announcementSegmentFilter(segment models.AnnouncementSegment, args ...interface{}) (string, []interface{}) {
+	conditions := []string{"is_active", fmt.Sprintf("platform = '%s'", models.PlatformTelegram)}
+
+	add := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if len(segment.InterfaceLanguages) > 0 {
+		add("interface_language_code = ANY($%d)", pq.Array(segment.InterfaceLanguages))
+	}
+
+	if len(segment.TargetLanguages) > 0 {
+		add("target_language_code = ANY($%d)", pq.Array(segment.TargetLanguages))
+	}
+
+	if len(segment.Statuses) > 0 {
+		add("status = ANY($%d)", pq.Array(segment.Statuses))
+	}
+
+	if segment.MinProfileCompletion > 0 {
+		add("profile_completion_level >= $%d", segment.MinProfileCompletion)
+	}
+
+	if segment.MaxProfileCompletion != nil {
+		add("profile_completion_level <= $%d", *segment.MaxProfileCompletion)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
@@ -0,0 +1,214 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// apiKeyColumns - поля API-ключа в порядке scanAPIKey.
+const apiKeyColumns = `
+	id, name, key_prefix, key_hash, scopes, COALESCE(created_by, 0), created_at,
+	expires_at, last_used_at, revoked_at, COALESCE(rotated_from, 0), service_account,
+	COALESCE(owner_user_id, 0)`
+
+// scanAPIKey сканирует API-ключ, выбранный с полями apiKeyColumns.
+func scanAPIKey(row rowScanner) (*models.APIKey, error) {
+	var (
+		key                              models.APIKey
+		expiresAt, lastUsedAt, revokedAt sql.NullTime
+	)
+
+	err := row.Scan(
+		&key.ID, &key.Name, &key.KeyPrefix, &key.KeyHash, pq.Array(&key.Scopes), &key.CreatedBy, &key.CreatedAt,
+		&expiresAt, &lastUsedAt, &revokedAt, &key.RotatedFrom, &key.ServiceAccount,
+		&key.OwnerUserID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan api key: %w", err)
+	}
+
+	key.ExpiresAt = nullTimePtr(expiresAt)
+	key.LastUsedAt = nullTimePtr(lastUsedAt)
+	key.RevokedAt = nullTimePtr(revokedAt)
+
+	return &key, nil
+}
+
+// nullTimePtr возвращает время или nil для NULL.
+func nullTimePtr(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Time
+}
+
+// CreateAPIKey сохраняет новый API-ключ.
+func (db *DB) CreateAPIKey(key *models.APIKey) error {
+	err := db.conn.QueryRowContext(context.Background(), `
+		INSERT INTO api_keys (name, key_prefix, key_hash, scopes, created_by, expires_at, service_account, owner_user_id)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6, $7, NULLIF($8, 0))
+		RETURNING id, created_at
+	`, key.Name, key.KeyPrefix, key.KeyHash, pq.Array(key.Scopes), key.CreatedBy, key.ExpiresAt, key.ServiceAccount,
+		key.OwnerUserID).Scan(
+		&key.ID, &key.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return nil
+}
+
+// GetAPIKey возвращает API-ключ по ID.
+func (db *DB) GetAPIKey(keyID int) (*models.APIKey, error) {
+	row := db.conn.QueryRowContext(context.Background(), `SELECT `+apiKeyColumns+` FROM api_keys WHERE id = $1`, keyID)
+
+	key, err := scanAPIKey(row)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.ErrAPIKeyNotFound
+	}
+
+	return key, err
+}
+
+// GetAPIKeyByHash возвращает API-ключ по SHA-256 от его значения.
+func (db *DB) GetAPIKeyByHash(keyHash string) (*models.APIKey, error) {
+	row := db.conn.QueryRowContext(context.Background(), `SELECT `+apiKeyColumns+` FROM api_keys WHERE key_hash = $1`, keyHash)
+
+	key, err := scanAPIKey(row)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.ErrAPIKeyNotFound
+	}
+
+	return key, err
+}
+
+// GetAPIKeys возвращает все API-ключи, новые первыми.
+func (db *DB) GetAPIKeys() ([]models.APIKey, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT `+apiKeyColumns+` FROM api_keys ORDER BY created_at DESC, id DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api keys: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	keys := []models.APIKey{}
+
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, *key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return keys, nil
+}
+
+// RotateAPIKey сохраняет ключ newKey на смену oldKeyID и сокращает срок старого ключа
+// до oldExpiresAt (если он и так истекает раньше, срок не меняется). Отозванный ключ не ротируется.
+func (db *DB) RotateAPIKey(oldKeyID int, newKey *models.APIKey, oldExpiresAt time.Time) error {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	result, err := transaction.ExecContext(context.Background(), `
+		UPDATE api_keys
+		SET expires_at = LEAST(COALESCE(expires_at, $2), $2)
+		WHERE id = $1 AND revoked_at IS NULL
+	`, oldKeyID, oldExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to shorten rotated api key: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check rotated api key: %w", err)
+	}
+
+	if affected == 0 {
+		return errors.ErrAPIKeyNotFound
+	}
+
+	err = transaction.QueryRowContext(context.Background(), `
+		INSERT INTO api_keys (
+			name, key_prefix, key_hash, scopes, created_by, expires_at, rotated_from, service_account, owner_user_id
+		)
+		VALUES ($1, $2, $3, $4, NULLIF($5, 0), $6, $7, $8, NULLIF($9, 0))
+		RETURNING id, created_at
+	`, newKey.Name, newKey.KeyPrefix, newKey.KeyHash, pq.Array(newKey.Scopes), newKey.CreatedBy, newKey.ExpiresAt, oldKeyID,
+		newKey.ServiceAccount, newKey.OwnerUserID).Scan(
+		&newKey.ID, &newKey.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create rotated api key: %w", err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return fmt.Errorf("failed to commit api key rotation: %w", err)
+	}
+
+	newKey.RotatedFrom = oldKeyID
+
+	return nil
+}
+
+// RevokeAPIKey отзывает API-ключ. Повторный отзыв не меняет время первого.
+func (db *DB) RevokeAPIKey(keyID int) error {
+	result, err := db.conn.ExecContext(context.Background(), `
+		UPDATE api_keys SET revoked_at = COALESCE(revoked_at, NOW()) WHERE id = $1
+	`, keyID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check revoked api key: %w", err)
+	}
+
+	if affected == 0 {
+		return errors.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// TouchAPIKey обновляет время последнего использования ключа не чаще раза в interval,
+// чтобы каждый запрос к admin API не превращался в запись в БД.
+func (db *DB) TouchAPIKey(keyID int, interval time.Duration) error {
+	_, err := db.conn.ExecContext(context.Background(), `
+		UPDATE api_keys SET last_used_at = NOW()
+		WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < NOW() - $2 * INTERVAL '1 second')
+	`, keyID, int(interval.Seconds()))
+	if err != nil {
+		return fmt.Errorf("failed to update api key last use: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/models"
+)
+
+// auditChainLockID - ключ advisory-блокировки, упорядочивающей запись в цепочку аудита.
+const auditChainLockID = 7_391_004_201
+
+// auditEventColumns - поля записи аудита в порядке scanAuditEvent.
+const auditEventColumns = `
+	id, created_at, actor_type, actor_id, action, target_type, target_id,
+	result, ip_address, details, prev_hash, hash`
+
+// scanAuditEvent сканирует запись аудита, выбранную с полями auditEventColumns.
+func scanAuditEvent(row rowScanner) (*models.AuditEvent, error) {
+	var (
+		event   models.AuditEvent
+		details []byte
+	)
+
+	err := row.Scan(
+		&event.ID, &event.CreatedAt, &event.ActorType, &event.ActorID, &event.Action, &event.TargetType, &event.TargetID,
+		&event.Result, &event.IPAddress, &details, &event.PrevHash, &event.Hash,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan audit event: %w", err)
+	}
+
+	event.Details, err = audit.DecodeDetails(details)
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// AppendAuditEvent добавляет запись в конец журнала аудита. Время записи и хеши заполняются здесь:
+// advisory-блокировка не дает двум записям сослаться на один и тот же предыдущий хеш.
+func (db *DB) AppendAuditEvent(event *models.AuditEvent) error {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	if _, err := transaction.ExecContext(context.Background(), `SELECT pg_advisory_xact_lock($1)`, auditChainLockID); err != nil {
+		return fmt.Errorf("failed to lock audit chain: %w", err)
+	}
+
+	prevHash := audit.GenesisHash
+
+	err = transaction.QueryRowContext(context.Background(), `
+		SELECT hash FROM audit_events ORDER BY id DESC LIMIT 1
+	`).Scan(&prevHash)
+	if err != nil && !stdErrors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to get last audit event: %w", err)
+	}
+
+	details, err := audit.CanonicalDetails(event.Details)
+	if err != nil {
+		return err
+	}
+
+	event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	event.PrevHash = prevHash
+
+	event.Hash, err = audit.ChainHash(prevHash, event)
+	if err != nil {
+		return err
+	}
+
+	err = transaction.QueryRowContext(context.Background(), `
+		INSERT INTO audit_events (
+			created_at, actor_type, actor_id, action, target_type, target_id,
+			result, ip_address, details, prev_hash, hash
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id
+	`, event.CreatedAt, event.ActorType, event.ActorID, event.Action, event.TargetType, event.TargetID,
+		event.Result, event.IPAddress, string(details), event.PrevHash, event.Hash).Scan(&event.ID)
+	if err != nil {
+		return fmt.Errorf("failed to append audit event: %w", err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return fmt.Errorf("failed to commit audit event: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuditEvents возвращает записи аудита по фильтру, новые первыми.
+func (db *DB) GetAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	where, args := auditFilterConditions(filter)
+	args = append(args, filter.Limit, filter.Offset)
+
+	query := fmt.Sprintf(`
+		SELECT `+auditEventColumns+` FROM audit_events
+		WHERE %s
+		ORDER BY id DESC
+		LIMIT $%d OFFSET $%d
+	`, where, len(args)-1, len(args))
+
+	return db.queryAuditEvents(query, args...)
+}
+
+// GetAuditChain возвращает до limit записей аудита с ID больше afterID по возрастанию ID -
+// в порядке цепочки.
+func (db *DB) GetAuditChain(afterID int64, limit int) ([]models.AuditEvent, error) {
+	return db.queryAuditEvents(`
+		SELECT `+auditEventColumns+` FROM audit_events
+		WHERE id > $1
+		ORDER BY id
+		LIMIT $2
+	`, afterID, limit)
+}
+
+// queryAuditEvents выполняет выборку записей аудита с полями auditEventColumns.
+func (db *DB) queryAuditEvents(query string, args ...interface{}) ([]models.AuditEvent, error) {
+	rows, err := db.conn.QueryContext(context.Background(), query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit events: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	events := []models.AuditEvent{}
+
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, *event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return events, nil
+}
+
+// auditFilterConditions строит условие WHERE по таблице audit_events для фильтра.
+func auditFilterConditions(filter models.AuditFilter) (string, []interface{}) {
+	conditions := []string{"TRUE"}
+	args := []interface{}{}
+
+	add := func(condition string, value interface{}) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	equal := [][2]string{
+		{"actor_type", filter.ActorType},
+		{"actor_id", filter.ActorID},
+		{"target_type", filter.TargetType},
+		{"target_id", filter.TargetID},
+		{"result", filter.Result},
+	}
+
+	for _, column := range equal {
+		if column[1] != "" {
+			add(column[0]+" = $%d", column[1])
+		}
+	}
+
+	if prefix, ok := strings.CutSuffix(filter.Action, "*"); ok {
+		add("starts_with(action, $%d)", prefix)
+	} else if filter.Action != "" {
+		add("action = $%d", filter.Action)
+	}
+
+	if filter.From != nil {
+		add("created_at >= $%d", *filter.From)
+	}
+
+	if filter.To != nil {
+		add("created_at < $%d", *filter.To)
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// EnsureAuditPseudonym возвращает псевдоним пользователя в журнале аудита. Если его еще нет,
+// сохраняется candidate. Псевдоним удаляется вместе с пользователем (ON DELETE CASCADE).
+func (db *DB) EnsureAuditPseudonym(userID int, candidate string) (string, error) {
+	var pseudonym string
+
+	err := db.conn.QueryRowContext(context.Background(), `
+		INSERT INTO audit_pseudonyms (user_id, pseudonym) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET user_id = EXCLUDED.user_id
+		RETURNING pseudonym
+	`, userID, candidate).Scan(&pseudonym)
+	if err != nil {
+		return "", fmt.Errorf("failed to get audit pseudonym: %w", err)
+	}
+
+	return pseudonym, nil
+}
@@ -341,7 +341,7 @@ func (bo *BatchOperations) BatchGetUsers(ctx context.Context, userIDs []int) ([]
 	}
 
 	query := fmt.Sprintf(`
-		SELECT id, telegram_id, username, first_name, native_language_code,
+		SELECT id, COALESCE(telegram_id, 0), username, first_name, native_language_code,
 		       target_language_code, target_language_level, interface_language_code,
 		       state, status, profile_completion_level, created_at, updated_at
 		FROM users 
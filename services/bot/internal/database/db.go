@@ -29,12 +29,19 @@ const (
 	fallbackInterestID4 = 4
 )
 
+// Коды ошибок PostgreSQL, которые транслируются в доменные ошибки.
+const (
+	pgForeignKeyViolation = "23503"
+	pgUniqueViolation     = "23505"
+)
+
 // DB представляет подключение к базе данных.
 type DB struct {
 	conn         *sql.DB
 	logger       *logging.DatabaseLogger
 	errorHandler *errors.ErrorHandler
 	batchOps     *BatchOperations
+	cipher       *FieldCipher // Шифрование персональных данных; nil - хранятся открытым текстом
 }
 
 // NewDB создает новое подключение к базе данных.
@@ -286,6 +293,9 @@ func (db *DB) GetUserByTelegramID(telegramID int64) (*models.User, error) {
 		State:                  "",
 		Status:                 "",
 		ProfileCompletionLevel: 0,
+		Role:                   models.RoleUser,
+		IsActive:               true,
+		Platform:               models.PlatformTelegram,
 		CreatedAt:              time.Now(),
 		UpdatedAt:              time.Now(),
 		Interests:              []int{},
@@ -302,14 +312,14 @@ func (db *DB) GetUserByTelegramID(telegramID int64) (*models.User, error) {
 		       COALESCE(target_language_code, '') as target_language_code,
 		       COALESCE(target_language_level, '') as target_language_level,
 		       interface_language_code, created_at, updated_at, state,
-		       profile_completion_level, status
+		       profile_completion_level, status, role, is_active, platform
 		FROM users
 		WHERE telegram_id = $1
 	`, telegramID).Scan(
 		&user.ID, &user.TelegramID, &user.Username, &user.FirstName,
 		&user.NativeLanguageCode, &user.TargetLanguageCode, &user.TargetLanguageLevel,
 		&user.InterfaceLanguageCode, &user.CreatedAt, &user.UpdatedAt,
-		&user.State, &user.ProfileCompletionLevel, &user.Status,
+		&user.State, &user.ProfileCompletionLevel, &user.Status, &user.Role, &user.IsActive, &user.Platform,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("operation failed: %w", err)
@@ -371,6 +381,9 @@ func (db *DB) FindOrCreateUser(telegramID int64, username, firstName string) (*m
 		State:                  "",
 		Status:                 "",
 		ProfileCompletionLevel: 0,
+		Role:                   models.RoleUser,
+		IsActive:               true,
+		Platform:               models.PlatformTelegram,
 		CreatedAt:              time.Now(),
 		UpdatedAt:              time.Now(),
 		Interests:              []int{},
@@ -387,18 +400,19 @@ func (db *DB) FindOrCreateUser(telegramID int64, username, firstName string) (*m
         ON CONFLICT (telegram_id) DO UPDATE SET
             username = EXCLUDED.username,
             first_name = EXCLUDED.first_name,
+            is_active = TRUE,
             updated_at = CURRENT_TIMESTAMP
         RETURNING id, telegram_id, username, first_name,
         COALESCE(native_language_code, '') as native_language_code,
         COALESCE(target_language_code, '') as target_language_code,
         COALESCE(target_language_level, '') as target_language_level,
         interface_language_code, created_at, updated_at, state,
-        profile_completion_level, status
+        profile_completion_level, status, role, is_active, platform
     `, telegramID, username, firstName).Scan(
 		&user.ID, &user.TelegramID, &user.Username, &user.FirstName,
 		&user.NativeLanguageCode, &user.TargetLanguageCode, &user.TargetLanguageLevel,
 		&user.InterfaceLanguageCode, &user.CreatedAt, &user.UpdatedAt,
-		&user.State, &user.ProfileCompletionLevel, &user.Status,
+		&user.State, &user.ProfileCompletionLevel, &user.Status, &user.Role, &user.IsActive, &user.Platform,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("operation failed: %w", err)
@@ -704,19 +718,27 @@ func (db *DB) ResetUserProfile(userID int) error {
 
 // Методы работы с отзывами пользователей
 
-// SaveUserFeedback сохраняет отзыв пользователя в базу данных.
-func (db *DB) SaveUserFeedback(userID int, feedbackText string, contactInfo *string) error {
+// SaveUserFeedback сохраняет отзыв пользователя в базу данных и возвращает его ID.
+func (db *DB) SaveUserFeedback(userID int, feedbackText string, contactInfo *string) (int, error) {
 	query := `
         INSERT INTO user_feedback (user_id, feedback_text, contact_info, created_at, is_processed)
         VALUES ($1, $2, $3, CURRENT_TIMESTAMP, false)
+        RETURNING id
     `
 
-	_, err := db.conn.ExecContext(context.Background(), query, userID, feedbackText, contactInfo)
+	contactInfo, err := db.encryptPersonalData(contactInfo)
 	if err != nil {
-		return fmt.Errorf("operation failed: %w", err)
+		return 0, err
 	}
 
-	return nil
+	var feedbackID int
+
+	err = db.conn.QueryRowContext(context.Background(), query, userID, feedbackText, contactInfo).Scan(&feedbackID)
+	if err != nil {
+		return 0, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return feedbackID, nil
 }
 
 // GetUserFeedbackByUserID получает отзывы пользователя.
@@ -787,6 +809,10 @@ func (db *DB) scanUserFeedbackRow(rows *sql.Rows) map[string]interface{} {
 		return nil // Пропускаем ошибочные записи
 	}
 
+	if contactInfo, err = db.decryptPersonalData(contactInfo); err != nil {
+		return nil
+	}
+
 	feedback := map[string]interface{}{
 		"id":            feedbackID,
 		"user_id":       userID,
@@ -846,7 +872,7 @@ func (db *DB) GetUnprocessedFeedback() ([]map[string]interface{}, error) {
 func getUnprocessedFeedbackQuery() string {
 	return `
         SELECT uf.id, uf.user_id, uf.feedback_text, uf.contact_info, uf.created_at,
-               u.username, u.telegram_id, u.first_name
+               u.username, COALESCE(u.telegram_id, 0), u.first_name
         FROM user_feedback uf
         JOIN users u ON uf.user_id = u.id
         WHERE uf.is_processed = false
@@ -888,6 +914,10 @@ func (db *DB) scanFeedbackRow(rows *sql.Rows) (map[string]interface{}, error) {
 		return nil, fmt.Errorf("operation failed: %w", err)
 	}
 
+	if contactInfo, err = db.decryptPersonalData(contactInfo); err != nil {
+		return nil, err
+	}
+
 	feedback := map[string]interface{}{
 		"id":            feedbackID,
 		"user_id":       userID,
@@ -904,6 +934,115 @@ func (db *DB) scanFeedbackRow(rows *sql.Rows) (map[string]interface{}, error) {
 	return feedback, nil
 }
 
+// GetAllFeedback получает все отзывы для администратора с автором, разметкой и числом вложений.
+func (db *DB) GetAllFeedback() ([]map[string]interface{}, error) {
+	rows, err := db.conn.QueryContext(context.Background(), getAllFeedbackQuery())
+	if err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	return db.processAllFeedbackRows(rows), nil
+}
+
+// getAllFeedbackQuery возвращает SQL запрос для получения всех отзывов.
+func getAllFeedbackQuery() string {
+	return `
+        SELECT uf.id, uf.feedback_text, uf.contact_info, uf.created_at,
+               uf.is_processed, u.username, COALESCE(u.telegram_id, 0), u.first_name,
+               uf.admin_response, uf.category, uf.category_auto, uf.priority,
+               COALESCE(uf.assignee_id, 0), a.first_name, uf.sla_due_at,
+               (NOT uf.is_processed AND uf.sla_due_at < CURRENT_TIMESTAMP) AS sla_breached,
+               (SELECT COUNT(*) FROM feedback_attachments fa WHERE fa.feedback_id = uf.id) AS attachment_count
+        FROM user_feedback uf
+        JOIN users u ON uf.user_id = u.id
+        LEFT JOIN users a ON uf.assignee_id = a.id
+        ORDER BY uf.created_at DESC
+    `
+}
+
+// processAllFeedbackRows обрабатывает строки результата запроса отзывов.
+func (db *DB) processAllFeedbackRows(rows *sql.Rows) []map[string]interface{} {
+	var feedbacks []map[string]interface{}
+
+	for rows.Next() {
+		feedback, err := db.scanAllFeedbackRow(rows)
+		if err != nil {
+			continue // Пропускаем ошибочные записи
+		}
+
+		feedbacks = append(feedbacks, feedback)
+	}
+
+	return feedbacks
+}
+
+// scanAllFeedbackRow сканирует одну строку результата запроса отзывов.
+func (db *DB) scanAllFeedbackRow(rows *sql.Rows) (map[string]interface{}, error) {
+	var (
+		feedbackID   int
+		feedbackText string
+		contactInfo  sql.NullString
+		createdAt    sql.NullTime
+		isProcessed  bool
+		username     sql.NullString
+		telegramID   int64
+		firstName    string
+		adminResp    sql.NullString
+		category     sql.NullString
+		categoryAuto bool
+		priority     string
+		assigneeID   int
+		assignee     sql.NullString
+		slaDueAt     sql.NullTime
+		slaBreached  sql.NullBool
+		attachments  int
+	)
+
+	err := rows.Scan(&feedbackID, &feedbackText, &contactInfo, &createdAt, &isProcessed,
+		&username, &telegramID, &firstName, &adminResp, &category, &categoryAuto, &priority,
+		&assigneeID, &assignee, &slaDueAt, &slaBreached, &attachments)
+	if err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	if contactInfo, err = db.decryptPersonalData(contactInfo); err != nil {
+		return nil, err
+	}
+
+	feedback := map[string]interface{}{
+		"id":            feedbackID,
+		"feedback_text": feedbackText,
+		"created_at":    createdAt.Time,
+		"telegram_id":   telegramID,
+		"first_name":    firstName,
+		"is_processed":  isProcessed,
+		"category":      category.String,
+		"category_auto": categoryAuto,
+		"priority":      priority,
+		"assignee_id":   assigneeID,
+		"sla_breached":  slaBreached.Bool,
+		"attachments":   attachments,
+	}
+
+	// Добавляем опциональные поля
+	feedback["username"] = getStringValue(username)
+	feedback["contact_info"] = getStringValue(contactInfo)
+	feedback["admin_response"] = getStringValue(adminResp)
+	feedback["assignee_name"] = getStringValue(assignee)
+
+	if slaDueAt.Valid {
+		feedback["sla_due_at"] = slaDueAt.Time
+	}
+
+	return feedback, nil
+}
+
 // getStringValue возвращает строковое значение из sql.NullString.
 func getStringValue(nullStr sql.NullString) interface{} {
 	if nullStr.Valid {
@@ -1061,6 +1200,1320 @@ func (db *DB) GetTimeAvailability(userID int) (*models.TimeAvailability, error)
 	return &availability, nil
 }
 
+// SaveUnavailabilityPeriod сохраняет период недоступности пользователя и заполняет его ID.
+func (db *DB) SaveUnavailabilityPeriod(userID int, period *models.UnavailabilityPeriod) error {
+	query := `
+		INSERT INTO user_unavailability_periods (user_id, start_date, end_date, reason)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	err := db.conn.QueryRowContext(context.Background(), query,
+		userID,
+		period.StartDate,
+		period.EndDate,
+		period.Reason,
+	).Scan(&period.ID, &period.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save unavailability period: %w", err)
+	}
+
+	period.UserID = userID
+
+	return nil
+}
+
+// GetUnavailabilityPeriods возвращает актуальные (не завершившиеся) периоды недоступности пользователя.
+func (db *DB) GetUnavailabilityPeriods(userID int) ([]models.UnavailabilityPeriod, error) {
+	query := `
+		SELECT id, user_id, start_date, end_date, reason, created_at
+		FROM user_unavailability_periods
+		WHERE user_id = $1 AND end_date >= CURRENT_DATE
+		ORDER BY start_date
+	`
+
+	rows, err := db.conn.QueryContext(context.Background(), query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unavailability periods: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var periods []models.UnavailabilityPeriod
+
+	for rows.Next() {
+		var period models.UnavailabilityPeriod
+
+		if err := rows.Scan(
+			&period.ID,
+			&period.UserID,
+			&period.StartDate,
+			&period.EndDate,
+			&period.Reason,
+			&period.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan unavailability period: %w", err)
+		}
+
+		periods = append(periods, period)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return periods, nil
+}
+
+// DeleteUnavailabilityPeriod удаляет период недоступности пользователя.
+func (db *DB) DeleteUnavailabilityPeriod(userID, periodID int) error {
+	_, err := db.conn.ExecContext(context.Background(),
+		"DELETE FROM user_unavailability_periods WHERE id = $1 AND user_id = $2",
+		periodID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete unavailability period: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredUnavailabilityPeriods удаляет все завершившиеся периоды недоступности.
+func (db *DB) DeleteExpiredUnavailabilityPeriods() (int64, error) {
+	result, err := db.conn.ExecContext(context.Background(),
+		"DELETE FROM user_unavailability_periods WHERE end_date < CURRENT_DATE")
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired unavailability periods: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+
+	return rowsAffected, nil
+}
+
+// CancelPendingMatches отменяет еще не отправленные совпадения пользователя.
+func (db *DB) CancelPendingMatches(userID int) (int64, error) {
+	result, err := db.conn.ExecContext(context.Background(), `
+		UPDATE match_queue
+		SET status = $2
+		WHERE status = $3 AND (user1_id = $1 OR user2_id = $1)
+	`, userID, models.MatchStatusCancelled, models.MatchStatusPending)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel pending matches: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+
+	return rowsAffected, nil
+}
+
+// CancelPendingMatchesOfUnavailableUsers отменяет еще не отправленные совпадения,
+// в которых хотя бы один собеседник сегодня в отпуске.
+func (db *DB) CancelPendingMatchesOfUnavailableUsers() (int64, error) {
+	result, err := db.conn.ExecContext(context.Background(), `
+		UPDATE match_queue m
+		SET status = $1
+		WHERE m.status = $2
+		  AND EXISTS (
+		      SELECT 1 FROM user_unavailability_periods p
+		      WHERE p.user_id IN (m.user1_id, m.user2_id)
+		        AND CURRENT_DATE BETWEEN p.start_date AND p.end_date
+		  )
+	`, models.MatchStatusCancelled, models.MatchStatusPending)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel pending matches of unavailable users: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+
+	return rowsAffected, nil
+}
+
+// GetUserLearningGoals возвращает цели изучения языка пользователя.
+func (db *DB) GetUserLearningGoals(userID int) ([]string, error) {
+	rows, err := db.conn.QueryContext(context.Background(),
+		"SELECT goal FROM user_learning_goals WHERE user_id = $1 ORDER BY goal", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learning goals: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var goals []string
+
+	for rows.Next() {
+		var goal string
+		if err := rows.Scan(&goal); err != nil {
+			return nil, fmt.Errorf("failed to scan learning goal: %w", err)
+		}
+
+		goals = append(goals, goal)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return goals, nil
+}
+
+// SaveUserLearningGoals заменяет набор целей изучения языка пользователя.
+func (db *DB) SaveUserLearningGoals(userID int, goals []string) error {
+	tx, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			log.Printf("Failed to rollback learning goals transaction: %v", rollbackErr)
+		}
+	}()
+
+	if _, err := tx.ExecContext(context.Background(), "DELETE FROM user_learning_goals WHERE user_id = $1", userID); err != nil {
+		return fmt.Errorf("failed to clear learning goals: %w", err)
+	}
+
+	if len(goals) > 0 {
+		_, err := tx.ExecContext(context.Background(), `
+			INSERT INTO user_learning_goals (user_id, goal)
+			SELECT $1, unnest($2::text[])
+			ON CONFLICT (user_id, goal) DO NOTHING
+		`, userID, pq.Array(goals))
+		if err != nil {
+			return fmt.Errorf("failed to save learning goals: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit learning goals: %w", err)
+	}
+
+	return nil
+}
+
+// GetLearningGoalStats возвращает распределение целей по изучаемым языкам.
+func (db *DB) GetLearningGoalStats() ([]models.LearningGoalStat, error) {
+	query := `
+		SELECT COALESCE(u.target_language_code, ''), g.goal, COUNT(DISTINCT g.user_id)
+		FROM user_learning_goals g
+		JOIN users u ON u.id = g.user_id
+		GROUP BY u.target_language_code, g.goal
+		ORDER BY u.target_language_code, COUNT(DISTINCT g.user_id) DESC
+	`
+
+	rows, err := db.conn.QueryContext(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get learning goal stats: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var stats []models.LearningGoalStat
+
+	for rows.Next() {
+		var stat models.LearningGoalStat
+		if err := rows.Scan(&stat.TargetLanguageCode, &stat.Goal, &stat.UsersCount); err != nil {
+			return nil, fmt.Errorf("failed to scan learning goal stat: %w", err)
+		}
+
+		stats = append(stats, stat)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return stats, nil
+}
+
+// CreateInterestSuggestion добавляет предложенный пользователем интерес в очередь модерации.
+func (db *DB) CreateInterestSuggestion(suggestion *models.InterestSuggestion) error {
+	query := `
+		INSERT INTO interest_suggestions (user_id, suggested_text, language_code, status)
+		VALUES ($1, $2, $3, 'pending')
+		RETURNING id, status, created_at
+	`
+
+	err := db.conn.QueryRowContext(context.Background(), query,
+		suggestion.UserID, suggestion.SuggestedText, suggestion.LanguageCode,
+	).Scan(&suggestion.ID, &suggestion.Status, &suggestion.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create interest suggestion: %w", err)
+	}
+
+	return nil
+}
+
+// CountPendingInterestSuggestions возвращает количество предложений пользователя, ожидающих модерации.
+func (db *DB) CountPendingInterestSuggestions(userID int) (int, error) {
+	var count int
+
+	err := db.conn.QueryRowContext(context.Background(),
+		"SELECT COUNT(*) FROM interest_suggestions WHERE user_id = $1 AND status = 'pending'", userID,
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count interest suggestions: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetInterestSuggestions возвращает предложения интересов с указанным статусом (все, если статус пустой).
+func (db *DB) GetInterestSuggestions(status string, limit int) ([]models.InterestSuggestion, error) {
+	query := `
+		SELECT id, user_id, suggested_text, language_code, status, interest_id,
+			   COALESCE(moderator_note, ''), created_at, reviewed_at
+		FROM interest_suggestions
+		WHERE $1 = '' OR status = $1
+		ORDER BY created_at ASC
+		LIMIT $2
+	`
+
+	rows, err := db.conn.QueryContext(context.Background(), query, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest suggestions: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var suggestions []models.InterestSuggestion
+
+	for rows.Next() {
+		var suggestion models.InterestSuggestion
+
+		err := rows.Scan(
+			&suggestion.ID, &suggestion.UserID, &suggestion.SuggestedText, &suggestion.LanguageCode,
+			&suggestion.Status, &suggestion.InterestID, &suggestion.ModeratorNote,
+			&suggestion.CreatedAt, &suggestion.ReviewedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan interest suggestion: %w", err)
+		}
+
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return suggestions, nil
+}
+
+// ApproveInterestSuggestion превращает предложение в интерес выбранной категории с переводами
+// и сразу добавляет его в интересы автора предложения. Возвращает ID созданного интереса.
+func (db *DB) ApproveInterestSuggestion(suggestionID int, approval models.InterestSuggestionApproval) (int, error) {
+	ctx := context.Background()
+
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			log.Printf("Failed to rollback interest suggestion transaction: %v", rollbackErr)
+		}
+	}()
+
+	var (
+		userID int
+		status string
+	)
+
+	err = tx.QueryRowContext(ctx,
+		"SELECT user_id, status FROM interest_suggestions WHERE id = $1 FOR UPDATE", suggestionID,
+	).Scan(&userID, &status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, errors.ErrInterestSuggestionNotFound
+		}
+
+		return 0, fmt.Errorf("failed to get interest suggestion: %w", err)
+	}
+
+	if status != models.InterestSuggestionPending {
+		return 0, errors.ErrInterestSuggestionReviewed
+	}
+
+	var categoryID int
+
+	err = tx.QueryRowContext(ctx,
+		"SELECT id FROM interest_categories WHERE key_name = $1", approval.CategoryKey,
+	).Scan(&categoryID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, errors.ErrInterestCategoryNotFound
+		}
+
+		return 0, fmt.Errorf("failed to get interest category: %w", err)
+	}
+
+	var interestID int
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO interests (key_name, category_id, type, display_order, source)
+		VALUES ($1, $2, $3, (SELECT COALESCE(MAX(display_order), 0) + 1 FROM interests WHERE category_id = $2), 'suggestion')
+		RETURNING id
+	`, approval.KeyName, categoryID, approval.CategoryKey).Scan(&interestID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pgUniqueViolation {
+			return 0, errors.ErrInterestKeyExists
+		}
+
+		return 0, fmt.Errorf("failed to create interest: %w", err)
+	}
+
+	for languageCode, name := range approval.Translations {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO interest_translations (interest_id, language_code, name)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (interest_id, language_code) DO UPDATE SET name = EXCLUDED.name
+		`, interestID, languageCode, name)
+		if err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pgForeignKeyViolation {
+				// Перевод на язык, которого нет в справочнике languages
+				return 0, errors.ErrInvalidInterestApproval
+			}
+
+			return 0, fmt.Errorf("failed to save interest translation: %w", err)
+		}
+	}
+
+	// Автор предложения сразу получает новый интерес в свой профиль
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO user_interest_selections (user_id, interest_id, is_primary, selection_order)
+		VALUES ($1, $2, false, (SELECT COALESCE(MAX(selection_order), 0) + 1 FROM user_interest_selections WHERE user_id = $1))
+		ON CONFLICT (user_id, interest_id) DO NOTHING
+	`, userID, interestID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select interest for proposer: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE interest_suggestions
+		SET status = 'approved', interest_id = $2, reviewed_at = NOW()
+		WHERE id = $1
+	`, suggestionID, interestID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update interest suggestion: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit interest suggestion approval: %w", err)
+	}
+
+	return interestID, nil
+}
+
+// RejectInterestSuggestion отклоняет предложение интереса с комментарием модератора.
+func (db *DB) RejectInterestSuggestion(suggestionID int, note string) error {
+	result, err := db.conn.ExecContext(context.Background(), `
+		UPDATE interest_suggestions
+		SET status = 'rejected', moderator_note = NULLIF($2, ''), reviewed_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`, suggestionID, note)
+	if err != nil {
+		return fmt.Errorf("failed to reject interest suggestion: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		var exists bool
+		if err := db.conn.QueryRowContext(context.Background(),
+			"SELECT EXISTS(SELECT 1 FROM interest_suggestions WHERE id = $1)", suggestionID,
+		).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check interest suggestion: %w", err)
+		}
+
+		if !exists {
+			return errors.ErrInterestSuggestionNotFound
+		}
+
+		return errors.ErrInterestSuggestionReviewed
+	}
+
+	return nil
+}
+
+// GetInterestRelations возвращает весь граф связей между интересами.
+func (db *DB) GetInterestRelations() ([]models.InterestRelation, error) {
+	query := `
+		SELECT r.id, r.interest_id, i1.key_name, r.related_interest_id, i2.key_name, r.relation_type, r.created_at
+		FROM interest_relations r
+		JOIN interests i1 ON i1.id = r.interest_id
+		JOIN interests i2 ON i2.id = r.related_interest_id
+		ORDER BY i1.key_name, i2.key_name
+	`
+
+	rows, err := db.conn.QueryContext(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest relations: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var relations []models.InterestRelation
+
+	for rows.Next() {
+		var relation models.InterestRelation
+
+		err := rows.Scan(
+			&relation.ID, &relation.InterestID, &relation.InterestKey,
+			&relation.RelatedInterestID, &relation.RelatedInterestKey,
+			&relation.RelationType, &relation.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan interest relation: %w", err)
+		}
+
+		relations = append(relations, relation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return relations, nil
+}
+
+// SaveInterestRelation создает или обновляет связь между интересами, заданными ключами.
+func (db *DB) SaveInterestRelation(relation *models.InterestRelation) error {
+	query := `
+		INSERT INTO interest_relations (interest_id, related_interest_id, relation_type)
+		SELECT i1.id, i2.id, $3
+		FROM interests i1, interests i2
+		WHERE i1.key_name = $1 AND i2.key_name = $2
+		ON CONFLICT (interest_id, related_interest_id) DO UPDATE SET relation_type = EXCLUDED.relation_type
+		RETURNING id, interest_id, related_interest_id, created_at
+	`
+
+	err := db.conn.QueryRowContext(context.Background(), query,
+		relation.InterestKey, relation.RelatedInterestKey, relation.RelationType,
+	).Scan(&relation.ID, &relation.InterestID, &relation.RelatedInterestID, &relation.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// Один из ключей не найден в справочнике интересов
+			return errors.ErrInterestNotFound
+		}
+
+		return fmt.Errorf("failed to save interest relation: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteInterestRelation удаляет связь между интересами.
+func (db *DB) DeleteInterestRelation(relationID int) error {
+	result, err := db.conn.ExecContext(context.Background(), "DELETE FROM interest_relations WHERE id = $1", relationID)
+	if err != nil {
+		return fmt.Errorf("failed to delete interest relation: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+
+	if affected == 0 {
+		return errors.ErrInterestRelationNotFound
+	}
+
+	return nil
+}
+
+// RefreshInterestCooccurrences пересчитывает статистику совместного выбора интересов.
+// Учитываются только пары, которые выбрали не меньше minUsers пользователей.
+func (db *DB) RefreshInterestCooccurrences(minUsers int) (int64, error) {
+	tx, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			db.logger.ErrorWithContext("Failed to rollback transaction", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": rollbackErr.Error()})
+		}
+	}()
+
+	if _, err := tx.ExecContext(context.Background(), `DELETE FROM interest_cooccurrences`); err != nil {
+		return 0, fmt.Errorf("failed to clear interest cooccurrences: %w", err)
+	}
+
+	result, err := tx.ExecContext(context.Background(), `
+		WITH interest_users AS (
+			SELECT interest_id, COUNT(DISTINCT user_id) AS users
+			FROM user_interest_selections
+			GROUP BY interest_id
+		)
+		INSERT INTO interest_cooccurrences (interest_id, related_interest_id, users_count, confidence, computed_at)
+		SELECT a.interest_id, b.interest_id, COUNT(DISTINCT a.user_id),
+			COUNT(DISTINCT a.user_id)::DOUBLE PRECISION / iu.users, NOW()
+		FROM user_interest_selections a
+		JOIN user_interest_selections b ON b.user_id = a.user_id AND b.interest_id <> a.interest_id
+		JOIN interest_users iu ON iu.interest_id = a.interest_id
+		GROUP BY a.interest_id, b.interest_id, iu.users
+		HAVING COUNT(DISTINCT a.user_id) >= $1
+	`, minUsers)
+	if err != nil {
+		return 0, fmt.Errorf("failed to compute interest cooccurrences: %w", err)
+	}
+
+	pairs, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("operation failed: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return pairs, nil
+}
+
+// GetTopInterestCooccurrences возвращает самые частые пары интересов.
+// Каждая пара возвращается один раз (interest_id < related_interest_id).
+func (db *DB) GetTopInterestCooccurrences(limit int) ([]models.InterestCooccurrence, error) {
+	query := `
+		SELECT c.interest_id, i1.key_name, c.related_interest_id, i2.key_name,
+			c.users_count, c.confidence, c.computed_at
+		FROM interest_cooccurrences c
+		JOIN interests i1 ON i1.id = c.interest_id
+		JOIN interests i2 ON i2.id = c.related_interest_id
+		WHERE c.interest_id < c.related_interest_id
+		ORDER BY c.users_count DESC, i1.key_name, i2.key_name
+		LIMIT $1
+	`
+
+	rows, err := db.conn.QueryContext(context.Background(), query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest cooccurrences: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var pairs []models.InterestCooccurrence
+
+	for rows.Next() {
+		var pair models.InterestCooccurrence
+
+		err := rows.Scan(
+			&pair.InterestID, &pair.InterestKey, &pair.RelatedInterestID, &pair.RelatedInterestKey,
+			&pair.UsersCount, &pair.Confidence, &pair.ComputedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan interest cooccurrence: %w", err)
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return pairs, nil
+}
+
+// GetInterestRecommendations возвращает интересы, которые чаще всего выбирают вместе с interestIDs.
+// Уже выбранные интересы в рекомендации не попадают.
+func (db *DB) GetInterestRecommendations(interestIDs []int, limit int) ([]models.InterestRecommendation, error) {
+	query := `
+		SELECT c.related_interest_id, i.key_name, ic.key_name, SUM(c.confidence) AS score
+		FROM interest_cooccurrences c
+		JOIN interests i ON i.id = c.related_interest_id
+		JOIN interest_categories ic ON ic.id = i.category_id
+		WHERE c.interest_id = ANY($1) AND NOT (c.related_interest_id = ANY($1))
+		GROUP BY c.related_interest_id, i.key_name, ic.key_name
+		ORDER BY score DESC, c.related_interest_id
+		LIMIT $2
+	`
+
+	rows, err := db.conn.QueryContext(context.Background(), query, pq.Array(interestIDs), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest recommendations: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var recommendations []models.InterestRecommendation
+
+	for rows.Next() {
+		var recommendation models.InterestRecommendation
+
+		err := rows.Scan(
+			&recommendation.InterestID, &recommendation.KeyName,
+			&recommendation.CategoryKey, &recommendation.Score,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan interest recommendation: %w", err)
+		}
+
+		recommendations = append(recommendations, recommendation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return recommendations, nil
+}
+
+// GetInterestCatalog возвращает снимок каталога интересов: категории, интересы, переводы
+// и число пользователей, выбравших каждый интерес.
+func (db *DB) GetInterestCatalog() (*models.InterestCatalog, error) {
+	catalog := &models.InterestCatalog{}
+
+	categoryRows, err := db.conn.QueryContext(context.Background(),
+		`SELECT id, key_name, display_order, created_at FROM interest_categories ORDER BY display_order, key_name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest categories: %w", err)
+	}
+
+	defer func() {
+		if closeErr := categoryRows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	for categoryRows.Next() {
+		var category models.InterestCategory
+		if err := categoryRows.Scan(&category.ID, &category.KeyName, &category.DisplayOrder, &category.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan interest category: %w", err)
+		}
+
+		catalog.Categories = append(catalog.Categories, category)
+	}
+
+	if err := categoryRows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	query := `
+		SELECT i.id, i.key_name, COALESCE(c.key_name, ''), COALESCE(i.display_order, 0), i.source, t.language_code, t.name,
+			(SELECT COUNT(*) FROM (
+				SELECT user_id FROM user_interest_selections WHERE interest_id = i.id
+				UNION
+				SELECT user_id FROM user_interests WHERE interest_id = i.id
+			) selected_by)
+		FROM interests i
+		LEFT JOIN interest_categories c ON c.id = i.category_id
+		LEFT JOIN interest_translations t ON t.interest_id = i.id
+		ORDER BY i.key_name, t.language_code
+	`
+
+	rows, err := db.conn.QueryContext(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest catalog: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			item     models.InterestCatalogItem
+			langCode sql.NullString
+			name     sql.NullString
+		)
+
+		err := rows.Scan(&item.ID, &item.KeyName, &item.CategoryKey, &item.DisplayOrder, &item.Source, &langCode, &name, &item.SelectionsCount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan interest catalog item: %w", err)
+		}
+
+		// Строки одного интереса идут подряд: по одной на перевод
+		last := len(catalog.Interests) - 1
+		if last < 0 || catalog.Interests[last].ID != item.ID {
+			item.Translations = make(map[string]string)
+			catalog.Interests = append(catalog.Interests, item)
+			last++
+		}
+
+		if langCode.Valid {
+			catalog.Interests[last].Translations[langCode.String] = name.String
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return catalog, nil
+}
+
+// ApplyInterestCatalogPlan применяет план синхронизации каталога интересов в одной транзакции.
+func (db *DB) ApplyInterestCatalogPlan(plan *models.InterestCatalogPlan) error {
+	tx, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			db.logger.ErrorWithContext("Failed to rollback transaction", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": rollbackErr.Error()})
+		}
+	}()
+
+	for _, category := range plan.Categories {
+		_, err := tx.ExecContext(context.Background(), `
+			INSERT INTO interest_categories (key_name, display_order) VALUES ($1, $2)
+			ON CONFLICT (key_name) DO UPDATE SET display_order = EXCLUDED.display_order
+		`, category.KeyName, category.DisplayOrder)
+		if err != nil {
+			return fmt.Errorf("failed to save interest category %s: %w", category.KeyName, err)
+		}
+	}
+
+	for _, change := range plan.Changes {
+		if err := applyInterestCatalogChange(tx, change); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// applyInterestCatalogChange применяет одно изменение каталога внутри транзакции.
+func applyInterestCatalogChange(tx *sql.Tx, change models.InterestCatalogChange) error {
+	ctx := context.Background()
+
+	switch change.Action {
+	case models.InterestCatalogInsert:
+		var interestID int
+
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO interests (key_name, category_id, type, display_order, source)
+			SELECT $1, id, key_name, $3, 'catalog' FROM interest_categories WHERE key_name = $2
+			RETURNING id
+		`, change.After.KeyName, change.After.CategoryKey, change.After.DisplayOrder).Scan(&interestID)
+		if err != nil {
+			return fmt.Errorf("failed to insert interest %s: %w", change.After.KeyName, err)
+		}
+
+		return saveInterestCatalogTranslations(tx, interestID, change.After)
+	case models.InterestCatalogRename, models.InterestCatalogUpdate:
+		_, err := tx.ExecContext(ctx, `
+			UPDATE interests
+			SET key_name = $2, display_order = $4,
+				category_id = (SELECT id FROM interest_categories WHERE key_name = $3), type = $3,
+				source = 'catalog'
+			WHERE id = $1
+		`, change.Before.ID, change.After.KeyName, change.After.CategoryKey, change.After.DisplayOrder)
+		if err != nil {
+			return fmt.Errorf("failed to update interest %s: %w", change.Before.KeyName, err)
+		}
+
+		return saveInterestCatalogTranslations(tx, change.Before.ID, change.After)
+	case models.InterestCatalogRemove:
+		return removeCatalogInterest(tx, change)
+	}
+
+	return fmt.Errorf("%w: unknown action %q", errors.ErrInvalidInterestCatalog, change.Action)
+}
+
+// saveInterestCatalogTranslations заменяет переводы интереса переводами из каталога.
+func saveInterestCatalogTranslations(tx *sql.Tx, interestID int, item *models.InterestCatalogItem) error {
+	ctx := context.Background()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM interest_translations WHERE interest_id = $1`, interestID); err != nil {
+		return fmt.Errorf("failed to clear translations of %s: %w", item.KeyName, err)
+	}
+
+	for lang, name := range item.Translations {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO interest_translations (interest_id, language_code, name) VALUES ($1, $2, $3)`,
+			interestID, lang, name)
+		if err != nil {
+			return fmt.Errorf("failed to save %s translation of %s: %w", lang, item.KeyName, err)
+		}
+	}
+
+	return nil
+}
+
+// removeCatalogInterest удаляет интерес, предварительно перенося выборы пользователей.
+// Число выборов перепроверяется в транзакции: пока план смотрели, интерес могли выбрать.
+func removeCatalogInterest(tx *sql.Tx, change models.InterestCatalogChange) error {
+	ctx := context.Background()
+	interestID := change.Before.ID
+
+	if change.MigrateTo == "" {
+		var selected bool
+
+		err := tx.QueryRowContext(ctx, `
+			SELECT EXISTS(SELECT 1 FROM user_interest_selections WHERE interest_id = $1)
+				OR EXISTS(SELECT 1 FROM user_interests WHERE interest_id = $1)
+		`, interestID).Scan(&selected)
+		if err != nil {
+			return fmt.Errorf("failed to count selections of %s: %w", change.Before.KeyName, err)
+		}
+
+		if selected {
+			return fmt.Errorf("%w: %s", errors.ErrInterestMigrationRequired, change.Before.KeyName)
+		}
+	} else {
+		var targetID int
+
+		err := tx.QueryRowContext(ctx, `SELECT id FROM interests WHERE key_name = $1`, change.MigrateTo).Scan(&targetID)
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("%w: %s -> %s", errors.ErrInvalidInterestMigration, change.Before.KeyName, change.MigrateTo)
+		}
+
+		if err != nil {
+			return fmt.Errorf("operation failed: %w", err)
+		}
+
+		// Если у пользователя уже есть целевой интерес, оставляем его выбор как есть
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO user_interest_selections (user_id, interest_id, is_primary, selection_order, created_at)
+			SELECT user_id, $2, is_primary, selection_order, created_at
+			FROM user_interest_selections WHERE interest_id = $1
+			ON CONFLICT (user_id, interest_id) DO NOTHING
+		`, interestID, targetID)
+		if err != nil {
+			return fmt.Errorf("failed to migrate selections of %s: %w", change.Before.KeyName, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO user_interests (user_id, interest_id, is_primary, created_at)
+			SELECT user_id, $2, is_primary, created_at
+			FROM user_interests WHERE interest_id = $1
+			ON CONFLICT (user_id, interest_id) DO NOTHING
+		`, interestID, targetID)
+		if err != nil {
+			return fmt.Errorf("failed to migrate legacy interests of %s: %w", change.Before.KeyName, err)
+		}
+	}
+
+	// Переводы, выборы, связи и статистика удаляются каскадно
+	if _, err := tx.ExecContext(ctx, `DELETE FROM interests WHERE id = $1`, interestID); err != nil {
+		return fmt.Errorf("failed to remove interest %s: %w", change.Before.KeyName, err)
+	}
+
+	return nil
+}
+
+// nameTranslationQueries - запросы для переводов названий интересов или категорий.
+type nameTranslationQueries struct {
+	upsert  string
+	remove  string
+	invalid error // Ошибка для перевода на язык, которого нет в справочнике languages
+}
+
+var (
+	interestNameTranslations = nameTranslationQueries{
+		upsert: `
+			INSERT INTO interest_translations (interest_id, language_code, name) VALUES ($1, $2, $3)
+			ON CONFLICT (interest_id, language_code) DO UPDATE SET name = EXCLUDED.name
+		`,
+		remove:  `DELETE FROM interest_translations WHERE interest_id = $1 AND language_code = $2`,
+		invalid: errors.ErrInvalidInterestData,
+	}
+	categoryNameTranslations = nameTranslationQueries{
+		upsert: `
+			INSERT INTO interest_category_translations (category_id, language_code, name) VALUES ($1, $2, $3)
+			ON CONFLICT (category_id, language_code) DO UPDATE SET name = EXCLUDED.name
+		`,
+		remove:  `DELETE FROM interest_category_translations WHERE category_id = $1 AND language_code = $2`,
+		invalid: errors.ErrInvalidInterestCategory,
+	}
+)
+
+// mergeNameTranslations добавляет и меняет переводы названия; пустое название удаляет перевод.
+func mergeNameTranslations(tx *sql.Tx, queries nameTranslationQueries, ownerID int, translations map[string]string) error {
+	for languageCode, name := range translations {
+		var err error
+		if name == "" {
+			_, err = tx.ExecContext(context.Background(), queries.remove, ownerID, languageCode)
+		} else {
+			_, err = tx.ExecContext(context.Background(), queries.upsert, ownerID, languageCode, name)
+		}
+
+		if err != nil {
+			if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pgForeignKeyViolation {
+				return fmt.Errorf("%w: unknown language %s", queries.invalid, languageCode)
+			}
+
+			return fmt.Errorf("failed to save %s translation: %w", languageCode, err)
+		}
+	}
+
+	return nil
+}
+
+// GetInterestCategoryItems возвращает категории интересов с переводами названий и числом интересов.
+func (db *DB) GetInterestCategoryItems() ([]models.InterestCategoryItem, error) {
+	query := `
+		SELECT c.id, c.key_name, COALESCE(c.display_order, 0),
+			(SELECT COUNT(*) FROM interests i WHERE i.category_id = c.id),
+			t.language_code, t.name
+		FROM interest_categories c
+		LEFT JOIN interest_category_translations t ON t.category_id = c.id
+		ORDER BY c.display_order, c.key_name, t.language_code
+	`
+
+	rows, err := db.conn.QueryContext(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interest categories: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var categories []models.InterestCategoryItem
+
+	for rows.Next() {
+		var (
+			item     models.InterestCategoryItem
+			langCode sql.NullString
+			name     sql.NullString
+		)
+
+		if err := rows.Scan(&item.ID, &item.KeyName, &item.DisplayOrder, &item.InterestsCount, &langCode, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan interest category: %w", err)
+		}
+
+		// Строки одной категории идут подряд: по одной на перевод
+		last := len(categories) - 1
+		if last < 0 || categories[last].ID != item.ID {
+			item.Translations = make(map[string]string)
+			categories = append(categories, item)
+			last++
+		}
+
+		if langCode.Valid {
+			categories[last].Translations[langCode.String] = name.String
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return categories, nil
+}
+
+// CreateInterestCategory создает категорию интересов с переводами названия.
+// Без порядка отображения категория встает в конец списка.
+func (db *DB) CreateInterestCategory(input models.InterestCategoryInput) (int, error) {
+	ctx := context.Background()
+
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			db.logger.ErrorWithContext("Failed to rollback transaction", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": rollbackErr.Error()})
+		}
+	}()
+
+	var categoryID int
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO interest_categories (key_name, display_order)
+		VALUES ($1, COALESCE($2, (SELECT COALESCE(MAX(display_order), 0) + 1 FROM interest_categories)))
+		RETURNING id
+	`, input.KeyName, input.DisplayOrder).Scan(&categoryID)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pgUniqueViolation {
+			return 0, errors.ErrInterestCategoryKeyExists
+		}
+
+		return 0, fmt.Errorf("failed to create interest category: %w", err)
+	}
+
+	if err := mergeNameTranslations(tx, categoryNameTranslations, categoryID, input.Translations); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return categoryID, nil
+}
+
+// UpdateInterestCategory переименовывает категорию, меняет ее порядок и переводы названия.
+func (db *DB) UpdateInterestCategory(categoryID int, input models.InterestCategoryInput) error {
+	ctx := context.Background()
+
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			db.logger.ErrorWithContext("Failed to rollback transaction", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": rollbackErr.Error()})
+		}
+	}()
+
+	var keyName string
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE interest_categories
+		SET key_name = COALESCE($2, key_name), display_order = COALESCE($3, display_order)
+		WHERE id = $1
+		RETURNING key_name
+	`, categoryID, input.KeyName, input.DisplayOrder).Scan(&keyName)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.ErrInterestCategoryNotFound
+		}
+
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pgUniqueViolation {
+			return errors.ErrInterestCategoryKeyExists
+		}
+
+		return fmt.Errorf("failed to update interest category: %w", err)
+	}
+
+	// Старое поле type дублирует ключ категории
+	if input.KeyName != nil {
+		if _, err := tx.ExecContext(ctx, `UPDATE interests SET type = $2 WHERE category_id = $1`, categoryID, keyName); err != nil {
+			return fmt.Errorf("failed to update interests of category: %w", err)
+		}
+	}
+
+	if err := mergeNameTranslations(tx, categoryNameTranslations, categoryID, input.Translations); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateInterest создает интерес в категории с переводами названия.
+// Без порядка отображения интерес встает в конец категории.
+func (db *DB) CreateInterest(input models.InterestInput) (int, error) {
+	ctx := context.Background()
+
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			db.logger.ErrorWithContext("Failed to rollback transaction", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": rollbackErr.Error()})
+		}
+	}()
+
+	var interestID int
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO interests (key_name, category_id, type, display_order, source)
+		SELECT $1, c.id, c.key_name,
+			COALESCE($3, (SELECT COALESCE(MAX(display_order), 0) + 1 FROM interests WHERE category_id = c.id)), 'admin'
+		FROM interest_categories c
+		WHERE c.key_name = $2
+		RETURNING id
+	`, input.KeyName, input.CategoryKey, input.DisplayOrder).Scan(&interestID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, errors.ErrInterestCategoryNotFound
+		}
+
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pgUniqueViolation {
+			return 0, errors.ErrInterestKeyExists
+		}
+
+		return 0, fmt.Errorf("failed to create interest: %w", err)
+	}
+
+	if err := mergeNameTranslations(tx, interestNameTranslations, interestID, input.Translations); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return interestID, nil
+}
+
+// UpdateInterest переименовывает интерес, переносит его в другую категорию, меняет порядок и переводы.
+// При переносе без явного порядка интерес встает в конец новой категории.
+func (db *DB) UpdateInterest(interestID int, input models.InterestInput) error {
+	ctx := context.Background()
+
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil && rollbackErr != sql.ErrTxDone {
+			db.logger.ErrorWithContext("Failed to rollback transaction", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": rollbackErr.Error()})
+		}
+	}()
+
+	var categoryID *int
+
+	displayOrder := input.DisplayOrder
+
+	if input.CategoryKey != nil {
+		var id, lastOrder int
+
+		err := tx.QueryRowContext(ctx, `
+			SELECT c.id, COALESCE((SELECT MAX(display_order) FROM interests WHERE category_id = c.id AND id <> $2), 0)
+			FROM interest_categories c WHERE c.key_name = $1
+		`, *input.CategoryKey, interestID).Scan(&id, &lastOrder)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errors.ErrInterestCategoryNotFound
+			}
+
+			return fmt.Errorf("failed to get interest category: %w", err)
+		}
+
+		categoryID = &id
+
+		if displayOrder == nil {
+			nextOrder := lastOrder + 1
+			displayOrder = &nextOrder
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE interests
+		SET key_name = COALESCE($2, key_name),
+			category_id = COALESCE($3, category_id),
+			type = COALESCE($4, type),
+			display_order = COALESCE($5, display_order)
+		WHERE id = $1
+	`, interestID, input.KeyName, categoryID, input.CategoryKey, displayOrder)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pgUniqueViolation {
+			return errors.ErrInterestKeyExists
+		}
+
+		return fmt.Errorf("failed to update interest: %w", err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("operation failed: %w", err)
+	} else if affected == 0 {
+		return errors.ErrInterestNotFound
+	}
+
+	if err := mergeNameTranslations(tx, interestNameTranslations, interestID, input.Translations); err != nil {
+		return err
+	}
+
+	// Без единого перевода интерес показывался бы пользователям сырым ключом
+	var translationsCount int
+
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM interest_translations WHERE interest_id = $1`, interestID).
+		Scan(&translationsCount)
+	if err != nil {
+		return fmt.Errorf("failed to count interest translations: %w", err)
+	}
+
+	if translationsCount == 0 {
+		return fmt.Errorf("%w: interest must keep at least one translation", errors.ErrInvalidInterestData)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateUserRole меняет роль пользователя.
+func (db *DB) UpdateUserRole(userID int, role string) error {
+	result, err := db.conn.ExecContext(context.Background(), `
+        UPDATE users SET role = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2
+    `, role, userID)
+	if err != nil {
+		return fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check updated user role: %w", err)
+	}
+
+	if affected == 0 {
+		return errors.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// AssignAdminRoles назначает роль admin пользователям из списков конфигурации.
+// Роли только повышаются: понижать администраторов можно лишь явно через UpdateUserRole.
+func (db *DB) AssignAdminRoles(telegramIDs []int64, usernames []string) (int, error) {
+	if len(telegramIDs) == 0 && len(usernames) == 0 {
+		return 0, nil
+	}
+
+	result, err := db.conn.ExecContext(context.Background(), `
+        UPDATE users SET role = 'admin', updated_at = CURRENT_TIMESTAMP
+        WHERE role <> 'admin'
+          AND (telegram_id = ANY($1) OR (username <> '' AND username = ANY($2)))
+    `, pq.Array(telegramIDs), pq.Array(usernames))
+	if err != nil {
+		return 0, fmt.Errorf("failed to assign admin roles: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to check assigned admin roles: %w", err)
+	}
+
+	return int(affected), nil
+}
+
 // ===== BATCH OPERATIONS METHODS =====
 
 // GetBatchOperations возвращает экземпляр BatchOperations для массовых операций.
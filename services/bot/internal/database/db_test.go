@@ -4,6 +4,9 @@ import (
 	"database/sql"
 	"testing"
 
+	"language-exchange-bot/internal/models"
+
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	_ "modernc.org/sqlite" // SQLite driver for testing
@@ -165,6 +168,7 @@ func TestDB_GetUserByTelegramID(t *testing.T) {
 	assert.Equal(t, "testuser", user.Username)
 	assert.Equal(t, "Test User", user.FirstName)
 	assert.Equal(t, "en", user.InterfaceLanguageCode)
+	assert.Equal(t, models.PlatformTelegram, user.Platform)
 
 	// Тестируем несуществующего пользователя
 	user, err = database.GetUserByTelegramID(999999999)
@@ -619,7 +623,7 @@ func TestDB_SaveUserFeedback(t *testing.T) {
 	// Сохраняем отзыв
 	feedbackText := "This is a test feedback message"
 	contactInfo := "test_contact"
-	err = database.SaveUserFeedback(userID, feedbackText, &contactInfo)
+	_, err = database.SaveUserFeedback(userID, feedbackText, &contactInfo)
 	require.NoError(t, err)
 
 	// Проверяем сохранение
@@ -658,9 +662,9 @@ func TestDB_GetUserFeedbackByUserID(t *testing.T) {
 	// Сохраняем несколько отзывов
 	contact1 := "contact1"
 	contact2 := "contact2"
-	err = database.SaveUserFeedback(userID, "First feedback", &contact1)
+	_, err = database.SaveUserFeedback(userID, "First feedback", &contact1)
 	require.NoError(t, err)
-	err = database.SaveUserFeedback(userID, "Second feedback", &contact2)
+	_, err = database.SaveUserFeedback(userID, "Second feedback", &contact2)
 	require.NoError(t, err)
 
 	// Получаем отзывы
@@ -698,9 +702,9 @@ func TestDB_GetUnprocessedFeedback(t *testing.T) {
 	// Сохраняем отзывы
 	contact1 := "contact1"
 	contact2 := "contact2"
-	err = database.SaveUserFeedback(user1.ID, "Unprocessed feedback 1", &contact1)
+	_, err = database.SaveUserFeedback(user1.ID, "Unprocessed feedback 1", &contact1)
 	require.NoError(t, err)
-	err = database.SaveUserFeedback(user2.ID, "Unprocessed feedback 2", &contact2)
+	_, err = database.SaveUserFeedback(user2.ID, "Unprocessed feedback 2", &contact2)
 	require.NoError(t, err)
 
 	// Получаем необработанные отзывы
@@ -744,7 +748,7 @@ func TestDB_MarkFeedbackProcessed(t *testing.T) {
 
 	// Сохраняем отзыв
 	contact := "contact"
-	err = database.SaveUserFeedback(userID, "Test feedback", &contact)
+	_, err = database.SaveUserFeedback(userID, "Test feedback", &contact)
 	require.NoError(t, err)
 
 	// Получаем отзыв
@@ -852,7 +856,10 @@ func setupTestUsersTable(t *testing.T, db *sql.DB) {
 			updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 			state TEXT DEFAULT 'new',
 			profile_completion_level INTEGER DEFAULT 0,
-			status TEXT DEFAULT 'new'
+			status TEXT DEFAULT 'new',
+			role TEXT NOT NULL DEFAULT 'user',
+			is_active BOOLEAN NOT NULL DEFAULT TRUE,
+			platform TEXT NOT NULL DEFAULT 'telegram'
 		)
 	`)
 	require.NoError(t, err)
@@ -1004,6 +1011,26 @@ func TestDB_UpdateUserProfileCompletionLevel(t *testing.T) {
 	assert.Equal(t, 75, level)
 }
 
+// TestAnnouncementSegmentFilter tests that segment filters are numbered after the given arguments
+// and that users who blocked the bot are always excluded.
+func TestAnnouncementSegmentFilter(t *testing.T) {
+	where, args := announcementSegmentFilter(models.AnnouncementSegment{}, 7)
+	assert.Equal(t, "is_active AND platform = 'telegram'", where)
+	assert.Equal(t, []interface{}{7}, args)
+
+	maxCompletion := 80
+	where, args = announcementSegmentFilter(models.AnnouncementSegment{
+		InterfaceLanguages:   []string{"ru", "en"},
+		Statuses:             []string{"active"},
+		MinProfileCompletion: 50,
+		MaxProfileCompletion: &maxCompletion,
+	}, 7)
+
+	assert.Equal(t, "is_active AND platform = 'telegram' AND interface_language_code = ANY($2) AND status = ANY($3)"+
+		" AND profile_completion_level >= $4 AND profile_completion_level <= $5", where)
+	assert.Equal(t, []interface{}{7, pq.Array([]string{"ru", "en"}), pq.Array([]string{"active"}), 50, 80}, args)
+}
+
 // setupTestUser creates a test user in the database.
 func setupTestUser(t *testing.T, db *sql.DB, telegramID int64, username, firstName string) {
 	_, err := db.Exec(`
@@ -0,0 +1,341 @@
+package database
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"language-exchange-bot/internal/errors"
+)
+
+// encryptedPrefix отличает зашифрованные значения от открытого текста, записанного до включения
+// шифрования. Формат значения: enc:v1:<id ключа>:<обернутый ключ данных>:<шифртекст>.
+const encryptedPrefix = "enc:v1:"
+
+// personalDataColumn - текстовая колонка с персональными данными, которая хранится зашифрованной.
+type personalDataColumn struct {
+	table  string
+	column string
+}
+
+// personalDataColumns - колонки, которые шифруются при записи и перешифровываются после ротации
+// ключей. Новое свободное текстовое поле профиля с персональными данными добавляется сюда.
+var personalDataColumns = []personalDataColumn{
+	{table: "user_feedback", column: "contact_info"},
+}
+
+// FieldCipher шифрует персональные данные по схеме envelope encryption: каждое значение шифруется
+// собственным случайным ключом данных (AES-256-GCM), а ключ данных - активным ключом набора.
+// При ротации ключей достаточно переобернуть ключи данных, сами данные не перешифровываются.
+type FieldCipher struct {
+	ring *KeyRing
+}
+
+// NewFieldCipher загружает ключи из provider и создает шифратор.
+func NewFieldCipher(provider KeyProvider) (*FieldCipher, error) {
+	ring, err := provider.LoadKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	return &FieldCipher{ring: ring}, nil
+}
+
+// ActiveKeyID возвращает идентификатор ключа, которым шифруются новые значения.
+func (c *FieldCipher) ActiveKeyID() string {
+	return c.ring.ActiveID
+}
+
+// Encrypt шифрует значение активным ключом.
+func (c *FieldCipher) Encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	ciphertext, err := seal(dataKey, []byte(plaintext), nil)
+	if err != nil {
+		return "", err
+	}
+
+	return c.envelope(dataKey, ciphertext)
+}
+
+// Decrypt расшифровывает значение. Значения без префикса enc:v1: записаны до включения
+// шифрования и возвращаются как есть.
+func (c *FieldCipher) Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	keyID, dataKey, ciphertext, err := c.open(value)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := unseal(dataKey, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("%w: value encrypted with key %q", errors.ErrEncryptedValueCorrupted, keyID)
+	}
+
+	return string(plaintext), nil
+}
+
+// Rotate переводит значение на активный ключ: открытый текст шифруется, у зашифрованного
+// значения ключ данных переоборачивается активным ключом.
+func (c *FieldCipher) Rotate(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return c.Encrypt(value)
+	}
+
+	_, dataKey, ciphertext, err := c.open(value)
+	if err != nil {
+		return "", err
+	}
+
+	return c.envelope(dataKey, ciphertext)
+}
+
+// IsEncrypted сообщает, зашифровано ли значение.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+// envelope оборачивает ключ данных активным ключом и собирает значение для записи в базу.
+func (c *FieldCipher) envelope(dataKey, ciphertext []byte) (string, error) {
+	keyID := c.ring.ActiveID
+
+	// Идентификатор ключа входит в проверяемые данные: подменить его в строке не выйдет
+	wrappedKey, err := seal(c.ring.Keys[keyID], dataKey, []byte(keyID))
+	if err != nil {
+		return "", err
+	}
+
+	return encryptedPrefix + keyID + ":" +
+		base64.RawStdEncoding.EncodeToString(wrappedKey) + ":" +
+		base64.RawStdEncoding.EncodeToString(ciphertext), nil
+}
+
+// open разбирает зашифрованное значение и разворачивает его ключ данных.
+func (c *FieldCipher) open(value string) (string, []byte, []byte, error) {
+	parts := strings.Split(strings.TrimPrefix(value, encryptedPrefix), ":")
+	if len(parts) != 3 {
+		return "", nil, nil, errors.ErrEncryptedValueCorrupted
+	}
+
+	keyID := parts[0]
+
+	key, ok := c.ring.Keys[keyID]
+	if !ok {
+		return "", nil, nil, fmt.Errorf("%w: %q", errors.ErrEncryptionKeyNotFound, keyID)
+	}
+
+	wrappedKey, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", nil, nil, errors.ErrEncryptedValueCorrupted
+	}
+
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", nil, nil, errors.ErrEncryptedValueCorrupted
+	}
+
+	dataKey, err := unseal(key, wrappedKey, []byte(keyID))
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("%w: data key of key %q", errors.ErrEncryptedValueCorrupted, keyID)
+	}
+
+	return keyID, dataKey, ciphertext, nil
+}
+
+// seal шифрует plaintext ключом key (AES-GCM) и возвращает nonce вместе с шифртекстом.
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// unseal расшифровывает результат seal.
+func unseal(key, sealed, additionalData []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.ErrEncryptedValueCorrupted
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// newGCM создает AES-GCM для ключа.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	return aead, nil
+}
+
+// SetFieldCipher включает шифрование персональных данных. Без шифратора значения пишутся
+// открытым текстом, а зашифрованные значения прочитать нельзя.
+func (db *DB) SetFieldCipher(fieldCipher *FieldCipher) {
+	db.cipher = fieldCipher
+}
+
+// encryptPersonalData шифрует значение колонки с персональными данными перед записью.
+func (db *DB) encryptPersonalData(value *string) (*string, error) {
+	if value == nil || *value == "" || db.cipher == nil {
+		return value, nil
+	}
+
+	encrypted, err := db.cipher.Encrypt(*value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt personal data: %w", err)
+	}
+
+	return &encrypted, nil
+}
+
+// decryptPersonalText расшифровывает прочитанное значение колонки с персональными данными.
+func (db *DB) decryptPersonalText(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	if db.cipher == nil {
+		return "", fmt.Errorf("%w: encrypted value found but encryption is not configured", errors.ErrEncryptionKeyNotFound)
+	}
+
+	plaintext, err := db.cipher.Decrypt(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt personal data: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// decryptPersonalData расшифровывает прочитанное значение колонки, допускающей NULL.
+func (db *DB) decryptPersonalData(value sql.NullString) (sql.NullString, error) {
+	if !value.Valid {
+		return value, nil
+	}
+
+	plaintext, err := db.decryptPersonalText(value.String)
+	if err != nil {
+		return value, err
+	}
+
+	return sql.NullString{String: plaintext, Valid: true}, nil
+}
+
+// ReencryptPersonalData переводит на активный ключ не больше limit значений каждой колонки
+// с персональными данными: шифрует записанное открытым текстом и переоборачивает ключи данных
+// значений, зашифрованных прежними ключами. Возвращает число перезаписанных значений;
+// без шифратора ничего не делает.
+func (db *DB) ReencryptPersonalData(limit int) (int, error) {
+	if db.cipher == nil {
+		return 0, nil
+	}
+
+	total := 0
+
+	for _, column := range personalDataColumns {
+		rewritten, err := db.reencryptColumn(column, limit)
+		total += rewritten
+
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// reencryptColumn перешифровывает одну пачку значений колонки в транзакции.
+func (db *DB) reencryptColumn(column personalDataColumn, limit int) (int, error) {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	rows, err := transaction.QueryContext(context.Background(), fmt.Sprintf(`
+		SELECT id, %[2]s FROM %[1]s
+		WHERE %[2]s IS NOT NULL AND %[2]s <> '' AND %[2]s NOT LIKE $1
+		ORDER BY id LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, column.table, column.column), encryptedPrefix+db.cipher.ActiveKeyID()+":%", limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to select %s.%s for re-encryption: %w", column.table, column.column, err)
+	}
+
+	values := map[int]string{}
+
+	for rows.Next() {
+		var (
+			id    int
+			value string
+		)
+
+		if err := rows.Scan(&id, &value); err != nil {
+			_ = rows.Close()
+
+			return 0, fmt.Errorf("failed to scan %s.%s: %w", column.table, column.column, err)
+		}
+
+		values[id] = value
+	}
+
+	if err := rows.Close(); err != nil {
+		return 0, fmt.Errorf("failed to close rows: %w", err)
+	}
+
+	for id, value := range values {
+		rotated, err := db.cipher.Rotate(value)
+		if err != nil {
+			return 0, fmt.Errorf("failed to re-encrypt %s.%s of row %d: %w", column.table, column.column, id, err)
+		}
+
+		if _, err := transaction.ExecContext(context.Background(),
+			fmt.Sprintf("UPDATE %s SET %s = $1 WHERE id = $2", column.table, column.column), rotated, id); err != nil {
+			return 0, fmt.Errorf("failed to update %s.%s: %w", column.table, column.column, err)
+		}
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return len(values), nil
+}
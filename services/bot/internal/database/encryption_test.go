@@ -0,0 +1,161 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/errors"
+)
+
+// testKey возвращает ключ в base64, заполненный байтом fill.
+func testKey(fill byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(rune(fill)), encryptionKeySize)))
+}
+
+// writeKeyFile создает файл ключей во временном каталоге.
+func writeKeyFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "keys")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+// TestKeyFileProvider тестирует чтение файла ключей: по умолчанию активен последний ключ.
+func TestKeyFileProvider(t *testing.T) {
+	path := writeKeyFile(t, "# ключи шифрования\n2026-01="+testKey('a')+"\n\n2026-10="+testKey('b')+"\n")
+
+	ring, err := KeyFileProvider{Path: path}.LoadKeys()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", ring.ActiveID)
+	assert.Len(t, ring.Keys, 2)
+
+	ring, err = KeyFileProvider{Path: path, ActiveID: "2026-01"}.LoadKeys()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-01", ring.ActiveID)
+
+	_, err = KeyFileProvider{Path: path, ActiveID: "missing"}.LoadKeys()
+	require.ErrorIs(t, err, errors.ErrInvalidEncryptionKeys)
+
+	for _, content := range []string{"", "no-separator", "a:b=" + testKey('a'), "short=" + base64.StdEncoding.EncodeToString([]byte("short"))} {
+		_, err = KeyFileProvider{Path: writeKeyFile(t, content)}.LoadKeys()
+		require.ErrorIs(t, err, errors.ErrInvalidEncryptionKeys, content)
+	}
+}
+
+// TestSecretDirKeyProvider тестирует чтение смонтированного секрета: скрытые файлы пропускаются,
+// активен последний по алфавиту ключ.
+func TestSecretDirKeyProvider(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2026-10"), []byte(testKey('b')+"\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "2026-01"), []byte(testKey('a')), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".hidden"), []byte("junk"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0o700))
+
+	ring, err := SecretDirKeyProvider{Dir: dir}.LoadKeys()
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", ring.ActiveID)
+	assert.Len(t, ring.Keys, 2)
+
+	_, err = SecretDirKeyProvider{Dir: t.TempDir()}.LoadKeys()
+	require.ErrorIs(t, err, errors.ErrInvalidEncryptionKeys)
+}
+
+// TestFieldCipher тестирует шифрование, чтение открытого текста и обнаружение подделки.
+func TestFieldCipher(t *testing.T) {
+	fieldCipher, err := NewFieldCipher(KeyFileProvider{Path: writeKeyFile(t, "k1="+testKey('a'))})
+	require.NoError(t, err)
+
+	encrypted, err := fieldCipher.Encrypt("@anna, +7 900 000-00-00")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(encrypted, "enc:v1:k1:"))
+	assert.NotContains(t, encrypted, "anna")
+
+	again, err := fieldCipher.Encrypt("@anna, +7 900 000-00-00")
+	require.NoError(t, err)
+	assert.NotEqual(t, encrypted, again)
+
+	plaintext, err := fieldCipher.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "@anna, +7 900 000-00-00", plaintext)
+
+	legacy, err := fieldCipher.Decrypt("telegram @anna")
+	require.NoError(t, err)
+	assert.Equal(t, "telegram @anna", legacy)
+
+	tampered := encrypted[:len(encrypted)-2] + "AA"
+	_, err = fieldCipher.Decrypt(tampered)
+	require.ErrorIs(t, err, errors.ErrEncryptedValueCorrupted)
+
+	_, err = fieldCipher.Decrypt(strings.Replace(encrypted, "enc:v1:k1:", "enc:v1:k2:", 1))
+	require.ErrorIs(t, err, errors.ErrEncryptionKeyNotFound)
+}
+
+// TestFieldCipher_Rotate тестирует ротацию: значение старого ключа переоборачивается новым,
+// шифртекст данных при этом не меняется, а старый ключ больше не нужен.
+func TestFieldCipher_Rotate(t *testing.T) {
+	oldCipher, err := NewFieldCipher(KeyFileProvider{Path: writeKeyFile(t, "k1="+testKey('a'))})
+	require.NoError(t, err)
+
+	encrypted, err := oldCipher.Encrypt("anna@example.com")
+	require.NoError(t, err)
+
+	newCipher, err := NewFieldCipher(KeyFileProvider{Path: writeKeyFile(t, "k1="+testKey('a')+"\nk2="+testKey('b'))})
+	require.NoError(t, err)
+
+	rotated, err := newCipher.Rotate(encrypted)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rotated, "enc:v1:k2:"))
+	assert.Equal(t, encrypted[strings.LastIndex(encrypted, ":"):], rotated[strings.LastIndex(rotated, ":"):])
+
+	onlyNew, err := NewFieldCipher(KeyFileProvider{Path: writeKeyFile(t, "k2="+testKey('b'))})
+	require.NoError(t, err)
+
+	plaintext, err := onlyNew.Decrypt(rotated)
+	require.NoError(t, err)
+	assert.Equal(t, "anna@example.com", plaintext)
+
+	fromPlaintext, err := onlyNew.Rotate("anna@example.com")
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(fromPlaintext))
+}
+
+// TestDB_personalData тестирует прозрачное шифрование в DB и отказ читать
+// зашифрованное значение без ключей.
+func TestDB_personalData(t *testing.T) {
+	fieldCipher, err := NewFieldCipher(KeyFileProvider{Path: writeKeyFile(t, "k1="+testKey('a'))})
+	require.NoError(t, err)
+
+	plain := &DB{}
+	contact := "@anna"
+
+	unchanged, err := plain.encryptPersonalData(&contact)
+	require.NoError(t, err)
+	assert.Equal(t, &contact, unchanged)
+
+	db := &DB{}
+	db.SetFieldCipher(fieldCipher)
+
+	encrypted, err := db.encryptPersonalData(&contact)
+	require.NoError(t, err)
+	assert.True(t, IsEncrypted(*encrypted))
+
+	decrypted, err := db.decryptPersonalData(sql.NullString{String: *encrypted, Valid: true})
+	require.NoError(t, err)
+	assert.Equal(t, sql.NullString{String: "@anna", Valid: true}, decrypted)
+
+	_, err = plain.decryptPersonalData(sql.NullString{String: *encrypted, Valid: true})
+	require.ErrorIs(t, err, errors.ErrEncryptionKeyNotFound)
+
+	null, err := plain.decryptPersonalData(sql.NullString{})
+	require.NoError(t, err)
+	assert.False(t, null.Valid)
+}
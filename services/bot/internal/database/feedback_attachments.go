@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"language-exchange-bot/internal/models"
+)
+
+// AddFeedbackAttachments сохраняет вложения отзыва и заполняет их ID.
+func (db *DB) AddFeedbackAttachments(feedbackID int, attachments []models.FeedbackAttachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	for i := range attachments {
+		attachment := &attachments[i]
+		attachment.FeedbackID = feedbackID
+
+		err := transaction.QueryRowContext(context.Background(), `
+			INSERT INTO feedback_attachments (feedback_id, kind, file_id, file_unique_id, file_name, mime_type, file_size)
+			VALUES ($1, $2, $3, $4, NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, 0))
+			RETURNING id, created_at
+		`, feedbackID, attachment.Kind, attachment.FileID, attachment.FileUniqueID,
+			attachment.FileName, attachment.MimeType, attachment.FileSize).Scan(&attachment.ID, &attachment.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to add feedback attachment: %w", err)
+		}
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return fmt.Errorf("failed to commit feedback attachments: %w", err)
+	}
+
+	return nil
+}
+
+// GetFeedbackAttachments возвращает вложения отзыва в порядке отправки.
+func (db *DB) GetFeedbackAttachments(feedbackID int) ([]models.FeedbackAttachment, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT id, feedback_id, kind, file_id, file_unique_id, file_name, mime_type, file_size, created_at
+		FROM feedback_attachments
+		WHERE feedback_id = $1
+		ORDER BY id
+	`, feedbackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback attachments: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	attachments := []models.FeedbackAttachment{}
+
+	for rows.Next() {
+		var (
+			attachment models.FeedbackAttachment
+			fileName   sql.NullString
+			mimeType   sql.NullString
+			fileSize   sql.NullInt64
+		)
+
+		err := rows.Scan(&attachment.ID, &attachment.FeedbackID, &attachment.Kind, &attachment.FileID,
+			&attachment.FileUniqueID, &fileName, &mimeType, &fileSize, &attachment.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feedback attachment: %w", err)
+		}
+
+		attachment.FileName = fileName.String
+		attachment.MimeType = mimeType.String
+		attachment.FileSize = int(fileSize.Int64)
+
+		attachments = append(attachments, attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return attachments, nil
+}
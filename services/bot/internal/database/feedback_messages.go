@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// AddFeedbackMessage добавляет сообщение в переписку по отзыву. Ответ администратора становится
+// admin_response и переносит отзыв в обработанные, ответ автора возвращает отзыв в активные.
+func (db *DB) AddFeedbackMessage(message *models.FeedbackMessage) error {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	var result sql.Result
+
+	if message.AuthorType == models.FeedbackAuthorAdmin {
+		result, err = transaction.ExecContext(context.Background(), `
+			UPDATE user_feedback
+			SET is_processed = true, admin_response = $2, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+		`, message.FeedbackID, message.Text)
+	} else {
+		result, err = transaction.ExecContext(context.Background(), `
+			UPDATE user_feedback
+			SET is_processed = false, updated_at = CURRENT_TIMESTAMP
+			WHERE id = $1
+		`, message.FeedbackID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update feedback: %w", err)
+	}
+
+	if affected, err := result.RowsAffected(); err == nil && affected == 0 {
+		return errors.ErrFeedbackNotFound
+	}
+
+	err = transaction.QueryRowContext(context.Background(), `
+		INSERT INTO feedback_messages (feedback_id, author_type, author_id, message_text)
+		VALUES ($1, $2, NULLIF($3, 0), $4)
+		RETURNING id, created_at
+	`, message.FeedbackID, message.AuthorType, message.AuthorID, message.Text).Scan(&message.ID, &message.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add feedback message: %w", err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return fmt.Errorf("failed to commit feedback message: %w", err)
+	}
+
+	return nil
+}
+
+// GetFeedbackThread возвращает отзыв и переписку по нему.
+func (db *DB) GetFeedbackThread(feedbackID int) (*models.FeedbackThread, error) {
+	thread := &models.FeedbackThread{FeedbackID: feedbackID, Messages: []models.FeedbackMessage{}}
+
+	err := db.conn.QueryRowContext(context.Background(), `
+		SELECT user_id, feedback_text, is_processed FROM user_feedback WHERE id = $1
+	`, feedbackID).Scan(&thread.UserID, &thread.FeedbackText, &thread.IsProcessed)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.ErrFeedbackNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback: %w", err)
+	}
+
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT id, feedback_id, author_type, COALESCE(author_id, 0), message_text, created_at
+		FROM feedback_messages
+		WHERE feedback_id = $1
+		ORDER BY created_at, id
+	`, feedbackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback messages: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	for rows.Next() {
+		var message models.FeedbackMessage
+
+		err := rows.Scan(&message.ID, &message.FeedbackID, &message.AuthorType, &message.AuthorID, &message.Text, &message.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feedback message: %w", err)
+		}
+
+		thread.Messages = append(thread.Messages, message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return thread, nil
+}
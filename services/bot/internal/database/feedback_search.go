@@ -0,0 +1,131 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"language-exchange-bot/internal/models"
+)
+
+// SearchFeedback возвращает отзывы по условиям query. С текстом запроса отзывы ищутся
+// полнотекстово и сортируются по релевантности: запрос разбирается конфигурацией языка
+// query.Language или, если язык не задан, всеми конфигурациями сразу. Отзывы с конфигурацией
+// simple (китайский и прочие языки без морфологии) дополнительно ищутся по подстроке.
+// Без текста отзывы возвращаются от новых к старым.
+func (db *DB) SearchFeedback(query models.FeedbackQuery) ([]models.FeedbackRecord, error) {
+	conditions := []string{"TRUE"}
+	args := []interface{}{}
+
+	add := func(value interface{}) int {
+		args = append(args, value)
+
+		return len(args)
+	}
+
+	rank := "0::real"
+	order := "uf.created_at DESC, uf.id DESC"
+
+	if query.Text != "" {
+		textArg := add(query.Text)
+
+		var tsQuery string
+
+		if query.Language != "" {
+			languageArg := add(query.Language)
+			tsQuery = fmt.Sprintf("websearch_to_tsquery(feedback_search_config($%d), $%d)", languageArg, textArg)
+			conditions = append(conditions, fmt.Sprintf("uf.search_config = feedback_search_config($%d)", languageArg))
+		} else {
+			parts := make([]string, 0, len(models.FeedbackSearchLanguages))
+			for _, lang := range models.FeedbackSearchLanguages {
+				parts = append(parts, fmt.Sprintf("websearch_to_tsquery(feedback_search_config('%s'), $%d)", lang, textArg))
+			}
+
+			tsQuery = "(" + strings.Join(parts, " || ") + ")"
+		}
+
+		conditions = append(conditions, fmt.Sprintf(`(uf.search_vector @@ %s
+			OR (uf.search_config = 'pg_catalog.simple'::regconfig AND strpos(lower(uf.feedback_text), lower($%d)) > 0))`,
+			tsQuery, textArg))
+		rank = fmt.Sprintf("ts_rank(uf.search_vector, %s)", tsQuery)
+		order = "rank DESC, " + order
+	}
+
+	switch query.Status {
+	case models.FeedbackStatusActive:
+		conditions = append(conditions, "COALESCE(uf.is_processed, false) = false")
+	case models.FeedbackStatusProcessed:
+		conditions = append(conditions, "uf.is_processed = true")
+	}
+
+	if query.From != nil {
+		conditions = append(conditions, fmt.Sprintf("uf.created_at >= $%d", add(*query.From)))
+	}
+
+	if query.To != nil {
+		conditions = append(conditions, fmt.Sprintf("uf.created_at < $%d", add(*query.To)))
+	}
+
+	if query.UserID != 0 {
+		conditions = append(conditions, fmt.Sprintf("uf.user_id = $%d", add(query.UserID)))
+	}
+
+	limitArg := add(query.Limit)
+
+	rows, err := db.conn.QueryContext(context.Background(), fmt.Sprintf(`
+		SELECT uf.id, COALESCE(uf.user_id, 0), COALESCE(u.telegram_id, 0), COALESCE(u.username, ''),
+		       COALESCE(u.first_name, ''), COALESCE(u.interface_language_code, ''), uf.feedback_text,
+		       COALESCE(uf.contact_info, ''), COALESCE(uf.is_processed, false), COALESCE(uf.admin_response, ''),
+		       COALESCE(uf.category, ''), uf.priority, COALESCE(uf.assignee_id, 0), uf.sla_due_at,
+		       uf.created_at, %s AS rank
+		FROM user_feedback uf
+		LEFT JOIN users u ON u.id = uf.user_id
+		WHERE %s
+		ORDER BY %s
+		LIMIT $%d
+	`, rank, strings.Join(conditions, " AND "), order, limitArg), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search feedback: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	records := []models.FeedbackRecord{}
+
+	for rows.Next() {
+		var (
+			record models.FeedbackRecord
+			dueAt  sql.NullTime
+		)
+
+		err := rows.Scan(&record.ID, &record.UserID, &record.TelegramID, &record.Username,
+			&record.FirstName, &record.Language, &record.Text,
+			&record.ContactInfo, &record.IsProcessed, &record.AdminResponse,
+			&record.Category, &record.Priority, &record.AssigneeID, &dueAt,
+			&record.CreatedAt, &record.Rank)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feedback record: %w", err)
+		}
+
+		if record.ContactInfo, err = db.decryptPersonalText(record.ContactInfo); err != nil {
+			return nil, err
+		}
+
+		if dueAt.Valid {
+			record.SLADueAt = &dueAt.Time
+		}
+
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,141 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// GetFeedbackTriage возвращает разметку отзыва.
+func (db *DB) GetFeedbackTriage(feedbackID int) (*models.FeedbackTriage, error) {
+	var (
+		triage   models.FeedbackTriage
+		category sql.NullString
+		dueAt    sql.NullTime
+	)
+
+	err := db.conn.QueryRowContext(context.Background(), `
+		SELECT id, category, category_auto, priority, COALESCE(assignee_id, 0), sla_due_at
+		FROM user_feedback
+		WHERE id = $1
+	`, feedbackID).Scan(&triage.FeedbackID, &category, &triage.CategoryAuto, &triage.Priority, &triage.AssigneeID, &dueAt)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.ErrFeedbackNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback triage: %w", err)
+	}
+
+	triage.Category = category.String
+
+	if dueAt.Valid {
+		triage.SLADueAt = &dueAt.Time
+	}
+
+	return &triage, nil
+}
+
+// UpdateFeedbackTriage сохраняет разметку отзыва. Срок обработки пересчитывается от времени
+// создания отзыва как created_at + sla; если срок изменился, уведомление о нарушении сбрасывается.
+func (db *DB) UpdateFeedbackTriage(triage *models.FeedbackTriage, sla time.Duration) error {
+	var dueAt sql.NullTime
+
+	err := db.conn.QueryRowContext(context.Background(), `
+		UPDATE user_feedback
+		SET category = NULLIF($2, ''),
+		    category_auto = $3,
+		    priority = $4,
+		    assignee_id = NULLIF($5, 0),
+		    sla_due_at = created_at + make_interval(secs => $6),
+		    sla_alerted_at = CASE
+		        WHEN sla_due_at IS DISTINCT FROM created_at + make_interval(secs => $6) THEN NULL
+		        ELSE sla_alerted_at
+		    END,
+		    updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING sla_due_at
+	`, triage.FeedbackID, triage.Category, triage.CategoryAuto, triage.Priority, triage.AssigneeID, sla.Seconds()).Scan(&dueAt)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return errors.ErrFeedbackNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to update feedback triage: %w", err)
+	}
+
+	triage.SLADueAt = nil
+	if dueAt.Valid {
+		triage.SLADueAt = &dueAt.Time
+	}
+
+	return nil
+}
+
+// GetFeedbackSLABreaches возвращает необработанные отзывы с истекшим сроком, о которых
+// еще не уведомляли, начиная с самых просроченных.
+func (db *DB) GetFeedbackSLABreaches(limit int) ([]models.FeedbackSLABreach, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT uf.id, uf.feedback_text, COALESCE(uf.category, ''), uf.priority, uf.sla_due_at,
+		       COALESCE(a.telegram_id, 0)
+		FROM user_feedback uf
+		LEFT JOIN users a ON a.id = uf.assignee_id
+		WHERE uf.is_processed = false
+		  AND uf.sla_alerted_at IS NULL
+		  AND uf.sla_due_at < CURRENT_TIMESTAMP
+		ORDER BY uf.sla_due_at
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feedback SLA breaches: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var breaches []models.FeedbackSLABreach
+
+	for rows.Next() {
+		var breach models.FeedbackSLABreach
+
+		err := rows.Scan(&breach.FeedbackID, &breach.FeedbackText, &breach.Category, &breach.Priority,
+			&breach.SLADueAt, &breach.AssigneeTelegramID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan feedback SLA breach: %w", err)
+		}
+
+		breaches = append(breaches, breach)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return breaches, nil
+}
+
+// MarkFeedbackSLAAlerted отмечает, что администраторы уведомлены о нарушении срока отзывов.
+func (db *DB) MarkFeedbackSLAAlerted(feedbackIDs []int) error {
+	if len(feedbackIDs) == 0 {
+		return nil
+	}
+
+	_, err := db.conn.ExecContext(context.Background(), `
+		UPDATE user_feedback SET sla_alerted_at = CURRENT_TIMESTAMP WHERE id = ANY($1)
+	`, pq.Array(feedbackIDs))
+	if err != nil {
+		return fmt.Errorf("failed to mark feedback SLA alerted: %w", err)
+	}
+
+	return nil
+}
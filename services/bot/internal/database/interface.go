@@ -2,6 +2,7 @@ package database
 
 import (
 	"database/sql"
+	"time"
 
 	"language-exchange-bot/internal/models"
 )
@@ -20,6 +21,8 @@ type Database interface {
 	UpdateUserTargetLanguage(userID int, langCode string) error
 	UpdateUserTargetLanguageLevel(userID int, level string) error
 	ResetUserProfile(userID int) error
+	UpdateUserRole(userID int, role string) error
+	AssignAdminRoles(telegramIDs []int64, usernames []string) (int, error)
 
 	// Языки
 	GetLanguages() ([]*models.Language, error)
@@ -38,8 +41,9 @@ type Database interface {
 	GetInterestByID(interestID int) (*models.Interest, error)
 
 	// Обратная связь
-	SaveUserFeedback(userID int, feedbackText string, contactInfo *string) error
+	SaveUserFeedback(userID int, feedbackText string, contactInfo *string) (int, error)
 	GetUnprocessedFeedback() ([]map[string]interface{}, error)
+	GetAllFeedback() ([]map[string]interface{}, error)
 	MarkFeedbackProcessed(feedbackID int, adminResponse string) error
 
 	// Доступность пользователя
@@ -50,6 +54,138 @@ type Database interface {
 	SaveFriendshipPreferences(userID int, preferences *models.FriendshipPreferences) error
 	GetFriendshipPreferences(userID int) (*models.FriendshipPreferences, error)
 
+	// Периоды недоступности (отпуск)
+	SaveUnavailabilityPeriod(userID int, period *models.UnavailabilityPeriod) error
+	GetUnavailabilityPeriods(userID int) ([]models.UnavailabilityPeriod, error)
+	DeleteUnavailabilityPeriod(userID, periodID int) error
+	DeleteExpiredUnavailabilityPeriods() (int64, error)
+	CancelPendingMatches(userID int) (int64, error)
+	CancelPendingMatchesOfUnavailableUsers() (int64, error)
+
+	// Цели изучения языка
+	GetUserLearningGoals(userID int) ([]string, error)
+	SaveUserLearningGoals(userID int, goals []string) error
+	GetLearningGoalStats() ([]models.LearningGoalStat, error)
+
+	// Предложения интересов от пользователей (очередь модерации)
+	CreateInterestSuggestion(suggestion *models.InterestSuggestion) error
+	CountPendingInterestSuggestions(userID int) (int, error)
+	GetInterestSuggestions(status string, limit int) ([]models.InterestSuggestion, error)
+	ApproveInterestSuggestion(suggestionID int, approval models.InterestSuggestionApproval) (int, error)
+	RejectInterestSuggestion(suggestionID int, note string) error
+
+	// Граф связей между интересами
+	GetInterestRelations() ([]models.InterestRelation, error)
+	SaveInterestRelation(relation *models.InterestRelation) error
+	DeleteInterestRelation(relationID int) error
+
+	// Рекомендации интересов по совместному выбору
+	RefreshInterestCooccurrences(minUsers int) (int64, error)
+	GetTopInterestCooccurrences(limit int) ([]models.InterestCooccurrence, error)
+	GetInterestRecommendations(interestIDs []int, limit int) ([]models.InterestRecommendation, error)
+
+	// Управление каталогом интересов через admin API
+	GetInterestCatalog() (*models.InterestCatalog, error)
+	GetInterestCategoryItems() ([]models.InterestCategoryItem, error)
+	CreateInterestCategory(input models.InterestCategoryInput) (int, error)
+	UpdateInterestCategory(categoryID int, input models.InterestCategoryInput) error
+	CreateInterest(input models.InterestInput) (int, error)
+	UpdateInterest(interestID int, input models.InterestInput) error
+
+	// Админ-панель: поиск пользователей и история действий администраторов в карточке (не журнал аудита)
+	GetUserByID(userID int) (*models.User, error)
+	SearchUsers(query string, limit int) ([]*models.User, error)
+	GetUserFeedbackByUserID(userID int) ([]map[string]interface{}, error)
+	CreateAdminActionLog(entry *models.AdminActionLog) error
+	GetAdminActionLogs(targetType string, targetID int, limit int) ([]models.AdminActionLog, error)
+
+	// Анонсы для сегментов пользователей
+	CreateAnnouncement(announcement *models.Announcement) error
+	GetAnnouncement(announcementID int) (*models.Announcement, error)
+	GetAnnouncements(limit int) ([]models.Announcement, error)
+	CountAnnouncementRecipients(segment models.AnnouncementSegment) (int, error)
+	QueueAnnouncementDeliveries(announcementID int) (int, error)
+	GetPendingAnnouncementDeliveries(announcementID int, limit int) ([]models.AnnouncementRecipient, error)
+	RecordAnnouncementDelivery(announcementID, userID int, status, deliveryError string) error
+	GetAnnouncementDeliveries(announcementID int, status string, limit int) ([]models.AnnouncementDelivery, error)
+	FinishAnnouncement(announcementID int) error
+	CancelAnnouncement(announcementID int) error
+	SetUserActive(userID int, active bool) error
+
+	// API-ключи admin API
+	CreateAPIKey(key *models.APIKey) error
+	GetAPIKey(keyID int) (*models.APIKey, error)
+	GetAPIKeyByHash(keyHash string) (*models.APIKey, error)
+	GetAPIKeys() ([]models.APIKey, error)
+	RotateAPIKey(oldKeyID int, newKey *models.APIKey, oldExpiresAt time.Time) error
+	RevokeAPIKey(keyID int) error
+	TouchAPIKey(keyID int, interval time.Duration) error
+
+	// Сессии admin API (refresh-токены)
+	CreateAdminSession(session *models.AdminSession) error
+	GetAdminSessionByHash(tokenHash string) (*models.AdminSession, error)
+	RotateAdminSession(oldSessionID int, newSession *models.AdminSession) error
+	RevokeAdminSession(sessionID int) error
+
+	// Журнал аудита
+	AppendAuditEvent(event *models.AuditEvent) error
+	GetAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error)
+	GetAuditChain(afterID int64, limit int) ([]models.AuditEvent, error)
+	EnsureAuditPseudonym(userID int, candidate string) (string, error)
+
+	// Переписка по отзывам
+	AddFeedbackMessage(message *models.FeedbackMessage) error
+	GetFeedbackThread(feedbackID int) (*models.FeedbackThread, error)
+
+	// Разметка отзывов и SLA
+	GetFeedbackTriage(feedbackID int) (*models.FeedbackTriage, error)
+	UpdateFeedbackTriage(triage *models.FeedbackTriage, sla time.Duration) error
+	GetFeedbackSLABreaches(limit int) ([]models.FeedbackSLABreach, error)
+	MarkFeedbackSLAAlerted(feedbackIDs []int) error
+
+	// Поиск и выгрузка отзывов
+	SearchFeedback(query models.FeedbackQuery) ([]models.FeedbackRecord, error)
+
+	// Вложения отзывов
+	AddFeedbackAttachments(feedbackID int, attachments []models.FeedbackAttachment) error
+	GetFeedbackAttachments(feedbackID int) ([]models.FeedbackAttachment, error)
+
+	// Опросы
+	CreateSurvey(survey *models.Survey) error
+	GetSurvey(surveyID int) (*models.Survey, error)
+	GetSurveys(limit int) ([]models.Survey, error)
+	CountSurveyRecipients(segment models.AnnouncementSegment, frequency models.SurveyFrequency) (int, error)
+	QueueSurveyDeliveries(surveyID int, frequency models.SurveyFrequency) (int, error)
+	GetPendingSurveyDeliveries(surveyID int, limit int) ([]models.SurveyRecipient, error)
+	RecordSurveyDelivery(surveyID, userID int, status, deliveryError string) error
+	FinishSurvey(surveyID int) error
+	CancelSurvey(surveyID int) error
+	GetDueSurveyIDs(now time.Time) ([]int, error)
+	IsSurveyRecipient(surveyID, userID int) (bool, error)
+	SaveSurveyAnswer(answer *models.SurveyAnswer) error
+	GetSurveyAnswerCounts(surveyID int) ([]models.SurveyAnswerCount, error)
+	GetSurveyParticipation(surveyID, questionCount int) (models.SurveyParticipation, error)
+
+	// Выгрузка данных пользователя
+	GetUserLanguagePairs(userID int) ([]models.UserLanguagePair, error)
+	GetUserMatches(userID int) ([]models.UserMatch, error)
+	GetUserSurveyAnswers(userID int) ([]models.SurveyAnswer, error)
+
+	// Удаление аккаунта пользователем
+	DeleteUserAccount(userID int) (*models.AccountDeletionResult, error)
+	GetAccountDeletionStats(since time.Time) (models.AccountDeletionStats, error)
+
+	// Сроки хранения данных
+	CountRetentionCandidates(dataType string, cutoff time.Time) (int64, error)
+	PurgeRetentionBatch(dataType string, cutoff time.Time, limit int) (int64, error)
+	GetRetentionUsers(cutoff time.Time, limit int) ([]*models.User, error)
+
+	// Пользователи других платформ
+	FindOrCreatePlatformUser(platform, externalID, username, firstName string) (*models.User, error)
+
+	// Шифрование персональных данных
+	ReencryptPersonalData(limit int) (int, error)
+
 	// Соединение
 	GetConnection() *sql.DB
 	Close() error
@@ -0,0 +1,166 @@
+package database
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"language-exchange-bot/internal/errors"
+)
+
+// encryptionKeySize - размер ключа шифрования ключей (KEK): AES-256.
+const encryptionKeySize = 32
+
+// keyIDPattern - допустимые идентификаторы ключей: идентификатор хранится в зашифрованном значении
+// между двоеточиями, поэтому сам двоеточий содержать не может.
+var keyIDPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// KeyRing - набор ключей шифрования ключей. Новые значения шифруются активным ключом,
+// остальные нужны, чтобы читать значения, зашифрованные до ротации.
+type KeyRing struct {
+	ActiveID string
+	Keys     map[string][]byte
+}
+
+// KeyProvider загружает набор ключей шифрования персональных данных.
+type KeyProvider interface {
+	LoadKeys() (*KeyRing, error)
+}
+
+// KeyFileProvider читает ключи из локального файла: по строке "<id>=<ключ в base64>" на ключ,
+// пустые строки и строки с # пропускаются. Активным считается ActiveID, а если он не задан -
+// последний ключ файла: для ротации достаточно дописать новый ключ в конец.
+type KeyFileProvider struct {
+	Path     string
+	ActiveID string
+}
+
+// LoadKeys читает и проверяет ключи из файла.
+func (p KeyFileProvider) LoadKeys() (*KeyRing, error) {
+	content, err := os.ReadFile(filepath.Clean(p.Path))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errors.ErrInvalidEncryptionKeys, err)
+	}
+
+	ring := &KeyRing{Keys: map[string][]byte{}}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNumber := 0
+
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d of %s is not <id>=<key>", errors.ErrInvalidEncryptionKeys, lineNumber, p.Path)
+		}
+
+		id = strings.TrimSpace(id)
+		if err := ring.add(id, strings.TrimSpace(encoded)); err != nil {
+			return nil, err
+		}
+
+		ring.ActiveID = id
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", errors.ErrInvalidEncryptionKeys, err)
+	}
+
+	return ring.withActive(p.ActiveID)
+}
+
+// SecretDirKeyProvider читает ключи из смонтированного секрета (Docker secrets, Kubernetes Secret):
+// каждый файл каталога - ключ в base64, имя файла - его идентификатор. Скрытые файлы пропускаются.
+// Активным считается ActiveID, а если он не задан - последний идентификатор по алфавиту:
+// удобно называть ключи датой, например 2026-10.
+type SecretDirKeyProvider struct {
+	Dir      string
+	ActiveID string
+}
+
+// LoadKeys читает и проверяет ключи из каталога.
+func (p SecretDirKeyProvider) LoadKeys() (*KeyRing, error) {
+	entries, err := os.ReadDir(filepath.Clean(p.Dir))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errors.ErrInvalidEncryptionKeys, err)
+	}
+
+	ring := &KeyRing{Keys: map[string][]byte{}}
+	ids := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		path := filepath.Join(p.Dir, entry.Name())
+
+		// Файлы секретов Kubernetes - символические ссылки, поэтому проверяем цель ссылки
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errors.ErrInvalidEncryptionKeys, err)
+		}
+
+		if err := ring.add(entry.Name(), strings.TrimSpace(string(content))); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, entry.Name())
+	}
+
+	if len(ids) > 0 {
+		sort.Strings(ids)
+		ring.ActiveID = ids[len(ids)-1]
+	}
+
+	return ring.withActive(p.ActiveID)
+}
+
+// add проверяет и добавляет ключ в набор.
+func (r *KeyRing) add(id, encoded string) error {
+	if !keyIDPattern.MatchString(id) {
+		return fmt.Errorf("%w: invalid key id %q", errors.ErrInvalidEncryptionKeys, id)
+	}
+
+	if _, exists := r.Keys[id]; exists {
+		return fmt.Errorf("%w: duplicate key id %q", errors.ErrInvalidEncryptionKeys, id)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(key) != encryptionKeySize {
+		return fmt.Errorf("%w: key %q must be %d bytes in base64", errors.ErrInvalidEncryptionKeys, id, encryptionKeySize)
+	}
+
+	r.Keys[id] = key
+
+	return nil
+}
+
+// withActive назначает активный ключ, если он задан явно, и проверяет, что он есть в наборе.
+func (r *KeyRing) withActive(activeID string) (*KeyRing, error) {
+	if activeID != "" {
+		r.ActiveID = activeID
+	}
+
+	if _, ok := r.Keys[r.ActiveID]; !ok {
+		return nil, fmt.Errorf("%w: active key %q not found", errors.ErrInvalidEncryptionKeys, r.ActiveID)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,155 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"language-exchange-bot/internal/models"
+)
+
+// retentionTarget - таблица и условие, по которым отбираются устаревшие строки одного типа данных.
+type retentionTarget struct {
+	table     string
+	condition string // Условие с параметром $1 - границей срока хранения
+}
+
+// retentionTargets - что считается устаревшим для каждого типа данных.
+// Неактивным считается обычный пользователь, профиль которого не обновлялся с границы:
+// updated_at обновляется при каждом обращении к боту.
+var retentionTargets = map[string]retentionTarget{
+	models.RetentionFeedback: {
+		table:     "user_feedback",
+		condition: "is_processed AND COALESCE(updated_at, created_at) < $1",
+	},
+	models.RetentionInactiveUsers: {
+		table:     "users",
+		condition: "role = 'user' AND COALESCE(updated_at, created_at) < $1",
+	},
+	models.RetentionMatches: {
+		table:     "match_queue",
+		condition: "status = 'cancelled' AND found_at < $1",
+	},
+}
+
+// lookupRetentionTarget возвращает описание типа данных или ошибку для неизвестного типа.
+func lookupRetentionTarget(dataType string) (retentionTarget, error) {
+	target, ok := retentionTargets[dataType]
+	if !ok {
+		return target, fmt.Errorf("unknown retention data type %q", dataType)
+	}
+
+	return target, nil
+}
+
+// CountRetentionCandidates возвращает число строк типа dataType, срок хранения которых истек до cutoff.
+func (db *DB) CountRetentionCandidates(dataType string, cutoff time.Time) (int64, error) {
+	target, err := lookupRetentionTarget(dataType)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", target.table, target.condition)
+	if err := db.conn.QueryRowContext(context.Background(), query, cutoff).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count %s retention candidates: %w", dataType, err)
+	}
+
+	return count, nil
+}
+
+// GetRetentionUsers возвращает не больше limit неактивных с cutoff пользователей (ID и Telegram ID).
+// Пользователи удаляются по одному через DeleteUserAccount, а не пачкой.
+func (db *DB) GetRetentionUsers(cutoff time.Time, limit int) ([]*models.User, error) {
+	target, err := lookupRetentionTarget(models.RetentionInactiveUsers)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.conn.QueryContext(context.Background(),
+		fmt.Sprintf("SELECT id, COALESCE(telegram_id, 0) FROM %s WHERE %s ORDER BY id LIMIT $2", target.table, target.condition),
+		cutoff, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select inactive users: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	users := make([]*models.User, 0, limit)
+
+	for rows.Next() {
+		user := &models.User{}
+		if err := rows.Scan(&user.ID, &user.TelegramID); err != nil {
+			return nil, fmt.Errorf("failed to scan inactive user: %w", err)
+		}
+
+		users = append(users, user)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate inactive users: %w", err)
+	}
+
+	return users, nil
+}
+
+// PurgeRetentionBatch удаляет не больше limit строк типа dataType, срок хранения которых истек
+// до cutoff, и возвращает число удаленных строк. Неактивные пользователи так не удаляются:
+// для них нужны уведомление собеседников и очистка кэша (см. GetRetentionUsers).
+func (db *DB) PurgeRetentionBatch(dataType string, cutoff time.Time, limit int) (int64, error) {
+	if dataType == models.RetentionInactiveUsers {
+		return 0, fmt.Errorf("%s are purged per account, not in batches", dataType)
+	}
+
+	target, err := lookupRetentionTarget(dataType)
+	if err != nil {
+		return 0, err
+	}
+
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	var ids []int64
+
+	query := fmt.Sprintf(
+		"SELECT COALESCE(array_agg(id), '{}') FROM (SELECT id FROM %s WHERE %s ORDER BY id LIMIT $2 FOR UPDATE SKIP LOCKED) batch",
+		target.table, target.condition,
+	)
+	if err := transaction.QueryRowContext(context.Background(), query, cutoff, limit).Scan(pq.Array(&ids)); err != nil {
+		return 0, fmt.Errorf("failed to select %s retention batch: %w", dataType, err)
+	}
+
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	deleted, err := transaction.ExecContext(context.Background(),
+		fmt.Sprintf("DELETE FROM %s WHERE id = ANY($1)", target.table), pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge %s: %w", dataType, err)
+	}
+
+	count, err := deleted.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count purged %s: %w", dataType, err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,458 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	stdErrors "errors"
+	"fmt"
+	"time"
+
+	"language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// surveyColumns - поля опроса вместе со счетчиками доставки и ответов.
+const surveyColumns = `
+	s.id, s.title, s.kind, s.questions, s.segment, s.status, s.scheduled_at, s.recipients_count,
+	COALESCE(s.created_by, 0), s.created_at, s.sent_at,
+	(SELECT COUNT(*) FROM survey_deliveries d WHERE d.survey_id = s.id AND d.status = 'sent'),
+	(SELECT COUNT(DISTINCT a.user_id) FROM survey_answers a WHERE a.survey_id = s.id)`
+
+// scanSurvey сканирует опрос, выбранный с полями surveyColumns.
+func scanSurvey(row rowScanner) (*models.Survey, error) {
+	var (
+		survey        models.Survey
+		questionsJSON []byte
+		segmentJSON   []byte
+		scheduledAt   sql.NullTime
+		sentAt        sql.NullTime
+	)
+
+	err := row.Scan(
+		&survey.ID, &survey.Title, &survey.Kind, &questionsJSON, &segmentJSON, &survey.Status, &scheduledAt,
+		&survey.RecipientsCount, &survey.CreatedBy, &survey.CreatedAt, &sentAt,
+		&survey.SentCount, &survey.RespondedCount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan survey: %w", err)
+	}
+
+	if err := json.Unmarshal(questionsJSON, &survey.Questions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal survey questions: %w", err)
+	}
+
+	if err := json.Unmarshal(segmentJSON, &survey.Segment); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal survey segment: %w", err)
+	}
+
+	if scheduledAt.Valid {
+		survey.ScheduledAt = &scheduledAt.Time
+	}
+
+	if sentAt.Valid {
+		survey.SentAt = &sentAt.Time
+	}
+
+	return &survey, nil
+}
+
+// CreateSurvey сохраняет опрос: черновик или запланированный, если задано время запуска.
+func (db *DB) CreateSurvey(survey *models.Survey) error {
+	questionsJSON, err := json.Marshal(survey.Questions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal survey questions: %w", err)
+	}
+
+	segmentJSON, err := json.Marshal(survey.Segment)
+	if err != nil {
+		return fmt.Errorf("failed to marshal survey segment: %w", err)
+	}
+
+	err = db.conn.QueryRowContext(context.Background(), `
+		INSERT INTO surveys (title, kind, questions, segment, status, scheduled_at, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, 0))
+		RETURNING id, created_at
+	`, survey.Title, survey.Kind, string(questionsJSON), string(segmentJSON), survey.Status, survey.ScheduledAt, survey.CreatedBy).Scan(
+		&survey.ID, &survey.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create survey: %w", err)
+	}
+
+	return nil
+}
+
+// GetSurvey возвращает опрос со счетчиками доставки и ответов.
+func (db *DB) GetSurvey(surveyID int) (*models.Survey, error) {
+	row := db.conn.QueryRowContext(context.Background(), `
+		SELECT `+surveyColumns+`
+		FROM surveys s
+		WHERE s.id = $1
+	`, surveyID)
+
+	survey, err := scanSurvey(row)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return nil, errors.ErrSurveyNotFound
+	}
+
+	return survey, err
+}
+
+// GetSurveys возвращает последние опросы, новые первыми.
+func (db *DB) GetSurveys(limit int) ([]models.Survey, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT `+surveyColumns+`
+		FROM surveys s
+		ORDER BY s.created_at DESC, s.id DESC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get surveys: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	surveys := []models.Survey{}
+
+	for rows.Next() {
+		survey, err := scanSurvey(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		surveys = append(surveys, *survey)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return surveys, nil
+}
+
+// CountSurveyRecipients считает активных пользователей сегмента, которым правила частоты
+// сейчас позволяют отправить опрос.
+func (db *DB) CountSurveyRecipients(segment models.AnnouncementSegment, frequency models.SurveyFrequency) (int, error) {
+	where, args := surveySegmentFilter(segment, frequency)
+
+	var count int
+	if err := db.conn.QueryRowContext(context.Background(), `SELECT COUNT(*) FROM users WHERE `+where, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count survey recipients: %w", err)
+	}
+
+	return count, nil
+}
+
+// QueueSurveyDeliveries переводит опрос в статус sending и создает записи доставки для
+// получателей сегмента, которых не ограничивают правила частоты. Для уже рассылаемого опроса
+// очередь не пересобирается. Возвращает число получателей.
+func (db *DB) QueueSurveyDeliveries(surveyID int, frequency models.SurveyFrequency) (int, error) {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	var (
+		status      string
+		segmentJSON []byte
+	)
+
+	err = transaction.QueryRowContext(context.Background(), `
+		SELECT status, segment FROM surveys WHERE id = $1 FOR UPDATE
+	`, surveyID).Scan(&status, &segmentJSON)
+	if stdErrors.Is(err, sql.ErrNoRows) {
+		return 0, errors.ErrSurveyNotFound
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock survey: %w", err)
+	}
+
+	switch status {
+	case models.SurveyStatusDraft, models.SurveyStatusScheduled:
+		var segment models.AnnouncementSegment
+		if err := json.Unmarshal(segmentJSON, &segment); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal survey segment: %w", err)
+		}
+
+		where, args := surveySegmentFilter(segment, frequency, surveyID)
+		if _, err := transaction.ExecContext(context.Background(), `
+			INSERT INTO survey_deliveries (survey_id, user_id)
+			SELECT $1, id FROM users WHERE `+where+`
+			ON CONFLICT DO NOTHING
+		`, args...); err != nil {
+			return 0, fmt.Errorf("failed to queue survey deliveries: %w", err)
+		}
+	case models.SurveyStatusSending:
+		// Продолжение прерванной рассылки: очередь уже создана
+	default:
+		return 0, errors.ErrSurveyNotSendable
+	}
+
+	var recipients int
+
+	err = transaction.QueryRowContext(context.Background(), `
+		UPDATE surveys
+		SET status = $2,
+		    recipients_count = (SELECT COUNT(*) FROM survey_deliveries WHERE survey_id = $1)
+		WHERE id = $1
+		RETURNING recipients_count
+	`, surveyID, models.SurveyStatusSending).Scan(&recipients)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start survey: %w", err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// GetPendingSurveyDeliveries возвращает получателей, которым опрос еще не отправлялся.
+func (db *DB) GetPendingSurveyDeliveries(surveyID int, limit int) ([]models.SurveyRecipient, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT d.user_id, COALESCE(u.telegram_id, 0), COALESCE(u.interface_language_code, '')
+		FROM survey_deliveries d
+		JOIN users u ON u.id = d.user_id
+		WHERE d.survey_id = $1 AND d.status = $2
+		ORDER BY d.user_id
+		LIMIT $3
+	`, surveyID, models.DeliveryStatusPending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending survey deliveries: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var recipients []models.SurveyRecipient
+
+	for rows.Next() {
+		var recipient models.SurveyRecipient
+		if err := rows.Scan(&recipient.UserID, &recipient.TelegramID, &recipient.Language); err != nil {
+			return nil, fmt.Errorf("failed to scan survey recipient: %w", err)
+		}
+
+		recipients = append(recipients, recipient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// RecordSurveyDelivery сохраняет результат доставки опроса получателю.
+func (db *DB) RecordSurveyDelivery(surveyID, userID int, status, deliveryError string) error {
+	_, err := db.conn.ExecContext(context.Background(), `
+		UPDATE survey_deliveries
+		SET status = $3, error = NULLIF($4, ''), attempted_at = NOW()
+		WHERE survey_id = $1 AND user_id = $2
+	`, surveyID, userID, status, deliveryError)
+	if err != nil {
+		return fmt.Errorf("failed to record survey delivery: %w", err)
+	}
+
+	return nil
+}
+
+// FinishSurvey помечает рассылку опроса завершенной.
+func (db *DB) FinishSurvey(surveyID int) error {
+	_, err := db.conn.ExecContext(context.Background(), `
+		UPDATE surveys SET status = $2, sent_at = NOW()
+		WHERE id = $1 AND status = $3
+	`, surveyID, models.SurveyStatusSent, models.SurveyStatusSending)
+	if err != nil {
+		return fmt.Errorf("failed to finish survey: %w", err)
+	}
+
+	return nil
+}
+
+// CancelSurvey отменяет черновик, запланированный опрос или идущую рассылку.
+func (db *DB) CancelSurvey(surveyID int) error {
+	result, err := db.conn.ExecContext(context.Background(), `
+		UPDATE surveys SET status = $2
+		WHERE id = $1 AND status IN ($3, $4, $5)
+	`, surveyID, models.SurveyStatusCancelled, models.SurveyStatusDraft, models.SurveyStatusScheduled, models.SurveyStatusSending)
+	if err != nil {
+		return fmt.Errorf("failed to cancel survey: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check cancelled survey: %w", err)
+	}
+
+	if affected == 0 {
+		return errors.ErrSurveyNotSendable
+	}
+
+	return nil
+}
+
+// GetDueSurveyIDs возвращает запланированные опросы, время запуска которых наступило к now.
+func (db *DB) GetDueSurveyIDs(now time.Time) ([]int, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT id FROM surveys
+		WHERE status = $1 AND scheduled_at <= $2
+		ORDER BY scheduled_at, id
+	`, models.SurveyStatusScheduled, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due surveys: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	var surveyIDs []int
+
+	for rows.Next() {
+		var surveyID int
+		if err := rows.Scan(&surveyID); err != nil {
+			return nil, fmt.Errorf("failed to scan survey ID: %w", err)
+		}
+
+		surveyIDs = append(surveyIDs, surveyID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return surveyIDs, nil
+}
+
+// IsSurveyRecipient сообщает, доставлен ли опрос пользователю.
+func (db *DB) IsSurveyRecipient(surveyID, userID int) (bool, error) {
+	var delivered bool
+
+	err := db.conn.QueryRowContext(context.Background(), `
+		SELECT EXISTS (
+			SELECT 1 FROM survey_deliveries WHERE survey_id = $1 AND user_id = $2 AND status = $3
+		)
+	`, surveyID, userID, models.DeliveryStatusSent).Scan(&delivered)
+	if err != nil {
+		return false, fmt.Errorf("failed to check survey recipient: %w", err)
+	}
+
+	return delivered, nil
+}
+
+// SaveSurveyAnswer сохраняет ответ на вопрос опроса; повторный ответ заменяет предыдущий.
+func (db *DB) SaveSurveyAnswer(answer *models.SurveyAnswer) error {
+	err := db.conn.QueryRowContext(context.Background(), `
+		INSERT INTO survey_answers (survey_id, user_id, question_index, value)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (survey_id, user_id, question_index)
+		DO UPDATE SET value = EXCLUDED.value, answered_at = NOW()
+		RETURNING answered_at
+	`, answer.SurveyID, answer.UserID, answer.QuestionIndex, answer.Value).Scan(&answer.AnsweredAt)
+	if err != nil {
+		return fmt.Errorf("failed to save survey answer: %w", err)
+	}
+
+	return nil
+}
+
+// GetSurveyAnswerCounts возвращает количество ответов по вопросам и значениям.
+func (db *DB) GetSurveyAnswerCounts(surveyID int) ([]models.SurveyAnswerCount, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT question_index, value, COUNT(*)
+		FROM survey_answers
+		WHERE survey_id = $1
+		GROUP BY question_index, value
+		ORDER BY question_index, value
+	`, surveyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get survey answer counts: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	counts := []models.SurveyAnswerCount{}
+
+	for rows.Next() {
+		var count models.SurveyAnswerCount
+		if err := rows.Scan(&count.QuestionIndex, &count.Value, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan survey answer count: %w", err)
+		}
+
+		counts = append(counts, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return counts, nil
+}
+
+// GetSurveyParticipation возвращает охват опроса: сколько получили, ответили хотя бы на один вопрос
+// и ответили на все questionCount вопросов.
+func (db *DB) GetSurveyParticipation(surveyID, questionCount int) (models.SurveyParticipation, error) {
+	var participation models.SurveyParticipation
+
+	err := db.conn.QueryRowContext(context.Background(), `
+		SELECT
+			(SELECT COUNT(*) FROM survey_deliveries WHERE survey_id = $1 AND status = $3),
+			COUNT(*),
+			COUNT(*) FILTER (WHERE answers >= $2)
+		FROM (
+			SELECT user_id, COUNT(*) AS answers
+			FROM survey_answers
+			WHERE survey_id = $1
+			GROUP BY user_id
+		) respondents
+	`, surveyID, questionCount, models.DeliveryStatusSent).Scan(
+		&participation.Sent, &participation.Responded, &participation.Completed,
+	)
+	if err != nil {
+		return participation, fmt.Errorf("failed to get survey participation: %w", err)
+	}
+
+	return participation, nil
+}
+
+// surveySegmentFilter строит условие WHERE по таблице users для сегмента опроса с правилами
+// частоты: пользователь не получал опрос за Cooldown и получил меньше MaxInWindow опросов за Window.
+// Параметры нумеруются после переданных args.
+func surveySegmentFilter(segment models.AnnouncementSegment, frequency models.SurveyFrequency, args ...interface{}) (string, []interface{}) {
+	where, args := announcementSegmentFilter(segment, args...)
+
+	args = append(args, frequency.Cooldown.Seconds(), frequency.Window.Seconds(), frequency.MaxInWindow)
+	cooldown, window, maxInWindow := len(args)-2, len(args)-1, len(args)
+
+	where += fmt.Sprintf(` AND NOT EXISTS (
+		SELECT 1 FROM survey_deliveries recent
+		WHERE recent.user_id = users.id AND recent.status = 'sent'
+		  AND recent.attempted_at > NOW() - $%d * INTERVAL '1 second'
+	) AND (
+		SELECT COUNT(*) FROM survey_deliveries recent
+		WHERE recent.user_id = users.id AND recent.status = 'sent'
+		  AND recent.attempted_at > NOW() - $%d * INTERVAL '1 second'
+	) < $%d`, cooldown, window, maxInWindow)
+
+	return where, args
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"language-exchange-bot/internal/models"
+)
+
+// GetUserLanguagePairs возвращает языковые пары пользователя с кодами языков.
+func (db *DB) GetUserLanguagePairs(userID int) ([]models.UserLanguagePair, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT COALESCE(native.code, ''), COALESCE(target.code, ''), COALESCE(p.target_level, ''), p.created_at
+		FROM user_language_pairs p
+		LEFT JOIN languages native ON native.id = p.native_language_id
+		LEFT JOIN languages target ON target.id = p.target_language_id
+		WHERE p.user_id = $1
+		ORDER BY p.created_at, p.id
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user language pairs: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	pairs := []models.UserLanguagePair{}
+
+	for rows.Next() {
+		var pair models.UserLanguagePair
+		if err := rows.Scan(&pair.NativeLanguageCode, &pair.TargetLanguageCode, &pair.TargetLevel, &pair.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan user language pair: %w", err)
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return pairs, nil
+}
+
+// GetUserMatches возвращает найденных для пользователя собеседников из очереди совпадений.
+func (db *DB) GetUserMatches(userID int) ([]models.UserMatch, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT id,
+		       CASE WHEN user1_id = $1 THEN COALESCE(user2_id, 0) ELSE COALESCE(user1_id, 0) END,
+		       COALESCE(compatibility_score, 0), COALESCE(status, ''), found_at, sent_at
+		FROM match_queue
+		WHERE user1_id = $1 OR user2_id = $1
+		ORDER BY found_at DESC, id DESC
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user matches: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	matches := []models.UserMatch{}
+
+	for rows.Next() {
+		var (
+			match  models.UserMatch
+			sentAt sql.NullTime
+		)
+
+		if err := rows.Scan(&match.ID, &match.PartnerUserID, &match.CompatibilityScore, &match.Status, &match.FoundAt, &sentAt); err != nil {
+			return nil, fmt.Errorf("failed to scan user match: %w", err)
+		}
+
+		if sentAt.Valid {
+			match.SentAt = &sentAt.Time
+		}
+
+		matches = append(matches, match)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return matches, nil
+}
+
+// GetUserSurveyAnswers возвращает ответы пользователя на опросы.
+func (db *DB) GetUserSurveyAnswers(userID int) ([]models.SurveyAnswer, error) {
+	rows, err := db.conn.QueryContext(context.Background(), `
+		SELECT survey_id, user_id, question_index, value, answered_at
+		FROM survey_answers
+		WHERE user_id = $1
+		ORDER BY answered_at, survey_id, question_index
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user survey answers: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			db.logger.ErrorWithContext("Failed to close database rows", "", 0, 0, "DatabaseOperation", map[string]interface{}{"error": closeErr.Error()})
+		}
+	}()
+
+	answers := []models.SurveyAnswer{}
+
+	for rows.Next() {
+		var answer models.SurveyAnswer
+		if err := rows.Scan(&answer.SurveyID, &answer.UserID, &answer.QuestionIndex, &answer.Value, &answer.AnsweredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan survey answer: %w", err)
+		}
+
+		answers = append(answers, answer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("operation failed: %w", err)
+	}
+
+	return answers, nil
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+	"fmt"
+
+	"language-exchange-bot/internal/models"
+)
+
+// FindOrCreatePlatformUser находит или регистрирует пользователя платформы кроме Telegram
+// по его ID на платформе. telegram_id у такого пользователя нет (NULL, в модели 0): адресат
+// на платформе определяется только по user_identities.
+func (db *DB) FindOrCreatePlatformUser(platform, externalID, username, firstName string) (*models.User, error) {
+	transaction, err := db.conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		_ = transaction.Rollback()
+	}()
+
+	var userID int
+
+	err = transaction.QueryRowContext(context.Background(), `
+		SELECT user_id FROM user_identities WHERE platform = $1 AND external_id = $2
+	`, platform, externalID).Scan(&userID)
+
+	switch {
+	case stdErrors.Is(err, sql.ErrNoRows):
+		if userID, err = db.createPlatformUser(transaction, platform, externalID); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, fmt.Errorf("failed to find platform user: %w", err)
+	}
+
+	user := &models.User{
+		Interests: []int{},
+		FriendshipPreferences: &models.FriendshipPreferences{
+			ActivityType:        "casual_chat",
+			CommunicationStyles: []string{"text"},
+			CommunicationFreq:   "weekly",
+		},
+	}
+
+	err = transaction.QueryRowContext(context.Background(), `
+		UPDATE users SET username = $2, first_name = $3, is_active = TRUE, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING id, COALESCE(telegram_id, 0), username, first_name,
+		COALESCE(native_language_code, ''), COALESCE(target_language_code, ''),
+		COALESCE(target_language_level, ''), interface_language_code, created_at, updated_at,
+		state, profile_completion_level, status, role, is_active, platform
+	`, userID, username, firstName).Scan(
+		&user.ID, &user.TelegramID, &user.Username, &user.FirstName,
+		&user.NativeLanguageCode, &user.TargetLanguageCode, &user.TargetLanguageLevel,
+		&user.InterfaceLanguageCode, &user.CreatedAt, &user.UpdatedAt,
+		&user.State, &user.ProfileCompletionLevel, &user.Status, &user.Role, &user.IsActive, &user.Platform,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update platform user: %w", err)
+	}
+
+	if err := transaction.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return user, nil
+}
+
+// createPlatformUser создает пользователя платформы и связывает его с ID на платформе.
+func (db *DB) createPlatformUser(transaction *sql.Tx, platform, externalID string) (int, error) {
+	var userID int
+
+	err := transaction.QueryRowContext(context.Background(), `
+		INSERT INTO users (platform, interface_language_code)
+		VALUES ($1, 'en')
+		RETURNING id
+	`, platform).Scan(&userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create platform user: %w", err)
+	}
+
+	if _, err := transaction.ExecContext(context.Background(), `
+		INSERT INTO user_identities (platform, external_id, user_id) VALUES ($1, $2, $3)
+	`, platform, externalID, userID); err != nil {
+		return 0, fmt.Errorf("failed to link platform user: %w", err)
+	}
+
+	return userID, nil
+}
@@ -174,6 +174,11 @@ var (
 		ErrorTypeValidation, "достигнут максимум основных интересов",
 		"Достигнут максимум основных интересов", "",
 	)
+	// ErrMaxPrimaryPerCategoryReached - ошибка валидации.
+	ErrMaxPrimaryPerCategoryReached = NewCustomError(
+		ErrorTypeValidation, "достигнут максимум основных интересов в категории",
+		"Достигнут максимум основных интересов в категории", "",
+	)
 	// ErrMinPrimaryInterestsRequired - ошибка валидации.
 	ErrMinPrimaryInterestsRequired = NewCustomError(
 		ErrorTypeValidation, "необходимо выбрать минимум основных интересов",
@@ -182,6 +187,10 @@ var (
 
 	// ErrUnsafeFilePath - ошибка файловой системы.
 	ErrUnsafeFilePath = NewCustomError(ErrorTypeInternal, "небезопасный путь к файлу", "Ошибка доступа к файлу", "")
+	// ErrLegacyInterestsConfigKey - ключ interests.json в прежнем написании camelCase.
+	ErrLegacyInterestsConfigKey = NewCustomError(
+		ErrorTypeInternal, "ключ interests.json в camelCase", "Ошибка конфигурации интересов", "",
+	)
 
 	// ErrFeedbackTooShort - ошибка отзывов.
 	ErrFeedbackTooShort = NewCustomError(
@@ -197,6 +206,17 @@ var (
 	// ErrUserNotFound - ошибка пользователей.
 	ErrUserNotFound = NewCustomError(ErrorTypeDatabase, "пользователь не найден", "Пользователь не найден", "")
 
+	// ErrInvalidUserRole - неизвестная роль пользователя.
+	ErrInvalidUserRole = NewCustomError(
+		ErrorTypeValidation, "неизвестная роль пользователя", "Роль должна быть user, moderator или admin", "",
+	)
+	// ErrPermissionDenied - у роли пользователя нет нужного права.
+	ErrPermissionDenied = NewCustomError(ErrorTypeValidation, "недостаточно прав", "Недостаточно прав для этого действия", "")
+	// ErrInvalidAdminAction - статус или состояние нельзя назначить через админ-панель.
+	ErrInvalidAdminAction = NewCustomError(
+		ErrorTypeValidation, "недопустимое действие администратора", "Это значение нельзя назначить пользователю", "",
+	)
+
 	// ErrTelegramAPIRateLimit - ошибка тестов.
 	ErrTelegramAPIRateLimit = NewCustomError(
 		ErrorTypeTelegramAPI, "превышен лимит запросов Telegram API", "Превышен лимит запросов", "",
@@ -218,6 +238,170 @@ var (
 		ErrorTypeCache, "ошибка подключения к Redis", "Ошибка подключения к кэшу", "",
 	)
 
+	// ErrInvalidDateRangeFormat - ошибка ввода периода недоступности.
+	ErrInvalidDateRangeFormat = NewCustomError(
+		ErrorTypeValidation, "некорректный формат периода", "Введите даты в формате ДД.ММ.ГГГГ - ДД.ММ.ГГГГ", "",
+	)
+	// ErrDateRangeEndBeforeStart - дата окончания раньше даты начала.
+	ErrDateRangeEndBeforeStart = NewCustomError(
+		ErrorTypeValidation, "дата окончания раньше даты начала", "Дата окончания раньше даты начала", "",
+	)
+	// ErrDateRangeInPast - период уже закончился.
+	ErrDateRangeInPast = NewCustomError(
+		ErrorTypeValidation, "период уже закончился", "Период уже закончился", "",
+	)
+	// ErrDateRangeTooLong - период слишком длинный.
+	ErrDateRangeTooLong = NewCustomError(
+		ErrorTypeValidation, "период слишком длинный", "Период слишком длинный", "",
+	)
+	// ErrTooManyUnavailabilityPeriods - превышено число периодов недоступности.
+	ErrTooManyUnavailabilityPeriods = NewCustomError(
+		ErrorTypeValidation, "слишком много периодов недоступности", "Достигнут максимум периодов недоступности", "",
+	)
+	// ErrInterestSuggestionTooShort - слишком короткое предложение интереса.
+	ErrInterestSuggestionTooShort = NewCustomError(
+		ErrorTypeValidation, "предложение интереса слишком короткое", "Название интереса слишком короткое", "",
+	)
+	// ErrInterestSuggestionTooLong - слишком длинное предложение интереса.
+	ErrInterestSuggestionTooLong = NewCustomError(
+		ErrorTypeValidation, "предложение интереса слишком длинное", "Название интереса слишком длинное", "",
+	)
+	// ErrTooManyInterestSuggestions - превышено число предложений на модерации.
+	ErrTooManyInterestSuggestions = NewCustomError(
+		ErrorTypeValidation, "слишком много предложений на модерации", "Достигнут лимит предложений на модерации", "",
+	)
+	// ErrInterestSuggestionNotFound - предложение интереса не найдено.
+	ErrInterestSuggestionNotFound = NewCustomError(
+		ErrorTypeDatabase, "предложение интереса не найдено", "Предложение интереса не найдено", "",
+	)
+	// ErrInterestSuggestionReviewed - предложение уже рассмотрено.
+	ErrInterestSuggestionReviewed = NewCustomError(
+		ErrorTypeValidation, "предложение интереса уже рассмотрено", "Предложение уже рассмотрено", "",
+	)
+	// ErrInvalidInterestApproval - некорректные данные для создания интереса.
+	ErrInvalidInterestApproval = NewCustomError(
+		ErrorTypeValidation, "некорректные данные интереса", "Некорректные данные для создания интереса", "",
+	)
+	// ErrInterestKeyExists - интерес с таким ключом уже существует.
+	ErrInterestKeyExists = NewCustomError(
+		ErrorTypeValidation, "интерес с таким ключом уже существует", "Интерес с таким ключом уже существует", "",
+	)
+	// ErrInterestCategoryNotFound - категория интересов не найдена.
+	ErrInterestCategoryNotFound = NewCustomError(
+		ErrorTypeValidation, "категория интересов не найдена", "Категория интересов не найдена", "",
+	)
+	// ErrInvalidInterestData - некорректные данные интереса в admin API.
+	ErrInvalidInterestData = NewCustomError(
+		ErrorTypeValidation, "некорректные данные интереса", "Некорректные данные интереса", "",
+	)
+	// ErrInvalidInterestCategory - некорректные данные категории интересов.
+	ErrInvalidInterestCategory = NewCustomError(
+		ErrorTypeValidation, "некорректные данные категории интересов", "Некорректные данные категории интересов", "",
+	)
+	// ErrInterestCategoryKeyExists - категория с таким ключом уже существует.
+	ErrInterestCategoryKeyExists = NewCustomError(
+		ErrorTypeValidation, "категория с таким ключом уже существует", "Категория с таким ключом уже существует", "",
+	)
+	// ErrInvalidAnnouncement - некорректный текст или сегмент анонса.
+	ErrInvalidAnnouncement = NewCustomError(
+		ErrorTypeValidation, "некорректный анонс", "Некорректный текст или сегмент анонса", "",
+	)
+	// ErrAnnouncementNotFound - анонс не найден.
+	ErrAnnouncementNotFound = NewCustomError(ErrorTypeValidation, "анонс не найден", "Анонс не найден", "")
+	// ErrAnnouncementNotSendable - анонс уже отправлен, отменен или рассылается.
+	ErrAnnouncementNotSendable = NewCustomError(
+		ErrorTypeValidation, "анонс нельзя отправить", "Анонс уже отправлен, отменен или рассылается", "",
+	)
+	// ErrInvalidSurvey - некорректные вопросы, сегмент или время запуска опроса.
+	ErrInvalidSurvey = NewCustomError(
+		ErrorTypeValidation, "некорректный опрос", "Некорректные вопросы, сегмент или время запуска опроса", "",
+	)
+	// ErrSurveyNotFound - опрос не найден.
+	ErrSurveyNotFound = NewCustomError(ErrorTypeValidation, "опрос не найден", "Опрос не найден", "")
+	// ErrSurveyNotSendable - опрос уже отправлен, отменен или рассылается.
+	ErrSurveyNotSendable = NewCustomError(
+		ErrorTypeValidation, "опрос нельзя отправить", "Опрос уже отправлен, отменен или рассылается", "",
+	)
+	// ErrSurveyClosed - опрос отменен или пользователь его не получал.
+	ErrSurveyClosed = NewCustomError(ErrorTypeValidation, "опрос закрыт", "Опрос закрыт", "")
+	// ErrRecipientBlocked - получатель заблокировал бота.
+	ErrRecipientBlocked = NewCustomError(ErrorTypeTelegramAPI, "получатель заблокировал бота", "Пользователь заблокировал бота", "")
+	// ErrRecipientNotOnTelegram - получатель пишет боту не из Telegram, и личное сообщение ему не доставить.
+	ErrRecipientNotOnTelegram = NewCustomError(
+		ErrorTypeValidation, "получатель не пользуется Telegram", "Пользователь пишет боту не из Telegram, сообщение не отправлено", "",
+	)
+	// ErrInvalidAPIKey - API-ключ неизвестен, отозван или истек.
+	ErrInvalidAPIKey = NewCustomError(ErrorTypeValidation, "недействительный API-ключ", "API-ключ недействителен", "")
+	// ErrAPIKeyNotFound - API-ключ не найден.
+	ErrAPIKeyNotFound = NewCustomError(ErrorTypeValidation, "API-ключ не найден", "API-ключ не найден", "")
+	// ErrInvalidAdminToken - access- или refresh-токен admin API неверен, отозван или истек.
+	ErrInvalidAdminToken = NewCustomError(ErrorTypeValidation, "недействительный токен admin API", "Токен недействителен, войдите заново", "")
+	// ErrInvalidTelegramLogin - данные Telegram Login Widget не прошли проверку подписи или устарели.
+	ErrInvalidTelegramLogin = NewCustomError(ErrorTypeValidation, "неверные данные Telegram Login", "Не удалось подтвердить вход через Telegram", "")
+	// ErrAdminAccessDenied - у пользователя нет прав в admin API.
+	ErrAdminAccessDenied = NewCustomError(ErrorTypeValidation, "нет доступа к admin API", "У вас нет доступа к панели администратора", "")
+	// ErrAdminSessionsDisabled - вход по JWT не настроен (не задан ADMIN_JWT_SECRET).
+	ErrAdminSessionsDisabled = NewCustomError(ErrorTypeValidation, "вход в admin API не настроен", "Вход в панель администратора не настроен", "")
+	// ErrAdminSessionNotFound - сессия admin API не найдена или уже отозвана.
+	ErrAdminSessionNotFound = NewCustomError(ErrorTypeValidation, "сессия admin API не найдена", "Сессия не найдена", "")
+	// ErrInvalidEncryptionKeys - ключи шифрования персональных данных не прочитаны или некорректны.
+	ErrInvalidEncryptionKeys = NewCustomError(
+		ErrorTypeDatabase, "некорректные ключи шифрования", "Ключи шифрования персональных данных не настроены", "",
+	)
+	// ErrEncryptionKeyNotFound - значение зашифровано ключом, которого нет в наборе ключей.
+	ErrEncryptionKeyNotFound = NewCustomError(
+		ErrorTypeDatabase, "ключ шифрования не найден", "Не найден ключ для расшифровки данных", "",
+	)
+	// ErrEncryptedValueCorrupted - зашифрованное значение повреждено или подделано.
+	ErrEncryptedValueCorrupted = NewCustomError(
+		ErrorTypeDatabase, "зашифрованное значение повреждено", "Не удалось расшифровать данные", "",
+	)
+	// ErrInvalidAPIKeyInput - некорректное имя, права или срок действия API-ключа.
+	ErrInvalidAPIKeyInput = NewCustomError(
+		ErrorTypeValidation, "некорректные параметры API-ключа", "Некорректное имя, права или срок действия API-ключа", "",
+	)
+	// ErrAPIKeyOwnerRequired - ключ без служебной учетной записи не привязан к пользователю (выпущен до привязки).
+	ErrAPIKeyOwnerRequired = NewCustomError(
+		ErrorTypeValidation, "API-ключ не привязан к пользователю", "Перевыпустите API-ключ с владельцем (ownerTelegramId)", "",
+	)
+	// ErrCallerMismatch - X-Telegram-User-ID не совпадает с владельцем API-ключа.
+	ErrCallerMismatch = NewCustomError(
+		ErrorTypeValidation, "пользователь запроса не совпадает с владельцем ключа", "Заголовок X-Telegram-User-ID не совпадает с владельцем API-ключа", "",
+	)
+	// ErrInterestCatalogEmpty - в файле нет каталога интересов.
+	ErrInterestCatalogEmpty = NewCustomError(
+		ErrorTypeValidation, "каталог интересов в файле пуст", "В файле нет каталога интересов", "",
+	)
+	// ErrInvalidInterestCatalog - некорректная запись каталога интересов.
+	ErrInvalidInterestCatalog = NewCustomError(
+		ErrorTypeValidation, "некорректный каталог интересов", "Некорректный каталог интересов", "",
+	)
+	// ErrInterestMigrationRequired - удаляемый интерес выбран пользователями, нужен интерес для переноса.
+	ErrInterestMigrationRequired = NewCustomError(
+		ErrorTypeValidation, "для удаления выбранного интереса нужен интерес для переноса",
+		"Интерес выбран пользователями: укажите, куда перенести их выбор", "",
+	)
+	// ErrInvalidInterestMigration - некорректный интерес для переноса выборов.
+	ErrInvalidInterestMigration = NewCustomError(
+		ErrorTypeValidation, "некорректный интерес для переноса", "Интерес для переноса должен остаться в каталоге", "",
+	)
+	// ErrInterestNotFound - интерес не найден в справочнике.
+	ErrInterestNotFound = NewCustomError(
+		ErrorTypeValidation, "интерес не найден", "Интерес не найден", "",
+	)
+	// ErrInterestRelationNotFound - связь между интересами не найдена.
+	ErrInterestRelationNotFound = NewCustomError(
+		ErrorTypeDatabase, "связь между интересами не найдена", "Связь между интересами не найдена", "",
+	)
+	// ErrInvalidInterestRelation - некорректная связь между интересами.
+	ErrInvalidInterestRelation = NewCustomError(
+		ErrorTypeValidation, "некорректная связь между интересами", "Некорректная связь между интересами", "",
+	)
+	// ErrInvalidLearningGoal - неизвестная цель изучения языка.
+	ErrInvalidLearningGoal = NewCustomError(
+		ErrorTypeValidation, "неизвестная цель изучения языка", "Неизвестная цель изучения языка", "",
+	)
+
 	// ===== НОВЫЕ ТИПЫ ОШИБОК =====.
 
 	// Ошибки изолированного редактирования.
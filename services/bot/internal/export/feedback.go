@@ -0,0 +1,126 @@
+// Package export выгружает данные бота в файлы для администраторов и пользователей.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"language-exchange-bot/internal/models"
+)
+
+// feedbackCSVHeader - колонки выгрузки отзывов.
+var feedbackCSVHeader = []string{
+	"id", "created_at", "user_id", "telegram_id", "username", "first_name", "language", "status",
+	"category", "priority", "assignee_id", "sla_due_at", "text", "contact_info", "admin_response",
+}
+
+// FeedbackFileName возвращает имя файла выгрузки отзывов в формате format.
+func FeedbackFileName(format string, now time.Time) string {
+	return fmt.Sprintf("feedback-%s.%s", now.UTC().Format("20060102-150405"), format)
+}
+
+// FeedbackContentType возвращает MIME-тип выгрузки отзывов в формате format.
+func FeedbackContentType(format string) string {
+	if format == models.FeedbackExportJSONL {
+		return "application/x-ndjson; charset=utf-8"
+	}
+
+	return "text/csv; charset=utf-8"
+}
+
+// WriteFeedback выгружает отзывы в формате format: CSV или JSONL.
+func WriteFeedback(w io.Writer, format string, records []models.FeedbackRecord) error {
+	switch format {
+	case models.FeedbackExportCSV:
+		return WriteFeedbackCSV(w, records)
+	case models.FeedbackExportJSONL:
+		return WriteFeedbackJSONL(w, records)
+	default:
+		return fmt.Errorf("unsupported feedback export format %q", format)
+	}
+}
+
+// WriteFeedbackCSV выгружает отзывы в CSV, по строке на отзыв.
+func WriteFeedbackCSV(w io.Writer, records []models.FeedbackRecord) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(feedbackCSVHeader); err != nil {
+		return fmt.Errorf("failed to write feedback csv: %w", err)
+	}
+
+	for i := range records {
+		record := &records[i]
+
+		status := models.FeedbackStatusActive
+		if record.IsProcessed {
+			status = models.FeedbackStatusProcessed
+		}
+
+		assigneeID, dueAt := "", ""
+		if record.AssigneeID != 0 {
+			assigneeID = strconv.Itoa(record.AssigneeID)
+		}
+
+		if record.SLADueAt != nil {
+			dueAt = record.SLADueAt.UTC().Format(time.RFC3339)
+		}
+
+		row := []string{
+			strconv.Itoa(record.ID),
+			record.CreatedAt.UTC().Format(time.RFC3339),
+			strconv.Itoa(record.UserID),
+			strconv.FormatInt(record.TelegramID, 10),
+			csvSafe(record.Username),
+			csvSafe(record.FirstName),
+			record.Language,
+			status,
+			record.Category,
+			record.Priority,
+			assigneeID,
+			dueAt,
+			csvSafe(record.Text),
+			csvSafe(record.ContactInfo),
+			csvSafe(record.AdminResponse),
+		}
+
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write feedback csv: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write feedback csv: %w", err)
+	}
+
+	return nil
+}
+
+// WriteFeedbackJSONL выгружает отзывы в JSON Lines: по JSON-объекту на строку.
+func WriteFeedbackJSONL(w io.Writer, records []models.FeedbackRecord) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	for i := range records {
+		if err := encoder.Encode(&records[i]); err != nil {
+			return fmt.Errorf("failed to write feedback jsonl: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// csvSafe экранирует значения, которые табличный редактор принял бы за формулу.
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@", rune(value[0])) {
+		return "'" + value
+	}
+
+	return value
+}
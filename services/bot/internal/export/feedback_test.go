@@ -0,0 +1,70 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/models"
+)
+
+// testRecords возвращает отзывы для выгрузки: обработанный с ответом и активный с формулой в тексте.
+func testRecords() []models.FeedbackRecord {
+	createdAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	dueAt := createdAt.Add(72 * time.Hour)
+
+	return []models.FeedbackRecord{
+		{
+			ID: 1, UserID: 10, TelegramID: 1001, Username: "anna", Language: "ru", Text: "Бот, спасибо!",
+			IsProcessed: true, AdminResponse: "Рады помочь", Category: models.FeedbackCategoryPraise,
+			Priority: models.FeedbackPriorityLow, CreatedAt: createdAt,
+		},
+		{
+			ID: 2, UserID: 11, TelegramID: 1002, FirstName: "Juan", Language: "es", Text: "=HYPERLINK(\"x\")",
+			Priority: models.FeedbackPriorityNormal, AssigneeID: 5, SLADueAt: &dueAt, CreatedAt: createdAt,
+		},
+	}
+}
+
+// TestWriteFeedbackCSV тестирует выгрузку в CSV: статус, срок и экранирование формул.
+func TestWriteFeedbackCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteFeedback(&buf, models.FeedbackExportCSV, testRecords()))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, feedbackCSVHeader, rows[0])
+	assert.Equal(t, []string{
+		"1", "2026-10-18T12:00:00Z", "10", "1001", "anna", "", "ru", models.FeedbackStatusProcessed,
+		models.FeedbackCategoryPraise, models.FeedbackPriorityLow, "", "", "Бот, спасибо!", "", "Рады помочь",
+	}, rows[1])
+	assert.Equal(t, models.FeedbackStatusActive, rows[2][7])
+	assert.Equal(t, "5", rows[2][10])
+	assert.Equal(t, "2026-10-21T12:00:00Z", rows[2][11])
+	assert.Equal(t, "'=HYPERLINK(\"x\")", rows[2][12])
+}
+
+// TestWriteFeedbackJSONL тестирует выгрузку в JSON Lines: объект на строку без HTML-экранирования.
+func TestWriteFeedbackJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteFeedback(&buf, models.FeedbackExportJSONL, testRecords()))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"text":"Бот, спасибо!"`)
+
+	var record models.FeedbackRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	assert.Equal(t, testRecords()[1].Text, record.Text)
+	assert.Equal(t, 5, record.AssigneeID)
+
+	assert.Error(t, WriteFeedback(&buf, "xlsx", nil))
+}
@@ -0,0 +1,28 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"language-exchange-bot/internal/models"
+)
+
+// UserDataFileName возвращает имя файла выгрузки данных пользователя с Telegram ID telegramID.
+func UserDataFileName(telegramID int64, now time.Time) string {
+	return fmt.Sprintf("mydata-%d-%s.json", telegramID, now.UTC().Format("20060102-150405"))
+}
+
+// WriteUserData выгружает данные пользователя в JSON с отступами.
+func WriteUserData(w io.Writer, data *models.UserDataExport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(data); err != nil {
+		return fmt.Errorf("failed to write user data: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"language-exchange-bot/internal/models"
+)
+
+// TestWriteUserData тестирует выгрузку данных пользователя: версия схемы, пустые списки и HTML без экранирования.
+func TestWriteUserData(t *testing.T) {
+	exportedAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	data := &models.UserDataExport{
+		SchemaVersion: models.UserDataSchemaVersion,
+		ExportedAt:    exportedAt,
+		Profile:       models.UserDataProfile{ID: 10, TelegramID: 1001, FirstName: "<Anna>"},
+		LanguagePairs: []models.UserLanguagePair{},
+		Matches:       []models.UserMatch{{ID: 3, PartnerUserID: 11, Status: "sent", FoundAt: exportedAt}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteUserData(&buf, data))
+
+	assert.Contains(t, buf.String(), `"firstName": "<Anna>"`)
+	assert.Contains(t, buf.String(), `"languagePairs": []`)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.InDelta(t, float64(models.UserDataSchemaVersion), decoded["schemaVersion"], 0)
+	assert.Equal(t, "2026-10-18T12:00:00Z", decoded["exportedAt"])
+
+	matches, ok := decoded["matches"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, matches, 1)
+	assert.NotContains(t, matches[0], "sentAt")
+}
+
+// TestUserDataFileName тестирует имя файла выгрузки.
+func TestUserDataFileName(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 30, 5, 0, time.FixedZone("MSK", 3*60*60))
+	assert.Equal(t, "mydata-1001-20261018-093005.json", UserDataFileName(1001, now))
+}
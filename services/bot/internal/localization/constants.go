@@ -146,6 +146,7 @@ const (
 	SymbolUnchecked = "☑ " // Unchecked symbol (gray checkmark)
 	SymbolChecked   = "✅ " // Checked symbol (green checkmark)
 	SymbolStar      = "⭐ " // Star symbol
+	SymbolSuggested = "✨ " // Suggested interest symbol
 	SymbolEmpty     = "☑"  // Empty symbol (without space) (gray checkmark)
 )
 
@@ -200,9 +201,12 @@ const (
 	DefaultMaxMatchesPerUser    = 10   // Максимальное количество совпадений на пользователя
 
 	// Matching algorithm scores.
-	DefaultPrimaryInterestScore    = 3 // Балл за основной интерес
-	DefaultAdditionalInterestScore = 1 // Балл за дополнительный интерес
-	DefaultMinCompatibilityScore   = 5 // Минимальный балл совместимости
+	DefaultPrimaryInterestScore     = 3 // Балл за основной интерес
+	DefaultAdditionalInterestScore  = 1 // Балл за дополнительный интерес
+	DefaultLearningGoalScore        = 2 // Балл за общую цель изучения языка
+	DefaultRelatedInterestScore     = 1 // Частичный балл за похожие интересы
+	DefaultParentChildInterestScore = 2 // Частичный балл за пару "общий интерес - частный случай"
+	DefaultMinCompatibilityScore    = 5 // Минимальный балл совместимости
 
 	// Interest limits.
 	DefaultMinPrimaryInterests         = 1  // Минимальное количество основных интересов
@@ -241,6 +245,182 @@ const (
 	PrimaryInterestMultiplier = 2 // Multiplier for maximum primary interest score
 )
 
+// Unavailability (Vacation) Constants
+// Used in: services/bot/internal/core/unavailability.go.
+const (
+	UnavailabilityDateLayout      = "02.01.2006"  // Формат ввода и отображения дат отпуска (ДД.ММ.ГГГГ)
+	MaxUnavailabilityPeriods      = 5             // Максимум одновременно активных периодов у пользователя
+	MaxUnavailabilityPeriodDays   = 365           // Максимальная длина одного периода в днях
+	UnavailabilityCleanupInterval = 6 * time.Hour // Интервал удаления завершившихся периодов
+)
+
+// Interest Suggestion Constants
+// Used in: services/bot/internal/core/interest_suggestions.go.
+const (
+	MinInterestSuggestionLength    = 2  // Минимальная длина названия предложенного интереса (в символах)
+	MaxInterestSuggestionLength    = 50 // Максимальная длина названия предложенного интереса (в символах)
+	MaxPendingInterestSuggestions  = 3  // Максимум предложений одного пользователя, ожидающих модерации
+	DefaultInterestSuggestionLimit = 50 // Размер страницы очереди модерации в admin API
+)
+
+// Interest Recommendation Constants
+// Used in: services/bot/internal/core/interest_recommendations.go.
+const (
+	MinInterestCooccurrenceUsers        = 3              // Минимум пользователей, выбравших оба интереса, чтобы пара попала в рекомендации
+	InterestCooccurrenceRefreshInterval = 12 * time.Hour // Интервал пересчета совместного выбора интересов
+	MaxSuggestedInterests               = 3              // Размер ряда «Рекомендуем вам» в редакторе интересов
+	DefaultInterestCooccurrenceLimit    = 20             // Количество пар в admin API по умолчанию
+)
+
+// Admin Panel Constants
+// Used in: services/bot/internal/core/admin_panel.go, services/bot/internal/adapters/telegram/handlers/admin_panel/admin_panel_handler.go.
+const (
+	AdminUserSearchLimit     = 10                       // Максимум пользователей в результатах поиска
+	AdminCardFeedbackLimit   = 5                        // Количество последних отзывов в карточке пользователя
+	AdminCardActionLimit     = 5                        // Количество последних действий администраторов в карточке
+	MaxAdminMessageLength    = 2000                     // Максимальная длина сообщения пользователю (в символах)
+	AdminPanelInputTTL       = 15 * time.Minute         // Сколько хранится адресат сообщения, пока администратор вводит текст
+	AdminPanelDMTargetPrefix = "admin_panel_dm_target_" // Префикс ключа кэша с адресатом сообщения (+ Telegram ID администратора)
+)
+
+// Feedback Reply Constants
+// Used in: services/bot/internal/core/feedback_replies.go, services/bot/internal/adapters/telegram/handlers/feedback/feedback_replies.go.
+const (
+	MaxFeedbackReplyLength      = 1000                      // Максимальная длина ответа в переписке по отзыву (в символах)
+	FeedbackReplyInputTTL       = 15 * time.Minute          // Сколько хранится отзыв, на который вводится ответ
+	FeedbackReplyTargetPrefix   = "feedback_reply_target_"  // Префикс ключа кэша с отзывом для ответа администратора (+ Telegram ID)
+	FeedbackAnswerTargetPrefix  = "feedback_answer_target_" // Префикс ключа кэша с отзывом для ответа пользователя (+ Telegram ID)
+	FeedbackThreadPreviewLimit  = 3                         // Количество последних сообщений переписки в просмотре отзыва
+	FeedbackThreadPreviewLength = 300                       // Сколько символов сообщения переписки показывать в просмотре отзыва
+)
+
+// Feedback Triage Constants
+// Used in: services/bot/internal/core/feedback_triage.go, services/bot/internal/adapters/telegram/handlers/feedback/feedback_triage.go.
+const (
+	FeedbackSLAUrgent         = 4 * time.Hour      // Срок обработки отзыва со срочным приоритетом
+	FeedbackSLAHigh           = 24 * time.Hour     // Срок обработки отзыва с высоким приоритетом
+	FeedbackSLANormal         = 72 * time.Hour     // Срок обработки отзыва с обычным приоритетом
+	FeedbackSLALow            = 7 * 24 * time.Hour // Срок обработки отзыва с низким приоритетом
+	FeedbackSLACheckInterval  = 15 * time.Minute   // Интервал проверки нарушений сроков
+	FeedbackSLAAlertBatchSize = 20                 // Сколько просроченных отзывов включать в одно уведомление
+	FeedbackFilterTTL         = 24 * time.Hour     // Сколько хранится фильтр просмотра отзывов администратора
+	FeedbackFilterPrefix      = "feedback_filter_" // Префикс ключа кэша с фильтром просмотра отзывов (+ Telegram ID)
+)
+
+// Feedback Search and Export Constants
+// Used in: services/bot/internal/core/feedback_search.go, services/bot/internal/adapters/telegram/handlers/feedback/feedback_search.go.
+const (
+	DefaultFeedbackSearchLimit   = 20    // Результатов поиска отзывов по умолчанию
+	MaxFeedbackSearchLimit       = 100   // Максимум результатов поиска отзывов в ответе
+	MaxFeedbackSearchQueryLength = 200   // Максимальная длина поискового запроса (в символах)
+	MaxFeedbackExportRows        = 20000 // Максимум отзывов в одной выгрузке
+	FeedbackSearchResultsShown   = 10    // Сколько результатов поиска показывать в Telegram
+	FeedbackSearchPreviewLength  = 120   // Сколько символов отзыва показывать в результатах поиска
+)
+
+// Feedback Attachment Constants
+// Used in: services/bot/internal/core/feedback_attachments.go, services/bot/internal/adapters/telegram/handlers/feedback/feedback_attachments.go.
+const (
+	MaxFeedbackAttachments = 10                // Максимум вложений в одном отзыве
+	FeedbackDraftTTL       = 30 * time.Minute  // Сколько хранится составляемый отзыв с вложениями
+	FeedbackDraftPrefix    = "feedback_draft_" // Префикс ключа кэша с составляемым отзывом (+ Telegram ID)
+)
+
+// Announcement Constants
+// Used in: services/bot/internal/core/announcements.go, services/bot/internal/adapters/admin/server.go.
+const (
+	AnnouncementGlobalInterval  = time.Second / 30 // Интервал между сообщениями рассылки (глобальный лимит Telegram ~30 сообщений/с)
+	AnnouncementPerChatInterval = time.Second      // Минимальный интервал между сообщениями в один чат
+	AnnouncementBatchSize       = 100              // Количество получателей, выбираемых из очереди за раз
+	AnnouncementMaxRetries      = 3                // Сколько раз повторять отправку после ответа 429 (retry_after)
+	AnnouncementThrottlePrune   = 1024             // Сколько слотов чатов накапливается до очистки прошедших
+	MaxAnnouncementTitleLength  = 200              // Максимальная длина заголовка анонса (в символах)
+	MaxAnnouncementLength       = 3500             // Максимальная длина текста анонса (в символах)
+	AnnouncementListLimit       = 50               // Количество анонсов и доставок в ответах admin API по умолчанию
+)
+
+// Survey Constants
+// Used in: services/bot/internal/core/surveys.go, services/bot/internal/adapters/telegram/surveys.go.
+const (
+	MaxSurveyTitleLength    = 200                  // Максимальная длина названия опроса (в символах)
+	MaxSurveyQuestions      = 5                    // Максимум вопросов в опросе
+	MaxSurveyQuestionLength = 300                  // Максимальная длина текста вопроса (в символах)
+	MinSurveyOptions        = 2                    // Минимум вариантов в вопросе с выбором
+	MaxSurveyOptions        = 6                    // Максимум вариантов в вопросе с выбором
+	MaxSurveyOptionLength   = 40                   // Максимальная длина варианта ответа (подпись кнопки)
+	SurveyCooldown          = 30 * 24 * time.Hour  // Пользователь получает не больше одного опроса за этот срок
+	SurveyFrequencyWindow   = 365 * 24 * time.Hour // Период, за который ограничено число опросов
+	SurveyMaxPerWindow      = 4                    // Максимум опросов одному пользователю за SurveyFrequencyWindow
+	SurveySchedulerInterval = time.Minute          // Как часто проверять опросы, которым пришло время запуска
+	SurveyDefaultLanguage   = "en"                 // Язык вопроса, если нет перевода на язык пользователя
+	SurveyStatsLimit        = 5                    // Сколько последних опросов показывать в статистике admin API
+)
+
+// User Data Export Constants
+// Used in: services/bot/internal/core/user_data.go, services/bot/internal/adapters/telegram/handlers/profile/personal_data.go.
+const (
+	MaxUserDataFeedback = 1000 // Максимум отзывов пользователя в выгрузке /mydata
+)
+
+// Account Deletion Constants
+// Used in: services/bot/internal/core/account_deletion.go, services/bot/internal/adapters/telegram/handlers/profile/account_deletion.go.
+const (
+	AccountDeletionStatsDays = 30 // За сколько последних дней показывать удаления аккаунтов в статистике
+)
+
+// Data Retention Constants
+// Used in: services/bot/internal/core/retention.go, services/bot/internal/config/config.go.
+const (
+	DefaultRetentionFeedbackMonths     = 0              // Обработанные отзывы по умолчанию хранятся бессрочно
+	DefaultRetentionInactiveUserMonths = 0              // Неактивные пользователи по умолчанию не удаляются
+	DefaultRetentionMatchMonths        = 0              // Отмененные совпадения по умолчанию хранятся бессрочно
+	RetentionInterval                  = 24 * time.Hour // Как часто запускать очистку по срокам хранения
+	RetentionBatchSize                 = 500            // Сколько строк удалять за один запрос
+)
+
+// Personal Data Encryption Constants
+// Used in: services/bot/internal/core/personal_data_encryption.go.
+const (
+	ReencryptionInterval  = time.Hour // Как часто переводить персональные данные на активный ключ
+	ReencryptionBatchSize = 200       // Сколько значений перешифровывать за одну транзакцию
+)
+
+// API Key Constants
+// Used in: services/bot/internal/core/api_keys.go, services/bot/internal/config/config.go, services/bot/cmd/api-keys/main.go.
+const (
+	APIKeyPrefix                 = "lxb_"      // Префикс ключей admin API (опознается в логах и сканерах секретов)
+	APIKeySecretBytes            = 32          // Случайных байт в ключе
+	APIKeyDisplayPrefixLength    = 12          // Длина начала ключа, которое хранится открыто для опознания
+	MaxAPIKeyNameLength          = 100         // Максимальная длина имени ключа
+	DefaultAPIKeyLifetimeDays    = 90          // Срок действия ключа по умолчанию (0 - бессрочный)
+	DefaultAPIKeyGracePeriodDays = 3           // Сколько старый ключ действует после ротации
+	APIKeyLastUsedInterval       = time.Minute // Как часто обновлять время последнего использования ключа
+)
+
+// Admin Session Constants
+// Used in: services/bot/internal/core/admin_sessions.go, services/bot/internal/config/config.go, services/bot/internal/auth/telegram_login.go.
+const (
+	AdminTokenIssuer               = "language-exchange-bot" // Издатель (iss) access-токенов admin API
+	AdminTokenType                 = "Bearer"                // Тип токена в заголовке Authorization
+	AdminRefreshTokenPrefix        = "lxr_"                  // Префикс refresh-токенов admin API
+	AdminRefreshTokenBytes         = 32                      // Случайных байт в refresh-токене
+	DefaultAdminAccessTokenMinutes = 15                      // Время жизни access-токена по умолчанию
+	DefaultAdminRefreshTokenDays   = 30                      // Время жизни refresh-токена по умолчанию
+	MinAdminJWTSecretLength        = 32                      // Минимальная длина ADMIN_JWT_SECRET
+	TelegramLoginMaxAge            = 24 * time.Hour          // Сколько действительны данные Telegram Login Widget
+	TelegramLoginMaxClockSkew      = 5 * time.Minute         // Допустимое опережение auth_date
+)
+
+// Audit Constants
+// Used in: services/bot/internal/core/audit.go.
+const (
+	DefaultAuditListLimit = 100   // Записей аудита в ответе по умолчанию
+	MaxAuditListLimit     = 1000  // Максимум записей аудита в ответе
+	MaxAuditExportRows    = 50000 // Максимум записей аудита в CSV-выгрузке
+	AuditVerifyBatchSize  = 1000  // Записей за один запрос при проверке цепочки
+	AuditPseudonymBytes   = 12    // Случайных байт в псевдониме пользователя
+)
+
 // Telegram Parse Modes
 // Used in: services/bot/internal/adapters/telegram/message_factory.go, services/bot/internal/adapters/telegram/handlers/message_factory.go.
 const (
@@ -255,9 +435,10 @@ const (
 	MaxFeedbackItems   = 1000 // Максимальное количество отзывов для отображения
 
 	// Feedback types
-	FeedbackTypeActiveLocal  = "active"  // Активные отзывы
-	FeedbackTypeArchiveLocal = "archive" // Архивные отзывы
-	FeedbackTypeAllLocal     = "all"     // Все отзывы
+	FeedbackTypeActiveLocal   = "active"   // Активные отзывы
+	FeedbackTypeArchiveLocal  = "archive"  // Архивные отзывы
+	FeedbackTypeAllLocal      = "all"      // Все отзывы
+	FeedbackTypeFilteredLocal = "filtered" // Активные отзывы по фильтру администратора
 )
 
 // Interest Handler Message Constants
@@ -381,6 +562,11 @@ const (
 	CallbackAvailEditFreqWeekly          = "avail_edit_freq_weekly"
 	CallbackAvailEditFreqMultipleMonthly = "avail_edit_freq_multiple_monthly"
 	CallbackAvailEditFreqFlexible        = "avail_edit_freq_flexible"
+
+	CallbackAvailEditVacation        = "avail_edit_vacation"
+	CallbackAvailVacationAdd         = "avail_vacation_add"
+	CallbackAvailVacationCancelInput = "avail_vacation_cancel_input"
+	CallbackAvailVacationBack        = "avail_vacation_back"
 )
 
 // Availability callback prefixes for routing
@@ -395,6 +581,95 @@ const (
 	CallbackPrefixAvailEditTimeSlot  = "avail_edit_timeslot_"
 	CallbackPrefixAvailEditCommStyle = "avail_edit_commstyle_"
 	CallbackPrefixAvailEditFreq      = "avail_edit_freq_"
+	CallbackPrefixAvailVacationDel   = "avail_vacation_delete_"
+)
+
+// Interest suggestion callbacks (isolated interest editor).
+const (
+	CallbackIsolatedSuggestInterest = "isolated_suggest_interest"
+	CallbackIsolatedSuggestCancel   = "isolated_suggest_cancel"
+)
+
+// Interest recommendation callbacks (isolated interest editor).
+const (
+	CallbackIsolatedToggleSuggestedPrefix = "isolated_toggle_suggested_"
+)
+
+// Learning goal callbacks (profile editor).
+const (
+	CallbackProfileLearningGoals      = "profile_goals"
+	CallbackPrefixProfileLearningGoal = "profile_goal_toggle_"
+)
+
+// Admin panel callbacks (user management).
+const (
+	CallbackAdminPanel              = "adm_panel"
+	CallbackAdminSearch             = "adm_search"
+	CallbackAdminCancel             = "adm_cancel"
+	CallbackPrefixAdminUser         = "adm_user_"
+	CallbackPrefixAdminReset        = "adm_reset_"
+	CallbackPrefixAdminResetConfirm = "adm_resetok_"
+	CallbackPrefixAdminStatus       = "adm_status_"
+	CallbackPrefixAdminSetStatus    = "adm_setstatus_" // + ID пользователя + "_" + статус
+	CallbackPrefixAdminState        = "adm_state_"
+	CallbackPrefixAdminSetState     = "adm_setstate_" // + ID пользователя + "_" + состояние
+	CallbackPrefixAdminMessage      = "adm_msg_"
+	CallbackPrefixAdminPanel        = "adm_" // Общий префикс callback'ов админ-панели
+)
+
+// Feedback reply callbacks (feedback browser and delivered replies).
+const (
+	CallbackPrefixFeedbackReply  = "fb_reply_" // + ID отзыва: администратор отвечает на отзыв
+	CallbackFeedbackReplyCancel  = "fb_reply_cancel"
+	CallbackPrefixFeedbackAnswer = "fb_answer_" // + ID отзыва: автор отвечает на ответ администратора
+	CallbackFeedbackAnswerCancel = "fb_answer_cancel"
+)
+
+// Feedback triage and filter callbacks (feedback browser).
+const (
+	CallbackPrefixFeedbackTriage     = "fbt_"      // Общий префикс callback'ов разметки отзыва
+	CallbackPrefixFeedbackTriageMenu = "fbt_menu_" // + тип списка + "_" + ID отзыва
+	CallbackPrefixFeedbackTriageCat  = "fbt_cat_"  // + тип списка + "_" + ID отзыва + "_" + категория
+	CallbackPrefixFeedbackTriagePri  = "fbt_pri_"  // + тип списка + "_" + ID отзыва + "_" + приоритет
+	CallbackPrefixFeedbackTriageMine = "fbt_me_"   // + тип списка + "_" + ID отзыва: взять себе или снять назначение
+	CallbackPrefixFeedbackTriageBack = "fbt_back_" // + тип списка + "_" + ID отзыва: вернуться к карточке
+	CallbackPrefixFeedbackFilter     = "fbf_"      // Общий префикс callback'ов фильтра отзывов
+	CallbackFeedbackFilterMenu       = "fbf_menu"
+	CallbackPrefixFeedbackFilterCat  = "fbf_cat_" // + категория
+	CallbackPrefixFeedbackFilterPri  = "fbf_pri_" // + приоритет
+	CallbackFeedbackFilterMine       = "fbf_mine"
+	CallbackFeedbackFilterBreached   = "fbf_breached"
+	CallbackFeedbackFilterShow       = "fbf_show"
+	CallbackFeedbackFilterReset      = "fbf_reset"
+)
+
+// Feedback search and export callbacks (feedback browser).
+const (
+	CallbackPrefixFeedbackSearch     = "fbs_"
+	CallbackFeedbackSearchStart      = "fbs_start"
+	CallbackFeedbackSearchCancel     = "fbs_cancel"
+	CallbackPrefixFeedbackSearchOpen = "fbs_open_" // + ID отзыва
+	CallbackPrefixFeedbackExport     = "fbx_"
+	CallbackFeedbackExportMenu       = "fbx_menu"
+	CallbackPrefixFeedbackExportSet  = "fbx_set_" // + формат + "_" + период + "_" + статус: изменить параметры
+	CallbackPrefixFeedbackExportGet  = "fbx_get_" // + формат + "_" + период + "_" + статус: получить файл
+)
+
+// Feedback attachment callbacks (feedback flow and feedback browser).
+const (
+	CallbackFeedbackDraftSend        = "fb_draft_send" // Автор отправляет отзыв из вложений без текста
+	CallbackPrefixFeedbackAttachment = "fba_"          // + ID отзыва: прислать вложения отзыва администратору
+)
+
+// Account deletion callbacks (/deleteme confirmation).
+const (
+	CallbackDeleteAccountConfirm = "deleteme_yes"
+	CallbackDeleteAccountCancel  = "deleteme_no"
+)
+
+// Survey callbacks (survey answers from any user).
+const (
+	CallbackPrefixSurveyAnswer = "srv_" // + ID опроса + "_" + номер вопроса + "_" + значение ответа
 )
 
 // =============================================================================
@@ -556,3 +831,141 @@ const (
 	LocaleErrorNoCommunicationSelected = "error_no_communication_selected"
 	LocaleErrorInvalidAvailabilityData = "error_invalid_availability_data"
 )
+
+// Locale keys for vacation / unavailability periods.
+const (
+	LocaleVacationEditButton     = "edit_vacation"
+	LocaleVacationTitle          = "vacation_title"
+	LocaleVacationDescription    = "vacation_description"
+	LocaleVacationNone           = "vacation_none"
+	LocaleVacationAddButton      = "vacation_add_button"
+	LocaleVacationDeleteButton   = "vacation_delete_button"
+	LocaleVacationEnterDates     = "vacation_enter_dates"
+	LocaleVacationAdded          = "vacation_added"
+	LocaleVacationDeleted        = "vacation_deleted"
+	LocaleVacationProfileField   = "profile_field_vacation"
+	LocaleVacationUnavailableNow = "vacation_unavailable_now"
+	LocaleVacationPartnerField   = "profile_field_partner_vacation"
+	LocaleErrorVacationFormat    = "error_vacation_format"
+	LocaleErrorVacationOrder     = "error_vacation_order"
+	LocaleErrorVacationPast      = "error_vacation_past"
+	LocaleErrorVacationTooLong   = "error_vacation_too_long"
+	LocaleErrorVacationTooMany   = "error_vacation_too_many"
+)
+
+// Locale keys for interest suggestions.
+const (
+	LocaleInterestSuggestButton       = "interest_suggest_button"
+	LocaleInterestSuggestPrompt       = "interest_suggest_prompt"
+	LocaleInterestSuggestSent         = "interest_suggest_sent"
+	LocaleInterestSuggestBackToEditor = "interest_suggest_back_to_editor"
+	LocaleErrorInterestSuggestShort   = "error_interest_suggest_short"
+	LocaleErrorInterestSuggestLong    = "error_interest_suggest_long"
+	LocaleErrorInterestSuggestTooMany = "error_interest_suggest_too_many"
+)
+
+// Locale keys for primary interest selection policy.
+const (
+	LocaleErrorPrimaryLimitTotal    = "error_primary_limit_total"
+	LocaleErrorPrimaryLimitCategory = "error_primary_limit_category"
+)
+
+// Locale keys for interest recommendations.
+const (
+	LocaleInterestSuggestedForYou = "interest_suggested_for_you"
+)
+
+// Locale keys for learning goals.
+const (
+	LocaleLearningGoalsEditButton   = "edit_learning_goals"
+	LocaleLearningGoalsTitle        = "learning_goals_title"
+	LocaleLearningGoalsDescription  = "learning_goals_description"
+	LocaleLearningGoalsProfileField = "profile_field_learning_goals"
+	LocaleLearningGoalPrefix        = "learning_goal_" // + код цели, например learning_goal_travel
+)
+
+// Locale keys for the admin panel.
+const (
+	LocaleAdminPanelTitle          = "admin_panel_title"
+	LocaleAdminPanelSearchButton   = "admin_panel_search_button"
+	LocaleAdminPanelSearchPrompt   = "admin_panel_search_prompt"
+	LocaleAdminPanelNoResults      = "admin_panel_no_results"
+	LocaleAdminPanelResults        = "admin_panel_results"
+	LocaleAdminPanelUserCard       = "admin_panel_user_card"
+	LocaleAdminPanelFeedbackHeader = "admin_panel_feedback_header"
+	LocaleAdminPanelFeedbackNone   = "admin_panel_feedback_none"
+	LocaleAdminPanelActionsHeader  = "admin_panel_actions_header"
+	LocaleAdminPanelResetButton    = "admin_panel_reset_button"
+	LocaleAdminPanelStatusButton   = "admin_panel_status_button"
+	LocaleAdminPanelStateButton    = "admin_panel_state_button"
+	LocaleAdminPanelMessageButton  = "admin_panel_message_button"
+	LocaleAdminPanelBackButton     = "admin_panel_back_button"
+	LocaleAdminPanelBackToUser     = "admin_panel_back_to_user"
+	LocaleAdminPanelConfirmButton  = "admin_panel_confirm_button"
+	LocaleAdminPanelCancelButton   = "admin_panel_cancel_button"
+	LocaleAdminPanelResetConfirm   = "admin_panel_reset_confirm"
+	LocaleAdminPanelChooseStatus   = "admin_panel_choose_status"
+	LocaleAdminPanelChooseState    = "admin_panel_choose_state"
+	LocaleAdminPanelActionDone     = "admin_panel_action_done"
+	LocaleAdminPanelMessagePrompt  = "admin_panel_message_prompt"
+	LocaleAdminPanelMessageSent    = "admin_panel_message_sent"
+	LocaleAdminPanelMessageFailed  = "admin_panel_message_failed"
+	LocaleAdminPanelMessageInvalid = "admin_panel_message_invalid"
+	LocaleAdminPanelMessageHeader  = "admin_panel_message_header"
+	LocaleAdminPanelAccessDenied   = "admin_panel_access_denied"
+	LocaleAdminPanelUserNotFound   = "admin_panel_user_not_found"
+	LocaleAdminPanelSessionExpired = "admin_panel_session_expired"
+	LocaleAdminPanelDeletions      = "admin_panel_deletions"
+	LocaleAdminPanelNotOnTelegram  = "admin_panel_message_not_telegram"
+)
+
+// Locale keys for feedback replies.
+const (
+	LocaleFeedbackReplyPrompt     = "feedback_reply_prompt"
+	LocaleFeedbackReplySent       = "feedback_reply_sent"
+	LocaleFeedbackReplyFailed     = "feedback_reply_failed"
+	LocaleFeedbackReplyInvalid    = "feedback_reply_invalid"
+	LocaleFeedbackReplyExpired    = "feedback_reply_expired"
+	LocaleFeedbackReplyCancelled  = "feedback_reply_cancelled"
+	LocaleFeedbackReplyNotFound   = "feedback_reply_not_found"
+	LocaleFeedbackReplyHeader     = "feedback_reply_header"
+	LocaleFeedbackAnswerButton    = "feedback_answer_button"
+	LocaleFeedbackAnswerPrompt    = "feedback_answer_prompt"
+	LocaleFeedbackAnswerSent      = "feedback_answer_sent"
+	LocaleFeedbackCancelButton    = "feedback_cancel_button"
+	LocaleFeedbackReplyNoTelegram = "feedback_reply_not_telegram"
+)
+
+// Locale keys for feedback attachments.
+const (
+	LocaleFeedbackAttachmentAdded       = "feedback_attachment_added"
+	LocaleFeedbackAttachmentLimit       = "feedback_attachment_limit"
+	LocaleFeedbackAttachmentUnsupported = "feedback_attachment_unsupported"
+	LocaleFeedbackSendButton            = "feedback_send_button"
+)
+
+// Locale keys for surveys.
+const (
+	LocaleSurveyNPSQuestion    = "survey_nps_question"
+	LocaleSurveyAnswerRecorded = "survey_answer_recorded"
+	LocaleSurveyThanks         = "survey_thanks"
+	LocaleSurveyClosed         = "survey_closed"
+	LocaleSurveyProgress       = "survey_progress"
+)
+
+// Locale keys for the personal data export.
+const (
+	LocaleMyDataCaption = "mydata_caption"
+	LocaleMyDataError   = "mydata_error"
+)
+
+// Locale keys for account deletion.
+const (
+	LocaleDeleteMeWarning       = "deleteme_warning"
+	LocaleDeleteMeConfirmButton = "deleteme_confirm_button"
+	LocaleDeleteMeCancelButton  = "deleteme_cancel_button"
+	LocaleDeleteMeDone          = "deleteme_done"
+	LocaleDeleteMeCancelled     = "deleteme_cancelled"
+	LocaleDeleteMeError         = "deleteme_error"
+	LocaleDeleteMePartnerNotice = "deleteme_partner_notice"
+)
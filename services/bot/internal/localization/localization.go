@@ -188,6 +188,13 @@ func (l *Localizer) Get(lang, key string) string {
 	return key
 }
 
+// Has сообщает, есть ли ключ в переводах указанного языка (без fallback на en).
+func (l *Localizer) Has(lang, key string) bool {
+	_, found := l.translations[lang][key]
+
+	return found
+}
+
 // GetWithParams возвращает локализованную строку с подстановкой параметров.
 func (l *Localizer) GetWithParams(lang, key string, params map[string]string) string {
 	text := l.Get(lang, key)
@@ -329,6 +336,52 @@ func (l *Localizer) scanInterestsRows(rows *sql.Rows, interests map[int]string)
 	)
 }
 
+// GetInterestCategoryNames возвращает названия категорий интересов из БД: ключ категории -> название.
+// Нужны для категорий, которых нет в файлах локализации (созданы или переименованы через admin API).
+func (l *Localizer) GetInterestCategoryNames(lang string) (map[string]string, error) {
+	names := make(map[string]string)
+
+	if l.db == nil {
+		return names, nil
+	}
+
+	rows, err := l.db.QueryContext(context.Background(), `
+		SELECT c.key_name, t.name
+		FROM interest_categories c
+		JOIN interest_category_translations t ON t.category_id = c.id AND t.language_code = $1
+	`, lang)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load interest category names: %w", err)
+	}
+
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			l.logger.ErrorWithContext(
+				"Failed to close database rows",
+				"", 0, 0, "GetInterestCategoryNames",
+				map[string]interface{}{
+					"error": closeErr.Error(),
+				},
+			)
+		}
+	}()
+
+	for rows.Next() {
+		var key, name string
+		if err := rows.Scan(&key, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan interest category name: %w", err)
+		}
+
+		names[key] = name
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load interest category names: %w", err)
+	}
+
+	return names, nil
+}
+
 // loadFallbackTranslations загружает базовые переводы для тестов.
 func (l *Localizer) loadFallbackTranslations() {
 	// Английский
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// AccountDeletion - обезличенная запись об удалении аккаунта: без ID, имени и Telegram ID пользователя.
+type AccountDeletion struct {
+	ID               int       `db:"id"                json:"id"`
+	DeletedAt        time.Time `db:"deleted_at"        json:"deletedAt"`
+	AccountAgeDays   int       `db:"account_age_days"  json:"accountAgeDays"`
+	FeedbackRemoved  int       `db:"feedback_removed"  json:"feedbackRemoved"`
+	MatchesCancelled int       `db:"matches_cancelled" json:"matchesCancelled"`
+	PartnersNotified int       `db:"partners_notified" json:"partnersNotified"`
+}
+
+// MatchPartner - текущий собеседник удаляемого пользователя, которого нужно уведомить.
+type MatchPartner struct {
+	UserID                int    `db:"user_id"                 json:"userId"`
+	TelegramID            int64  `db:"telegram_id"             json:"telegramId"`
+	InterfaceLanguageCode string `db:"interface_language_code" json:"interfaceLanguageCode"`
+	Platform              string `db:"platform"                json:"platform"`
+}
+
+// AccountDeletionResult - итог удаления аккаунта: обезличенная запись и собеседники для уведомления.
+type AccountDeletionResult struct {
+	Deletion AccountDeletion
+	Partners []MatchPartner
+}
+
+// AccountDeletionStats - обезличенная статистика удалений аккаунтов для администраторов.
+type AccountDeletionStats struct {
+	Total            int `json:"total"`
+	Recent           int `json:"recent"` // Удалено за последние RecentDays дней
+	RecentDays       int `json:"recentDays"`
+	FeedbackRemoved  int `json:"feedbackRemoved"`
+	MatchesCancelled int `json:"matchesCancelled"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// Действия администраторов, которые записываются в журнал.
+const (
+	AdminActionResetProfile = "reset_profile"
+	AdminActionChangeStatus = "change_status"
+	AdminActionForceState   = "force_state"
+	AdminActionSendMessage  = "send_message"
+)
+
+// AdminTargetUser - тип объекта журнала для действий над пользователем.
+const AdminTargetUser = "user"
+
+// AdminActionLog - последнее действие администратора для карточки пользователя в админ-панели.
+// Это кэш для интерфейса, а не журнал аудита: записи изменяются при удалении и очистке данных,
+// источник истины - AuditEvent (audit_events).
+type AdminActionLog struct {
+	ID         int                    `db:"id"          json:"id"`
+	AdminID    int                    `db:"admin_id"    json:"adminId"`
+	Action     string                 `db:"action"      json:"action"`
+	TargetType string                 `db:"target_type" json:"targetType"`
+	TargetID   int                    `db:"target_id"   json:"targetId"`
+	Details    map[string]interface{} `db:"details"     json:"details"`
+	CreatedAt  time.Time              `db:"created_at"  json:"createdAt"`
+}
+
+// AdminUserCard - карточка пользователя в админ-панели: профиль, отзывы и последние действия администраторов.
+type AdminUserCard struct {
+	User     *User                    `json:"user"`
+	Feedback []map[string]interface{} `json:"feedback"`
+	Actions  []AdminActionLog         `json:"actions"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// AdminSession - сессия admin API, открытая входом через Telegram Login Widget.
+// Сам refresh-токен не хранится: по нему считается TokenHash.
+type AdminSession struct {
+	ID          int        `db:"id"           json:"id"`
+	UserID      int        `db:"user_id"      json:"userId"`
+	TokenHash   string     `db:"token_hash"   json:"-"`
+	CreatedAt   time.Time  `db:"created_at"   json:"createdAt"`
+	ExpiresAt   time.Time  `db:"expires_at"   json:"expiresAt"`
+	RevokedAt   *time.Time `db:"revoked_at"   json:"revokedAt,omitempty"`
+	RotatedFrom int        `db:"rotated_from" json:"rotatedFrom,omitempty"`
+}
+
+// IsUsable сообщает, действует ли refresh-токен сессии в момент now: не отозван и не истек.
+func (s *AdminSession) IsUsable(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// AdminClaims - claims access-токена (JWT) admin API. Роль и права вшиты в токен,
+// поэтому он проверяется без обращения к БД.
+type AdminClaims struct {
+	Issuer      string   `json:"iss"`
+	Subject     string   `json:"sub"` // Telegram ID
+	UserID      int      `json:"uid"`
+	TelegramID  int64    `json:"tid"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"perms"`
+	SessionID   int      `json:"sid"`
+	IssuedAt    int64    `json:"iat"`
+	ExpiresAt   int64    `json:"exp"`
+}
+
+// HasPermission сообщает, есть ли право в токене.
+func (c *AdminClaims) HasPermission(permission string) bool {
+	return slices.Contains(c.Permissions, permission)
+}
+
+// AdminTokens - пара токенов, выдаваемая при входе и обновлении сессии.
+type AdminTokens struct {
+	AccessToken      string    `json:"accessToken"`
+	TokenType        string    `json:"tokenType"`
+	ExpiresIn        int       `json:"expiresIn"` // Секунд до истечения access-токена
+	RefreshToken     string    `json:"refreshToken"`
+	RefreshExpiresAt time.Time `json:"refreshExpiresAt"`
+	Role             string    `json:"role"`
+	Permissions      []string  `json:"permissions"`
+}
@@ -0,0 +1,68 @@
+package models
+
+import "time"
+
+// Статусы анонса.
+const (
+	AnnouncementStatusDraft     = "draft"
+	AnnouncementStatusSending   = "sending"
+	AnnouncementStatusSent      = "sent"
+	AnnouncementStatusCancelled = "cancelled"
+)
+
+// Статусы доставки анонса получателю.
+const (
+	DeliveryStatusPending = "pending"
+	DeliveryStatusSent    = "sent"
+	DeliveryStatusFailed  = "failed"
+	DeliveryStatusBlocked = "blocked" // Пользователь заблокировал бота
+)
+
+// AnnouncementSegment - фильтры получателей анонса. Пустой список не ограничивает выборку;
+// пользователи, заблокировавшие бота, не получают анонсы никогда.
+type AnnouncementSegment struct {
+	InterfaceLanguages   []string `json:"interfaceLanguages,omitempty"`
+	TargetLanguages      []string `json:"targetLanguages,omitempty"`
+	Statuses             []string `json:"statuses,omitempty"`
+	MinProfileCompletion int      `json:"minProfileCompletion,omitempty"`
+	MaxProfileCompletion *int     `json:"maxProfileCompletion,omitempty"`
+}
+
+// Announcement - анонс для рассылки сегменту пользователей.
+type Announcement struct {
+	ID              int                 `db:"id"               json:"id"`
+	Title           string              `db:"title"            json:"title"`
+	Message         string              `db:"message"          json:"message"`
+	Segment         AnnouncementSegment `db:"segment"          json:"segment"`
+	Status          string              `db:"status"           json:"status"`
+	RecipientsCount int                 `db:"recipients_count" json:"recipientsCount"`
+	SentCount       int                 `json:"sentCount"`
+	FailedCount     int                 `json:"failedCount"`
+	BlockedCount    int                 `json:"blockedCount"`
+	CreatedBy       int                 `db:"created_by"       json:"createdBy,omitempty"`
+	CreatedAt       time.Time           `db:"created_at"       json:"createdAt"`
+	SentAt          *time.Time          `db:"sent_at"          json:"sentAt,omitempty"`
+}
+
+// AnnouncementInput - данные для создания анонса через admin API.
+type AnnouncementInput struct {
+	Title   string              `json:"title"`
+	Message string              `json:"message"`
+	Segment AnnouncementSegment `json:"segment"`
+}
+
+// AnnouncementRecipient - получатель анонса, ожидающий доставки.
+type AnnouncementRecipient struct {
+	UserID     int   `db:"user_id"     json:"userId"`
+	TelegramID int64 `db:"telegram_id" json:"telegramId"`
+}
+
+// AnnouncementDelivery - результат доставки анонса одному получателю.
+type AnnouncementDelivery struct {
+	AnnouncementID int        `db:"announcement_id" json:"announcementId"`
+	UserID         int        `db:"user_id"         json:"userId"`
+	TelegramID     int64      `db:"telegram_id"     json:"telegramId"`
+	Status         string     `db:"status"          json:"status"`
+	Error          string     `db:"error"           json:"error,omitempty"`
+	AttemptedAt    *time.Time `db:"attempted_at"    json:"attemptedAt,omitempty"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// APIKeyScopeAll - право ключа на все действия admin API.
+const APIKeyScopeAll = "*"
+
+// APIKey - ключ admin API. Сам ключ не хранится: по нему считается KeyHash.
+type APIKey struct {
+	ID          int        `db:"id"           json:"id"`
+	Name        string     `db:"name"         json:"name"`
+	KeyPrefix   string     `db:"key_prefix"   json:"keyPrefix"`
+	KeyHash     string     `db:"key_hash"     json:"-"`
+	Scopes      []string   `db:"scopes"       json:"scopes"`
+	CreatedBy   int        `db:"created_by"   json:"createdBy,omitempty"`
+	CreatedAt   time.Time  `db:"created_at"   json:"createdAt"`
+	ExpiresAt   *time.Time `db:"expires_at"   json:"expiresAt,omitempty"`
+	LastUsedAt  *time.Time `db:"last_used_at" json:"lastUsedAt,omitempty"`
+	RevokedAt   *time.Time `db:"revoked_at"   json:"revokedAt,omitempty"`
+	RotatedFrom int        `db:"rotated_from" json:"rotatedFrom,omitempty"`
+	// ServiceAccount - ключ служебной учетной записи: запросы ограничены только правами ключа.
+	// Остальные ключи действуют от имени владельца OwnerUserID.
+	ServiceAccount bool `db:"service_account" json:"serviceAccount"`
+	// OwnerUserID - пользователь, от имени которого действует ключ без служебной учетной записи:
+	// роль вызывающего берется по нему, а не по заголовку X-Telegram-User-ID. Задается при создании.
+	OwnerUserID int `db:"owner_user_id" json:"ownerUserId,omitempty"`
+}
+
+// HasScope сообщает, разрешено ли ключу действие.
+func (k *APIKey) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, APIKeyScopeAll) || slices.Contains(k.Scopes, scope)
+}
+
+// IsUsable сообщает, действует ли ключ в момент now: не отозван и не истек.
+func (k *APIKey) IsUsable(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// APIKeyInput - данные для выпуска ключа. Без ExpiresInDays действует срок по умолчанию,
+// 0 - бессрочный ключ.
+type APIKeyInput struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays *int     `json:"expiresInDays,omitempty"`
+	// ServiceAccount задается только при создании; выпускать такие ключи может только администратор.
+	ServiceAccount bool `json:"serviceAccount,omitempty"`
+	// OwnerTelegramID обязателен для ключа без служебной учетной записи. Не администратор
+	// может привязать ключ только к себе.
+	OwnerTelegramID int64 `json:"ownerTelegramId,omitempty"`
+}
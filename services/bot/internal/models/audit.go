@@ -0,0 +1,64 @@
+package models
+
+import "time"
+
+// Типы инициаторов событий аудита.
+const (
+	AuditActorUser   = "user"    // Пользователь бота (actor_id - псевдоним пользователя)
+	AuditActorAdmin  = "admin"   // Пользователь с ролью (actor_id - псевдоним пользователя)
+	AuditActorAPIKey = "api_key" // Ключ admin API (actor_id - ID ключа)
+	AuditActorSystem = "system"  // Сам сервис
+)
+
+// Псевдонимы пользователей в журнале аудита. Связь псевдонима с пользователем удаляется
+// вместе с аккаунтом, поэтому записи аудита удаленного пользователя обезличены.
+const (
+	AuditPseudonymPrefix  = "p_"        // Начало псевдонима, отличает его от ID ключей и объектов
+	AuditPseudonymUnknown = "p_unknown" // Псевдоним не удалось получить: запись аудита не отменяется
+)
+
+// Результаты событий аудита.
+const (
+	AuditResultSuccess = "success"
+	AuditResultFailure = "failure"
+)
+
+// AuditEvent - запись журнала аудита: кто, что, над чем и когда сделал.
+// Hash считается от PrevHash и полей записи, поэтому записи образуют цепочку.
+type AuditEvent struct {
+	ID         int64                  `db:"id"          json:"id"`
+	CreatedAt  time.Time              `db:"created_at"  json:"createdAt"`
+	ActorType  string                 `db:"actor_type"  json:"actorType"`
+	ActorID    string                 `db:"actor_id"    json:"actorId,omitempty"`
+	Action     string                 `db:"action"      json:"action"`
+	TargetType string                 `db:"target_type" json:"targetType,omitempty"`
+	TargetID   string                 `db:"target_id"   json:"targetId,omitempty"`
+	Result     string                 `db:"result"      json:"result"`
+	IPAddress  string                 `db:"ip_address"  json:"ipAddress,omitempty"`
+	Details    map[string]interface{} `db:"details"     json:"details,omitempty"`
+	PrevHash   string                 `db:"prev_hash"   json:"prevHash"`
+	Hash       string                 `db:"hash"        json:"hash"`
+}
+
+// AuditFilter - условия выборки журнала аудита. Пустые поля не ограничивают выборку;
+// Action, оканчивающийся на "*", выбирает все действия с этим префиксом (например, "feedback.*").
+type AuditFilter struct {
+	ActorType  string
+	ActorID    string
+	Action     string
+	TargetType string
+	TargetID   string
+	Result     string
+	From       *time.Time
+	To         *time.Time
+	Limit      int
+	Offset     int
+}
+
+// AuditVerification - результат проверки цепочки журнала аудита.
+// При нарушении BrokenAtID - первая запись, не сходящаяся с предыдущей.
+type AuditVerification struct {
+	Valid      bool  `json:"valid"`
+	Checked    int   `json:"checked"`
+	BrokenAtID int64 `json:"brokenAtId,omitempty"`
+}
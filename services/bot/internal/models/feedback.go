@@ -0,0 +1,174 @@
+package models
+
+import "time"
+
+// Авторы сообщений в переписке по отзыву.
+const (
+	FeedbackAuthorAdmin = "admin" // Ответ администратора
+	FeedbackAuthorUser  = "user"  // Ответ автора отзыва
+)
+
+// FeedbackMessage - сообщение в переписке по отзыву.
+type FeedbackMessage struct {
+	ID         int       `db:"id"           json:"id"`
+	FeedbackID int       `db:"feedback_id"  json:"feedbackId"`
+	AuthorType string    `db:"author_type"  json:"authorType"`
+	AuthorID   int       `db:"author_id"    json:"authorId"`
+	Text       string    `db:"message_text" json:"text"`
+	CreatedAt  time.Time `db:"created_at"   json:"createdAt"`
+}
+
+// FeedbackThread - отзыв и переписка по нему в порядке отправки.
+type FeedbackThread struct {
+	FeedbackID   int               `json:"feedbackId"`
+	UserID       int               `json:"userId"`
+	FeedbackText string            `json:"feedbackText"`
+	IsProcessed  bool              `json:"isProcessed"`
+	Messages     []FeedbackMessage `json:"messages"`
+}
+
+// FeedbackReply - сохраненный ответ администратора и данные для его доставки автору отзыва.
+type FeedbackReply struct {
+	Recipient    *User
+	FeedbackText string
+	Message      FeedbackMessage
+}
+
+// Типы вложений отзыва.
+const (
+	FeedbackAttachmentPhoto    = "photo"
+	FeedbackAttachmentDocument = "document"
+	FeedbackAttachmentVoice    = "voice"
+)
+
+// FeedbackAttachment - файл, приложенный к отзыву. Файл хранится в Telegram и пересылается по FileID.
+type FeedbackAttachment struct {
+	ID           int       `db:"id"             json:"id"`
+	FeedbackID   int       `db:"feedback_id"    json:"feedbackId"`
+	Kind         string    `db:"kind"           json:"kind"`
+	FileID       string    `db:"file_id"        json:"fileId"`
+	FileUniqueID string    `db:"file_unique_id" json:"fileUniqueId"`
+	FileName     string    `db:"file_name"      json:"fileName,omitempty"`
+	MimeType     string    `db:"mime_type"      json:"mimeType,omitempty"`
+	FileSize     int       `db:"file_size"      json:"fileSize,omitempty"`
+	CreatedAt    time.Time `db:"created_at"     json:"createdAt"`
+}
+
+// FeedbackDraft - отзыв, который пользователь еще составляет: вложения и текст, ожидающий контактов.
+type FeedbackDraft struct {
+	Text         string               `json:"text,omitempty"`
+	Attachments  []FeedbackAttachment `json:"attachments,omitempty"`
+	MediaGroupID string               `json:"mediaGroupId,omitempty"` // Альбом последнего вложения
+}
+
+// Категории отзывов.
+const (
+	FeedbackCategoryBug       = "bug"       // Ошибка
+	FeedbackCategoryFeature   = "feature"   // Предложение
+	FeedbackCategoryComplaint = "complaint" // Жалоба
+	FeedbackCategoryPraise    = "praise"    // Благодарность
+)
+
+// Приоритеты отзывов.
+const (
+	FeedbackPriorityLow    = "low"
+	FeedbackPriorityNormal = "normal"
+	FeedbackPriorityHigh   = "high"
+	FeedbackPriorityUrgent = "urgent"
+)
+
+// FeedbackCategories - допустимые категории в порядке вывода.
+var FeedbackCategories = []string{
+	FeedbackCategoryBug, FeedbackCategoryFeature, FeedbackCategoryComplaint, FeedbackCategoryPraise,
+}
+
+// FeedbackPriorities - допустимые приоритеты по возрастанию срочности.
+var FeedbackPriorities = []string{
+	FeedbackPriorityLow, FeedbackPriorityNormal, FeedbackPriorityHigh, FeedbackPriorityUrgent,
+}
+
+// FeedbackTriage - разметка отзыва: категория, приоритет, ответственный и срок обработки.
+type FeedbackTriage struct {
+	FeedbackID   int        `db:"id"            json:"feedbackId"`
+	Category     string     `db:"category"      json:"category,omitempty"`
+	CategoryAuto bool       `db:"category_auto" json:"categoryAuto"`
+	Priority     string     `db:"priority"      json:"priority"`
+	AssigneeID   int        `db:"assignee_id"   json:"assigneeId,omitempty"`
+	SLADueAt     *time.Time `db:"sla_due_at"    json:"slaDueAt,omitempty"`
+}
+
+// FeedbackTriageUpdate - изменение разметки отзыва; nil-поля не меняются.
+type FeedbackTriageUpdate struct {
+	Category   *string `json:"category,omitempty"`
+	Priority   *string `json:"priority,omitempty"`
+	AssigneeID *int    `json:"assigneeId,omitempty"` // 0 снимает ответственного
+}
+
+// FeedbackSLABreach - необработанный отзыв с истекшим сроком обработки.
+type FeedbackSLABreach struct {
+	FeedbackID         int
+	FeedbackText       string
+	Category           string
+	Priority           string
+	SLADueAt           time.Time
+	AssigneeTelegramID int64 // 0, если ответственный не назначен
+}
+
+// FeedbackFilter - фильтр просмотра активных отзывов; пустые поля не ограничивают выборку.
+type FeedbackFilter struct {
+	Category     string `json:"category,omitempty"`
+	Priority     string `json:"priority,omitempty"`
+	AssignedToMe bool   `json:"assignedToMe,omitempty"`
+	Breached     bool   `json:"breached,omitempty"`
+}
+
+// IsEmpty сообщает, что фильтр не задан.
+func (f FeedbackFilter) IsEmpty() bool {
+	return f == FeedbackFilter{}
+}
+
+// Статусы отзывов в поиске и выгрузке.
+const (
+	FeedbackStatusActive    = "active"    // Не обработан
+	FeedbackStatusProcessed = "processed" // Обработан
+)
+
+// Форматы выгрузки отзывов.
+const (
+	FeedbackExportCSV   = "csv"
+	FeedbackExportJSONL = "jsonl"
+)
+
+// FeedbackSearchLanguages - языки, для которых настроен полнотекстовый поиск по отзывам.
+var FeedbackSearchLanguages = []string{"ru", "en", "es", "zh"}
+
+// FeedbackQuery - условия поиска и выгрузки отзывов; пустые поля не ограничивают выборку.
+type FeedbackQuery struct {
+	Text     string     // Полнотекстовый запрос в синтаксисе веб-поиска ("фраза", -исключение, or)
+	Language string     // Язык запроса: ru, en, es, zh; пусто - все языки
+	Status   string     // FeedbackStatusActive или FeedbackStatusProcessed
+	From     *time.Time // Создан не раньше
+	To       *time.Time // Создан раньше (граница не включается)
+	UserID   int        // Только отзывы пользователя; 0 - всех пользователей
+	Limit    int
+}
+
+// FeedbackRecord - отзыв в результатах поиска и выгрузке.
+type FeedbackRecord struct {
+	ID            int        `json:"id"`
+	UserID        int        `json:"userId"`
+	TelegramID    int64      `json:"telegramId"`
+	Username      string     `json:"username,omitempty"`
+	FirstName     string     `json:"firstName,omitempty"`
+	Language      string     `json:"language,omitempty"`
+	Text          string     `json:"text"`
+	ContactInfo   string     `json:"contactInfo,omitempty"`
+	IsProcessed   bool       `json:"isProcessed"`
+	AdminResponse string     `json:"adminResponse,omitempty"`
+	Category      string     `json:"category,omitempty"`
+	Priority      string     `json:"priority"`
+	AssigneeID    int        `json:"assigneeId,omitempty"`
+	SLADueAt      *time.Time `json:"slaDueAt,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	Rank          float64    `json:"rank,omitempty"` // Релевантность в результатах поиска
+}
@@ -12,3 +12,66 @@ type Interest struct {
 	CreatedAt    time.Time `db:"created_at"`
 	CategoryKey  string    `db:"category_key"` // Добавляем для удобства
 }
+
+// Статусы модерации предложенных интересов.
+const (
+	InterestSuggestionPending  = "pending"
+	InterestSuggestionApproved = "approved"
+	InterestSuggestionRejected = "rejected"
+)
+
+// InterestSuggestion представляет интерес, предложенный пользователем и ожидающий модерации.
+type InterestSuggestion struct {
+	ID            int        `db:"id"             json:"id"`
+	UserID        int        `db:"user_id"        json:"userId"`
+	SuggestedText string     `db:"suggested_text" json:"suggestedText"`
+	LanguageCode  string     `db:"language_code"  json:"languageCode"`
+	Status        string     `db:"status"         json:"status"`
+	InterestID    *int       `db:"interest_id"    json:"interestId,omitempty"`
+	ModeratorNote string     `db:"moderator_note" json:"moderatorNote,omitempty"`
+	CreatedAt     time.Time  `db:"created_at"     json:"createdAt"`
+	ReviewedAt    *time.Time `db:"reviewed_at"    json:"reviewedAt,omitempty"`
+}
+
+// InterestSuggestionApproval описывает, каким интересом становится одобренное предложение.
+type InterestSuggestionApproval struct {
+	CategoryKey  string            `json:"category_key"`
+	KeyName      string            `json:"key_name"`
+	Translations map[string]string `json:"translations"` // код языка -> название
+}
+
+// Типы связей между интересами.
+const (
+	InterestRelationRelated = "related" // Похожие интересы, связь симметрична
+	InterestRelationParent  = "parent"  // InterestID - общий интерес, RelatedInterestID - его частный случай
+)
+
+// InterestRelation представляет ребро графа связей между интересами.
+type InterestRelation struct {
+	ID                 int       `db:"id"                  json:"id"`
+	InterestID         int       `db:"interest_id"         json:"interestId"`
+	InterestKey        string    `db:"interest_key"        json:"interestKey"`
+	RelatedInterestID  int       `db:"related_interest_id" json:"relatedInterestId"`
+	RelatedInterestKey string    `db:"related_key"         json:"relatedInterestKey"`
+	RelationType       string    `db:"relation_type"       json:"relationType"`
+	CreatedAt          time.Time `db:"created_at"          json:"createdAt"`
+}
+
+// InterestCooccurrence - как часто выбравшие InterestID выбирали и RelatedInterestID.
+type InterestCooccurrence struct {
+	InterestID         int       `db:"interest_id"         json:"interestId"`
+	InterestKey        string    `db:"interest_key"        json:"interestKey"`
+	RelatedInterestID  int       `db:"related_interest_id" json:"relatedInterestId"`
+	RelatedInterestKey string    `db:"related_key"         json:"relatedInterestKey"`
+	UsersCount         int       `db:"users_count"         json:"usersCount"`
+	Confidence         float64   `db:"confidence"          json:"confidence"` // Доля выбравших InterestID
+	ComputedAt         time.Time `db:"computed_at"         json:"computedAt"`
+}
+
+// InterestRecommendation - интерес, рекомендованный по выбору похожих пользователей.
+type InterestRecommendation struct {
+	InterestID  int     `db:"interest_id"  json:"interestId"`
+	KeyName     string  `db:"key_name"     json:"keyName"`
+	CategoryKey string  `db:"category_key" json:"categoryKey"`
+	Score       float64 `db:"score"        json:"score"`
+}
@@ -0,0 +1,85 @@
+package models
+
+// Действия плана синхронизации каталога интересов.
+const (
+	InterestCatalogInsert = "insert" // Интерес есть в файле, но нет в БД
+	InterestCatalogRename = "rename" // Ключ в БД указан в previous_keys интереса из файла
+	InterestCatalogUpdate = "update" // Изменились категория, порядок или переводы
+	InterestCatalogRemove = "remove" // Интерес есть в БД, но нет в файле
+)
+
+// Происхождение интереса (interests.source). catalog-sync удаляет только интересы из каталога.
+const (
+	InterestSourceCatalog    = "catalog"    // Из config/interests.json
+	InterestSourceSuggestion = "suggestion" // Одобрен из предложения пользователя
+	InterestSourceAdmin      = "admin"      // Создан через admin API
+)
+
+// InterestCatalogItem - интерес каталога вместе с категорией и переводами.
+type InterestCatalogItem struct {
+	ID              int               `json:"id,omitempty"`
+	KeyName         string            `json:"keyName"`
+	CategoryKey     string            `json:"categoryKey"`
+	DisplayOrder    int               `json:"displayOrder"`
+	Translations    map[string]string `json:"translations"`
+	SelectionsCount int               `json:"selectionsCount,omitempty"` // Сколько пользователей выбрали интерес
+	Source          string            `json:"source,omitempty"`          // Происхождение интереса, InterestSource*
+}
+
+// IsCatalogManaged сообщает, что интерес принадлежит каталогу interests.json.
+// Пустое происхождение (снимок без колонки source) считается каталожным.
+func (i InterestCatalogItem) IsCatalogManaged() bool {
+	return i.Source == "" || i.Source == InterestSourceCatalog
+}
+
+// InterestCatalog - снимок каталога интересов в БД.
+type InterestCatalog struct {
+	Categories []InterestCategory    `json:"categories"`
+	Interests  []InterestCatalogItem `json:"interests"`
+}
+
+// InterestCatalogChange - одно изменение каталога.
+// Before пуст для insert, After пуст для remove.
+type InterestCatalogChange struct {
+	Action    string               `json:"action"`
+	Before    *InterestCatalogItem `json:"before,omitempty"`
+	After     *InterestCatalogItem `json:"after,omitempty"`
+	MigrateTo string               `json:"migrateTo,omitempty"` // Куда перенести выборы пользователей при удалении
+}
+
+// InterestCatalogPlan - план синхронизации каталога интересов с файлом.
+type InterestCatalogPlan struct {
+	Categories []InterestCategory      `json:"categories"` // Новые категории и категории с новым порядком
+	Changes    []InterestCatalogChange `json:"changes"`
+}
+
+// IsEmpty сообщает, что каталог в БД уже совпадает с файлом.
+func (p *InterestCatalogPlan) IsEmpty() bool {
+	return len(p.Categories) == 0 && len(p.Changes) == 0
+}
+
+// InterestCategoryItem - категория интересов вместе с переводами названия.
+type InterestCategoryItem struct {
+	ID             int               `json:"id"`
+	KeyName        string            `json:"keyName"`
+	DisplayOrder   int               `json:"displayOrder"`
+	Translations   map[string]string `json:"translations"`
+	InterestsCount int               `json:"interestsCount"`
+}
+
+// InterestCategoryInput - изменения категории из admin API.
+// Пустые поля не меняются; пустое название в Translations удаляет перевод.
+type InterestCategoryInput struct {
+	KeyName      *string           `json:"key_name"`
+	DisplayOrder *int              `json:"display_order"`
+	Translations map[string]string `json:"translations"` // код языка -> название
+}
+
+// InterestInput - изменения интереса из admin API.
+// Пустые поля не меняются; пустое название в Translations удаляет перевод.
+type InterestInput struct {
+	KeyName      *string           `json:"key_name"`
+	CategoryKey  *string           `json:"category_key"`
+	DisplayOrder *int              `json:"display_order"`
+	Translations map[string]string `json:"translations"` // код языка -> название
+}
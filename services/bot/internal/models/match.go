@@ -0,0 +1,8 @@
+package models
+
+// Статусы совпадения в очереди match_queue.
+const (
+	MatchStatusPending   = "pending"   // Найдено, собеседникам еще не отправлено
+	MatchStatusSent      = "sent"      // Отправлено: собеседники знакомы
+	MatchStatusCancelled = "cancelled" // Отменено (в том числе из-за отпуска собеседника)
+)
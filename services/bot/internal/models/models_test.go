@@ -576,3 +576,49 @@ func TestModels_DataIntegrity(t *testing.T) {
 	assert.NotEmpty(t, category.KeyName)
 	assert.NotEmpty(t, category.Name)
 }
+
+// TestUnavailabilityPeriod_IsActiveOn тестирует попадание даты в период недоступности.
+func TestUnavailabilityPeriod_IsActiveOn(t *testing.T) {
+	period := UnavailabilityPeriod{
+		StartDate: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 7, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.False(t, period.IsActiveOn(time.Date(2026, 6, 30, 23, 59, 0, 0, time.UTC)))
+	assert.True(t, period.IsActiveOn(time.Date(2026, 7, 1, 8, 0, 0, 0, time.UTC)))
+	assert.True(t, period.IsActiveOn(time.Date(2026, 7, 14, 22, 0, 0, 0, time.UTC)))
+	assert.False(t, period.IsActiveOn(time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC)))
+}
+
+// TestUnavailabilityPeriod_IsExpired тестирует определение завершившихся периодов.
+func TestUnavailabilityPeriod_IsExpired(t *testing.T) {
+	period := UnavailabilityPeriod{
+		StartDate: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2026, 7, 14, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.False(t, period.IsExpired(time.Date(2026, 7, 14, 18, 0, 0, 0, time.UTC)))
+	assert.True(t, period.IsExpired(time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC)))
+}
+
+// TestUser_OnTelegram тестирует, кому можно написать в Telegram.
+func TestUser_OnTelegram(t *testing.T) {
+	assert.True(t, (&User{TelegramID: 123456789}).OnTelegram())
+	assert.True(t, (&User{TelegramID: 123456789, Platform: PlatformTelegram}).OnTelegram())
+	assert.False(t, (&User{Platform: PlatformDiscord}).OnTelegram())
+	// Без telegram_id написать некуда
+	assert.False(t, (&User{Platform: PlatformTelegram}).OnTelegram())
+}
+
+// TestParseQualifiedUserID тестирует разбор ID пользователя с указанием платформы.
+func TestParseQualifiedUserID(t *testing.T) {
+	platform, externalID, ok := ParseQualifiedUserID(QualifiedUserID(PlatformDiscord, "80351110224678912"))
+	assert.True(t, ok)
+	assert.Equal(t, PlatformDiscord, platform)
+	assert.Equal(t, "80351110224678912", externalID)
+
+	for _, id := range []string{"", "12345", "discord:", "slack:U123"} {
+		_, _, ok = ParseQualifiedUserID(id)
+		assert.False(t, ok, id)
+	}
+}
@@ -0,0 +1,32 @@
+package models
+
+import "strings"
+
+// Платформы, через которые пользователи общаются с ботом.
+const (
+	PlatformTelegram = "telegram"
+	PlatformDiscord  = "discord"
+)
+
+// OnTelegram сообщает, можно ли написать пользователю в Telegram. У пользователей других платформ
+// telegram_id нет (NULL, в модели 0), их ID на платформе хранится в user_identities. Пустая
+// платформа - пользователь, загруженный без нее, то есть из Telegram.
+func (u *User) OnTelegram() bool {
+	return u.TelegramID != 0 && (u.Platform == "" || u.Platform == PlatformTelegram)
+}
+
+// QualifiedUserID возвращает ID пользователя с указанием платформы, например discord:80351110224678912.
+// ID разных платформ могут совпадать, поэтому адаптеры идентифицируют пользователей так.
+func QualifiedUserID(platform, externalID string) string {
+	return platform + ":" + externalID
+}
+
+// ParseQualifiedUserID разбирает ID пользователя с указанием платформы.
+func ParseQualifiedUserID(id string) (platform, externalID string, ok bool) {
+	platform, externalID, ok = strings.Cut(id, ":")
+	if !ok || externalID == "" || (platform != PlatformTelegram && platform != PlatformDiscord) {
+		return "", "", false
+	}
+
+	return platform, externalID, true
+}
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Типы данных, для которых действуют сроки хранения.
+const (
+	RetentionFeedback      = "feedback"       // Обработанные отзывы
+	RetentionInactiveUsers = "inactive_users" // Пользователи без активности
+	RetentionMatches       = "matches"        // Отмененные совпадения
+)
+
+// RetentionPolicy - срок хранения одного типа данных. Months = 0 - хранить бессрочно.
+// Устаревшие данные всегда удаляются; неактивные пользователи удаляются так же, как по /deleteme.
+type RetentionPolicy struct {
+	DataType string `json:"dataType"`
+	Months   int    `json:"months"`
+}
+
+// PurgeBefore возвращает момент, данные старше которого подлежат очистке.
+func (p RetentionPolicy) PurgeBefore(now time.Time) time.Time {
+	return now.AddDate(0, -p.Months, 0)
+}
+
+// RetentionReportItem - результат применения одной политики: сколько строк подлежит
+// очистке (при пробном запуске) или было очищено.
+type RetentionReportItem struct {
+	RetentionPolicy
+
+	Enabled bool       `json:"enabled"`
+	Cutoff  *time.Time `json:"cutoff,omitempty"`
+	Count   int64      `json:"count"`
+}
+
+// RetentionReport - отчет об очистке данных по срокам хранения.
+type RetentionReport struct {
+	GeneratedAt time.Time             `json:"generatedAt"`
+	DryRun      bool                  `json:"dryRun"`
+	Items       []RetentionReportItem `json:"items"`
+}
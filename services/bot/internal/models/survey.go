@@ -0,0 +1,133 @@
+package models
+
+import "time"
+
+// Виды опросов.
+const (
+	SurveyKindNPS           = "nps"           // Один вопрос NPS
+	SurveyKindQuestionnaire = "questionnaire" // Несколько коротких вопросов
+)
+
+// Виды вопросов опроса.
+const (
+	SurveyQuestionNPS    = "nps"    // Оценка 0-10
+	SurveyQuestionRating = "rating" // Оценка 1-5
+	SurveyQuestionChoice = "choice" // Один вариант из списка
+)
+
+// Статусы опроса.
+const (
+	SurveyStatusDraft     = "draft"
+	SurveyStatusScheduled = "scheduled"
+	SurveyStatusSending   = "sending"
+	SurveyStatusSent      = "sent"
+	SurveyStatusCancelled = "cancelled"
+)
+
+// SurveyText - текст с переводами: язык интерфейса -> текст.
+type SurveyText map[string]string
+
+// SurveyQuestion - вопрос опроса. Для вопроса NPS без текста используется стандартная формулировка.
+type SurveyQuestion struct {
+	Kind    string       `json:"kind"`
+	Text    SurveyText   `json:"text,omitempty"`
+	Options []SurveyText `json:"options,omitempty"` // Варианты ответа для choice
+}
+
+// Survey - опрос для рассылки сегменту пользователей.
+type Survey struct {
+	ID              int                 `db:"id"               json:"id"`
+	Title           string              `db:"title"            json:"title"`
+	Kind            string              `db:"kind"             json:"kind"`
+	Questions       []SurveyQuestion    `db:"questions"        json:"questions"`
+	Segment         AnnouncementSegment `db:"segment"          json:"segment"`
+	Status          string              `db:"status"           json:"status"`
+	ScheduledAt     *time.Time          `db:"scheduled_at"     json:"scheduledAt,omitempty"`
+	RecipientsCount int                 `db:"recipients_count" json:"recipientsCount"`
+	SentCount       int                 `json:"sentCount"`
+	RespondedCount  int                 `json:"respondedCount"`
+	CreatedBy       int                 `db:"created_by"       json:"createdBy,omitempty"`
+	CreatedAt       time.Time           `db:"created_at"       json:"createdAt"`
+	SentAt          *time.Time          `db:"sent_at"          json:"sentAt,omitempty"`
+}
+
+// SurveyInput - данные для создания опроса через admin API. Без scheduledAt опрос
+// остается черновиком и запускается вручную.
+type SurveyInput struct {
+	Title       string              `json:"title"`
+	Kind        string              `json:"kind"`
+	Questions   []SurveyQuestion    `json:"questions,omitempty"`
+	Segment     AnnouncementSegment `json:"segment"`
+	ScheduledAt *time.Time          `json:"scheduledAt,omitempty"`
+}
+
+// SurveyFrequency - правила, ограничивающие, как часто один пользователь получает опросы.
+type SurveyFrequency struct {
+	Cooldown    time.Duration // Минимальный промежуток между опросами
+	Window      time.Duration // Период, за который считается MaxInWindow
+	MaxInWindow int           // Максимум опросов за Window
+}
+
+// SurveyRecipient - получатель опроса, ожидающий доставки.
+type SurveyRecipient struct {
+	UserID     int    `db:"user_id"                 json:"userId"`
+	TelegramID int64  `db:"telegram_id"             json:"telegramId"`
+	Language   string `db:"interface_language_code" json:"language"`
+}
+
+// SurveyPrompt - вопрос опроса на языке получателя, готовый к отправке.
+type SurveyPrompt struct {
+	SurveyID      int      `json:"surveyId"`
+	QuestionIndex int      `json:"questionIndex"`
+	QuestionCount int      `json:"questionCount"`
+	Kind          string   `json:"kind"`
+	Language      string   `json:"language"` // Язык интерфейса получателя
+	Text          string   `json:"text"`
+	Options       []string `json:"options,omitempty"` // Подписи вариантов для choice
+}
+
+// SurveyAnswer - ответ пользователя на вопрос опроса.
+type SurveyAnswer struct {
+	SurveyID      int       `db:"survey_id"      json:"surveyId"`
+	UserID        int       `db:"user_id"        json:"userId"`
+	QuestionIndex int       `db:"question_index" json:"questionIndex"`
+	Value         int       `db:"value"          json:"value"`
+	AnsweredAt    time.Time `db:"answered_at"    json:"answeredAt"`
+}
+
+// SurveyAnswerCount - сколько раз на вопрос ответили значением Value.
+type SurveyAnswerCount struct {
+	QuestionIndex int `db:"question_index" json:"questionIndex"`
+	Value         int `db:"value"          json:"value"`
+	Count         int `db:"count"          json:"count"`
+}
+
+// SurveyParticipation - охват опроса: доставлено, ответили хотя бы на один вопрос, ответили на все.
+type SurveyParticipation struct {
+	Sent      int `json:"sent"`
+	Responded int `json:"responded"`
+	Completed int `json:"completed"`
+}
+
+// SurveyQuestionResult - сводка ответов на вопрос опроса.
+type SurveyQuestionResult struct {
+	Index        int         `json:"index"`
+	Kind         string      `json:"kind"`
+	Text         string      `json:"text"`
+	Answers      int         `json:"answers"`
+	Distribution map[int]int `json:"distribution"`      // Значение -> количество ответов
+	Options      []string    `json:"options,omitempty"` // Подписи вариантов для choice
+	Average      *float64    `json:"average,omitempty"` // Для nps и rating
+	NPS          *float64    `json:"nps,omitempty"`     // Доля промоутеров минус доля критиков, -100..100
+	Promoters    int         `json:"promoters,omitempty"`
+	Passives     int         `json:"passives,omitempty"`
+	Detractors   int         `json:"detractors,omitempty"`
+}
+
+// SurveyResults - агрегированные результаты опроса.
+type SurveyResults struct {
+	Survey        Survey                 `json:"survey"`
+	Participation SurveyParticipation    `json:"participation"`
+	ResponseRate  float64                `json:"responseRate"` // Доля ответивших от получивших, 0..1
+	Questions     []SurveyQuestionResult `json:"questions"`
+}
@@ -17,7 +17,14 @@ const (
 	StateWaitingFriendshipPreferences = "waiting_friendship_preferences"
 	StateWaitingTime                  = "waiting_time" // Legacy, оставлено для совместимости
 	StateWaitingFeedback              = "waiting_feedback"
-	StateWaitingFeedbackContact       = "waiting_feedback_contact" // Для сбора контактной информации без username
+	StateWaitingFeedbackContact       = "waiting_feedback_contact"     // Для сбора контактной информации без username
+	StateWaitingUnavailabilityDates   = "waiting_unavailability_dates" // Ввод дат отпуска в редакторе доступности
+	StateWaitingInterestSuggestion    = "waiting_interest_suggestion"  // Ввод названия предлагаемого интереса
+	StateWaitingAdminUserSearch       = "waiting_admin_user_search"    // Ввод запроса поиска в админ-панели
+	StateWaitingAdminMessage          = "waiting_admin_message"        // Ввод сообщения пользователю в админ-панели
+	StateWaitingFeedbackReply         = "waiting_feedback_reply"       // Ввод ответа администратора на отзыв
+	StateWaitingFeedbackAnswer        = "waiting_feedback_answer"      // Ввод ответа автора отзыва администратору
+	StateWaitingFeedbackSearch        = "waiting_feedback_search"      // Ввод запроса поиска по отзывам
 	StateActive                       = "active"
 )
 
@@ -29,6 +36,13 @@ const (
 	StatusPaused  = "paused"
 )
 
+// Роли пользователя.
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleAdmin     = "admin"
+)
+
 // User представляет пользователя системы.
 type User struct {
 	ID                     int       `db:"id"                       json:"id"`
@@ -42,6 +56,9 @@ type User struct {
 	State                  string    `db:"state"                    json:"state"`
 	Status                 string    `db:"status"                   json:"status"`
 	ProfileCompletionLevel int       `db:"profile_completion_level" json:"profileCompletionLevel"`
+	Role                   string    `db:"role"                     json:"role"`
+	IsActive               bool      `db:"is_active"                json:"isActive"` // false, если пользователь заблокировал бота
+	Platform               string    `db:"platform"                 json:"platform"` // Платформа пользователя: PlatformTelegram или PlatformDiscord
 	CreatedAt              time.Time `db:"created_at"               json:"createdAt"`
 	UpdatedAt              time.Time `db:"updated_at"               json:"updatedAt"`
 	Interests              []int     `db:"-" json:"interests"` // Не храним в БД, загружаем отдельно
@@ -49,6 +66,8 @@ type User struct {
 	// Дополнительные поля для расширенного профиля
 	TimeAvailability      *TimeAvailability      `db:"-" json:"timeAvailability"`      // Временная доступность
 	FriendshipPreferences *FriendshipPreferences `db:"-" json:"friendshipPreferences"` // Предпочтения общения
+	UnavailabilityPeriods []UnavailabilityPeriod `db:"-" json:"unavailabilityPeriods"` // Отпуск и другие периоды недоступности
+	LearningGoals         []string               `db:"-" json:"learningGoals"`         // Цели изучения языка
 }
 
 // TimeAvailability - временная доступность пользователя
@@ -64,3 +83,81 @@ type FriendshipPreferences struct {
 	CommunicationStyles []string `db:"communication_styles"      json:"communicationStyles"`    // массив способов общения для мультивыбора
 	CommunicationFreq   string   `db:"communication_frequency"   json:"communicationFrequency"` // spontaneous, weekly, daily
 }
+
+// Причины недоступности пользователя.
+const (
+	UnavailabilityReasonVacation     = "vacation"
+	UnavailabilityReasonBusinessTrip = "business_trip"
+	UnavailabilityReasonExams        = "exams"
+	UnavailabilityReasonOther        = "other"
+)
+
+// UnavailabilityPeriod - период, когда пользователь недоступен для общения (отпуск и т.п.).
+// Даты хранятся без времени, оба конца периода включительно.
+type UnavailabilityPeriod struct {
+	ID        int       `db:"id"         json:"id"`
+	UserID    int       `db:"user_id"    json:"userId"`
+	StartDate time.Time `db:"start_date" json:"startDate"`
+	EndDate   time.Time `db:"end_date"   json:"endDate"`
+	Reason    string    `db:"reason"     json:"reason"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+// IsActiveOn проверяет, попадает ли указанный момент в период недоступности.
+func (p UnavailabilityPeriod) IsActiveOn(t time.Time) bool {
+	day := truncateToDay(t)
+
+	return !day.Before(truncateToDay(p.StartDate)) && !day.After(truncateToDay(p.EndDate))
+}
+
+// IsExpired проверяет, закончился ли период к указанному моменту.
+func (p UnavailabilityPeriod) IsExpired(t time.Time) bool {
+	return truncateToDay(p.EndDate).Before(truncateToDay(t))
+}
+
+// truncateToDay отбрасывает время, оставляя только дату (в UTC).
+func truncateToDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Цели изучения языка.
+const (
+	LearningGoalTravel     = "travel"
+	LearningGoalExamIELTS  = "exam_ielts"
+	LearningGoalExamHSK    = "exam_hsk"
+	LearningGoalExamDELE   = "exam_dele"
+	LearningGoalBusiness   = "business"
+	LearningGoalRelocation = "relocation"
+	LearningGoalCasual     = "casual"
+)
+
+// LearningGoals - все допустимые цели в порядке отображения.
+var LearningGoals = []string{
+	LearningGoalTravel,
+	LearningGoalExamIELTS,
+	LearningGoalExamHSK,
+	LearningGoalExamDELE,
+	LearningGoalBusiness,
+	LearningGoalRelocation,
+	LearningGoalCasual,
+}
+
+// IsValidLearningGoal проверяет, что цель входит в список допустимых.
+func IsValidLearningGoal(goal string) bool {
+	for _, known := range LearningGoals {
+		if known == goal {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LearningGoalStat - количество пользователей с целью для конкретного изучаемого языка.
+type LearningGoalStat struct {
+	TargetLanguageCode string `db:"target_language_code" json:"targetLanguageCode"`
+	Goal               string `db:"goal"                 json:"goal"`
+	UsersCount         int    `db:"users_count"          json:"usersCount"`
+}
@@ -0,0 +1,79 @@
+package models
+
+import "time"
+
+// UserDataSchemaVersion - версия схемы выгрузки данных пользователя. Увеличивается при
+// несовместимых изменениях: удалении или переименовании полей и смене их типов.
+const UserDataSchemaVersion = 1
+
+// UserDataExport - все данные, которые бот хранит о пользователе (выгрузка /mydata).
+type UserDataExport struct {
+	SchemaVersion         int                    `json:"schemaVersion"`
+	ExportedAt            time.Time              `json:"exportedAt"`
+	Profile               UserDataProfile        `json:"profile"`
+	LanguagePairs         []UserLanguagePair     `json:"languagePairs"`
+	Interests             []UserDataInterest     `json:"interests"`
+	LearningGoals         []string               `json:"learningGoals"`
+	Availability          *TimeAvailability      `json:"availability"`
+	UnavailabilityPeriods []UnavailabilityPeriod `json:"unavailabilityPeriods"`
+	Preferences           *FriendshipPreferences `json:"preferences"`
+	Feedback              []UserDataFeedback     `json:"feedback"`
+	Matches               []UserMatch            `json:"matches"`
+	SurveyAnswers         []SurveyAnswer         `json:"surveyAnswers"`
+}
+
+// UserDataProfile - профиль пользователя в выгрузке.
+type UserDataProfile struct {
+	ID                     int       `json:"id"`
+	TelegramID             int64     `json:"telegramId"`
+	Username               string    `json:"username"`
+	FirstName              string    `json:"firstName"`
+	NativeLanguageCode     string    `json:"nativeLanguageCode"`
+	TargetLanguageCode     string    `json:"targetLanguageCode"`
+	TargetLanguageLevel    string    `json:"targetLanguageLevel"`
+	InterfaceLanguageCode  string    `json:"interfaceLanguageCode"`
+	Status                 string    `json:"status"`
+	Role                   string    `json:"role"`
+	ProfileCompletionLevel int       `json:"profileCompletionLevel"`
+	IsActive               bool      `json:"isActive"`
+	CreatedAt              time.Time `json:"createdAt"`
+	UpdatedAt              time.Time `json:"updatedAt"`
+}
+
+// UserLanguagePair - языковая пара пользователя: родной и изучаемый язык с уровнем.
+type UserLanguagePair struct {
+	NativeLanguageCode string    `db:"native_language_code" json:"nativeLanguageCode"`
+	TargetLanguageCode string    `db:"target_language_code" json:"targetLanguageCode"`
+	TargetLevel        string    `db:"target_level"         json:"targetLevel,omitempty"`
+	CreatedAt          time.Time `db:"created_at"           json:"createdAt"`
+}
+
+// UserDataInterest - выбранный пользователем интерес в выгрузке.
+type UserDataInterest struct {
+	ID         int       `json:"id"`
+	Name       string    `json:"name"`
+	IsPrimary  bool      `json:"isPrimary"`
+	SelectedAt time.Time `json:"selectedAt"`
+}
+
+// UserDataFeedback - отзыв пользователя с перепиской и вложениями.
+type UserDataFeedback struct {
+	ID            int                  `json:"id"`
+	Text          string               `json:"text"`
+	ContactInfo   string               `json:"contactInfo,omitempty"`
+	IsProcessed   bool                 `json:"isProcessed"`
+	AdminResponse string               `json:"adminResponse,omitempty"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	Messages      []FeedbackMessage    `json:"messages"`
+	Attachments   []FeedbackAttachment `json:"attachments"`
+}
+
+// UserMatch - найденный для пользователя собеседник. Данные собеседника в выгрузку не входят.
+type UserMatch struct {
+	ID                 int        `db:"id"                  json:"id"`
+	PartnerUserID      int        `db:"partner_user_id"     json:"partnerUserId"`
+	CompatibilityScore int        `db:"compatibility_score" json:"compatibilityScore"`
+	Status             string     `db:"status"              json:"status"`
+	FoundAt            time.Time  `db:"found_at"            json:"foundAt"`
+	SentAt             *time.Time `db:"sent_at"             json:"sentAt,omitempty"`
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+
+	"github.com/gorilla/mux"
+)
+
+// adminClaimsContextKey is the request context key of the authenticated *models.AdminClaims.
+type adminClaimsContextKey struct{}
+
+// setupAuth configures admin session routes. They are not behind authMiddleware:
+// the login itself is authenticated by the Telegram Login Widget signature.
+func (s *AdminServer) setupAuth(r *mux.Router) {
+	authRouter := r.PathPrefix("/api/auth").Subrouter()
+	authRouter.Use(s.corsMiddleware)
+
+	authRouter.HandleFunc("/telegram", s.handleAdminLogin).Methods("POST", "OPTIONS")
+	authRouter.HandleFunc("/refresh", s.handleAdminRefresh).Methods("POST", "OPTIONS")
+	authRouter.HandleFunc("/logout", s.handleAdminLogout).Methods("POST", "OPTIONS")
+}
+
+// handleAdminLogin opens an admin session from Telegram Login Widget data
+// @Summary Log in with Telegram
+// @Description Exchange the data returned by the Telegram Login Widget (id, first_name, username, auth_date, hash, ...)
+// @Description for a short-lived access token (JWT with role and permissions) and a refresh token.
+// @Description Only moderators and admins can log in. Requires ADMIN_JWT_SECRET
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body map[string]interface{} true "Telegram Login Widget data"
+// @Success 200 {object} models.AdminTokens
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/auth/telegram [post].
+func (s *AdminServer) handleAdminLogin(w http.ResponseWriter, r *http.Request) {
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	loginFields := make(map[string]string, len(raw))
+
+	for key, value := range raw {
+		var text string
+		if err := json.Unmarshal(value, &text); err != nil {
+			// Числа (id, auth_date) подписываются в том виде, в котором их прислал Telegram
+			text = string(value)
+		}
+
+		loginFields[key] = text
+	}
+
+	if s.botService == nil {
+		http.Error(w, "Service unavailable", http.StatusServiceUnavailable)
+
+		return
+	}
+
+	tokens, err := s.botService.LoginAdmin(loginFields)
+	if err != nil {
+		writeAdminSessionError(w, err, "Failed to log in")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tokens)
+}
+
+// handleAdminRefresh exchanges a refresh token for a new token pair
+// @Summary Refresh admin session
+// @Description Exchange a refresh token for a new access token and a new refresh token. The old refresh token
+// @Description stops working; the role is re-read, so a demoted user loses access
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body map[string]string true "Refresh token, e.g. {\"refreshToken\": \"lxr_...\"}"
+// @Success 200 {object} models.AdminTokens
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/auth/refresh [post].
+func (s *AdminServer) handleAdminRefresh(w http.ResponseWriter, r *http.Request) {
+	refreshToken, ok := s.refreshTokenFromBody(w, r)
+	if !ok {
+		return
+	}
+
+	tokens, err := s.botService.RefreshAdminSession(refreshToken)
+	if err != nil {
+		writeAdminSessionError(w, err, "Failed to refresh session")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tokens)
+}
+
+// handleAdminLogout revokes an admin session
+// @Summary Log out
+// @Description Revoke the refresh token. The access token keeps working until it expires
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body map[string]string true "Refresh token, e.g. {\"refreshToken\": \"lxr_...\"}"
+// @Success 200 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /api/auth/logout [post].
+func (s *AdminServer) handleAdminLogout(w http.ResponseWriter, r *http.Request) {
+	refreshToken, ok := s.refreshTokenFromBody(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.botService.LogoutAdmin(refreshToken); err != nil {
+		writeAdminSessionError(w, err, "Failed to log out")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "logged_out"})
+}
+
+// authenticateAccessToken validates the access token locally and passes its claims to next.
+func (s *AdminServer) authenticateAccessToken(w http.ResponseWriter, r *http.Request, accessToken string, next http.Handler) {
+	if s.botService == nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+
+		return
+	}
+
+	claims, err := s.botService.AuthenticateAdminToken(accessToken)
+	if err != nil {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+
+		return
+	}
+
+	next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), adminClaimsContextKey{}, claims)))
+}
+
+// bearerToken returns the token from the Authorization: Bearer header.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return "", false
+	}
+
+	return token, true
+}
+
+// requestAdminClaims returns the access token claims authenticated by authMiddleware, or nil.
+func requestAdminClaims(r *http.Request) *models.AdminClaims {
+	claims, _ := r.Context().Value(adminClaimsContextKey{}).(*models.AdminClaims)
+
+	return claims
+}
+
+// refreshTokenFromBody parses {"refreshToken": "..."} or writes an error.
+func (s *AdminServer) refreshTokenFromBody(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var body struct {
+		RefreshToken string `json:"refreshToken"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.RefreshToken == "" {
+		http.Error(w, "refreshToken is required", http.StatusBadRequest)
+
+		return "", false
+	}
+
+	if s.botService == nil {
+		http.Error(w, "Service unavailable", http.StatusServiceUnavailable)
+
+		return "", false
+	}
+
+	return body.RefreshToken, true
+}
+
+// writeAdminSessionError maps admin session errors to HTTP status codes.
+func writeAdminSessionError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, errorsPkg.ErrInvalidTelegramLogin), errors.Is(err, errorsPkg.ErrInvalidAdminToken):
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+	case errors.Is(err, errorsPkg.ErrAdminAccessDenied):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	case errors.Is(err, errorsPkg.ErrAdminSessionsDisabled):
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	default:
+		log.Printf("%s: %v", fallback, err)
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
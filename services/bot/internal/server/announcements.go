@@ -0,0 +1,341 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"language-exchange-bot/internal/core"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+
+	"github.com/gorilla/mux"
+)
+
+// handleGetAnnouncements returns the latest announcements with delivery counters
+// @Summary List announcements
+// @Description Retrieve the latest announcements, newest first, with sent, failed and blocked counters
+// @Tags announcements
+// @Produce json
+// @Security ApiKeyAuth
+// @Param limit query int false "Maximum number of announcements"
+// @Success 200 {array} models.Announcement
+// @Failure 400 {object} map[string]string
+// @Router /api/v2/announcements [get].
+func (s *AdminServer) handleGetAnnouncements(w http.ResponseWriter, r *http.Request) {
+	limit, ok := announcementLimit(w, r)
+	if !ok {
+		return
+	}
+
+	announcements, err := s.botService.GetAnnouncements(limit)
+	if err != nil {
+		writeAnnouncementError(w, err, "Failed to get announcements")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, announcements)
+}
+
+// handleCreateAnnouncement creates an announcement draft
+// @Summary Create announcement
+// @Description Create a draft for a user segment. Empty segment filters match everyone; users who blocked the bot are always skipped.
+// @Description recipientsCount is the current segment size
+// @Tags announcements
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.AnnouncementInput true "Title, message and segment"
+// @Success 201 {object} models.Announcement
+// @Failure 400 {object} map[string]string
+// @Router /api/v2/announcements [post].
+func (s *AdminServer) handleCreateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	var input models.AnnouncementInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	announcement, err := s.botService.CreateAnnouncement(input, s.callerUserID(r))
+	if err != nil {
+		writeAnnouncementError(w, err, "Failed to create announcement")
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, announcement)
+}
+
+// handlePreviewAnnouncement returns an announcement with the text users will receive
+// @Summary Preview announcement
+// @Description Retrieve an announcement, the rendered message text and the number of recipients
+// @Tags announcements
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Announcement ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]string
+// @Router /api/v2/announcements/{id} [get].
+func (s *AdminServer) handlePreviewAnnouncement(w http.ResponseWriter, r *http.Request) {
+	announcementID, ok := announcementIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	announcement, text, err := s.botService.PreviewAnnouncement(announcementID)
+	if err != nil {
+		writeAnnouncementError(w, err, "Failed to get announcement")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"announcement": announcement, "text": text})
+}
+
+// handleTestAnnouncement sends an announcement to a single chat without recording deliveries
+// @Summary Test-send announcement
+// @Description Send the announcement to telegramId from the body or, by default, to the caller: the access token
+// @Description subject or the API key owner
+// @Tags announcements
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Announcement ID"
+// @Param request body map[string]int64 false "Optional recipient, e.g. {\"telegramId\": 123}"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v2/announcements/{id}/test [post].
+func (s *AdminServer) handleTestAnnouncement(w http.ResponseWriter, r *http.Request) {
+	announcementID, ok := announcementIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		TelegramID int64 `json:"telegramId"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	chatID := body.TelegramID
+	if chatID == 0 {
+		chatID = s.callerTelegramID(r)
+	}
+
+	if chatID == 0 {
+		http.Error(w, "telegramId is required for a service account key", http.StatusBadRequest)
+
+		return
+	}
+
+	dispatcher := s.announcementDispatcher(w)
+	if dispatcher == nil {
+		return
+	}
+
+	if err := dispatcher.TestSend(r.Context(), announcementID, chatID); err != nil {
+		writeAnnouncementError(w, err, "Failed to send test announcement")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"status": "sent", "telegramId": chatID})
+}
+
+// handleSendAnnouncement queues recipients and starts delivery in the background
+// @Summary Send announcement
+// @Description Queue the segment and deliver the announcement within Telegram rate limits. Sending an announcement in the sending status resumes an interrupted delivery
+// @Tags announcements
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Announcement ID"
+// @Success 202 {object} map[string]interface{}
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v2/announcements/{id}/send [post].
+func (s *AdminServer) handleSendAnnouncement(w http.ResponseWriter, r *http.Request) {
+	announcementID, ok := announcementIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	dispatcher := s.announcementDispatcher(w)
+	if dispatcher == nil {
+		return
+	}
+
+	// Рассылка переживает HTTP-запрос, поэтому контекст запроса не передается
+	recipients, err := dispatcher.Send(context.Background(), announcementID)
+	if err != nil {
+		writeAnnouncementError(w, err, "Failed to send announcement")
+
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"status": models.AnnouncementStatusSending, "recipientsCount": recipients})
+}
+
+// handleCancelAnnouncement cancels a draft or stops an announcement being sent
+// @Summary Cancel announcement
+// @Description Cancel a draft or stop the delivery; messages already delivered stay with recipients
+// @Tags announcements
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Announcement ID"
+// @Success 200 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v2/announcements/{id}/cancel [post].
+func (s *AdminServer) handleCancelAnnouncement(w http.ResponseWriter, r *http.Request) {
+	announcementID, ok := announcementIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.botService.CancelAnnouncement(announcementID); err != nil {
+		writeAnnouncementError(w, err, "Failed to cancel announcement")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": models.AnnouncementStatusCancelled})
+}
+
+// handleGetAnnouncementDeliveries returns per-recipient delivery results
+// @Summary Get announcement deliveries
+// @Description Retrieve delivery results of an announcement, optionally filtered by status (pending, sent, failed, blocked)
+// @Tags announcements
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Announcement ID"
+// @Param status query string false "Delivery status"
+// @Param limit query int false "Maximum number of deliveries"
+// @Success 200 {array} models.AnnouncementDelivery
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v2/announcements/{id}/deliveries [get].
+func (s *AdminServer) handleGetAnnouncementDeliveries(w http.ResponseWriter, r *http.Request) {
+	announcementID, ok := announcementIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	limit, ok := announcementLimit(w, r)
+	if !ok {
+		return
+	}
+
+	deliveries, err := s.botService.GetAnnouncementDeliveries(announcementID, r.URL.Query().Get("status"), limit)
+	if err != nil {
+		writeAnnouncementError(w, err, "Failed to get announcement deliveries")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deliveries)
+}
+
+// announcementDispatcher returns the delivery dispatcher or writes 503 when the bot is not connected.
+func (s *AdminServer) announcementDispatcher(w http.ResponseWriter) *core.AnnouncementDispatcher {
+	if s.handler != nil {
+		if dispatcher := s.handler.AnnouncementDispatcher(); dispatcher != nil {
+			return dispatcher
+		}
+	}
+
+	http.Error(w, "Telegram bot is not available", http.StatusServiceUnavailable)
+
+	return nil
+}
+
+// callerUser returns the user from the access token or the owner of the API key,
+// or nil for a service account key.
+func (s *AdminServer) callerUser(r *http.Request) *models.User {
+	telegramID := s.callerTelegramID(r)
+	if telegramID == 0 {
+		return nil
+	}
+
+	user, err := s.botService.GetCachedUser(telegramID)
+	if err != nil {
+		return nil
+	}
+
+	return user
+}
+
+// callerTelegramID returns the Telegram ID of the access token subject or of the API key owner,
+// or 0 for a service account key. X-Telegram-User-ID is not trusted (see callerRole).
+func (s *AdminServer) callerTelegramID(r *http.Request) int64 {
+	if claims := requestAdminClaims(r); claims != nil {
+		return claims.TelegramID
+	}
+
+	if key := requestAPIKey(r); key != nil && !key.ServiceAccount {
+		if owner, err := s.botService.GetAPIKeyOwner(key); err == nil {
+			return owner.TelegramID
+		}
+	}
+
+	return 0
+}
+
+// announcementIDFromPath parses the announcement ID or writes 400.
+func announcementIDFromPath(w http.ResponseWriter, r *http.Request) (int, bool) {
+	announcementID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid announcement ID", http.StatusBadRequest)
+
+		return 0, false
+	}
+
+	return announcementID, true
+}
+
+// announcementLimit parses the optional limit query parameter or writes 400.
+func announcementLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	limitStr := r.URL.Query().Get("limit")
+	if limitStr == "" {
+		return localization.AnnouncementListLimit, true
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit <= 0 {
+		http.Error(w, "Invalid limit", http.StatusBadRequest)
+
+		return 0, false
+	}
+
+	return limit, true
+}
+
+// writeAnnouncementError maps announcement errors to HTTP status codes.
+func writeAnnouncementError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, errorsPkg.ErrAnnouncementNotFound):
+		http.Error(w, "Announcement not found", http.StatusNotFound)
+	case errors.Is(err, errorsPkg.ErrAnnouncementNotSendable):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, errorsPkg.ErrInvalidAnnouncement):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, errorsPkg.ErrRecipientBlocked):
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+	default:
+		log.Printf("%s: %v", fallback, err)
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
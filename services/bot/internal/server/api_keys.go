@@ -0,0 +1,261 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+
+	"github.com/gorilla/mux"
+)
+
+// handleGetAPIKeys lists admin API keys
+// @Summary List API keys
+// @Description Retrieve all admin API keys with scopes, expiry and last use; key values are never returned
+// @Tags api-keys
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} models.APIKey
+// @Router /api/v2/api-keys [get].
+func (s *AdminServer) handleGetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	keys, err := s.botService.GetAPIKeys()
+	if err != nil {
+		writeAPIKeyError(w, err, "Failed to get API keys")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, keys)
+}
+
+// handleCreateAPIKey issues an admin API key
+// @Summary Create API key
+// @Description Issue a key with a name, scopes (permissions or *) and optional expiresInDays (0 - never expires).
+// @Description The key value is returned only in this response. A key cannot grant scopes the calling key does not have
+// @Description A key acts on behalf of its owner ownerTelegramId (the caller by default); only admins can issue keys
+// @Description to other users. serviceAccount keys have no owner and are limited by their scopes; only admins can issue them
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.APIKeyInput true "Name, scopes, owner and optional expiresInDays"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Router /api/v2/api-keys [post].
+func (s *AdminServer) handleCreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var input models.APIKeyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if !coversScopes(r, input.Scopes) {
+		http.Error(w, "Forbidden: scopes exceed the calling key", http.StatusForbidden)
+
+		return
+	}
+
+	if input.ServiceAccount && !s.mayIssueServiceAccount(r) {
+		http.Error(w, "Forbidden: only admins can issue service account keys", http.StatusForbidden)
+
+		return
+	}
+
+	if !s.bindAPIKeyOwner(r, &input) {
+		http.Error(w, "Forbidden: only admins can issue keys to other users", http.StatusForbidden)
+
+		return
+	}
+
+	key, rawKey, err := s.botService.CreateAPIKey(input, s.callerUserID(r))
+	if err != nil {
+		writeAPIKeyError(w, err, "Failed to create API key")
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"key": key, "apiKey": rawKey})
+}
+
+// handleRotateAPIKey issues a replacement for an API key
+// @Summary Rotate API key
+// @Description Issue a new key with the same name and scopes. The old key keeps working for gracePeriodDays
+// @Description (API_KEY_GRACE_PERIOD_DAYS by default) so clients can switch over
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "API key ID"
+// @Param request body map[string]int false "Optional overlap, e.g. {\"gracePeriodDays\": 1}"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v2/api-keys/{id}/rotate [post].
+func (s *AdminServer) handleRotateAPIKey(w http.ResponseWriter, r *http.Request) {
+	keyID, ok := apiKeyIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		GracePeriodDays *int `json:"gracePeriodDays"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	oldKey, err := s.botService.GetAPIKey(keyID)
+	if err != nil {
+		writeAPIKeyError(w, err, "Failed to get API key")
+
+		return
+	}
+
+	if !coversScopes(r, oldKey.Scopes) {
+		http.Error(w, "Forbidden: scopes exceed the calling key", http.StatusForbidden)
+
+		return
+	}
+
+	if oldKey.ServiceAccount && !s.mayIssueServiceAccount(r) {
+		http.Error(w, "Forbidden: only admins can issue service account keys", http.StatusForbidden)
+
+		return
+	}
+
+	key, rawKey, err := s.botService.RotateAPIKey(keyID, body.GracePeriodDays, s.callerUserID(r))
+	if err != nil {
+		writeAPIKeyError(w, err, "Failed to rotate API key")
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"key": key, "apiKey": rawKey})
+}
+
+// handleRevokeAPIKey revokes an API key immediately
+// @Summary Revoke API key
+// @Description Revoke a key; requests with it are rejected right away
+// @Tags api-keys
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "API key ID"
+// @Success 200 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v2/api-keys/{id}/revoke [post].
+func (s *AdminServer) handleRevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	keyID, ok := apiKeyIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.botService.RevokeAPIKey(keyID); err != nil {
+		writeAPIKeyError(w, err, "Failed to revoke API key")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": "revoked"})
+}
+
+// coversScopes reports whether the caller may issue a key with the given scopes:
+// an API key grants only its own scopes, an access token grants the permissions of its role.
+func coversScopes(r *http.Request, scopes []string) bool {
+	claims, key := requestAdminClaims(r), requestAPIKey(r)
+
+	for _, scope := range scopes {
+		switch {
+		case claims != nil:
+			if !claimsCoverScope(claims, scope) {
+				return false
+			}
+		case key == nil || !key.HasScope(scope):
+			return false
+		}
+	}
+
+	return true
+}
+
+// mayIssueServiceAccount reports whether the caller is an admin: an access token with the admin role,
+// a service account key, or a key used on behalf of an admin.
+func (s *AdminServer) mayIssueServiceAccount(r *http.Request) bool {
+	if claims := requestAdminClaims(r); claims != nil {
+		return claims.Role == models.RoleAdmin
+	}
+
+	role, err := s.callerRole(r)
+
+	return err == nil && role == models.RoleAdmin
+}
+
+// bindAPIKeyOwner binds a non-service key to the caller unless another owner is given; only admins
+// may issue keys to other users.
+func (s *AdminServer) bindAPIKeyOwner(r *http.Request, input *models.APIKeyInput) bool {
+	if input.ServiceAccount {
+		return true
+	}
+
+	caller := s.callerTelegramID(r)
+	if input.OwnerTelegramID == 0 {
+		input.OwnerTelegramID = caller
+
+		return true
+	}
+
+	return input.OwnerTelegramID == caller || s.mayIssueServiceAccount(r)
+}
+
+// claimsCoverScope reports whether the access token grants the scope; * is reserved for admins.
+func claimsCoverScope(claims *models.AdminClaims, scope string) bool {
+	if scope == models.APIKeyScopeAll {
+		return claims.Role == models.RoleAdmin
+	}
+
+	return claims.HasPermission(scope)
+}
+
+// callerUserID returns the internal ID of the access token subject or of the API key owner, or 0.
+func (s *AdminServer) callerUserID(r *http.Request) int {
+	if caller := s.callerUser(r); caller != nil {
+		return caller.ID
+	}
+
+	return 0
+}
+
+// apiKeyIDFromPath parses the API key ID or writes 400.
+func apiKeyIDFromPath(w http.ResponseWriter, r *http.Request) (int, bool) {
+	keyID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid API key ID", http.StatusBadRequest)
+
+		return 0, false
+	}
+
+	return keyID, true
+}
+
+// writeAPIKeyError maps API key errors to HTTP status codes.
+func writeAPIKeyError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, errorsPkg.ErrAPIKeyNotFound):
+		http.Error(w, "API key not found", http.StatusNotFound)
+	case errors.Is(err, errorsPkg.ErrInvalidAPIKeyInput):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		log.Printf("%s: %v", fallback, err)
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
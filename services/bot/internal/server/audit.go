@@ -0,0 +1,258 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+)
+
+// handleGetAuditEvents returns audit log records matching the filters
+// @Summary List audit events
+// @Description Retrieve audit log records, newest first. action accepts a prefix ending with * (e.g. feedback.*);
+// @Description from and to are RFC 3339 timestamps, to is exclusive. Users are stored as pseudonyms that are
+// @Description dropped with the account, so records of deleted users cannot be linked back to them
+// @Tags audit
+// @Produce json
+// @Security ApiKeyAuth
+// @Param actorType query string false "user, admin, api_key or system"
+// @Param actorId query string false "User pseudonym (p_...) or API key ID"
+// @Param actorTelegramId query int false "Telegram ID of an existing user, resolved to the user's pseudonym"
+// @Param action query string false "Action or action prefix"
+// @Param targetType query string false "Target type (feedback, user, webhook)"
+// @Param targetId query string false "Target ID"
+// @Param result query string false "success or failure"
+// @Param from query string false "Start of the period"
+// @Param to query string false "End of the period"
+// @Param limit query int false "Maximum number of records"
+// @Param offset query int false "Number of records to skip"
+// @Success 200 {array} models.AuditEvent
+// @Failure 400 {object} map[string]string
+// @Router /api/v2/audit [get].
+func (s *AdminServer) handleGetAuditEvents(w http.ResponseWriter, r *http.Request) {
+	filter, ok := s.auditFilter(w, r)
+	if !ok {
+		return
+	}
+
+	events, err := s.botService.GetAuditEvents(filter)
+	if err != nil {
+		log.Printf("Failed to get audit events: %v", err)
+		http.Error(w, "Failed to get audit events", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, events)
+}
+
+// handleExportAuditEvents exports audit log records as CSV
+// @Summary Export audit events
+// @Description Download audit log records matching the same filters as GET /api/v2/audit as CSV, hashes included
+// @Tags audit
+// @Produce text/csv
+// @Security ApiKeyAuth
+// @Success 200 {string} string "CSV file"
+// @Failure 400 {object} map[string]string
+// @Router /api/v2/audit/export [get].
+func (s *AdminServer) handleExportAuditEvents(w http.ResponseWriter, r *http.Request) {
+	filter, ok := s.auditFilter(w, r)
+	if !ok {
+		return
+	}
+
+	events, err := s.botService.ExportAuditEvents(filter)
+	if err != nil {
+		log.Printf("Failed to export audit events: %v", err)
+		http.Error(w, "Failed to export audit events", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="audit-%s.csv"`, time.Now().UTC().Format("20060102-150405")))
+
+	if err := audit.WriteCSV(w, events); err != nil {
+		log.Printf("Failed to write audit CSV: %v", err)
+	}
+}
+
+// handleVerifyAuditChain checks the audit log hash chain
+// @Summary Verify audit log
+// @Description Walk the audit log from the first record and check that every record matches the hash chain.
+// @Description brokenAtId is the first record that was modified or follows a deleted record
+// @Tags audit
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} models.AuditVerification
+// @Router /api/v2/audit/verify [get].
+func (s *AdminServer) handleVerifyAuditChain(w http.ResponseWriter, _ *http.Request) {
+	verification, err := s.botService.VerifyAuditChain()
+	if err != nil {
+		log.Printf("Failed to verify audit chain: %v", err)
+		http.Error(w, "Failed to verify audit chain", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, verification)
+}
+
+// auditStatusRecorder remembers the response status for auditMiddleware.
+type auditStatusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *auditStatusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// auditMiddleware records every authenticated admin API call with its caller and response status.
+// It runs after authMiddleware, so the caller is known.
+func (s *AdminServer) auditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := &auditStatusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(recorder, r)
+
+		if s.botService == nil {
+			return
+		}
+
+		result := models.AuditResultSuccess
+		if recorder.status >= http.StatusBadRequest {
+			result = models.AuditResultFailure
+		}
+
+		s.recordRequestAudit(r, audit.ActionAPIRequest, "", "", result, map[string]interface{}{
+			"method": r.Method,
+			"path":   r.URL.Path,
+			"status": recorder.status,
+		})
+	})
+}
+
+// recordRequestAudit records an action made through the admin API on behalf of the request caller.
+func (s *AdminServer) recordRequestAudit(r *http.Request, action, targetType, targetID, result string, details map[string]interface{}) {
+	event := &models.AuditEvent{
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Result:     result,
+		IPAddress:  clientIP(r),
+		Details:    details,
+	}
+
+	if claims := requestAdminClaims(r); claims != nil {
+		event.ActorType = models.AuditActorAdmin
+		event.ActorID = s.botService.AuditPseudonym(claims.UserID)
+	} else if key := requestAPIKey(r); key != nil {
+		event.ActorType = models.AuditActorAPIKey
+		event.ActorID = strconv.Itoa(key.ID)
+
+		if key.OwnerUserID != 0 {
+			if event.Details == nil {
+				event.Details = map[string]interface{}{}
+			}
+
+			event.Details["user"] = s.botService.AuditPseudonym(key.OwnerUserID)
+		}
+	} else {
+		event.ActorType = models.AuditActorSystem
+	}
+
+	s.botService.RecordAudit(event)
+}
+
+// clientIP returns the address the request came from.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// auditFilter parses audit log filters and resolves actorTelegramId to the user's pseudonym.
+// On failure it writes the error response.
+func (s *AdminServer) auditFilter(w http.ResponseWriter, r *http.Request) (models.AuditFilter, bool) {
+	filter, err := auditFilterFromQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return filter, false
+	}
+
+	value := r.URL.Query().Get("actorTelegramId")
+	if value == "" {
+		return filter, true
+	}
+
+	telegramID, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid actorTelegramId", http.StatusBadRequest)
+
+		return filter, false
+	}
+
+	filter.ActorID, err = s.botService.AuditPseudonymByTelegramID(telegramID)
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrUserNotFound) {
+			http.Error(w, "User not found", http.StatusNotFound)
+		} else {
+			log.Printf("Failed to resolve audit actor: %v", err)
+			http.Error(w, "Failed to get audit events", http.StatusInternalServerError)
+		}
+
+		return filter, false
+	}
+
+	return filter, true
+}
+
+// auditFilterFromQuery parses audit log filters from the query string.
+func auditFilterFromQuery(r *http.Request) (models.AuditFilter, error) {
+	query := r.URL.Query()
+	filter := models.AuditFilter{
+		ActorType:  query.Get("actorType"),
+		ActorID:    query.Get("actorId"),
+		Action:     query.Get("action"),
+		TargetType: query.Get("targetType"),
+		TargetID:   query.Get("targetId"),
+		Result:     query.Get("result"),
+	}
+
+	for name, target := range map[string]*int{"limit": &filter.Limit, "offset": &filter.Offset} {
+		if value := query.Get(name); value != "" {
+			parsed, err := strconv.Atoi(value)
+			if err != nil || parsed < 0 {
+				return filter, fmt.Errorf("invalid %s", name)
+			}
+
+			*target = parsed
+		}
+	}
+
+	for name, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		if value := query.Get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s: expected RFC 3339 timestamp", name)
+			}
+
+			*target = &parsed
+		}
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/core"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/export"
+	"language-exchange-bot/internal/models"
+)
+
+// handleSearchFeedback searches feedback by full text
+// @Summary Search feedback
+// @Description Full-text search over feedback text, most relevant first. q uses web search syntax ("phrase", -word, or).
+// @Description lang parses the query with the ru, en, es or zh configuration and limits results to feedback in that language;
+// @Description without it all configurations are tried. from and to are RFC 3339 timestamps or YYYY-MM-DD dates, to is exclusive
+// @Tags feedback
+// @Produce json
+// @Security ApiKeyAuth
+// @Param q query string true "Search query"
+// @Param lang query string false "ru, en, es or zh"
+// @Param status query string false "active or processed"
+// @Param from query string false "Start of the period"
+// @Param to query string false "End of the period"
+// @Param limit query int false "Maximum number of records"
+// @Success 200 {array} models.FeedbackRecord
+// @Failure 400 {object} map[string]string
+// @Router /api/v2/feedback/search [get].
+func (s *AdminServer) handleSearchFeedback(w http.ResponseWriter, r *http.Request) {
+	query, err := feedbackQueryFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	records, err := s.botService.SearchFeedback(query)
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrInvalidUserInput) {
+			http.Error(w, "Invalid search query, language, status or period", http.StatusBadRequest)
+
+			return
+		}
+
+		log.Printf("Failed to search feedback: %v", err)
+		http.Error(w, "Failed to search feedback", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, records)
+}
+
+// handleExportFeedback exports feedback as CSV or JSON Lines
+// @Summary Export feedback
+// @Description Download feedback, newest first, as CSV or JSON Lines. Accepts the same filters as GET /api/v2/feedback/search;
+// @Description q is optional here and limits the export to matching feedback
+// @Tags feedback
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security ApiKeyAuth
+// @Param format query string false "csv (default) or jsonl"
+// @Param q query string false "Search query"
+// @Param lang query string false "ru, en, es or zh"
+// @Param status query string false "active or processed"
+// @Param from query string false "Start of the period"
+// @Param to query string false "End of the period"
+// @Success 200 {string} string "Export file"
+// @Failure 400 {object} map[string]string
+// @Router /api/v2/feedback/export [get].
+func (s *AdminServer) handleExportFeedback(w http.ResponseWriter, r *http.Request) {
+	query, err := feedbackQueryFromRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = models.FeedbackExportCSV
+	}
+
+	if format != models.FeedbackExportCSV && format != models.FeedbackExportJSONL {
+		http.Error(w, "invalid format: expected csv or jsonl", http.StatusBadRequest)
+
+		return
+	}
+
+	records, err := s.botService.ExportFeedback(query)
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrInvalidUserInput) {
+			http.Error(w, "Invalid search query, language, status or period", http.StatusBadRequest)
+
+			return
+		}
+
+		log.Printf("Failed to export feedback: %v", err)
+		http.Error(w, "Failed to export feedback", http.StatusInternalServerError)
+
+		return
+	}
+
+	s.recordRequestAudit(r, audit.ActionFeedbackExport, audit.TargetFeedback, "", models.AuditResultSuccess,
+		core.FeedbackExportAuditDetails(query, format, len(records)))
+
+	w.Header().Set("Content-Type", export.FeedbackContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.FeedbackFileName(format, time.Now())))
+
+	if err := export.WriteFeedback(w, format, records); err != nil {
+		log.Printf("Failed to write feedback export: %v", err)
+	}
+}
+
+// feedbackQueryFromRequest reads feedback search and export filters from the query string.
+func feedbackQueryFromRequest(r *http.Request) (models.FeedbackQuery, error) {
+	values := r.URL.Query()
+	query := models.FeedbackQuery{
+		Text:     values.Get("q"),
+		Language: values.Get("lang"),
+		Status:   values.Get("status"),
+	}
+
+	if value := values.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			return query, errors.New("invalid limit")
+		}
+
+		query.Limit = parsed
+	}
+
+	for name, target := range map[string]**time.Time{"from": &query.From, "to": &query.To} {
+		if value := values.Get(name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				parsed, err = time.Parse(time.DateOnly, value)
+			}
+
+			if err != nil {
+				return query, fmt.Errorf("invalid %s: expected RFC 3339 timestamp or YYYY-MM-DD date", name)
+			}
+
+			*target = &parsed
+		}
+	}
+
+	return query, nil
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"log"
+	"net/http"
+	"time"
+)
+
+// handleGetRetentionReport returns a dry-run report of the data retention purge
+// @Summary Data retention dry run
+// @Description Count the rows each retention policy would purge right now without deleting anything.
+// @Description Policies with months = 0 are disabled and keep data forever
+// @Tags retention
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} models.RetentionReport
+// @Failure 500 {object} map[string]string
+// @Router /api/v2/retention/report [get].
+func (s *AdminServer) handleGetRetentionReport(w http.ResponseWriter, _ *http.Request) {
+	report, err := s.botService.RetentionReport(time.Now())
+	if err != nil {
+		log.Printf("Failed to build retention report: %v", err)
+		http.Error(w, "Failed to build retention report", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, report)
+}
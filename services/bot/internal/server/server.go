@@ -12,8 +12,10 @@ import (
 	"time"
 
 	"language-exchange-bot/internal/adapters/telegram"
+	"language-exchange-bot/internal/audit"
 	"language-exchange-bot/internal/core"
 	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/localization"
 	"language-exchange-bot/internal/models"
 	docs "language-exchange-bot/internal/server/docs"
 
@@ -68,6 +70,9 @@ func NewWithWebhook(port string, botService *core.BotService, handler *telegram.
 		r.HandleFunc("/webhook/telegram/{token}", s.handleTelegramWebhook).Methods("POST")
 	}
 
+	// Admin sessions (JWT) - login through Telegram Login Widget
+	s.setupAuth(r)
+
 	// API Version 1 - Current stable version
 	s.setupAPIV1(r)
 
@@ -97,18 +102,27 @@ func (s *AdminServer) setupAPIV1(r *mux.Router) {
 	v1 := r.PathPrefix("/api/v1").Subrouter()
 	v1.Use(s.corsMiddleware)
 	v1.Use(s.authMiddleware)
+	v1.Use(s.auditMiddleware)
 
 	// API v1 endpoints (current stable API)
-	v1.HandleFunc("/stats", s.handleGetStats).Methods("GET")
-	v1.HandleFunc("/users/{id:[0-9]+}", s.handleGetUser).Methods("GET")
-	v1.HandleFunc("/users", s.handleGetUsers).Methods("GET").Queries("limit", "{limit:[0-9]+}", "offset", "{offset:[0-9]+}")
-	v1.HandleFunc("/feedback/unprocessed", s.handleGetUnprocessedFeedback).Methods("GET")
-	v1.HandleFunc("/feedback/{id:[0-9]+}/process", s.handleProcessFeedback).Methods("POST")
-	v1.HandleFunc("/rate-limits/stats", s.handleGetRateLimitStats).Methods("GET")
-	v1.HandleFunc("/cache/stats", s.handleGetCacheStats).Methods("GET")
-	v1.HandleFunc("/webhook/status", s.handleGetWebhookStatus).Methods("GET")
-	v1.HandleFunc("/webhook/setup", s.handleSetupWebhook).Methods("POST")
-	v1.HandleFunc("/webhook/remove", s.handleRemoveWebhook).Methods("POST")
+	v1.HandleFunc("/stats", s.requirePermission(core.PermissionViewStats, s.handleGetStats)).Methods("GET")
+	v1.HandleFunc("/users/{id:[0-9]+}", s.requirePermission(core.PermissionViewUsers, s.handleGetUser)).Methods("GET")
+	v1.HandleFunc("/users", s.requirePermission(core.PermissionViewUsers, s.handleGetUsers)).Methods("GET").Queries("limit", "{limit:[0-9]+}", "offset", "{offset:[0-9]+}")
+	v1.HandleFunc("/feedback/unprocessed", s.requirePermission(core.PermissionViewFeedback, s.handleGetUnprocessedFeedback)).Methods("GET")
+	v1.HandleFunc("/feedback/{id:[0-9]+}/process", s.requirePermission(core.PermissionManageFeedback, s.handleProcessFeedback)).Methods("POST")
+	v1.HandleFunc("/interest-suggestions", s.requirePermission(core.PermissionModerateInterests, s.handleGetInterestSuggestions)).Methods("GET")
+	v1.HandleFunc("/interest-suggestions/{id:[0-9]+}/approve", s.requirePermission(core.PermissionModerateInterests, s.handleApproveInterestSuggestion)).Methods("POST")
+	v1.HandleFunc("/interest-suggestions/{id:[0-9]+}/reject", s.requirePermission(core.PermissionModerateInterests, s.handleRejectInterestSuggestion)).Methods("POST")
+	v1.HandleFunc("/interest-relations", s.requirePermission(core.PermissionModerateInterests, s.handleGetInterestRelations)).Methods("GET")
+	v1.HandleFunc("/interest-relations", s.requirePermission(core.PermissionManageInterests, s.handleSaveInterestRelation)).Methods("POST")
+	v1.HandleFunc("/interest-relations/{id:[0-9]+}", s.requirePermission(core.PermissionManageInterests, s.handleDeleteInterestRelation)).Methods("DELETE")
+	v1.HandleFunc("/interest-cooccurrences", s.requirePermission(core.PermissionViewStats, s.handleGetInterestCooccurrences)).Methods("GET")
+	v1.HandleFunc("/interest-cooccurrences/refresh", s.requirePermission(core.PermissionManageInterests, s.handleRefreshInterestCooccurrences)).Methods("POST")
+	v1.HandleFunc("/rate-limits/stats", s.requirePermission(core.PermissionViewStats, s.handleGetRateLimitStats)).Methods("GET")
+	v1.HandleFunc("/cache/stats", s.requirePermission(core.PermissionViewStats, s.handleGetCacheStats)).Methods("GET")
+	v1.HandleFunc("/webhook/status", s.requirePermission(core.PermissionManageSystem, s.handleGetWebhookStatus)).Methods("GET")
+	v1.HandleFunc("/webhook/setup", s.requirePermission(core.PermissionManageSystem, s.handleSetupWebhook)).Methods("POST")
+	v1.HandleFunc("/webhook/remove", s.requirePermission(core.PermissionManageSystem, s.handleRemoveWebhook)).Methods("POST")
 }
 
 // setupAPIV2 configures API version 2 routes (future version with enhanced features).
@@ -117,23 +131,62 @@ func (s *AdminServer) setupAPIV2(r *mux.Router) {
 	v2 := r.PathPrefix("/api/v2").Subrouter()
 	v2.Use(s.corsMiddleware)
 	v2.Use(s.authMiddleware)
+	v2.Use(s.auditMiddleware)
 
 	// API v2 endpoints (enhanced version - currently same as v1 for compatibility)
 	// TODO: Add new features and enhancements in v2
-	v2.HandleFunc("/stats", s.handleGetStatsV2).Methods("GET")
-	v2.HandleFunc("/users/{id:[0-9]+}", s.handleGetUser).Methods("GET")
-	v2.HandleFunc("/users", s.handleGetUsers).Methods("GET").Queries("limit", "{limit:[0-9]+}", "offset", "{offset:[0-9]+}")
-	v2.HandleFunc("/feedback/unprocessed", s.handleGetUnprocessedFeedback).Methods("GET")
-	v2.HandleFunc("/feedback/{id:[0-9]+}/process", s.handleProcessFeedback).Methods("POST")
-	v2.HandleFunc("/rate-limits/stats", s.handleGetRateLimitStats).Methods("GET")
-	v2.HandleFunc("/cache/stats", s.handleGetCacheStats).Methods("GET")
-	v2.HandleFunc("/webhook/status", s.handleGetWebhookStatus).Methods("GET")
-	v2.HandleFunc("/webhook/setup", s.handleSetupWebhook).Methods("POST")
-	v2.HandleFunc("/webhook/remove", s.handleRemoveWebhook).Methods("POST")
+	v2.HandleFunc("/stats", s.requirePermission(core.PermissionViewStats, s.handleGetStatsV2)).Methods("GET")
+	v2.HandleFunc("/users/{id:[0-9]+}", s.requirePermission(core.PermissionViewUsers, s.handleGetUser)).Methods("GET")
+	v2.HandleFunc("/users", s.requirePermission(core.PermissionViewUsers, s.handleGetUsers)).Methods("GET").Queries("limit", "{limit:[0-9]+}", "offset", "{offset:[0-9]+}")
+	v2.HandleFunc("/feedback/unprocessed", s.requirePermission(core.PermissionViewFeedback, s.handleGetUnprocessedFeedback)).Methods("GET")
+	v2.HandleFunc("/feedback/{id:[0-9]+}/process", s.requirePermission(core.PermissionManageFeedback, s.handleProcessFeedback)).Methods("POST")
+	v2.HandleFunc("/feedback/{id:[0-9]+}/triage", s.requirePermission(core.PermissionManageFeedback, s.handleUpdateFeedbackTriage)).Methods("PATCH")
+	v2.HandleFunc("/feedback/search", s.requirePermission(core.PermissionViewFeedback, s.handleSearchFeedback)).Methods("GET")
+	v2.HandleFunc("/feedback/export", s.requirePermission(core.PermissionViewFeedback, s.handleExportFeedback)).Methods("GET")
+	v2.HandleFunc("/interest-suggestions", s.requirePermission(core.PermissionModerateInterests, s.handleGetInterestSuggestions)).Methods("GET")
+	v2.HandleFunc("/interest-suggestions/{id:[0-9]+}/approve", s.requirePermission(core.PermissionModerateInterests, s.handleApproveInterestSuggestion)).Methods("POST")
+	v2.HandleFunc("/interest-suggestions/{id:[0-9]+}/reject", s.requirePermission(core.PermissionModerateInterests, s.handleRejectInterestSuggestion)).Methods("POST")
+	v2.HandleFunc("/interest-relations", s.requirePermission(core.PermissionModerateInterests, s.handleGetInterestRelations)).Methods("GET")
+	v2.HandleFunc("/interest-relations", s.requirePermission(core.PermissionManageInterests, s.handleSaveInterestRelation)).Methods("POST")
+	v2.HandleFunc("/interest-relations/{id:[0-9]+}", s.requirePermission(core.PermissionManageInterests, s.handleDeleteInterestRelation)).Methods("DELETE")
+	v2.HandleFunc("/interest-cooccurrences", s.requirePermission(core.PermissionViewStats, s.handleGetInterestCooccurrences)).Methods("GET")
+	v2.HandleFunc("/interest-cooccurrences/refresh", s.requirePermission(core.PermissionManageInterests, s.handleRefreshInterestCooccurrences)).Methods("POST")
+	v2.HandleFunc("/rate-limits/stats", s.requirePermission(core.PermissionViewStats, s.handleGetRateLimitStats)).Methods("GET")
+	v2.HandleFunc("/cache/stats", s.requirePermission(core.PermissionViewStats, s.handleGetCacheStats)).Methods("GET")
+	v2.HandleFunc("/webhook/status", s.requirePermission(core.PermissionManageSystem, s.handleGetWebhookStatus)).Methods("GET")
+	v2.HandleFunc("/webhook/setup", s.requirePermission(core.PermissionManageSystem, s.handleSetupWebhook)).Methods("POST")
+	v2.HandleFunc("/webhook/remove", s.requirePermission(core.PermissionManageSystem, s.handleRemoveWebhook)).Methods("POST")
 
 	// New v2 endpoints
-	v2.HandleFunc("/system/health", s.handleGetSystemHealth).Methods("GET")
-	v2.HandleFunc("/metrics/performance", s.handleGetPerformanceMetrics).Methods("GET")
+	v2.HandleFunc("/system/health", s.requirePermission(core.PermissionViewStats, s.handleGetSystemHealth)).Methods("GET")
+	v2.HandleFunc("/metrics/performance", s.requirePermission(core.PermissionViewStats, s.handleGetPerformanceMetrics)).Methods("GET")
+	v2.HandleFunc("/interest-categories", s.requirePermission(core.PermissionModerateInterests, s.handleGetInterestCategories)).Methods("GET")
+	v2.HandleFunc("/interest-categories", s.requirePermission(core.PermissionManageInterests, s.handleCreateInterestCategory)).Methods("POST")
+	v2.HandleFunc("/interest-categories/{id:[0-9]+}", s.requirePermission(core.PermissionManageInterests, s.handleUpdateInterestCategory)).Methods("PATCH")
+	v2.HandleFunc("/interests", s.requirePermission(core.PermissionModerateInterests, s.handleGetInterests)).Methods("GET")
+	v2.HandleFunc("/interests", s.requirePermission(core.PermissionManageInterests, s.handleCreateInterest)).Methods("POST")
+	v2.HandleFunc("/interests/{id:[0-9]+}", s.requirePermission(core.PermissionManageInterests, s.handleUpdateInterest)).Methods("PATCH")
+	v2.HandleFunc("/users/{id:[0-9]+}/role", s.requirePermission(core.PermissionManageRoles, s.handleUpdateUserRole)).Methods("PATCH")
+	v2.HandleFunc("/announcements", s.requirePermission(core.PermissionManageAnnouncements, s.handleGetAnnouncements)).Methods("GET")
+	v2.HandleFunc("/announcements", s.requirePermission(core.PermissionManageAnnouncements, s.handleCreateAnnouncement)).Methods("POST")
+	v2.HandleFunc("/announcements/{id:[0-9]+}", s.requirePermission(core.PermissionManageAnnouncements, s.handlePreviewAnnouncement)).Methods("GET")
+	v2.HandleFunc("/announcements/{id:[0-9]+}/test", s.requirePermission(core.PermissionManageAnnouncements, s.handleTestAnnouncement)).Methods("POST")
+	v2.HandleFunc("/announcements/{id:[0-9]+}/send", s.requirePermission(core.PermissionManageAnnouncements, s.handleSendAnnouncement)).Methods("POST")
+	v2.HandleFunc("/announcements/{id:[0-9]+}/cancel", s.requirePermission(core.PermissionManageAnnouncements, s.handleCancelAnnouncement)).Methods("POST")
+	v2.HandleFunc("/announcements/{id:[0-9]+}/deliveries", s.requirePermission(core.PermissionManageAnnouncements, s.handleGetAnnouncementDeliveries)).Methods("GET")
+	v2.HandleFunc("/surveys", s.requirePermission(core.PermissionManageAnnouncements, s.handleGetSurveys)).Methods("GET")
+	v2.HandleFunc("/surveys", s.requirePermission(core.PermissionManageAnnouncements, s.handleCreateSurvey)).Methods("POST")
+	v2.HandleFunc("/surveys/{id:[0-9]+}/results", s.requirePermission(core.PermissionViewStats, s.handleGetSurveyResults)).Methods("GET")
+	v2.HandleFunc("/surveys/{id:[0-9]+}/send", s.requirePermission(core.PermissionManageAnnouncements, s.handleSendSurvey)).Methods("POST")
+	v2.HandleFunc("/surveys/{id:[0-9]+}/cancel", s.requirePermission(core.PermissionManageAnnouncements, s.handleCancelSurvey)).Methods("POST")
+	v2.HandleFunc("/api-keys", s.requirePermission(core.PermissionManageAPIKeys, s.handleGetAPIKeys)).Methods("GET")
+	v2.HandleFunc("/api-keys", s.requirePermission(core.PermissionManageAPIKeys, s.handleCreateAPIKey)).Methods("POST")
+	v2.HandleFunc("/api-keys/{id:[0-9]+}/rotate", s.requirePermission(core.PermissionManageAPIKeys, s.handleRotateAPIKey)).Methods("POST")
+	v2.HandleFunc("/api-keys/{id:[0-9]+}/revoke", s.requirePermission(core.PermissionManageAPIKeys, s.handleRevokeAPIKey)).Methods("POST")
+	v2.HandleFunc("/audit", s.requirePermission(core.PermissionViewAudit, s.handleGetAuditEvents)).Methods("GET")
+	v2.HandleFunc("/audit/export", s.requirePermission(core.PermissionViewAudit, s.handleExportAuditEvents)).Methods("GET")
+	v2.HandleFunc("/audit/verify", s.requirePermission(core.PermissionViewAudit, s.handleVerifyAuditChain)).Methods("GET")
+	v2.HandleFunc("/retention/report", s.requirePermission(core.PermissionManageSystem, s.handleGetRetentionReport)).Methods("GET")
 }
 
 // Start starts the admin HTTP server.
@@ -152,8 +205,8 @@ func (s *AdminServer) Stop(ctx context.Context) error {
 func (s *AdminServer) corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, "+adminKeyHeader+", "+telegramUserHeader)
 
 		if r.Method == http.MethodOptions {
 			w.WriteHeader(http.StatusOK)
@@ -165,21 +218,173 @@ func (s *AdminServer) corsMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-// authMiddleware provides basic authentication for admin endpoints.
+// authMiddleware authenticates the request by the access token from the Authorization: Bearer header
+// or by the API key from the X-Admin-Key header and stores the token claims or the key
+// in the request context for requirePermission.
 func (s *AdminServer) authMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// TODO: Implement proper authentication
-		// For now, just check for a simple header
-		if auth := r.Header.Get("X-Admin-Key"); auth != "admin-secret-key" {
+		if accessToken, ok := bearerToken(r); ok {
+			s.authenticateAccessToken(w, r, accessToken, next)
+
+			return
+		}
+
+		rawKey := r.Header.Get(adminKeyHeader)
+		if rawKey == "" || s.botService == nil {
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		key, err := s.botService.AuthenticateAPIKey(rawKey)
+		if err != nil {
+			if !errors.Is(err, errorsPkg.ErrInvalidAPIKey) {
+				log.Printf("Failed to authenticate API key: %v", err)
+			}
+
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), apiKeyContextKey{}, key)))
 	})
 }
 
+// adminKeyHeader carries the admin API key (see cmd/api-keys).
+const adminKeyHeader = "X-Admin-Key"
+
+// telegramUserHeader optionally repeats the Telegram ID of the API key owner. The owner is bound to the key
+// at creation; the header is only checked against it and never selects the caller.
+const telegramUserHeader = "X-Telegram-User-ID"
+
+// apiKeyContextKey is the request context key of the authenticated *models.APIKey.
+type apiKeyContextKey struct{}
+
+// requestAPIKey returns the API key authenticated by authMiddleware, or nil.
+func requestAPIKey(r *http.Request) *models.APIKey {
+	key, _ := r.Context().Value(apiKeyContextKey{}).(*models.APIKey)
+
+	return key
+}
+
+// requirePermission allows the request only if the access token claims grant the permission, or,
+// for API keys, if both the key scopes and the caller's role grant it. The same role matrix
+// guards Telegram admin callbacks (see core.HasPermission).
+func (s *AdminServer) requirePermission(permission core.Permission, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if claims := requestAdminClaims(r); claims != nil {
+			if !claims.HasPermission(string(permission)) {
+				http.Error(w, "Forbidden", http.StatusForbidden)
+
+				return
+			}
+
+			next(w, r)
+
+			return
+		}
+
+		if key := requestAPIKey(r); key == nil || !key.HasScope(string(permission)) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+
+			return
+		}
+
+		role, err := s.callerRole(r)
+		if err != nil {
+			writeCallerRoleError(w, err)
+
+			return
+		}
+
+		if !core.HasPermission(role, permission) {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// callerRole returns the role the API key acts with. A service account key is limited by its scopes alone;
+// other keys act with the role of the user they were bound to at creation. X-Telegram-User-ID is not
+// trusted: it may only repeat the key owner, a header naming anyone else is rejected.
+func (s *AdminServer) callerRole(r *http.Request) (string, error) {
+	key := requestAPIKey(r)
+	if key == nil {
+		return "", errorsPkg.ErrInvalidAPIKey
+	}
+
+	if key.ServiceAccount {
+		if r.Header.Get(telegramUserHeader) != "" {
+			return "", errorsPkg.ErrCallerMismatch
+		}
+
+		return models.RoleAdmin, nil
+	}
+
+	owner, err := s.apiKeyOwner(r, key)
+	if err != nil {
+		return "", err
+	}
+
+	return owner.Role, nil
+}
+
+// apiKeyOwner returns the user a non-service key is bound to and checks that X-Telegram-User-ID,
+// if sent, names that user.
+func (s *AdminServer) apiKeyOwner(r *http.Request, key *models.APIKey) (*models.User, error) {
+	owner, err := s.botService.GetAPIKeyOwner(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if header := r.Header.Get(telegramUserHeader); header != "" {
+		telegramID, err := strconv.ParseInt(header, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid %s header", errorsPkg.ErrInvalidUserInput, telegramUserHeader)
+		}
+
+		if telegramID != owner.TelegramID {
+			return nil, errorsPkg.ErrCallerMismatch
+		}
+	}
+
+	return owner, nil
+}
+
+// writeJSON writes a JSON response with the given status code. Once the status is sent an encoding
+// error can only be logged.
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(payload); err != nil {
+		log.Printf("Failed to encode JSON response: %v", err)
+	}
+}
+
+// writeCallerRoleError maps caller identification errors to HTTP status codes.
+func writeCallerRoleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, errorsPkg.ErrInvalidUserInput):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, errorsPkg.ErrInvalidAPIKey):
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+	case errors.Is(err, errorsPkg.ErrCallerMismatch):
+		http.Error(w, "Forbidden: "+telegramUserHeader+" does not match the API key owner", http.StatusForbidden)
+	case errors.Is(err, errorsPkg.ErrAPIKeyOwnerRequired):
+		http.Error(w, "Forbidden: the API key is not bound to a user, reissue it with ownerTelegramId", http.StatusForbidden)
+	case errors.Is(err, errorsPkg.ErrUserNotFound):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	default:
+		log.Printf("Failed to resolve caller role: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
 // handleHealth provides health check endpoint
 // @Summary Health check
 // @Description Check if the service is healthy
@@ -193,14 +398,7 @@ func (s *AdminServer) handleHealth(w http.ResponseWriter, r *http.Request) {
 		"time":   time.Now().Format(time.RFC3339),
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, response)
 }
 
 // handleReady provides readiness check endpoint
@@ -216,42 +414,26 @@ func (s *AdminServer) handleReady(w http.ResponseWriter, r *http.Request) {
 		"time":   time.Now().Format(time.RFC3339),
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, response)
 }
 
 // handleGetStats returns general statistics
 // @Summary Get general statistics
-// @Description Retrieve general bot statistics
+// @Description Retrieve general bot statistics, including learning goal distribution per target language
 // @Tags statistics
 // @Produce json
 // @Security ApiKeyAuth
 // @Success 200 {object} map[string]interface{}
 // @Router /api/v1/stats [get].
 func (s *AdminServer) handleGetStats(w http.ResponseWriter, r *http.Request) {
-	stats := map[string]interface{}{
-		"timestamp":    time.Now().Format(time.RFC3339),
-		"version":      "3.0.0",
-		"service":      "language-exchange-bot",
-		"uptime":       "simulated uptime data", // TODO: Add real uptime tracking
-		"active_users": 0,                       // TODO: Add real user count
-		"total_users":  0,                       // TODO: Add real user count
-	}
-
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(stats); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	stats, err := s.getStatsData()
+	if err != nil {
+		http.Error(w, "Failed to get statistics", http.StatusInternalServerError)
 
 		return
 	}
+
+	writeJSON(w, http.StatusOK, stats)
 }
 
 // handleGetUser returns user information
@@ -289,14 +471,7 @@ func (s *AdminServer) handleGetUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(user); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, user)
 }
 
 // handleGetUsers returns list of users with pagination
@@ -319,14 +494,7 @@ func (s *AdminServer) handleGetUsers(w http.ResponseWriter, r *http.Request) {
 		"offset": 0,
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, response)
 }
 
 // handleGetUnprocessedFeedback returns unprocessed feedback
@@ -345,14 +513,7 @@ func (s *AdminServer) handleGetUnprocessedFeedback(w http.ResponseWriter, r *htt
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(feedback); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, feedback)
 }
 
 // handleProcessFeedback marks feedback as processed
@@ -404,19 +565,64 @@ func (s *AdminServer) handleProcessFeedback(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	s.recordRequestAudit(r, audit.ActionFeedbackProcess, audit.TargetFeedback, feedbackIDStr, models.AuditResultSuccess, nil)
+
 	response := map[string]string{
 		"status": "processed",
 		"id":     feedbackIDStr,
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	writeJSON(w, http.StatusOK, response)
+}
 
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+// handleUpdateFeedbackTriage changes the category, priority or assignee of feedback
+// @Summary Update feedback triage
+// @Description Set the category (bug, feature, complaint, praise; empty clears it), the priority (low, normal, high, urgent) or the assignee (0 removes it). Changing the priority recalculates the SLA deadline from the feedback creation time
+// @Tags feedback
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Feedback ID"
+// @Param request body models.FeedbackTriageUpdate true "Fields to change"
+// @Success 200 {object} models.FeedbackTriage
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v2/feedback/{id}/triage [patch].
+func (s *AdminServer) handleUpdateFeedbackTriage(w http.ResponseWriter, r *http.Request) {
+	feedbackIDStr := mux.Vars(r)["id"]
+
+	feedbackID, err := strconv.Atoi(feedbackIDStr)
+	if err != nil {
+		http.Error(w, "Invalid feedback ID", http.StatusBadRequest)
 
 		return
 	}
+
+	var update models.FeedbackTriageUpdate
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	triage, err := s.botService.ApplyFeedbackTriage(feedbackID, update)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsPkg.ErrFeedbackNotFound):
+			http.Error(w, "Feedback not found", http.StatusNotFound)
+		case errors.Is(err, errorsPkg.ErrInvalidUserInput), errors.Is(err, errorsPkg.ErrUserNotFound):
+			http.Error(w, "Invalid category, priority or assignee", http.StatusBadRequest)
+		default:
+			http.Error(w, "Failed to update feedback triage", http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	s.recordRequestAudit(r, audit.ActionFeedbackTriage, audit.TargetFeedback, feedbackIDStr, models.AuditResultSuccess,
+		core.FeedbackTriageAuditDetails(update))
+
+	writeJSON(w, http.StatusOK, triage)
 }
 
 // handleGetRateLimitStats returns rate limiting statistics
@@ -436,14 +642,7 @@ func (s *AdminServer) handleGetRateLimitStats(w http.ResponseWriter, r *http.Req
 
 	stats := s.handler.GetRateLimiterStats()
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(stats); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, stats)
 }
 
 // handleGetCacheStats returns cache statistics
@@ -457,14 +656,7 @@ func (s *AdminServer) handleGetRateLimitStats(w http.ResponseWriter, r *http.Req
 func (s *AdminServer) handleGetCacheStats(w http.ResponseWriter, r *http.Request) {
 	stats := s.botService.GetCacheStats()
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(stats); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, stats)
 }
 
 // handleNavigation serves the main navigation page
@@ -541,14 +733,7 @@ func (s *AdminServer) handleGetWebhookStatus(w http.ResponseWriter, r *http.Requ
 		"bot_api_available":    s.handler != nil && s.handler.GetBotAPI() != nil,
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(status); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, status)
 }
 
 // handleSetupWebhook sets up webhook for the bot
@@ -576,6 +761,8 @@ func (s *AdminServer) handleSetupWebhook(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	s.recordRequestAudit(r, audit.ActionWebhookSetup, audit.TargetWebhook, "", models.AuditResultSuccess, map[string]interface{}{"webhookUrl": webhookURL})
+
 	// This would need access to the bot instance to setup webhook
 	// For now, return success with note
 	result := map[string]interface{}{
@@ -584,14 +771,7 @@ func (s *AdminServer) handleSetupWebhook(w http.ResponseWriter, r *http.Request)
 		"note":        "Webhook setup requires bot instance access. Use environment variables for initial setup.",
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(result); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, result)
 }
 
 // handleRemoveWebhook removes webhook configuration
@@ -603,20 +783,15 @@ func (s *AdminServer) handleSetupWebhook(w http.ResponseWriter, r *http.Request)
 // @Success 200 {object} map[string]interface{}
 // @Router /api/v1/webhook/remove [post].
 func (s *AdminServer) handleRemoveWebhook(w http.ResponseWriter, r *http.Request) {
+	s.recordRequestAudit(r, audit.ActionWebhookRemove, audit.TargetWebhook, "", models.AuditResultSuccess, nil)
+
 	// This would need access to the bot instance to remove webhook
 	result := map[string]interface{}{
 		"status": "webhook_removal_requested",
 		"note":   "Webhook removal requires bot instance access. Use environment variables to switch modes.",
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(result); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, result)
 }
 
 // ===== Helper Methods =====
@@ -632,9 +807,337 @@ func (s *AdminServer) getStatsData() (map[string]interface{}, error) {
 		"total_users":  0,                       // TODO: Add real user count
 	}
 
+	// Распределение целей изучения по изучаемым языкам
+	if s.botService != nil {
+		distribution, err := s.botService.GetLearningGoalDistribution()
+		if err != nil {
+			log.Printf("Failed to get learning goal distribution: %v", err)
+		} else {
+			stats["learning_goals"] = distribution
+		}
+
+		// Результаты последних опросов: охват, распределение ответов и NPS
+		surveys, err := s.botService.GetRecentSurveyResults(localization.SurveyStatsLimit)
+		if err != nil {
+			log.Printf("Failed to get survey results: %v", err)
+		} else {
+			stats["surveys"] = surveys
+		}
+
+		// Удаленные аккаунты: только обезличенные счетчики
+		deletions, err := s.botService.GetAccountDeletionStats()
+		if err != nil {
+			log.Printf("Failed to get account deletion stats: %v", err)
+		} else {
+			stats["account_deletions"] = deletions
+		}
+	}
+
 	return stats, nil
 }
 
+// handleGetInterestSuggestions returns the interest suggestion moderation queue
+// @Summary Get interest suggestions
+// @Description Retrieve user-submitted interest suggestions, filtered by status (pending by default)
+// @Tags interests
+// @Produce json
+// @Security ApiKeyAuth
+// @Param status query string false "Suggestion status: pending, approved, rejected or all"
+// @Param limit query int false "Maximum number of suggestions"
+// @Success 200 {array} models.InterestSuggestion
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/interest-suggestions [get].
+func (s *AdminServer) handleGetInterestSuggestions(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+
+	switch status {
+	case "":
+		status = models.InterestSuggestionPending
+	case "all":
+		status = ""
+	case models.InterestSuggestionPending, models.InterestSuggestionApproved, models.InterestSuggestionRejected:
+	default:
+		http.Error(w, "Invalid status", http.StatusBadRequest)
+
+		return
+	}
+
+	limit := 0
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+
+			return
+		}
+
+		limit = parsed
+	}
+
+	suggestions, err := s.botService.GetInterestSuggestions(status, limit)
+	if err != nil {
+		http.Error(w, "Failed to get interest suggestions", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, suggestions)
+}
+
+// handleApproveInterestSuggestion turns a suggestion into a catalog interest
+// @Summary Approve interest suggestion
+// @Description Create a localized interest in the chosen category from a pending suggestion and select it for the proposer
+// @Tags interests
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Suggestion ID"
+// @Param request body models.InterestSuggestionApproval true "Category, key and translations of the new interest"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/interest-suggestions/{id}/approve [post].
+func (s *AdminServer) handleApproveInterestSuggestion(w http.ResponseWriter, r *http.Request) {
+	suggestionID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid suggestion ID", http.StatusBadRequest)
+
+		return
+	}
+
+	var approval models.InterestSuggestionApproval
+	if err := json.NewDecoder(r.Body).Decode(&approval); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	interestID, err := s.botService.ApproveInterestSuggestion(suggestionID, approval)
+	if err != nil {
+		writeInterestSuggestionError(w, err, "Failed to approve interest suggestion")
+
+		return
+	}
+
+	response := map[string]interface{}{
+		"status":      models.InterestSuggestionApproved,
+		"id":          suggestionID,
+		"interest_id": interestID,
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleRejectInterestSuggestion rejects a pending suggestion
+// @Summary Reject interest suggestion
+// @Description Reject a pending interest suggestion with an optional moderator note
+// @Tags interests
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Suggestion ID"
+// @Param request body map[string]string false "Rejection request with optional note"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/interest-suggestions/{id}/reject [post].
+func (s *AdminServer) handleRejectInterestSuggestion(w http.ResponseWriter, r *http.Request) {
+	suggestionID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid suggestion ID", http.StatusBadRequest)
+
+		return
+	}
+
+	var req map[string]string
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	if err := s.botService.RejectInterestSuggestion(suggestionID, req["note"]); err != nil {
+		writeInterestSuggestionError(w, err, "Failed to reject interest suggestion")
+
+		return
+	}
+
+	response := map[string]interface{}{
+		"status": models.InterestSuggestionRejected,
+		"id":     suggestionID,
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// writeInterestSuggestionError maps moderation errors to HTTP status codes.
+func writeInterestSuggestionError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, errorsPkg.ErrInterestSuggestionNotFound):
+		http.Error(w, "Interest suggestion not found", http.StatusNotFound)
+	case errors.Is(err, errorsPkg.ErrInterestSuggestionReviewed):
+		http.Error(w, "Interest suggestion already reviewed", http.StatusConflict)
+	case errors.Is(err, errorsPkg.ErrInterestKeyExists):
+		http.Error(w, "Interest with this key already exists", http.StatusConflict)
+	case errors.Is(err, errorsPkg.ErrInvalidInterestApproval), errors.Is(err, errorsPkg.ErrInterestCategoryNotFound):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		log.Printf("%s: %v", fallback, err)
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
+
+// handleGetInterestRelations returns the interest relationship graph
+// @Summary Get interest relations
+// @Description Retrieve the interest relationship graph used for partial compatibility credit
+// @Tags interests
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} models.InterestRelation
+// @Router /api/v1/interest-relations [get].
+func (s *AdminServer) handleGetInterestRelations(w http.ResponseWriter, r *http.Request) {
+	relations, err := s.botService.GetInterestRelations()
+	if err != nil {
+		http.Error(w, "Failed to get interest relations", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, relations)
+}
+
+// handleSaveInterestRelation creates or updates an interest relation
+// @Summary Save interest relation
+// @Description Create a relation between two interests by key or change its type (related or parent); takes effect immediately
+// @Tags interests
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body map[string]string true "interest_key, related_key and relation_type (related or parent)"
+// @Success 200 {object} models.InterestRelation
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/interest-relations [post].
+func (s *AdminServer) handleSaveInterestRelation(w http.ResponseWriter, r *http.Request) {
+	var req map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	relation, err := s.botService.SaveInterestRelation(req["interest_key"], req["related_key"], req["relation_type"])
+	if err != nil {
+		if errors.Is(err, errorsPkg.ErrInvalidInterestRelation) || errors.Is(err, errorsPkg.ErrInterestNotFound) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		log.Printf("Failed to save interest relation: %v", err)
+		http.Error(w, "Failed to save interest relation", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, relation)
+}
+
+// handleDeleteInterestRelation removes an interest relation
+// @Summary Delete interest relation
+// @Description Remove a relation from the interest relationship graph
+// @Tags interests
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Relation ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/interest-relations/{id} [delete].
+func (s *AdminServer) handleDeleteInterestRelation(w http.ResponseWriter, r *http.Request) {
+	relationID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid relation ID", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.botService.DeleteInterestRelation(relationID); err != nil {
+		if errors.Is(err, errorsPkg.ErrInterestRelationNotFound) {
+			http.Error(w, "Interest relation not found", http.StatusNotFound)
+
+			return
+		}
+
+		http.Error(w, "Failed to delete interest relation", http.StatusInternalServerError)
+
+		return
+	}
+
+	response := map[string]interface{}{
+		"status": "deleted",
+		"id":     relationID,
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleGetInterestCooccurrences returns the most frequently co-selected interest pairs
+// @Summary Get interest co-occurrences
+// @Description Retrieve the top interest pairs picked by the same users; confidence is the share of users with the first interest who also picked the second
+// @Tags interests
+// @Produce json
+// @Security ApiKeyAuth
+// @Param limit query int false "Maximum number of pairs"
+// @Success 200 {array} models.InterestCooccurrence
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/interest-cooccurrences [get].
+func (s *AdminServer) handleGetInterestCooccurrences(w http.ResponseWriter, r *http.Request) {
+	limit := 0
+
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 0 {
+			http.Error(w, "Invalid limit", http.StatusBadRequest)
+
+			return
+		}
+
+		limit = parsed
+	}
+
+	pairs, err := s.botService.GetTopInterestCooccurrences(limit)
+	if err != nil {
+		http.Error(w, "Failed to get interest co-occurrences", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, pairs)
+}
+
+// handleRefreshInterestCooccurrences recomputes interest co-occurrences without waiting for the periodic job
+// @Summary Refresh interest co-occurrences
+// @Description Recompute interest co-occurrence statistics from current user selections
+// @Tags interests
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {object} map[string]int64
+// @Router /api/v1/interest-cooccurrences/refresh [post].
+func (s *AdminServer) handleRefreshInterestCooccurrences(w http.ResponseWriter, r *http.Request) {
+	pairs, err := s.botService.RefreshInterestCooccurrences()
+	if err != nil {
+		log.Printf("Failed to refresh interest co-occurrences: %v", err)
+		http.Error(w, "Failed to refresh interest co-occurrences", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]int64{"pairs": pairs})
+}
+
 // ===== API v2 Handlers =====
 
 // handleGetStatsV2 returns enhanced statistics for API v2
@@ -668,14 +1171,7 @@ func (s *AdminServer) handleGetStatsV2(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(enhancedStats); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, enhancedStats)
 }
 
 // handleGetSystemHealth returns comprehensive system health information
@@ -710,14 +1206,7 @@ func (s *AdminServer) handleGetSystemHealth(w http.ResponseWriter, r *http.Reque
 		"uptime": "available", // TODO: implement actual uptime tracking
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-
-	if err := json.NewEncoder(w).Encode(health); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
-
-		return
-	}
+	writeJSON(w, http.StatusOK, health)
 }
 
 // handleGetPerformanceMetrics returns detailed performance metrics
@@ -756,12 +1245,254 @@ func (s *AdminServer) handleGetPerformanceMetrics(w http.ResponseWriter, r *http
 		},
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	writeJSON(w, http.StatusOK, metrics)
+}
 
-	if err := json.NewEncoder(w).Encode(metrics); err != nil {
-		log.Printf("Failed to encode JSON response: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+// handleGetInterestCategories returns interest categories with their localized names
+// @Summary Get interest categories
+// @Description Retrieve interest categories with display order, names stored in the database and the number of interests
+// @Tags interests
+// @Produce json
+// @Security ApiKeyAuth
+// @Success 200 {array} models.InterestCategoryItem
+// @Router /api/v2/interest-categories [get].
+func (s *AdminServer) handleGetInterestCategories(w http.ResponseWriter, r *http.Request) {
+	categories, err := s.botService.GetInterestCategoryItems()
+	if err != nil {
+		http.Error(w, "Failed to get interest categories", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, categories)
+}
+
+// handleCreateInterestCategory creates an interest category
+// @Summary Create interest category
+// @Description Create a category with a key and at least one localized name; without display_order it is placed last.
+// @Description catalog-sync never removes categories; add the category to config/interests.json before catalog interests use it
+// @Tags interests
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.InterestCategoryInput true "key_name, optional display_order and translations"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v2/interest-categories [post].
+func (s *AdminServer) handleCreateInterestCategory(w http.ResponseWriter, r *http.Request) {
+	var input models.InterestCategoryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	categoryID, err := s.botService.CreateInterestCategory(input)
+	if err != nil {
+		writeInterestCatalogError(w, err, "Failed to create interest category")
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"status": "created", "id": categoryID})
+}
+
+// handleUpdateInterestCategory renames, reorders or localizes an interest category
+// @Summary Update interest category
+// @Description Change key_name, display_order or translations; omitted fields stay unchanged, an empty name removes that translation.
+// @Description Names from locales/*.json take precedence over database names
+// @Tags interests
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Category ID"
+// @Param request body models.InterestCategoryInput true "Fields to change"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v2/interest-categories/{id} [patch].
+func (s *AdminServer) handleUpdateInterestCategory(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid category ID", http.StatusBadRequest)
+
+		return
+	}
+
+	var input models.InterestCategoryInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.botService.UpdateInterestCategory(categoryID, input); err != nil {
+		if errors.Is(err, errorsPkg.ErrInterestCategoryNotFound) {
+			http.Error(w, "Interest category not found", http.StatusNotFound)
+
+			return
+		}
+
+		writeInterestCatalogError(w, err, "Failed to update interest category")
 
 		return
 	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"status": "updated", "id": categoryID})
+}
+
+// handleGetInterests returns catalog interests with their translations
+// @Summary Get interests
+// @Description Retrieve interests with category, display order, translations and the number of users who selected them
+// @Tags interests
+// @Produce json
+// @Security ApiKeyAuth
+// @Param category query string false "Category key to filter by"
+// @Success 200 {array} models.InterestCatalogItem
+// @Router /api/v2/interests [get].
+func (s *AdminServer) handleGetInterests(w http.ResponseWriter, r *http.Request) {
+	interests, err := s.botService.GetInterestCatalogItems(r.URL.Query().Get("category"))
+	if err != nil {
+		http.Error(w, "Failed to get interests", http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, interests)
+}
+
+// handleCreateInterest creates an interest in a category
+// @Summary Create interest
+// @Description Create an interest with a key, category and at least one localized name; without display_order it is placed last in the category.
+// @Description The interest is marked as admin-created and catalog-sync keeps it; once added to config/interests.json it is managed by the file
+// @Tags interests
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.InterestInput true "key_name, category_key, optional display_order and translations"
+// @Success 201 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v2/interests [post].
+func (s *AdminServer) handleCreateInterest(w http.ResponseWriter, r *http.Request) {
+	var input models.InterestInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	interestID, err := s.botService.CreateInterest(input)
+	if err != nil {
+		writeInterestCatalogError(w, err, "Failed to create interest")
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"status": "created", "id": interestID})
+}
+
+// handleUpdateInterest renames, reorders, re-categorizes or localizes an interest
+// @Summary Update interest
+// @Description Change key_name, category_key, display_order or translations; omitted fields stay unchanged, an empty name removes that translation.
+// @Description User selections are kept. The last translation cannot be removed
+// @Tags interests
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Interest ID"
+// @Param request body models.InterestInput true "Fields to change"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v2/interests/{id} [patch].
+func (s *AdminServer) handleUpdateInterest(w http.ResponseWriter, r *http.Request) {
+	interestID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid interest ID", http.StatusBadRequest)
+
+		return
+	}
+
+	var input models.InterestInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	if err := s.botService.UpdateInterest(interestID, input); err != nil {
+		writeInterestCatalogError(w, err, "Failed to update interest")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"status": "updated", "id": interestID})
+}
+
+// writeInterestCatalogError maps interest catalog errors to HTTP status codes.
+func writeInterestCatalogError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, errorsPkg.ErrInterestNotFound):
+		http.Error(w, "Interest not found", http.StatusNotFound)
+	case errors.Is(err, errorsPkg.ErrInterestKeyExists), errors.Is(err, errorsPkg.ErrInterestCategoryKeyExists):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, errorsPkg.ErrInvalidInterestData), errors.Is(err, errorsPkg.ErrInvalidInterestCategory),
+		errors.Is(err, errorsPkg.ErrInterestCategoryNotFound):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		log.Printf("%s: %v", fallback, err)
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
+
+// handleUpdateUserRole changes the role of a user
+// @Summary Update user role
+// @Description Set the role of a user (user, moderator, admin); requires the roles.manage permission
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Telegram User ID"
+// @Param request body map[string]string true "New role, e.g. {\"role\": \"moderator\"}"
+// @Success 200 {object} models.User
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /api/v2/users/{id}/role [patch].
+func (s *AdminServer) handleUpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	telegramID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+
+		return
+	}
+
+	var request struct {
+		Role string `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	user, err := s.botService.UpdateUserRole(telegramID, request.Role)
+	if err != nil {
+		switch {
+		case errors.Is(err, errorsPkg.ErrInvalidUserRole):
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		case errors.Is(err, errorsPkg.ErrUserNotFound):
+			http.Error(w, "User not found", http.StatusNotFound)
+		default:
+			log.Printf("Failed to update user role: %v", err)
+			http.Error(w, "Failed to update user role", http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, user)
 }
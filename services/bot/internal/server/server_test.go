@@ -2,13 +2,27 @@ package server
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"language-exchange-bot/internal/audit"
+	"language-exchange-bot/internal/auth"
+	"language-exchange-bot/internal/config"
+	"language-exchange-bot/internal/core"
+	"language-exchange-bot/internal/localization"
+	"language-exchange-bot/internal/models"
+	"language-exchange-bot/tests/mocks"
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // BotServiceInterface - интерфейс для тестирования.
@@ -80,6 +94,18 @@ func (m *mockTelegramHandler) GetRateLimiterStats() map[string]interface{} {
 	}
 }
 
+// newTestServer создает сервер на DatabaseMock и выпускает для него ключ служебной учетной записи с правами scopes.
+func newTestServer(t *testing.T, db *mocks.DatabaseMock, scopes ...string) (*AdminServer, string) {
+	t.Helper()
+
+	service := core.NewBotServiceWithInterface(db, &localization.Localizer{})
+
+	_, rawKey, err := service.CreateAPIKey(models.APIKeyInput{Name: "test", Scopes: scopes, ServiceAccount: true}, 0)
+	require.NoError(t, err)
+
+	return New("8080", service, nil), rawKey
+}
+
 // TestAdminServer_Constructor - тест конструкторов.
 func TestAdminServer_Constructor(t *testing.T) {
 	// Test New constructor
@@ -95,6 +121,17 @@ func TestAdminServer_Constructor(t *testing.T) {
 	assert.True(t, server2.webhookMode)
 }
 
+// TestWriteJSON - тест общего ответа JSON с кодом статуса.
+func TestWriteJSON(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	writeJSON(w, http.StatusCreated, map[string]int{"id": 7})
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"id":7}`, w.Body.String())
+}
+
 func TestAdminServer_handleHealth(t *testing.T) {
 	server := New("8080", nil, nil)
 
@@ -166,7 +203,7 @@ func TestAdminServer_corsMiddleware(t *testing.T) {
 }
 
 func TestAdminServer_authMiddleware_ValidToken(t *testing.T) {
-	server := New("8080", nil, nil)
+	server, adminKey := newTestServer(t, mocks.NewDatabaseMock(), models.APIKeyScopeAll)
 
 	// Create a test handler
 	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -182,7 +219,7 @@ func TestAdminServer_authMiddleware_ValidToken(t *testing.T) {
 
 	// Test with valid admin key
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/test", nil)
-	req.Header.Set("X-Admin-Key", "admin-secret-key")
+	req.Header.Set("X-Admin-Key", adminKey)
 
 	w := httptest.NewRecorder()
 
@@ -194,7 +231,7 @@ func TestAdminServer_authMiddleware_ValidToken(t *testing.T) {
 }
 
 func TestAdminServer_authMiddleware_InvalidToken(t *testing.T) {
-	server := New("8080", nil, nil)
+	server, _ := newTestServer(t, mocks.NewDatabaseMock(), models.APIKeyScopeAll)
 
 	// Create a test handler
 	testHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -286,10 +323,10 @@ func TestAdminServer_getStatsData(t *testing.T) {
 }
 
 func TestAdminServer_handleGetStats(t *testing.T) {
-	server := New("8080", nil, nil)
+	server, adminKey := newTestServer(t, mocks.NewDatabaseMock(), models.APIKeyScopeAll)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/v1/stats", nil)
-	req.Header.Set("X-Admin-Key", "admin-secret-key")
+	req.Header.Set("X-Admin-Key", adminKey)
 
 	w := httptest.NewRecorder()
 
@@ -318,10 +355,10 @@ func TestAdminServer_handleGetStats(t *testing.T) {
 func TestAdminServer_handleGetStatsV2(t *testing.T) {
 	t.Skip("Requires full BotService mock - skipping for now")
 
-	server := New("8080", nil, nil)
+	server, adminKey := newTestServer(t, mocks.NewDatabaseMock(), models.APIKeyScopeAll)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/v2/stats", nil)
-	req.Header.Set("X-Admin-Key", "admin-secret-key")
+	req.Header.Set("X-Admin-Key", adminKey)
 
 	w := httptest.NewRecorder()
 
@@ -348,10 +385,10 @@ func TestAdminServer_handleGetStatsV2(t *testing.T) {
 func TestAdminServer_handleGetSystemHealth(t *testing.T) {
 	t.Skip("Requires full BotService mock - skipping for now")
 
-	server := New("8080", nil, nil)
+	server, adminKey := newTestServer(t, mocks.NewDatabaseMock(), models.APIKeyScopeAll)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/v2/system/health", nil)
-	req.Header.Set("X-Admin-Key", "admin-secret-key")
+	req.Header.Set("X-Admin-Key", adminKey)
 
 	w := httptest.NewRecorder()
 
@@ -380,10 +417,10 @@ func TestAdminServer_handleGetSystemHealth(t *testing.T) {
 func TestAdminServer_handleGetPerformanceMetrics(t *testing.T) {
 	t.Skip("Requires full BotService mock - skipping for now")
 
-	server := New("8080", nil, nil)
+	server, adminKey := newTestServer(t, mocks.NewDatabaseMock(), models.APIKeyScopeAll)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/v2/metrics/performance", nil)
-	req.Header.Set("X-Admin-Key", "admin-secret-key")
+	req.Header.Set("X-Admin-Key", adminKey)
 
 	w := httptest.NewRecorder()
 
@@ -427,7 +464,7 @@ func TestAdminServer_handleGetStats_Unauthenticated(t *testing.T) {
 
 // Test invalid HTTP methods.
 func TestAdminServer_InvalidMethods(t *testing.T) {
-	server := New("8080", nil, nil)
+	server, adminKey := newTestServer(t, mocks.NewDatabaseMock(), models.APIKeyScopeAll)
 
 	r := mux.NewRouter()
 	server.setupAPIV1(r)
@@ -446,7 +483,7 @@ func TestAdminServer_InvalidMethods(t *testing.T) {
 		t.Run(tc.method+"_"+tc.path, func(t *testing.T) {
 			req := httptest.NewRequest(tc.method, tc.path, nil)
 			if tc.path != "/healthz" && tc.path != "/readyz" {
-				req.Header.Set("X-Admin-Key", "admin-secret-key")
+				req.Header.Set("X-Admin-Key", adminKey)
 			}
 
 			w := httptest.NewRecorder()
@@ -478,3 +515,382 @@ func TestAdminServer_StartStop(t *testing.T) {
 	err := server.Stop(context.TODO())
 	assert.NoError(t, err)
 }
+
+// TestAdminServer_requirePermission - тест проверки прав ключа и роли вызывающего.
+func TestAdminServer_requirePermission(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+
+	moderator, err := db.CreateUser(1001, "moderator", "Moderator", "en")
+	require.NoError(t, err)
+	require.NoError(t, db.UpdateUserRole(moderator.ID, models.RoleModerator))
+
+	_, err = db.CreateUser(1002, "member", "Member", "en")
+	require.NoError(t, err)
+
+	server, adminKey := newTestServer(t, db, models.APIKeyScopeAll)
+
+	_, feedbackKey, err := server.botService.CreateAPIKey(models.APIKeyInput{
+		Name:           "feedback",
+		Scopes:         []string{string(core.PermissionViewFeedback)},
+		ServiceAccount: true,
+	}, 0)
+	require.NoError(t, err)
+
+	_, userKey, err := server.botService.CreateAPIKey(models.APIKeyInput{
+		Name:            "dashboard",
+		Scopes:          []string{models.APIKeyScopeAll},
+		OwnerTelegramID: 1001,
+	}, 0)
+	require.NoError(t, err)
+
+	_, memberKey, err := server.botService.CreateAPIKey(models.APIKeyInput{
+		Name:            "member",
+		Scopes:          []string{models.APIKeyScopeAll},
+		OwnerTelegramID: 1002,
+	}, 0)
+	require.NoError(t, err)
+
+	// Ключ без служебной учетной записи, выпущенный до привязки к владельцу
+	require.NoError(t, db.CreateAPIKey(&models.APIKey{
+		Name: "legacy", KeyHash: core.HashAPIKey("lxb_legacy"), Scopes: []string{models.APIKeyScopeAll},
+	}))
+
+	okHandler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	tests := []struct {
+		name       string
+		key        string
+		caller     string
+		permission core.Permission
+		expected   int
+	}{
+		{name: "admin key without user", permission: core.PermissionManageRoles, expected: http.StatusOK},
+		{name: "scoped key", key: feedbackKey, permission: core.PermissionViewFeedback, expected: http.StatusOK},
+		{name: "scoped key outside scope", key: feedbackKey, permission: core.PermissionViewStats, expected: http.StatusForbidden},
+		{name: "service key with user", caller: "1001", permission: core.PermissionViewFeedback, expected: http.StatusForbidden},
+		{name: "user key for moderator", key: userKey, permission: core.PermissionViewFeedback, expected: http.StatusOK},
+		{name: "user key with owner header", key: userKey, caller: "1001", permission: core.PermissionViewFeedback, expected: http.StatusOK},
+		{name: "user key limited by owner role", key: userKey, permission: core.PermissionManageRoles, expected: http.StatusForbidden},
+		{name: "user key with other user", key: memberKey, caller: "1001", permission: core.PermissionViewStats, expected: http.StatusForbidden},
+		{name: "regular user key", key: memberKey, permission: core.PermissionViewStats, expected: http.StatusForbidden},
+		{name: "unbound user key", key: "lxb_legacy", permission: core.PermissionViewStats, expected: http.StatusForbidden},
+		{name: "invalid header", key: userKey, caller: "abc", permission: core.PermissionViewStats, expected: http.StatusBadRequest},
+		{name: "unknown key", key: "lxb_unknown", permission: core.PermissionViewStats, expected: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := tt.key
+			if key == "" {
+				key = adminKey
+			}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v2/test", nil)
+			req.Header.Set(adminKeyHeader, key)
+
+			if tt.caller != "" {
+				req.Header.Set(telegramUserHeader, tt.caller)
+			}
+
+			w := httptest.NewRecorder()
+
+			server.authMiddleware(server.requirePermission(tt.permission, okHandler)).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected, w.Code)
+		})
+	}
+}
+
+// TestAdminServer_announcements тестирует создание, предпросмотр и отмену анонса через admin API v2.
+func TestAdminServer_announcements(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+
+	for i, lang := range []string{"ru", "ru", "en"} {
+		_, err := db.CreateUser(int64(2001+i), "user", "User", lang)
+		require.NoError(t, err)
+	}
+
+	moderator, err := db.CreateUser(2010, "moderator", "Moderator", "en")
+	require.NoError(t, err)
+	require.NoError(t, db.UpdateUserRole(moderator.ID, models.RoleModerator))
+
+	server, adminKey := newTestServer(t, db, models.APIKeyScopeAll)
+	r := mux.NewRouter()
+	server.setupAPIV2(r)
+
+	do := func(method, path, body, caller string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("X-Admin-Key", adminKey)
+
+		if caller != "" {
+			req.Header.Set(telegramUserHeader, caller)
+		}
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := do(http.MethodPost, "/api/v2/announcements", `{"title":"News","message":"Hello","segment":{"interfaceLanguages":["ru"]}}`, "")
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var created models.Announcement
+
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&created))
+	assert.Equal(t, 2, created.RecipientsCount)
+	assert.Equal(t, models.AnnouncementStatusDraft, created.Status)
+
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/api/v2/announcements", `{"title":"","message":"Hello"}`, "").Code)
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/api/v2/announcements", "", "2010").Code)
+
+	w = do(http.MethodGet, "/api/v2/announcements/1", "", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"text":"News\n\nHello"`)
+
+	assert.Equal(t, http.StatusNotFound, do(http.MethodGet, "/api/v2/announcements/99", "", "").Code)
+	assert.Equal(t, http.StatusServiceUnavailable, do(http.MethodPost, "/api/v2/announcements/1/send", "", "").Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/api/v2/announcements/1/test", "", "").Code)
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/api/v2/announcements/1/deliveries?status=lost", "", "").Code)
+
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/api/v2/announcements/1/cancel", "", "").Code)
+	assert.Equal(t, http.StatusConflict, do(http.MethodPost, "/api/v2/announcements/1/cancel", "", "").Code)
+}
+
+// TestAdminServer_apiKeys тестирует выпуск, ротацию и отзыв ключей через admin API v2.
+func TestAdminServer_apiKeys(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+	server, adminKey := newTestServer(t, db, models.APIKeyScopeAll)
+	r := mux.NewRouter()
+	server.setupAPIV2(r)
+
+	do := func(method, path, body, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(adminKeyHeader, key)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		return w
+	}
+
+	var issued struct {
+		Key    models.APIKey `json:"key"`
+		APIKey string        `json:"apiKey"`
+	}
+
+	w := do(http.MethodPost, "/api/v2/api-keys", `{"name":"ops","scopes":["apikeys.manage"],"serviceAccount":true}`, adminKey)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&issued))
+	assert.NotContains(t, w.Body.String(), core.HashAPIKey(issued.APIKey))
+
+	opsKey, opsKeyID := issued.APIKey, issued.Key.ID
+	assert.True(t, issued.Key.ServiceAccount)
+
+	// Ключ без служебной учетной записи привязан к владельцу и ограничен его ролью
+	member, err := db.CreateUser(3001, "member", "Member", "en")
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/api/v2/api-keys", `{"name":"dashboard","scopes":["apikeys.manage"]}`, adminKey).Code)
+
+	w = do(http.MethodPost, "/api/v2/api-keys", `{"name":"dashboard","scopes":["apikeys.manage"],"ownerTelegramId":3001}`, adminKey)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&issued))
+	assert.False(t, issued.Key.ServiceAccount)
+	assert.Equal(t, member.ID, issued.Key.OwnerUserID)
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/api/v2/api-keys", "", issued.APIKey).Code)
+
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/api/v2/api-keys", `{"name":"bad","scopes":["nope"]}`, adminKey).Code)
+	assert.Equal(t, http.StatusForbidden, do(http.MethodPost, "/api/v2/api-keys", `{"name":"root","scopes":["*"]}`, opsKey).Code)
+	assert.Equal(t, http.StatusForbidden, do(http.MethodPost, "/api/v2/api-keys/1/rotate", "", opsKey).Code)
+
+	w = do(http.MethodPost, fmt.Sprintf("/api/v2/api-keys/%d/rotate", opsKeyID), `{"gracePeriodDays":0}`, adminKey)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&issued))
+	assert.Equal(t, opsKeyID, issued.Key.RotatedFrom)
+
+	// Без периода перекрытия старый ключ перестает действовать сразу
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodGet, "/api/v2/api-keys", "", opsKey).Code)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/api/v2/api-keys", "", issued.APIKey).Code)
+
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, fmt.Sprintf("/api/v2/api-keys/%d/revoke", issued.Key.ID), "", adminKey).Code)
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodGet, "/api/v2/api-keys", "", issued.APIKey).Code)
+	assert.Equal(t, http.StatusNotFound, do(http.MethodPost, "/api/v2/api-keys/99/revoke", "", adminKey).Code)
+}
+
+// TestAdminServer_audit тестирует запись вызовов admin API, фильтры, CSV-выгрузку и проверку цепочки.
+func TestAdminServer_audit(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+	server, adminKey := newTestServer(t, db, models.APIKeyScopeAll)
+	r := mux.NewRouter()
+	server.setupAPIV2(r)
+
+	_, statsKey, err := server.botService.CreateAPIKey(models.APIKeyInput{Name: "stats", Scopes: []string{"stats.view"}, ServiceAccount: true}, 0)
+	require.NoError(t, err)
+
+	do := func(method, path, body, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(adminKeyHeader, key)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, do(http.MethodPost, "/api/v2/webhook/setup", `{"webhook_url":"https://example.com/hook"}`, adminKey).Code)
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/api/v2/audit", "", statsKey).Code)
+
+	var events []models.AuditEvent
+
+	w := do(http.MethodGet, "/api/v2/audit?action=webhook.*", "", adminKey)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&events))
+	require.Len(t, events, 1)
+	assert.Equal(t, audit.ActionWebhookSetup, events[0].Action)
+	assert.Equal(t, models.AuditActorAPIKey, events[0].ActorType)
+	assert.Equal(t, "1", events[0].ActorID)
+	assert.Equal(t, "https://example.com/hook", events[0].Details["webhookUrl"])
+
+	w = do(http.MethodGet, "/api/v2/audit?action=api.request&result=failure", "", adminKey)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&events))
+	require.Len(t, events, 1)
+	assert.Equal(t, "/api/v2/audit", events[0].Details["path"])
+	assert.Equal(t, float64(http.StatusForbidden), events[0].Details["status"])
+
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/api/v2/audit?from=yesterday", "", adminKey).Code)
+
+	w = do(http.MethodGet, "/api/v2/audit/export?targetType=webhook", "", adminKey)
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, audit.ActionWebhookSetup, records[1][4])
+
+	var verification models.AuditVerification
+
+	w = do(http.MethodGet, "/api/v2/audit/verify", "", adminKey)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&verification))
+	assert.True(t, verification.Valid)
+	assert.Positive(t, verification.Checked)
+
+	db.TamperAuditEvent(2, func(event *models.AuditEvent) { event.ActorID = "42" })
+
+	w = do(http.MethodGet, "/api/v2/audit/verify", "", adminKey)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&verification))
+	assert.False(t, verification.Valid)
+	assert.Equal(t, int64(2), verification.BrokenAtID)
+	assert.Equal(t, 1, verification.Checked)
+}
+
+// TestAdminServer_retentionReport тестирует пробный отчет об очистке по срокам хранения.
+func TestAdminServer_retentionReport(t *testing.T) {
+	db := mocks.NewDatabaseMock()
+	server, adminKey := newTestServer(t, db, models.APIKeyScopeAll)
+	r := mux.NewRouter()
+	server.setupAPIV2(r)
+
+	_, statsKey, err := server.botService.CreateAPIKey(models.APIKeyInput{Name: "stats", Scopes: []string{"stats.view"}, ServiceAccount: true}, 0)
+	require.NoError(t, err)
+
+	do := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/retention/report", nil)
+		req.Header.Set(adminKeyHeader, key)
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		return w
+	}
+
+	assert.Equal(t, http.StatusForbidden, do(statsKey).Code)
+
+	w := do(adminKey)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var report models.RetentionReport
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &report))
+	assert.True(t, report.DryRun)
+	require.Len(t, report.Items, 3)
+	assert.Equal(t, models.RetentionFeedback, report.Items[0].DataType)
+	assert.False(t, report.Items[0].Enabled, "retention is off until RETENTION_* is set")
+}
+
+// TestAdminServer_adminSessions тестирует вход через Telegram Login Widget, права из JWT и обновление сессии.
+func TestAdminServer_adminSessions(t *testing.T) {
+	const botToken = "123456:test-token"
+
+	db := mocks.NewDatabaseMock()
+
+	for telegramID, role := range map[int64]string{3001: models.RoleModerator, 3002: models.RoleAdmin, 3003: models.RoleUser} {
+		user, err := db.CreateUser(telegramID, "user", "User", "en")
+		require.NoError(t, err)
+		require.NoError(t, db.UpdateUserRole(user.ID, role))
+	}
+
+	server, _ := newTestServer(t, db, models.APIKeyScopeAll)
+	server.botService.Config = &config.Config{
+		TelegramToken:           botToken,
+		AdminJWTSecret:          "0123456789abcdef0123456789abcdef",
+		AdminAccessTokenMinutes: 15,
+		AdminRefreshTokenDays:   30,
+	}
+
+	do := func(method, path, body, accessToken string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		if accessToken != "" {
+			req.Header.Set("Authorization", "Bearer "+accessToken)
+		}
+
+		w := httptest.NewRecorder()
+		server.server.Handler.ServeHTTP(w, req)
+
+		return w
+	}
+
+	login := func(telegramID int64) *httptest.ResponseRecorder {
+		fields := map[string]string{
+			"id":         strconv.FormatInt(telegramID, 10),
+			"first_name": "User",
+			"auth_date":  strconv.FormatInt(time.Now().Unix(), 10),
+		}
+		hash := auth.TelegramLoginHash(fields, botToken)
+		body := fmt.Sprintf(`{"id":%d,"first_name":"User","auth_date":%s,"hash":%q}`, telegramID, fields["auth_date"], hash)
+
+		return do(http.MethodPost, "/api/auth/telegram", body, "")
+	}
+
+	var moderator, admin models.AdminTokens
+
+	w := login(3001)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&moderator))
+
+	w = login(3002)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&admin))
+
+	assert.Equal(t, http.StatusForbidden, login(3003).Code)
+
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/api/v1/stats", "", moderator.AccessToken).Code)
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/api/v2/announcements", "", moderator.AccessToken).Code)
+	assert.Equal(t, http.StatusOK, do(http.MethodGet, "/api/v2/announcements", "", admin.AccessToken).Code)
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodGet, "/api/v1/stats", "", admin.AccessToken+"x").Code)
+
+	w = do(http.MethodPost, "/api/auth/refresh", fmt.Sprintf(`{"refreshToken":%q}`, admin.RefreshToken), "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var refreshed models.AdminTokens
+
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&refreshed))
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodPost, "/api/auth/refresh", fmt.Sprintf(`{"refreshToken":%q}`, admin.RefreshToken), "").Code)
+
+	assert.Equal(t, http.StatusOK, do(http.MethodPost, "/api/auth/logout", fmt.Sprintf(`{"refreshToken":%q}`, refreshed.RefreshToken), "").Code)
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodPost, "/api/auth/refresh", fmt.Sprintf(`{"refreshToken":%q}`, refreshed.RefreshToken), "").Code)
+}
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"language-exchange-bot/internal/core"
+	errorsPkg "language-exchange-bot/internal/errors"
+	"language-exchange-bot/internal/models"
+
+	"github.com/gorilla/mux"
+)
+
+// handleGetSurveys returns the latest surveys with delivery and response counters
+// @Summary List surveys
+// @Description Retrieve the latest surveys, newest first, with sent and responded counters
+// @Tags surveys
+// @Produce json
+// @Security ApiKeyAuth
+// @Param limit query int false "Maximum number of surveys"
+// @Success 200 {array} models.Survey
+// @Failure 400 {object} map[string]string
+// @Router /api/v2/surveys [get].
+func (s *AdminServer) handleGetSurveys(w http.ResponseWriter, r *http.Request) {
+	limit, ok := announcementLimit(w, r)
+	if !ok {
+		return
+	}
+
+	surveys, err := s.botService.GetSurveys(limit)
+	if err != nil {
+		writeSurveyError(w, err, "Failed to get surveys")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, surveys)
+}
+
+// handleCreateSurvey creates a survey draft or schedules a survey
+// @Summary Create survey
+// @Description Create an NPS survey (kind "nps", the standard question is used when questions are omitted) or a questionnaire of
+// @Description up to 5 nps, rating (1-5) or choice questions with texts per interface language. With scheduledAt the survey starts
+// @Description automatically, otherwise it stays a draft. recipientsCount is the number of segment users not limited by the survey frequency rules
+// @Tags surveys
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param request body models.SurveyInput true "Title, kind, questions, segment and optional start time"
+// @Success 201 {object} models.Survey
+// @Failure 400 {object} map[string]string
+// @Router /api/v2/surveys [post].
+func (s *AdminServer) handleCreateSurvey(w http.ResponseWriter, r *http.Request) {
+	var input models.SurveyInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+
+		return
+	}
+
+	survey, err := s.botService.CreateSurvey(input, s.callerUserID(r))
+	if err != nil {
+		writeSurveyError(w, err, "Failed to create survey")
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, survey)
+}
+
+// handleGetSurveyResults returns aggregated survey answers
+// @Summary Get survey results
+// @Description Retrieve the answer distribution per question, average scores, NPS and the response rate
+// @Tags surveys
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Survey ID"
+// @Success 200 {object} models.SurveyResults
+// @Failure 404 {object} map[string]string
+// @Router /api/v2/surveys/{id}/results [get].
+func (s *AdminServer) handleGetSurveyResults(w http.ResponseWriter, r *http.Request) {
+	surveyID, ok := surveyIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	results, err := s.botService.GetSurveyResults(surveyID)
+	if err != nil {
+		writeSurveyError(w, err, "Failed to get survey results")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
+
+// handleSendSurvey starts a survey immediately
+// @Summary Send survey
+// @Description Queue segment users not limited by the survey frequency rules and send the first question within Telegram rate limits.
+// @Description Sending a survey in the sending status resumes an interrupted delivery
+// @Tags surveys
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Survey ID"
+// @Success 202 {object} map[string]interface{}
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 503 {object} map[string]string
+// @Router /api/v2/surveys/{id}/send [post].
+func (s *AdminServer) handleSendSurvey(w http.ResponseWriter, r *http.Request) {
+	surveyID, ok := surveyIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	dispatcher := s.surveyDispatcher(w)
+	if dispatcher == nil {
+		return
+	}
+
+	// Рассылка переживает HTTP-запрос, поэтому контекст запроса не передается
+	recipients, err := dispatcher.Send(context.Background(), surveyID)
+	if err != nil {
+		writeSurveyError(w, err, "Failed to send survey")
+
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, map[string]interface{}{"status": models.SurveyStatusSending, "recipientsCount": recipients})
+}
+
+// handleCancelSurvey cancels a survey
+// @Summary Cancel survey
+// @Description Cancel a draft or scheduled survey or stop the delivery; recipients can no longer answer a cancelled survey
+// @Tags surveys
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Survey ID"
+// @Success 200 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v2/surveys/{id}/cancel [post].
+func (s *AdminServer) handleCancelSurvey(w http.ResponseWriter, r *http.Request) {
+	surveyID, ok := surveyIDFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.botService.CancelSurvey(surveyID); err != nil {
+		writeSurveyError(w, err, "Failed to cancel survey")
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"status": models.SurveyStatusCancelled})
+}
+
+// surveyDispatcher returns the survey dispatcher or writes 503 when the bot is not connected.
+func (s *AdminServer) surveyDispatcher(w http.ResponseWriter) *core.SurveyDispatcher {
+	if s.handler != nil {
+		if dispatcher := s.handler.SurveyDispatcher(); dispatcher != nil {
+			return dispatcher
+		}
+	}
+
+	http.Error(w, "Telegram bot is not available", http.StatusServiceUnavailable)
+
+	return nil
+}
+
+// surveyIDFromPath parses the survey ID or writes 400.
+func surveyIDFromPath(w http.ResponseWriter, r *http.Request) (int, bool) {
+	surveyID, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, "Invalid survey ID", http.StatusBadRequest)
+
+		return 0, false
+	}
+
+	return surveyID, true
+}
+
+// writeSurveyError maps survey errors to HTTP status codes.
+func writeSurveyError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, errorsPkg.ErrSurveyNotFound):
+		http.Error(w, "Survey not found", http.StatusNotFound)
+	case errors.Is(err, errorsPkg.ErrSurveyNotSendable):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, errorsPkg.ErrInvalidSurvey):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	default:
+		log.Printf("%s: %v", fallback, err)
+		http.Error(w, fallback, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,148 @@
+// Package triage suggests a feedback category from keyword rules per interface language.
+// Keywords are lowercase substrings (usually word stems), so one rule covers several word forms.
+package triage
+
+import (
+	"strings"
+
+	"language-exchange-bot/internal/models"
+)
+
+// Rules - ключевые слова категорий для одного языка.
+type Rules map[string][]string
+
+// DefaultRules - правила по языкам интерфейса бота.
+var DefaultRules = map[string]Rules{
+	"ru": {
+		models.FeedbackCategoryBug: {
+			"ошибк", "баг", "не работает", "не работают", "сломал", "вылет", "завис", "глюк", "не приход", "не отвеча",
+		},
+		models.FeedbackCategoryFeature: {
+			"добавьте", "добавить", "хотелось бы", "предлага", "было бы здорово", "было бы удобно", "функци", "возможность",
+		},
+		models.FeedbackCategoryComplaint: {
+			"жалоб", "ужасн", "отвратительн", "недоволен", "недовольна", "спам", "оскорб", "грубо", "хамств",
+		},
+		models.FeedbackCategoryPraise: {
+			"спасибо", "благодар", "отличн", "супер", "классн", "нравится", "молодц", "круто",
+		},
+	},
+	"en": {
+		models.FeedbackCategoryBug: {
+			"bug", "error", "crash", "broken", "doesn't work", "does not work", "not working", "freez", "stuck", "glitch",
+		},
+		models.FeedbackCategoryFeature: {
+			"please add", "would be nice", "would be great", "feature", "suggest", "it would help", "wish", "could you add",
+		},
+		models.FeedbackCategoryComplaint: {
+			"complain", "terrible", "awful", "unhappy", "disappointed", "spam", "rude", "abus", "harass",
+		},
+		models.FeedbackCategoryPraise: {
+			"thank", "great job", "awesome", "love it", "love this", "excellent", "amazing", "well done",
+		},
+	},
+	"es": {
+		models.FeedbackCategoryBug: {
+			"error", "fallo", "falla", "no funciona", "se cuelga", "se bloquea", "roto", "bug",
+		},
+		models.FeedbackCategoryFeature: {
+			"añadir", "agregar", "añadan", "agreguen", "sería genial", "sería bueno", "sugiero", "función", "funcion",
+		},
+		models.FeedbackCategoryComplaint: {
+			"queja", "terrible", "horrible", "decepcion", "molest", "spam", "grosero", "acoso",
+		},
+		models.FeedbackCategoryPraise: {
+			"gracias", "excelente", "genial", "me encanta", "increíble", "buen trabajo", "perfecto",
+		},
+	},
+	"zh": {
+		models.FeedbackCategoryBug: {
+			"错误", "故障", "崩溃", "闪退", "卡住", "无法", "不能用", "bug",
+		},
+		models.FeedbackCategoryFeature: {
+			"建议", "希望", "增加", "添加", "功能", "能不能",
+		},
+		models.FeedbackCategoryComplaint: {
+			"投诉", "糟糕", "太差", "不满", "垃圾", "骚扰", "辱骂",
+		},
+		models.FeedbackCategoryPraise: {
+			"谢谢", "感谢", "很好", "太棒", "喜欢", "优秀",
+		},
+	},
+}
+
+// Suggester предлагает категорию отзыва по правилам.
+type Suggester struct {
+	rules map[string]Rules
+}
+
+// NewSuggester создает Suggester с указанными правилами.
+func NewSuggester(rules map[string]Rules) *Suggester {
+	return &Suggester{rules: rules}
+}
+
+// NewDefaultSuggester создает Suggester с правилами DefaultRules.
+func NewDefaultSuggester() *Suggester {
+	return NewSuggester(DefaultRules)
+}
+
+// Suggest возвращает категорию с наибольшим числом совпавших ключевых слов или пустую строку.
+// Сначала проверяются правила языка интерфейса автора, затем правила остальных языков:
+// пользователи нередко пишут не на языке интерфейса. При равенстве побеждает категория,
+// раньше стоящая в models.FeedbackCategories.
+func (s *Suggester) Suggest(text, lang string) string {
+	text = strings.ToLower(text)
+
+	if rules, ok := s.rules[lang]; ok {
+		if category := bestCategory(text, rules); category != "" {
+			return category
+		}
+	}
+
+	scores := make(map[string]int)
+
+	for ruleLang, rules := range s.rules {
+		if ruleLang == lang {
+			continue
+		}
+
+		for category, count := range score(text, rules) {
+			scores[category] += count
+		}
+	}
+
+	return pickBest(scores)
+}
+
+// bestCategory выбирает категорию по правилам одного языка.
+func bestCategory(text string, rules Rules) string {
+	return pickBest(score(text, rules))
+}
+
+// score считает совпавшие ключевые слова по категориям.
+func score(text string, rules Rules) map[string]int {
+	scores := make(map[string]int)
+
+	for category, keywords := range rules {
+		for _, keyword := range keywords {
+			if strings.Contains(text, keyword) {
+				scores[category]++
+			}
+		}
+	}
+
+	return scores
+}
+
+// pickBest возвращает категорию с наибольшим счетом в порядке models.FeedbackCategories.
+func pickBest(scores map[string]int) string {
+	best, bestScore := "", 0
+
+	for _, category := range models.FeedbackCategories {
+		if scores[category] > bestScore {
+			best, bestScore = category, scores[category]
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,58 @@
+package triage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"language-exchange-bot/internal/models"
+)
+
+func TestSuggest(t *testing.T) {
+	suggester := NewDefaultSuggester()
+
+	tests := []struct {
+		name string
+		text string
+		lang string
+		want string
+	}{
+		{"ru bug", "Бот не работает, после обновления постоянно вылетает", "ru", models.FeedbackCategoryBug},
+		{"ru feature", "Хотелось бы добавить возможность выбирать время", "ru", models.FeedbackCategoryFeature},
+		{"ru praise", "Спасибо, отличный бот!", "ru", models.FeedbackCategoryPraise},
+		{"en complaint", "I am disappointed, a partner was rude", "en", models.FeedbackCategoryComplaint},
+		{"es bug", "La aplicación no funciona", "es", models.FeedbackCategoryBug},
+		{"zh feature", "建议增加语音功能", "zh", models.FeedbackCategoryFeature},
+		{"other language fallback", "Thank you, great job", "ru", models.FeedbackCategoryPraise},
+		{"unknown language", "The bot keeps crashing", "de", models.FeedbackCategoryBug},
+		{"no match", "Просто сообщение", "ru", ""},
+		{"case insensitive", "ОШИБКА при регистрации", "ru", models.FeedbackCategoryBug},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, suggester.Suggest(tt.text, tt.lang))
+		})
+	}
+}
+
+func TestSuggest_InterfaceLanguageFirst(t *testing.T) {
+	suggester := NewSuggester(map[string]Rules{
+		"ru": {models.FeedbackCategoryPraise: {"ok"}},
+		"en": {models.FeedbackCategoryBug: {"ok", "fail"}},
+	})
+
+	assert.Equal(t, models.FeedbackCategoryPraise, suggester.Suggest("ok, fail", "ru"))
+	assert.Equal(t, models.FeedbackCategoryBug, suggester.Suggest("ok, fail", "en"))
+}
+
+func TestSuggest_TieUsesCategoryOrder(t *testing.T) {
+	suggester := NewSuggester(map[string]Rules{
+		"en": {
+			models.FeedbackCategoryPraise: {"thanks"},
+			models.FeedbackCategoryBug:    {"error"},
+		},
+	})
+
+	assert.Equal(t, models.FeedbackCategoryBug, suggester.Suggest("thanks, but error", "en"))
+}
@@ -4,15 +4,35 @@ package mocks
 import (
 	"database/sql"
 	"errors"
+	"language-exchange-bot/internal/audit"
+	errorsPkg "language-exchange-bot/internal/errors"
 	"language-exchange-bot/internal/models"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // DatabaseMock имитирует базу данных для тестов.
 type DatabaseMock struct {
 	users     map[int64]*models.User
+	platforms map[string]int64
 	languages map[string]*models.Language
 	interests map[int]*models.Interest
+	periods   map[int][]models.UnavailabilityPeriod
+	proposals map[int]*models.InterestSuggestion
+	relations map[int]*models.InterestRelation
+	pairs     []models.InterestCooccurrence
+	groups    map[int]*models.InterestCategoryItem
+	adminLogs []models.AdminActionLog
+	announces map[int]*models.Announcement
+	delivers  map[int][]models.AnnouncementDelivery
+	apiKeys   []*models.APIKey
+	sessions  []*models.AdminSession
+	audit     []models.AuditEvent
+	aliases   map[int]string
+	nextID    int
 	lastError error
 }
 
@@ -20,8 +40,16 @@ type DatabaseMock struct {
 func NewDatabaseMock() *DatabaseMock {
 	db := &DatabaseMock{
 		users:     make(map[int64]*models.User),
+		platforms: make(map[string]int64),
 		languages: make(map[string]*models.Language),
 		interests: make(map[int]*models.Interest),
+		periods:   make(map[int][]models.UnavailabilityPeriod),
+		proposals: make(map[int]*models.InterestSuggestion),
+		relations: make(map[int]*models.InterestRelation),
+		groups:    make(map[int]*models.InterestCategoryItem),
+		announces: make(map[int]*models.Announcement),
+		delivers:  make(map[int][]models.AnnouncementDelivery),
+		aliases:   make(map[int]string),
 	}
 
 	// Предзаполняем тестовыми языками
@@ -98,6 +126,8 @@ func (db *DatabaseMock) CreateUser(telegramID int64, username, firstName, langua
 		State:                  "new",
 		ProfileCompletionLevel: 0,
 		Status:                 "new",
+		Role:                   models.RoleUser,
+		IsActive:               true,
 	}
 
 	db.users[telegramID] = user
@@ -116,6 +146,7 @@ func (db *DatabaseMock) FindOrCreateUser(telegramID int64, username, firstName s
 		// Обновляем информацию если она изменилась
 		user.Username = username
 		user.FirstName = firstName
+		user.IsActive = true // Пользователь снова пишет боту
 		user.UpdatedAt = time.Now()
 
 		return user, nil
@@ -125,6 +156,41 @@ func (db *DatabaseMock) FindOrCreateUser(telegramID int64, username, firstName s
 	return db.CreateUser(telegramID, username, firstName, "en")
 }
 
+// FindOrCreatePlatformUser находит или создает пользователя другой платформы. Как и в базе,
+// telegram_id у такого пользователя нет (0); в моке он хранится под отрицательным ключом.
+func (db *DatabaseMock) FindOrCreatePlatformUser(platform, externalID, username, firstName string) (*models.User, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	qualifiedID := models.QualifiedUserID(platform, externalID)
+
+	key, exists := db.platforms[qualifiedID]
+	if !exists {
+		key = -int64(len(db.platforms) + 1)
+		db.platforms[qualifiedID] = key
+	}
+
+	user, err := db.FindOrCreateUser(key, username, firstName)
+	if err != nil {
+		return nil, err
+	}
+
+	user.TelegramID, user.Platform = 0, platform
+
+	return user, nil
+}
+
+// GetPlatformUser возвращает пользователя другой платформы по его ID на платформе.
+func (db *DatabaseMock) GetPlatformUser(platform, externalID string) (*models.User, error) {
+	key, exists := db.platforms[models.QualifiedUserID(platform, externalID)]
+	if !exists {
+		return nil, sql.ErrNoRows
+	}
+
+	return db.users[key], nil
+}
+
 // UpdateUser обновляет пользователя.
 func (db *DatabaseMock) UpdateUser(user *models.User) error {
 	if db.lastError != nil {
@@ -132,6 +198,15 @@ func (db *DatabaseMock) UpdateUser(user *models.User) error {
 	}
 
 	user.UpdatedAt = time.Now()
+
+	for key, stored := range db.users {
+		if stored.ID == user.ID {
+			db.users[key] = user
+
+			return nil
+		}
+	}
+
 	db.users[user.TelegramID] = user
 
 	return nil
@@ -293,13 +368,13 @@ func (db *DatabaseMock) UpdateUserStatus(userID int, status string) error {
 }
 
 // SaveUserFeedback сохраняет отзыв пользователя (заглушка).
-func (db *DatabaseMock) SaveUserFeedback(_ int, _ string, _ *string) error {
+func (db *DatabaseMock) SaveUserFeedback(_ int, _ string, _ *string) (int, error) {
 	if db.lastError != nil {
-		return db.lastError
+		return 0, db.lastError
 	}
 
 	// Для тестов просто возвращаем успех
-	return nil
+	return 1, nil
 }
 
 // GetUnprocessedFeedback возвращает необработанные отзывы (заглушка).
@@ -606,9 +681,1445 @@ func (db *DatabaseMock) GetFriendshipPreferences(userID int) (*models.Friendship
 	return nil, errors.New("user not found")
 }
 
+// SaveUnavailabilityPeriod сохраняет период недоступности пользователя.
+func (db *DatabaseMock) SaveUnavailabilityPeriod(userID int, period *models.UnavailabilityPeriod) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	db.nextID++
+	period.ID = db.nextID
+	period.UserID = userID
+	period.CreatedAt = time.Now()
+	db.periods[userID] = append(db.periods[userID], *period)
+
+	return nil
+}
+
+// GetUnavailabilityPeriods получает актуальные периоды недоступности пользователя.
+func (db *DatabaseMock) GetUnavailabilityPeriods(userID int) ([]models.UnavailabilityPeriod, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	var result []models.UnavailabilityPeriod
+
+	for _, period := range db.periods[userID] {
+		if !period.IsExpired(time.Now()) {
+			result = append(result, period)
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteUnavailabilityPeriod удаляет период недоступности пользователя.
+func (db *DatabaseMock) DeleteUnavailabilityPeriod(userID, periodID int) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	periods := db.periods[userID]
+	for i, period := range periods {
+		if period.ID == periodID {
+			db.periods[userID] = append(periods[:i], periods[i+1:]...)
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// DeleteExpiredUnavailabilityPeriods удаляет завершившиеся периоды недоступности.
+func (db *DatabaseMock) DeleteExpiredUnavailabilityPeriods() (int64, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	var deleted int64
+
+	for userID, periods := range db.periods {
+		kept := periods[:0]
+
+		for _, period := range periods {
+			if period.IsExpired(time.Now()) {
+				deleted++
+
+				continue
+			}
+
+			kept = append(kept, period)
+		}
+
+		db.periods[userID] = kept
+	}
+
+	return deleted, nil
+}
+
+// CancelPendingMatches отменяет еще не отправленные совпадения пользователя (заглушка).
+func (db *DatabaseMock) CancelPendingMatches(_ int) (int64, error) {
+	return 0, db.lastError
+}
+
+// CancelPendingMatchesOfUnavailableUsers отменяет совпадения собеседников в отпуске (заглушка).
+func (db *DatabaseMock) CancelPendingMatchesOfUnavailableUsers() (int64, error) {
+	return 0, db.lastError
+}
+
+// GetUserLearningGoals получает цели изучения языка пользователя.
+func (db *DatabaseMock) GetUserLearningGoals(userID int) ([]string, error) {
+	for _, user := range db.users {
+		if user.ID == userID {
+			return user.LearningGoals, nil
+		}
+	}
+
+	return nil, errors.New("user not found")
+}
+
+// SaveUserLearningGoals сохраняет цели изучения языка пользователя.
+func (db *DatabaseMock) SaveUserLearningGoals(userID int, goals []string) error {
+	for _, user := range db.users {
+		if user.ID == userID {
+			user.LearningGoals = append([]string(nil), goals...)
+			user.UpdatedAt = time.Now()
+
+			return nil
+		}
+	}
+
+	return errors.New("user not found")
+}
+
+// GetLearningGoalStats возвращает распределение целей по изучаемым языкам.
+func (db *DatabaseMock) GetLearningGoalStats() ([]models.LearningGoalStat, error) {
+	counts := make(map[[2]string]int)
+
+	for _, user := range db.users {
+		for _, goal := range user.LearningGoals {
+			counts[[2]string{user.TargetLanguageCode, goal}]++
+		}
+	}
+
+	stats := make([]models.LearningGoalStat, 0, len(counts))
+	for key, count := range counts {
+		stats = append(stats, models.LearningGoalStat{TargetLanguageCode: key[0], Goal: key[1], UsersCount: count})
+	}
+
+	return stats, nil
+}
+
+// CreateInterestSuggestion добавляет предложение интереса в очередь модерации.
+func (db *DatabaseMock) CreateInterestSuggestion(suggestion *models.InterestSuggestion) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	db.nextID++
+	suggestion.ID = db.nextID
+	suggestion.Status = models.InterestSuggestionPending
+	suggestion.CreatedAt = time.Now()
+
+	stored := *suggestion
+	db.proposals[suggestion.ID] = &stored
+
+	return nil
+}
+
+// CountPendingInterestSuggestions возвращает количество предложений пользователя на модерации.
+func (db *DatabaseMock) CountPendingInterestSuggestions(userID int) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	count := 0
+
+	for _, suggestion := range db.proposals {
+		if suggestion.UserID == userID && suggestion.Status == models.InterestSuggestionPending {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// GetInterestSuggestions возвращает предложения интересов с указанным статусом.
+func (db *DatabaseMock) GetInterestSuggestions(status string, limit int) ([]models.InterestSuggestion, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	var result []models.InterestSuggestion
+
+	for id := 1; id <= db.nextID && len(result) < limit; id++ {
+		suggestion, ok := db.proposals[id]
+		if ok && (status == "" || suggestion.Status == status) {
+			result = append(result, *suggestion)
+		}
+	}
+
+	return result, nil
+}
+
+// ApproveInterestSuggestion создает интерес из предложения и выбирает его для автора.
+func (db *DatabaseMock) ApproveInterestSuggestion(suggestionID int, approval models.InterestSuggestionApproval) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	suggestion, ok := db.proposals[suggestionID]
+	if !ok {
+		return 0, errors.New("interest suggestion not found")
+	}
+
+	if suggestion.Status != models.InterestSuggestionPending {
+		return 0, errors.New("interest suggestion already reviewed")
+	}
+
+	interestID := len(db.interests) + 1
+	db.interests[interestID] = &models.Interest{ID: interestID, KeyName: approval.KeyName, Type: approval.CategoryKey, CategoryKey: approval.CategoryKey}
+
+	for _, user := range db.users {
+		if user.ID == suggestion.UserID {
+			user.Interests = append(user.Interests, interestID)
+		}
+	}
+
+	now := time.Now()
+	suggestion.Status = models.InterestSuggestionApproved
+	suggestion.InterestID = &interestID
+	suggestion.ReviewedAt = &now
+
+	return interestID, nil
+}
+
+// RejectInterestSuggestion отклоняет предложение интереса.
+func (db *DatabaseMock) RejectInterestSuggestion(suggestionID int, note string) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	suggestion, ok := db.proposals[suggestionID]
+	if !ok {
+		return errors.New("interest suggestion not found")
+	}
+
+	if suggestion.Status != models.InterestSuggestionPending {
+		return errors.New("interest suggestion already reviewed")
+	}
+
+	now := time.Now()
+	suggestion.Status = models.InterestSuggestionRejected
+	suggestion.ModeratorNote = note
+	suggestion.ReviewedAt = &now
+
+	return nil
+}
+
+// GetInterestRelations возвращает граф связей между интересами.
+func (db *DatabaseMock) GetInterestRelations() ([]models.InterestRelation, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	result := make([]models.InterestRelation, 0, len(db.relations))
+
+	for id := 1; id <= db.nextID; id++ {
+		if relation, ok := db.relations[id]; ok {
+			result = append(result, *relation)
+		}
+	}
+
+	return result, nil
+}
+
+// SaveInterestRelation сохраняет связь между интересами, заданными ключами.
+func (db *DatabaseMock) SaveInterestRelation(relation *models.InterestRelation) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	relation.InterestID, relation.RelatedInterestID = 0, 0
+
+	for _, interest := range db.interests {
+		switch interest.KeyName {
+		case relation.InterestKey:
+			relation.InterestID = interest.ID
+		case relation.RelatedInterestKey:
+			relation.RelatedInterestID = interest.ID
+		}
+	}
+
+	if relation.InterestID == 0 || relation.RelatedInterestID == 0 {
+		return errors.New("interest not found")
+	}
+
+	for _, existing := range db.relations {
+		if existing.InterestID == relation.InterestID && existing.RelatedInterestID == relation.RelatedInterestID {
+			existing.RelationType = relation.RelationType
+			*relation = *existing
+
+			return nil
+		}
+	}
+
+	db.nextID++
+	relation.ID = db.nextID
+	relation.CreatedAt = time.Now()
+
+	stored := *relation
+	db.relations[relation.ID] = &stored
+
+	return nil
+}
+
+// DeleteInterestRelation удаляет связь между интересами.
+func (db *DatabaseMock) DeleteInterestRelation(relationID int) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	if _, ok := db.relations[relationID]; !ok {
+		return errors.New("interest relation not found")
+	}
+
+	delete(db.relations, relationID)
+
+	return nil
+}
+
+// RefreshInterestCooccurrences в моке не пересчитывает пары: выборы интересов не хранятся.
+func (db *DatabaseMock) RefreshInterestCooccurrences(minUsers int) (int64, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	return int64(len(db.pairs)), nil
+}
+
+// GetTopInterestCooccurrences возвращает пары интересов, заданные через SetInterestCooccurrences.
+func (db *DatabaseMock) GetTopInterestCooccurrences(limit int) ([]models.InterestCooccurrence, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	if limit < len(db.pairs) {
+		return append([]models.InterestCooccurrence(nil), db.pairs[:limit]...), nil
+	}
+
+	return append([]models.InterestCooccurrence(nil), db.pairs...), nil
+}
+
+// GetInterestRecommendations суммирует confidence пар, начинающихся с выбранных интересов.
+func (db *DatabaseMock) GetInterestRecommendations(interestIDs []int, limit int) ([]models.InterestRecommendation, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	selected := make(map[int]bool, len(interestIDs))
+	for _, id := range interestIDs {
+		selected[id] = true
+	}
+
+	scores := make(map[int]float64)
+
+	for _, pair := range db.pairs {
+		if selected[pair.InterestID] && !selected[pair.RelatedInterestID] {
+			scores[pair.RelatedInterestID] += pair.Confidence
+		}
+	}
+
+	result := make([]models.InterestRecommendation, 0, len(scores))
+
+	for id, score := range scores {
+		recommendation := models.InterestRecommendation{InterestID: id, Score: score}
+		if interest, ok := db.interests[id]; ok {
+			recommendation.KeyName = interest.KeyName
+			recommendation.CategoryKey = interest.CategoryKey
+		}
+
+		result = append(result, recommendation)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+
+		return result[i].InterestID < result[j].InterestID
+	})
+
+	if limit < len(result) {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
+// GetInterestCatalog возвращает интересы мока без переводов.
+func (db *DatabaseMock) GetInterestCatalog() (*models.InterestCatalog, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	catalog := &models.InterestCatalog{}
+
+	for _, interest := range db.interests {
+		catalog.Interests = append(catalog.Interests, models.InterestCatalogItem{
+			ID:           interest.ID,
+			KeyName:      interest.KeyName,
+			CategoryKey:  interest.CategoryKey,
+			DisplayOrder: interest.DisplayOrder,
+			Translations: map[string]string{},
+		})
+	}
+
+	sort.Slice(catalog.Interests, func(i, j int) bool { return catalog.Interests[i].KeyName < catalog.Interests[j].KeyName })
+
+	return catalog, nil
+}
+
+// GetInterestCategoryItems возвращает категории, созданные через CreateInterestCategory.
+func (db *DatabaseMock) GetInterestCategoryItems() ([]models.InterestCategoryItem, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	categories := make([]models.InterestCategoryItem, 0, len(db.groups))
+	for _, category := range db.groups {
+		categories = append(categories, *category)
+	}
+
+	sort.Slice(categories, func(i, j int) bool { return categories[i].DisplayOrder < categories[j].DisplayOrder })
+
+	return categories, nil
+}
+
+// CreateInterestCategory создает категорию интересов в памяти.
+func (db *DatabaseMock) CreateInterestCategory(input models.InterestCategoryInput) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	for _, category := range db.groups {
+		if category.KeyName == *input.KeyName {
+			return 0, errors.New("interest category already exists")
+		}
+	}
+
+	categoryID := len(db.groups) + 1
+	category := &models.InterestCategoryItem{ID: categoryID, KeyName: *input.KeyName, DisplayOrder: categoryID, Translations: input.Translations}
+
+	if input.DisplayOrder != nil {
+		category.DisplayOrder = *input.DisplayOrder
+	}
+
+	db.groups[categoryID] = category
+
+	return categoryID, nil
+}
+
+// UpdateInterestCategory изменяет категорию интересов в памяти.
+func (db *DatabaseMock) UpdateInterestCategory(categoryID int, input models.InterestCategoryInput) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	category, ok := db.groups[categoryID]
+	if !ok {
+		return errors.New("interest category not found")
+	}
+
+	if input.KeyName != nil {
+		category.KeyName = *input.KeyName
+	}
+
+	if input.DisplayOrder != nil {
+		category.DisplayOrder = *input.DisplayOrder
+	}
+
+	return nil
+}
+
+// CreateInterest создает интерес в памяти.
+func (db *DatabaseMock) CreateInterest(input models.InterestInput) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	for _, interest := range db.interests {
+		if interest.KeyName == *input.KeyName {
+			return 0, errors.New("interest already exists")
+		}
+	}
+
+	interestID := len(db.interests) + 1
+	interest := &models.Interest{ID: interestID, KeyName: *input.KeyName, Type: *input.CategoryKey, CategoryKey: *input.CategoryKey}
+
+	if input.DisplayOrder != nil {
+		interest.DisplayOrder = *input.DisplayOrder
+	}
+
+	db.interests[interestID] = interest
+
+	return interestID, nil
+}
+
+// UpdateInterest изменяет интерес в памяти.
+func (db *DatabaseMock) UpdateInterest(interestID int, input models.InterestInput) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	interest, ok := db.interests[interestID]
+	if !ok {
+		return errors.New("interest not found")
+	}
+
+	if input.KeyName != nil {
+		interest.KeyName = *input.KeyName
+	}
+
+	if input.CategoryKey != nil {
+		interest.CategoryKey = *input.CategoryKey
+		interest.Type = *input.CategoryKey
+	}
+
+	if input.DisplayOrder != nil {
+		interest.DisplayOrder = *input.DisplayOrder
+	}
+
+	return nil
+}
+
+// UpdateUserRole меняет роль пользователя.
+func (db *DatabaseMock) UpdateUserRole(userID int, role string) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	for _, user := range db.users {
+		if user.ID == userID {
+			user.Role = role
+			user.UpdatedAt = time.Now()
+
+			return nil
+		}
+	}
+
+	return errors.New("user not found")
+}
+
+// AssignAdminRoles назначает роль admin пользователям из списков конфигурации.
+func (db *DatabaseMock) AssignAdminRoles(telegramIDs []int64, usernames []string) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	assigned := 0
+
+	for telegramID, user := range db.users {
+		configured := slices.Contains(telegramIDs, telegramID) ||
+			user.Username != "" && slices.Contains(usernames, user.Username)
+		if !configured || user.Role == models.RoleAdmin {
+			continue
+		}
+
+		user.Role = models.RoleAdmin
+		assigned++
+	}
+
+	return assigned, nil
+}
+
+// GetUserByID возвращает пользователя по внутреннему ID.
+func (db *DatabaseMock) GetUserByID(userID int) (*models.User, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	for _, user := range db.users {
+		if user.ID == userID {
+			return user, nil
+		}
+	}
+
+	return nil, sql.ErrNoRows
+}
+
+// SearchUsers ищет пользователей по ID, Telegram ID или началу username.
+func (db *DatabaseMock) SearchUsers(query string, limit int) ([]*models.User, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	number, numErr := strconv.ParseInt(query, 10, 64)
+	prefix := strings.ToLower(query)
+
+	var users []*models.User
+
+	for _, user := range db.users {
+		matched := numErr == nil && (user.TelegramID == number || int64(user.ID) == number) ||
+			numErr != nil && strings.HasPrefix(strings.ToLower(user.Username), prefix)
+		if matched {
+			users = append(users, user)
+		}
+	}
+
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+
+	if len(users) > limit {
+		users = users[:limit]
+	}
+
+	return users, nil
+}
+
+// GetUserFeedbackByUserID возвращает отзывы пользователя (заглушка).
+func (db *DatabaseMock) GetUserFeedbackByUserID(_ int) ([]map[string]interface{}, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return []map[string]interface{}{}, nil
+}
+
+// CreateAdminActionLog записывает действие администратора в журнал.
+func (db *DatabaseMock) CreateAdminActionLog(entry *models.AdminActionLog) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	entry.ID = len(db.adminLogs) + 1
+	entry.CreatedAt = time.Now()
+	db.adminLogs = append(db.adminLogs, *entry)
+
+	return nil
+}
+
+// GetAdminActionLogs возвращает последние действия над объектом, новые первыми.
+func (db *DatabaseMock) GetAdminActionLogs(targetType string, targetID int, limit int) ([]models.AdminActionLog, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	var entries []models.AdminActionLog
+
+	for i := len(db.adminLogs) - 1; i >= 0 && len(entries) < limit; i-- {
+		entry := db.adminLogs[i]
+		if entry.TargetType == targetType && entry.TargetID == targetID {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// CreateAnnouncement сохраняет черновик анонса.
+func (db *DatabaseMock) CreateAnnouncement(announcement *models.Announcement) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	announcement.ID = len(db.announces) + 1
+	announcement.Status = models.AnnouncementStatusDraft
+	announcement.CreatedAt = time.Now()
+	stored := *announcement
+	db.announces[announcement.ID] = &stored
+
+	return nil
+}
+
+// GetAnnouncement возвращает анонс со счетчиками доставки.
+func (db *DatabaseMock) GetAnnouncement(announcementID int) (*models.Announcement, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	announcement, exists := db.announces[announcementID]
+	if !exists {
+		return nil, errorsPkg.ErrAnnouncementNotFound
+	}
+
+	result := *announcement
+	result.SentCount, result.FailedCount, result.BlockedCount = 0, 0, 0
+
+	for _, delivery := range db.delivers[announcementID] {
+		switch delivery.Status {
+		case models.DeliveryStatusSent:
+			result.SentCount++
+		case models.DeliveryStatusFailed:
+			result.FailedCount++
+		case models.DeliveryStatusBlocked:
+			result.BlockedCount++
+		}
+	}
+
+	return &result, nil
+}
+
+// GetAnnouncements возвращает последние анонсы, новые первыми.
+func (db *DatabaseMock) GetAnnouncements(limit int) ([]models.Announcement, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	var announcements []models.Announcement
+
+	for id := len(db.announces); id > 0 && len(announcements) < limit; id-- {
+		announcement, err := db.GetAnnouncement(id)
+		if err != nil {
+			return nil, err
+		}
+
+		announcements = append(announcements, *announcement)
+	}
+
+	return announcements, nil
+}
+
+// CountAnnouncementRecipients считает активных пользователей, подходящих под сегмент.
+func (db *DatabaseMock) CountAnnouncementRecipients(segment models.AnnouncementSegment) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	return len(db.segmentUsers(segment)), nil
+}
+
+// QueueAnnouncementDeliveries ставит в очередь получателей анонса и переводит его в рассылку.
+func (db *DatabaseMock) QueueAnnouncementDeliveries(announcementID int) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	announcement, exists := db.announces[announcementID]
+	if !exists {
+		return 0, errorsPkg.ErrAnnouncementNotFound
+	}
+
+	switch announcement.Status {
+	case models.AnnouncementStatusDraft:
+		for _, user := range db.segmentUsers(announcement.Segment) {
+			db.delivers[announcementID] = append(db.delivers[announcementID], models.AnnouncementDelivery{
+				AnnouncementID: announcementID,
+				UserID:         user.ID,
+				TelegramID:     user.TelegramID,
+				Status:         models.DeliveryStatusPending,
+			})
+		}
+	case models.AnnouncementStatusSending:
+		// Продолжение прерванной рассылки
+	default:
+		return 0, errorsPkg.ErrAnnouncementNotSendable
+	}
+
+	announcement.Status = models.AnnouncementStatusSending
+	announcement.RecipientsCount = len(db.delivers[announcementID])
+
+	return announcement.RecipientsCount, nil
+}
+
+// GetPendingAnnouncementDeliveries возвращает получателей, которым анонс еще не отправлялся.
+func (db *DatabaseMock) GetPendingAnnouncementDeliveries(announcementID int, limit int) ([]models.AnnouncementRecipient, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	var recipients []models.AnnouncementRecipient
+
+	for _, delivery := range db.delivers[announcementID] {
+		if len(recipients) >= limit {
+			break
+		}
+
+		if delivery.Status == models.DeliveryStatusPending {
+			recipients = append(recipients, models.AnnouncementRecipient{UserID: delivery.UserID, TelegramID: delivery.TelegramID})
+		}
+	}
+
+	return recipients, nil
+}
+
+// RecordAnnouncementDelivery сохраняет результат доставки анонса получателю.
+func (db *DatabaseMock) RecordAnnouncementDelivery(announcementID, userID int, status, deliveryError string) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	deliveries := db.delivers[announcementID]
+	for i := range deliveries {
+		if deliveries[i].UserID == userID {
+			now := time.Now()
+			deliveries[i].Status = status
+			deliveries[i].Error = deliveryError
+			deliveries[i].AttemptedAt = &now
+
+			return nil
+		}
+	}
+
+	return errors.New("announcement delivery not found")
+}
+
+// GetAnnouncementDeliveries возвращает доставки анонса; пустой статус - все доставки.
+func (db *DatabaseMock) GetAnnouncementDeliveries(announcementID int, status string, limit int) ([]models.AnnouncementDelivery, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	var deliveries []models.AnnouncementDelivery
+
+	for _, delivery := range db.delivers[announcementID] {
+		if len(deliveries) >= limit {
+			break
+		}
+
+		if status == "" || delivery.Status == status {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries, nil
+}
+
+// FinishAnnouncement отмечает рассылку анонса завершенной.
+func (db *DatabaseMock) FinishAnnouncement(announcementID int) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	if announcement, exists := db.announces[announcementID]; exists && announcement.Status == models.AnnouncementStatusSending {
+		now := time.Now()
+		announcement.Status = models.AnnouncementStatusSent
+		announcement.SentAt = &now
+	}
+
+	return nil
+}
+
+// CancelAnnouncement отменяет черновик или идущую рассылку.
+func (db *DatabaseMock) CancelAnnouncement(announcementID int) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	announcement, exists := db.announces[announcementID]
+	if !exists || (announcement.Status != models.AnnouncementStatusDraft && announcement.Status != models.AnnouncementStatusSending) {
+		return errorsPkg.ErrAnnouncementNotSendable
+	}
+
+	announcement.Status = models.AnnouncementStatusCancelled
+
+	return nil
+}
+
+// SetUserActive отмечает, может ли бот писать пользователю.
+func (db *DatabaseMock) SetUserActive(userID int, active bool) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	for _, user := range db.users {
+		if user.ID == userID {
+			user.IsActive = active
+
+			return nil
+		}
+	}
+
+	return errors.New("user not found")
+}
+
+// CreateAPIKey сохраняет новый API-ключ.
+func (db *DatabaseMock) CreateAPIKey(key *models.APIKey) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	key.ID = len(db.apiKeys) + 1
+	key.CreatedAt = time.Now()
+	stored := *key
+	db.apiKeys = append(db.apiKeys, &stored)
+
+	return nil
+}
+
+// GetAPIKey возвращает API-ключ по ID.
+func (db *DatabaseMock) GetAPIKey(keyID int) (*models.APIKey, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	for _, key := range db.apiKeys {
+		if key.ID == keyID {
+			result := *key
+
+			return &result, nil
+		}
+	}
+
+	return nil, errorsPkg.ErrAPIKeyNotFound
+}
+
+// GetAPIKeyByHash возвращает API-ключ по хешу.
+func (db *DatabaseMock) GetAPIKeyByHash(keyHash string) (*models.APIKey, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	for _, key := range db.apiKeys {
+		if key.KeyHash == keyHash {
+			result := *key
+
+			return &result, nil
+		}
+	}
+
+	return nil, errorsPkg.ErrAPIKeyNotFound
+}
+
+// GetAPIKeys возвращает все API-ключи, новые первыми.
+func (db *DatabaseMock) GetAPIKeys() ([]models.APIKey, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	keys := make([]models.APIKey, 0, len(db.apiKeys))
+	for i := len(db.apiKeys) - 1; i >= 0; i-- {
+		keys = append(keys, *db.apiKeys[i])
+	}
+
+	return keys, nil
+}
+
+// RotateAPIKey сохраняет ключ на смену старого и сокращает срок старого ключа.
+func (db *DatabaseMock) RotateAPIKey(oldKeyID int, newKey *models.APIKey, oldExpiresAt time.Time) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	for _, key := range db.apiKeys {
+		if key.ID != oldKeyID || key.RevokedAt != nil {
+			continue
+		}
+
+		if key.ExpiresAt == nil || oldExpiresAt.Before(*key.ExpiresAt) {
+			key.ExpiresAt = &oldExpiresAt
+		}
+
+		newKey.RotatedFrom = oldKeyID
+
+		return db.CreateAPIKey(newKey)
+	}
+
+	return errorsPkg.ErrAPIKeyNotFound
+}
+
+// RevokeAPIKey отзывает API-ключ.
+func (db *DatabaseMock) RevokeAPIKey(keyID int) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	for _, key := range db.apiKeys {
+		if key.ID == keyID {
+			if key.RevokedAt == nil {
+				now := time.Now()
+				key.RevokedAt = &now
+			}
+
+			return nil
+		}
+	}
+
+	return errorsPkg.ErrAPIKeyNotFound
+}
+
+// TouchAPIKey обновляет время последнего использования ключа.
+func (db *DatabaseMock) TouchAPIKey(keyID int, _ time.Duration) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	for _, key := range db.apiKeys {
+		if key.ID == keyID {
+			now := time.Now()
+			key.LastUsedAt = &now
+		}
+	}
+
+	return nil
+}
+
+// CreateAdminSession сохраняет новую сессию admin API.
+func (db *DatabaseMock) CreateAdminSession(session *models.AdminSession) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	session.ID = len(db.sessions) + 1
+	session.CreatedAt = time.Now()
+	stored := *session
+	db.sessions = append(db.sessions, &stored)
+
+	return nil
+}
+
+// GetAdminSessionByHash возвращает сессию admin API по хешу refresh-токена.
+func (db *DatabaseMock) GetAdminSessionByHash(tokenHash string) (*models.AdminSession, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	for _, session := range db.sessions {
+		if session.TokenHash == tokenHash {
+			result := *session
+
+			return &result, nil
+		}
+	}
+
+	return nil, errorsPkg.ErrAdminSessionNotFound
+}
+
+// RotateAdminSession отзывает сессию и сохраняет новую на ее смену.
+func (db *DatabaseMock) RotateAdminSession(oldSessionID int, newSession *models.AdminSession) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	for _, session := range db.sessions {
+		if session.ID != oldSessionID || session.RevokedAt != nil {
+			continue
+		}
+
+		now := time.Now()
+		session.RevokedAt = &now
+		newSession.RotatedFrom = oldSessionID
+
+		return db.CreateAdminSession(newSession)
+	}
+
+	return errorsPkg.ErrAdminSessionNotFound
+}
+
+// RevokeAdminSession отзывает сессию admin API.
+func (db *DatabaseMock) RevokeAdminSession(sessionID int) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	for _, session := range db.sessions {
+		if session.ID == sessionID {
+			if session.RevokedAt == nil {
+				now := time.Now()
+				session.RevokedAt = &now
+			}
+
+			return nil
+		}
+	}
+
+	return errorsPkg.ErrAdminSessionNotFound
+}
+
+// AppendAuditEvent добавляет запись в конец журнала аудита, продолжая цепочку хешей.
+func (db *DatabaseMock) AppendAuditEvent(event *models.AuditEvent) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	prevHash := audit.GenesisHash
+	if len(db.audit) > 0 {
+		prevHash = db.audit[len(db.audit)-1].Hash
+	}
+
+	event.ID = int64(len(db.audit) + 1)
+	event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+	event.PrevHash = prevHash
+
+	hash, err := audit.ChainHash(prevHash, event)
+	if err != nil {
+		return err
+	}
+
+	event.Hash = hash
+	db.audit = append(db.audit, *event)
+
+	return nil
+}
+
+// GetAuditEvents возвращает записи аудита по фильтру, новые первыми.
+func (db *DatabaseMock) GetAuditEvents(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	events := []models.AuditEvent{}
+
+	for i := len(db.audit) - 1; i >= 0; i-- {
+		event := db.audit[i]
+		actionPrefix, isPrefix := strings.CutSuffix(filter.Action, "*")
+
+		if (filter.ActorType != "" && event.ActorType != filter.ActorType) ||
+			(filter.ActorID != "" && event.ActorID != filter.ActorID) ||
+			(isPrefix && !strings.HasPrefix(event.Action, actionPrefix)) ||
+			(!isPrefix && filter.Action != "" && event.Action != filter.Action) ||
+			(filter.TargetType != "" && event.TargetType != filter.TargetType) ||
+			(filter.TargetID != "" && event.TargetID != filter.TargetID) ||
+			(filter.Result != "" && event.Result != filter.Result) ||
+			(filter.From != nil && event.CreatedAt.Before(*filter.From)) ||
+			(filter.To != nil && !event.CreatedAt.Before(*filter.To)) {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	if filter.Offset >= len(events) {
+		return []models.AuditEvent{}, nil
+	}
+
+	events = events[filter.Offset:]
+	if filter.Limit > 0 && filter.Limit < len(events) {
+		events = events[:filter.Limit]
+	}
+
+	return events, nil
+}
+
+// GetAuditChain возвращает до limit записей аудита с ID больше afterID по возрастанию ID.
+func (db *DatabaseMock) GetAuditChain(afterID int64, limit int) ([]models.AuditEvent, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	events := []models.AuditEvent{}
+
+	for _, event := range db.audit {
+		if event.ID > afterID && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+// EnsureAuditPseudonym возвращает псевдоним пользователя, сохраняя candidate при первом вызове.
+func (db *DatabaseMock) EnsureAuditPseudonym(userID int, candidate string) (string, error) {
+	if db.lastError != nil {
+		return "", db.lastError
+	}
+
+	if pseudonym, ok := db.aliases[userID]; ok {
+		return pseudonym, nil
+	}
+
+	db.aliases[userID] = candidate
+
+	return candidate, nil
+}
+
+// TamperAuditEvent изменяет сохраненную запись аудита в обход цепочки - для тестов проверки.
+func (db *DatabaseMock) TamperAuditEvent(eventID int64, modify func(event *models.AuditEvent)) {
+	for i := range db.audit {
+		if db.audit[i].ID == eventID {
+			modify(&db.audit[i])
+		}
+	}
+}
+
+// AddFeedbackMessage добавляет сообщение в переписку по отзыву (заглушка: отзывы в моке не хранятся).
+func (db *DatabaseMock) AddFeedbackMessage(_ *models.FeedbackMessage) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	return errorsPkg.ErrFeedbackNotFound
+}
+
+// GetFeedbackThread возвращает переписку по отзыву (заглушка: отзывы в моке не хранятся).
+func (db *DatabaseMock) GetFeedbackThread(_ int) (*models.FeedbackThread, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return nil, errorsPkg.ErrFeedbackNotFound
+}
+
+// GetFeedbackTriage возвращает разметку отзыва (заглушка: отзывы в моке не хранятся).
+func (db *DatabaseMock) GetFeedbackTriage(_ int) (*models.FeedbackTriage, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return nil, errorsPkg.ErrFeedbackNotFound
+}
+
+// UpdateFeedbackTriage сохраняет разметку отзыва (заглушка: отзывы в моке не хранятся).
+func (db *DatabaseMock) UpdateFeedbackTriage(_ *models.FeedbackTriage, _ time.Duration) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	return errorsPkg.ErrFeedbackNotFound
+}
+
+// GetFeedbackSLABreaches возвращает отзывы с истекшим сроком (заглушка: отзывы в моке не хранятся).
+func (db *DatabaseMock) GetFeedbackSLABreaches(_ int) ([]models.FeedbackSLABreach, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return nil, nil
+}
+
+// MarkFeedbackSLAAlerted отмечает уведомление о нарушении срока (заглушка).
+func (db *DatabaseMock) MarkFeedbackSLAAlerted(_ []int) error {
+	return db.lastError
+}
+
+// SearchFeedback ищет отзывы (заглушка: отзывы в моке не хранятся).
+func (db *DatabaseMock) SearchFeedback(_ models.FeedbackQuery) ([]models.FeedbackRecord, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return []models.FeedbackRecord{}, nil
+}
+
+// AddFeedbackAttachments сохраняет вложения отзыва (заглушка).
+func (db *DatabaseMock) AddFeedbackAttachments(_ int, _ []models.FeedbackAttachment) error {
+	return db.lastError
+}
+
+// GetFeedbackAttachments возвращает вложения отзыва (заглушка: вложения в моке не хранятся).
+func (db *DatabaseMock) GetFeedbackAttachments(_ int) ([]models.FeedbackAttachment, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return []models.FeedbackAttachment{}, nil
+}
+
+// CreateSurvey сохраняет опрос (заглушка: опросы в моке не хранятся).
+func (db *DatabaseMock) CreateSurvey(_ *models.Survey) error {
+	return db.lastError
+}
+
+// GetSurvey возвращает опрос (заглушка: опрос всегда не найден).
+func (db *DatabaseMock) GetSurvey(_ int) (*models.Survey, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return nil, errorsPkg.ErrSurveyNotFound
+}
+
+// GetSurveys возвращает последние опросы (заглушка).
+func (db *DatabaseMock) GetSurveys(_ int) ([]models.Survey, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return []models.Survey{}, nil
+}
+
+// CountSurveyRecipients считает получателей опроса по сегменту без учета правил частоты (заглушка).
+func (db *DatabaseMock) CountSurveyRecipients(segment models.AnnouncementSegment, _ models.SurveyFrequency) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	return len(db.segmentUsers(segment)), nil
+}
+
+// QueueSurveyDeliveries ставит опрос в очередь рассылки (заглушка).
+func (db *DatabaseMock) QueueSurveyDeliveries(_ int, _ models.SurveyFrequency) (int, error) {
+	if db.lastError != nil {
+		return 0, db.lastError
+	}
+
+	return 0, errorsPkg.ErrSurveyNotFound
+}
+
+// GetPendingSurveyDeliveries возвращает ожидающих доставки получателей (заглушка).
+func (db *DatabaseMock) GetPendingSurveyDeliveries(_ int, _ int) ([]models.SurveyRecipient, error) {
+	return nil, db.lastError
+}
+
+// RecordSurveyDelivery сохраняет результат доставки опроса (заглушка).
+func (db *DatabaseMock) RecordSurveyDelivery(_, _ int, _, _ string) error {
+	return db.lastError
+}
+
+// FinishSurvey завершает рассылку опроса (заглушка).
+func (db *DatabaseMock) FinishSurvey(_ int) error {
+	return db.lastError
+}
+
+// CancelSurvey отменяет опрос (заглушка).
+func (db *DatabaseMock) CancelSurvey(_ int) error {
+	if db.lastError != nil {
+		return db.lastError
+	}
+
+	return errorsPkg.ErrSurveyNotSendable
+}
+
+// GetDueSurveyIDs возвращает опросы, которые пора запустить (заглушка).
+func (db *DatabaseMock) GetDueSurveyIDs(_ time.Time) ([]int, error) {
+	return nil, db.lastError
+}
+
+// IsSurveyRecipient сообщает, получил ли пользователь опрос (заглушка).
+func (db *DatabaseMock) IsSurveyRecipient(_, _ int) (bool, error) {
+	return false, db.lastError
+}
+
+// SaveSurveyAnswer сохраняет ответ на опрос (заглушка).
+func (db *DatabaseMock) SaveSurveyAnswer(_ *models.SurveyAnswer) error {
+	return db.lastError
+}
+
+// GetSurveyAnswerCounts возвращает количество ответов на опрос (заглушка).
+func (db *DatabaseMock) GetSurveyAnswerCounts(_ int) ([]models.SurveyAnswerCount, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return []models.SurveyAnswerCount{}, nil
+}
+
+// GetSurveyParticipation возвращает охват опроса (заглушка).
+func (db *DatabaseMock) GetSurveyParticipation(_, _ int) (models.SurveyParticipation, error) {
+	return models.SurveyParticipation{}, db.lastError
+}
+
+// GetUserLanguagePairs возвращает языковые пары пользователя (заглушка).
+func (db *DatabaseMock) GetUserLanguagePairs(_ int) ([]models.UserLanguagePair, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return []models.UserLanguagePair{}, nil
+}
+
+// GetUserMatches возвращает собеседников пользователя (заглушка).
+func (db *DatabaseMock) GetUserMatches(_ int) ([]models.UserMatch, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return []models.UserMatch{}, nil
+}
+
+// GetUserSurveyAnswers возвращает ответы пользователя на опросы (заглушка).
+func (db *DatabaseMock) GetUserSurveyAnswers(_ int) ([]models.SurveyAnswer, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	return []models.SurveyAnswer{}, nil
+}
+
+// DeleteUserAccount удаляет пользователя из мока и возвращает обезличенную запись.
+func (db *DatabaseMock) DeleteUserAccount(userID int) (*models.AccountDeletionResult, error) {
+	if db.lastError != nil {
+		return nil, db.lastError
+	}
+
+	for telegramID, user := range db.users {
+		if user.ID == userID {
+			delete(db.users, telegramID)
+			delete(db.periods, userID)
+			delete(db.aliases, userID)
+
+			return &models.AccountDeletionResult{
+				Deletion: models.AccountDeletion{DeletedAt: time.Now()},
+				Partners: []models.MatchPartner{},
+			}, nil
+		}
+	}
+
+	return nil, errorsPkg.ErrUserNotFound
+}
+
+// GetAccountDeletionStats возвращает статистику удалений аккаунтов (заглушка).
+func (db *DatabaseMock) GetAccountDeletionStats(_ time.Time) (models.AccountDeletionStats, error) {
+	return models.AccountDeletionStats{}, db.lastError
+}
+
+// CountRetentionCandidates возвращает число строк с истекшим сроком хранения (заглушка).
+func (db *DatabaseMock) CountRetentionCandidates(_ string, _ time.Time) (int64, error) {
+	return 0, db.lastError
+}
+
+// PurgeRetentionBatch удаляет строки с истекшим сроком хранения (заглушка).
+func (db *DatabaseMock) PurgeRetentionBatch(_ string, _ time.Time, _ int) (int64, error) {
+	return 0, db.lastError
+}
+
+// GetRetentionUsers возвращает неактивных пользователей для удаления (заглушка).
+func (db *DatabaseMock) GetRetentionUsers(_ time.Time, _ int) ([]*models.User, error) {
+	return []*models.User{}, db.lastError
+}
+
+// GetAllFeedback возвращает все отзывы для администратора (заглушка).
+func (db *DatabaseMock) GetAllFeedback() ([]map[string]interface{}, error) {
+	return []map[string]interface{}{}, db.lastError
+}
+
+// ReencryptPersonalData переводит персональные данные на активный ключ (заглушка).
+func (db *DatabaseMock) ReencryptPersonalData(_ int) (int, error) {
+	return 0, db.lastError
+}
+
+// segmentUsers возвращает активных пользователей, подходящих под сегмент, по возрастанию ID.
+func (db *DatabaseMock) segmentUsers(segment models.AnnouncementSegment) []*models.User {
+	var users []*models.User
+
+	for _, user := range db.users {
+		if !user.IsActive ||
+			(len(segment.InterfaceLanguages) > 0 && !slices.Contains(segment.InterfaceLanguages, user.InterfaceLanguageCode)) ||
+			(len(segment.TargetLanguages) > 0 && !slices.Contains(segment.TargetLanguages, user.TargetLanguageCode)) ||
+			(len(segment.Statuses) > 0 && !slices.Contains(segment.Statuses, user.Status)) ||
+			user.ProfileCompletionLevel < segment.MinProfileCompletion ||
+			(segment.MaxProfileCompletion != nil && user.ProfileCompletionLevel > *segment.MaxProfileCompletion) {
+			continue
+		}
+
+		users = append(users, user)
+	}
+
+	sort.Slice(users, func(i, j int) bool { return users[i].ID < users[j].ID })
+
+	return users
+}
+
+// SetInterestCooccurrences задает пары совместного выбора интересов для тестов.
+func (db *DatabaseMock) SetInterestCooccurrences(pairs []models.InterestCooccurrence) {
+	db.pairs = pairs
+}
+
 // Reset очищает все данные в моке.
 func (db *DatabaseMock) Reset() {
 	db.users = make(map[int64]*models.User)
+	db.periods = make(map[int][]models.UnavailabilityPeriod)
+	db.proposals = make(map[int]*models.InterestSuggestion)
+	db.relations = make(map[int]*models.InterestRelation)
+	db.pairs = nil
+	db.groups = make(map[int]*models.InterestCategoryItem)
+	db.adminLogs = nil
+	db.announces = make(map[int]*models.Announcement)
+	db.delivers = make(map[int][]models.AnnouncementDelivery)
+	db.apiKeys = nil
+	db.sessions = nil
+	db.audit = nil
+	db.nextID = 0
 	db.lastError = nil
 	db.seedLanguages()
 	db.seedInterests()
@@ -0,0 +1,30 @@
+-- Инициализация таблицы периодов недоступности пользователей (отпуск, командировка и т.п.)
+-- Создание таблицы: user_unavailability_periods
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ТАБЛИЦА ПЕРИОДОВ НЕДОСТУПНОСТИ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS user_unavailability_periods (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    reason TEXT DEFAULT 'vacation' CHECK (reason IN ('vacation', 'business_trip', 'exams', 'other')),
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    -- Период не может заканчиваться раньше, чем начинается
+    CONSTRAINT user_unavailability_periods_dates_check CHECK (end_date >= start_date)
+);
+
+-- Индексы для производительности
+CREATE INDEX IF NOT EXISTS idx_user_unavailability_periods_user_id ON user_unavailability_periods(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_unavailability_periods_dates ON user_unavailability_periods(start_date, end_date);
+CREATE INDEX IF NOT EXISTS idx_user_unavailability_periods_end_date ON user_unavailability_periods(end_date);
+
+-- Комментарии к полям
+COMMENT ON TABLE user_unavailability_periods IS 'Периоды, когда пользователь недоступен для языкового обмена (исключаются из подбора)';
+COMMENT ON COLUMN user_unavailability_periods.start_date IS 'Первый день недоступности (включительно)';
+COMMENT ON COLUMN user_unavailability_periods.end_date IS 'Последний день недоступности (включительно); прошедшие периоды удаляются автоматически';
+COMMENT ON COLUMN user_unavailability_periods.reason IS 'Причина: vacation, business_trip, exams, other';
@@ -0,0 +1,27 @@
+-- Инициализация таблицы целей изучения языка
+-- Создание таблицы: user_learning_goals
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ТАБЛИЦА ЦЕЛЕЙ ИЗУЧЕНИЯ ЯЗЫКА
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS user_learning_goals (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    goal TEXT NOT NULL CHECK (goal IN (
+        'travel', 'exam_ielts', 'exam_hsk', 'exam_dele', 'business', 'relocation', 'casual'
+    )),
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    -- Одна и та же цель не может быть выбрана дважды
+    CONSTRAINT unique_user_learning_goal UNIQUE (user_id, goal)
+);
+
+-- Индексы для производительности
+CREATE INDEX IF NOT EXISTS idx_user_learning_goals_user_id ON user_learning_goals(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_learning_goals_goal ON user_learning_goals(goal);
+
+-- Комментарии к полям
+COMMENT ON TABLE user_learning_goals IS 'Цели изучения языка пользователей (мультивыбор), используются в подборе как мягкий сигнал';
+COMMENT ON COLUMN user_learning_goals.goal IS 'Цель: travel, exam_ielts, exam_hsk, exam_dele, business, relocation, casual';
@@ -0,0 +1,30 @@
+-- Инициализация очереди предложенных пользователями интересов
+-- Создание таблицы: interest_suggestions
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ТАБЛИЦА ПРЕДЛОЖЕНИЙ ИНТЕРЕСОВ (ОЧЕРЕДЬ МОДЕРАЦИИ)
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS interest_suggestions (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    suggested_text TEXT NOT NULL,
+    language_code VARCHAR(10) NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    interest_id INT REFERENCES interests(id) ON DELETE SET NULL,
+    moderator_note TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    reviewed_at TIMESTAMP
+);
+
+-- Индексы для производительности
+CREATE INDEX IF NOT EXISTS idx_interest_suggestions_status ON interest_suggestions(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_interest_suggestions_user_id ON interest_suggestions(user_id);
+
+-- Комментарии к полям
+COMMENT ON TABLE interest_suggestions IS 'Интересы, предложенные пользователями; после одобрения становятся записями в interests';
+COMMENT ON COLUMN interest_suggestions.suggested_text IS 'Текст предложения в том виде, в котором его ввел пользователь';
+COMMENT ON COLUMN interest_suggestions.language_code IS 'Язык интерфейса пользователя на момент предложения';
+COMMENT ON COLUMN interest_suggestions.status IS 'Статус модерации: pending, approved, rejected';
+COMMENT ON COLUMN interest_suggestions.interest_id IS 'Созданный интерес (для одобренных предложений)';
@@ -0,0 +1,27 @@
+-- Инициализация графа связей между интересами
+-- Создание таблицы: interest_relations
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ТАБЛИЦА СВЯЗЕЙ МЕЖДУ ИНТЕРЕСАМИ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS interest_relations (
+    id SERIAL PRIMARY KEY,
+    interest_id INT NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    related_interest_id INT NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    relation_type TEXT NOT NULL CHECK (relation_type IN ('related', 'parent')),
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    -- Интерес не может быть связан сам с собой
+    CONSTRAINT check_interest_relation_distinct CHECK (interest_id <> related_interest_id),
+    CONSTRAINT unique_interest_relation UNIQUE (interest_id, related_interest_id)
+);
+
+-- Индексы для производительности (связи ищутся в обе стороны)
+CREATE INDEX IF NOT EXISTS idx_interest_relations_interest_id ON interest_relations(interest_id);
+CREATE INDEX IF NOT EXISTS idx_interest_relations_related_interest_id ON interest_relations(related_interest_id);
+
+-- Комментарии к полям
+COMMENT ON TABLE interest_relations IS 'Граф связей между интересами: частичные баллы совместимости за похожие интересы';
+COMMENT ON COLUMN interest_relations.relation_type IS 'related - похожие интересы; parent - interest_id является родителем related_interest_id';
@@ -0,0 +1,27 @@
+-- Инициализация статистики совместного выбора интересов
+-- Создание таблицы: interest_cooccurrences
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ТАБЛИЦА СОВМЕСТНОГО ВЫБОРА ИНТЕРЕСОВ
+-- =============================================================================
+
+-- Таблица полностью пересчитывается периодической задачей из user_interest_selections
+CREATE TABLE IF NOT EXISTS interest_cooccurrences (
+    interest_id INT NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    related_interest_id INT NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    users_count INT NOT NULL,
+    confidence DOUBLE PRECISION NOT NULL,
+    computed_at TIMESTAMP DEFAULT NOW(),
+
+    PRIMARY KEY (interest_id, related_interest_id),
+    CONSTRAINT check_interest_cooccurrence_distinct CHECK (interest_id <> related_interest_id)
+);
+
+-- Индекс для рейтинга пар в admin API
+CREATE INDEX IF NOT EXISTS idx_interest_cooccurrences_users_count ON interest_cooccurrences(users_count DESC);
+
+-- Комментарии к полям
+COMMENT ON TABLE interest_cooccurrences IS 'Кто выбрал interest_id, тот часто выбирал и related_interest_id: рекомендации интересов';
+COMMENT ON COLUMN interest_cooccurrences.users_count IS 'Сколько пользователей выбрали оба интереса';
+COMMENT ON COLUMN interest_cooccurrences.confidence IS 'Доля выбравших interest_id, которые выбрали и related_interest_id';
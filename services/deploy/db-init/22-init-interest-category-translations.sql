@@ -0,0 +1,20 @@
+-- Инициализация переводов названий категорий интересов
+-- Создание таблицы: interest_category_translations
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ТАБЛИЦА ПЕРЕВОДОВ КАТЕГОРИЙ ИНТЕРЕСОВ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS interest_category_translations (
+    id SERIAL PRIMARY KEY,
+    category_id INT NOT NULL REFERENCES interest_categories(id) ON DELETE CASCADE,
+    language_code VARCHAR(10) NOT NULL REFERENCES languages(code),
+    name TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    CONSTRAINT unique_interest_category_translation UNIQUE (category_id, language_code)
+);
+
+-- Комментарии к полям
+COMMENT ON TABLE interest_category_translations IS 'Названия категорий интересов из admin API; используются, если в locales/*.json нет ключа category_<key_name>';
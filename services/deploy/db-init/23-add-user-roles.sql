@@ -0,0 +1,16 @@
+-- Инициализация ролей пользователей
+-- Изменение таблицы: users (поле role)
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- РОЛИ ПОЛЬЗОВАТЕЛЕЙ
+-- =============================================================================
+
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'user'
+    CHECK (role IN ('user', 'moderator', 'admin'));
+
+CREATE INDEX IF NOT EXISTS idx_users_role ON users(role);
+
+-- Комментарии к полям
+COMMENT ON COLUMN users.role IS 'Роль пользователя: user, moderator, admin; администраторы из ADMIN_CHAT_IDS/ADMIN_USERNAMES назначаются при запуске бота';
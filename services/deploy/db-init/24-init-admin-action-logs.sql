@@ -0,0 +1,25 @@
+-- Инициализация журнала действий администраторов
+-- Создание таблицы: admin_action_logs
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ЖУРНАЛ ДЕЙСТВИЙ АДМИНИСТРАТОРОВ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS admin_action_logs (
+    id SERIAL PRIMARY KEY,
+    admin_id INT REFERENCES users(id) ON DELETE SET NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id INT,
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_action_logs_target ON admin_action_logs(target_type, target_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_admin_action_logs_admin ON admin_action_logs(admin_id, created_at DESC);
+
+-- Комментарии к полям
+COMMENT ON TABLE admin_action_logs IS 'Кэш последних действий администраторов для карточки пользователя в админ-панели; не журнал аудита: источник истины - audit_events';
+COMMENT ON COLUMN admin_action_logs.action IS 'reset_profile, change_status, force_state, send_message';
+COMMENT ON COLUMN admin_action_logs.details IS 'Параметры действия, например {"from": "active", "to": "paused"}';
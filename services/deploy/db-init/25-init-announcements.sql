@@ -0,0 +1,54 @@
+-- Инициализация анонсов
+-- Создание таблиц: announcements, announcement_deliveries; изменение таблицы: users (поле is_active)
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- АКТИВНОСТЬ ПОЛЬЗОВАТЕЛЕЙ
+-- =============================================================================
+
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS is_active BOOLEAN NOT NULL DEFAULT TRUE;
+
+COMMENT ON COLUMN users.is_active IS 'FALSE, если пользователь заблокировал бота; снова TRUE, когда он пишет боту';
+
+-- =============================================================================
+-- АНОНСЫ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS announcements (
+    id SERIAL PRIMARY KEY,
+    title TEXT NOT NULL,
+    message TEXT NOT NULL,
+    segment JSONB NOT NULL DEFAULT '{}',
+    status TEXT NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'sending', 'sent', 'cancelled')),
+    recipients_count INT NOT NULL DEFAULT 0,
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    sent_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_announcements_created ON announcements(created_at DESC);
+
+-- =============================================================================
+-- ДОСТАВКА АНОНСОВ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS announcement_deliveries (
+    announcement_id INT NOT NULL REFERENCES announcements(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'sent', 'failed', 'blocked')),
+    error TEXT,
+    attempted_at TIMESTAMP,
+    PRIMARY KEY (announcement_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_announcement_deliveries_status ON announcement_deliveries(announcement_id, status);
+
+-- Комментарии к полям
+COMMENT ON TABLE announcements IS 'Анонсы для рассылки сегментам пользователей';
+COMMENT ON COLUMN announcements.segment IS 'Фильтры получателей: interfaceLanguages, targetLanguages, statuses, minProfileCompletion, maxProfileCompletion';
+COMMENT ON COLUMN announcements.recipients_count IS 'Количество получателей на момент начала рассылки';
+COMMENT ON TABLE announcement_deliveries IS 'Результат доставки анонса каждому получателю';
+COMMENT ON COLUMN announcement_deliveries.status IS 'pending, sent, failed; blocked - пользователь заблокировал бота';
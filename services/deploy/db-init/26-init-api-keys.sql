@@ -0,0 +1,29 @@
+-- Инициализация API-ключей admin API
+-- Создание таблиц: api_keys
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- API-КЛЮЧИ ADMIN API
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    rotated_from INT REFERENCES api_keys(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_created ON api_keys(created_at DESC);
+
+COMMENT ON TABLE api_keys IS 'Ключи admin API; сам ключ не хранится, только SHA-256';
+COMMENT ON COLUMN api_keys.key_prefix IS 'Начало ключа для опознания в списках и логах';
+COMMENT ON COLUMN api_keys.scopes IS 'Права ключа (feedback.view, users.manage, ...) или * для всех прав';
+COMMENT ON COLUMN api_keys.expires_at IS 'NULL - бессрочный ключ; при ротации старый ключ действует до конца периода перекрытия';
+COMMENT ON COLUMN api_keys.rotated_from IS 'Ключ, на смену которому выпущен этот';
@@ -0,0 +1,23 @@
+-- Инициализация сессий admin API
+-- Создание таблиц: admin_sessions
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- СЕССИИ ADMIN API (REFRESH-ТОКЕНЫ)
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS admin_sessions (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    rotated_from INT REFERENCES admin_sessions(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_sessions_user ON admin_sessions(user_id);
+
+COMMENT ON TABLE admin_sessions IS 'Сессии admin API: refresh-токены входа через Telegram Login Widget; хранится только SHA-256';
+COMMENT ON COLUMN admin_sessions.revoked_at IS 'Время выхода или обмена токена на новый; отозванный токен повторно не принимается';
+COMMENT ON COLUMN admin_sessions.rotated_from IS 'Сессия, refresh-токен которой обменян на этот';
@@ -0,0 +1,50 @@
+-- Инициализация журнала аудита
+-- Создание таблиц: audit_events
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ЖУРНАЛ АУДИТА
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS audit_events (
+    id BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL,
+    actor_type VARCHAR(20) NOT NULL,
+    actor_id VARCHAR(64) NOT NULL DEFAULT '',
+    action VARCHAR(100) NOT NULL,
+    target_type VARCHAR(50) NOT NULL DEFAULT '',
+    target_id VARCHAR(64) NOT NULL DEFAULT '',
+    result VARCHAR(20) NOT NULL,
+    ip_address VARCHAR(64) NOT NULL DEFAULT '',
+    details JSONB NOT NULL DEFAULT '{}',
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL UNIQUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_created ON audit_events(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events(actor_type, actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_action ON audit_events(action);
+CREATE INDEX IF NOT EXISTS idx_audit_events_target ON audit_events(target_type, target_id);
+
+-- Журнал только дополняется: изменение и удаление записей запрещены
+CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_audit_events_append_only ON audit_events;
+CREATE TRIGGER trg_audit_events_append_only
+    BEFORE UPDATE OR DELETE ON audit_events
+    FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+DROP TRIGGER IF EXISTS trg_audit_events_no_truncate ON audit_events;
+CREATE TRIGGER trg_audit_events_no_truncate
+    BEFORE TRUNCATE ON audit_events
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_events_append_only();
+
+COMMENT ON TABLE audit_events IS 'Журнал аудита: кто, что и когда сделал; только дополняется';
+COMMENT ON COLUMN audit_events.actor_type IS 'user, admin (пользователь с ролью), api_key или system';
+COMMENT ON COLUMN audit_events.actor_id IS 'Telegram ID пользователя или ID ключа admin API';
+COMMENT ON COLUMN audit_events.prev_hash IS 'hash предыдущей записи (64 нуля для первой)';
+COMMENT ON COLUMN audit_events.hash IS 'SHA-256 от prev_hash и полей записи: изменение любой записи рвет цепочку';
@@ -0,0 +1,54 @@
+-- Инициализация полнотекстового поиска по отзывам
+-- Изменение таблицы: user_feedback (конфигурация и вектор поиска)
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ПОЛНОТЕКСТОВЫЙ ПОИСК ПО ОТЗЫВАМ
+-- =============================================================================
+
+-- Конфигурация поиска для языка интерфейса автора. Для китайского во встроенных
+-- конфигурациях нет сегментации слов, поэтому используется simple, а поиск
+-- дополнительно сравнивает подстроку.
+CREATE OR REPLACE FUNCTION feedback_search_config(lang TEXT) RETURNS regconfig AS $$
+    SELECT CASE lang
+        WHEN 'ru' THEN 'pg_catalog.russian'::regconfig
+        WHEN 'en' THEN 'pg_catalog.english'::regconfig
+        WHEN 'es' THEN 'pg_catalog.spanish'::regconfig
+        ELSE 'pg_catalog.simple'::regconfig
+    END
+$$ LANGUAGE sql IMMUTABLE;
+
+ALTER TABLE user_feedback
+ADD COLUMN IF NOT EXISTS search_config regconfig NOT NULL DEFAULT 'pg_catalog.simple'::regconfig;
+
+-- Конфигурация уже сохраненных отзывов по текущему языку интерфейса автора
+UPDATE user_feedback uf
+SET search_config = feedback_search_config(u.interface_language_code)
+FROM users u
+WHERE u.id = uf.user_id;
+
+ALTER TABLE user_feedback
+ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector(search_config, feedback_text)) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_user_feedback_search ON user_feedback USING GIN (search_vector);
+
+-- Новый отзыв получает конфигурацию по языку интерфейса автора на момент отправки
+CREATE OR REPLACE FUNCTION set_user_feedback_search_config() RETURNS trigger AS $$
+BEGIN
+    NEW.search_config := COALESCE(
+        (SELECT feedback_search_config(interface_language_code) FROM users WHERE id = NEW.user_id),
+        'pg_catalog.simple'::regconfig
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_user_feedback_search_config ON user_feedback;
+CREATE TRIGGER trg_user_feedback_search_config
+    BEFORE INSERT ON user_feedback
+    FOR EACH ROW EXECUTE FUNCTION set_user_feedback_search_config();
+
+-- Комментарии к полям
+COMMENT ON COLUMN user_feedback.search_config IS 'Конфигурация полнотекстового поиска по языку автора: russian, english, spanish, simple (zh и прочие)';
+COMMENT ON COLUMN user_feedback.search_vector IS 'Лексемы текста отзыва для полнотекстового поиска';
@@ -0,0 +1,34 @@
+-- Инициализация пользователей других платформ
+-- Создание таблицы: user_identities, изменение таблицы: users (поле platform)
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ПЛАТФОРМА ПОЛЬЗОВАТЕЛЯ
+-- =============================================================================
+
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS platform TEXT NOT NULL DEFAULT 'telegram'
+    CHECK (platform IN ('telegram', 'discord'));
+
+-- Пользователи других платформ получают служебный отрицательный telegram_id из этой
+-- последовательности: колонка остается NOT NULL UNIQUE, а реальные Telegram ID положительны
+CREATE SEQUENCE IF NOT EXISTS external_user_telegram_id_seq;
+
+-- =============================================================================
+-- ИДЕНТИФИКАТОРЫ ПОЛЬЗОВАТЕЛЕЙ НА ПЛАТФОРМАХ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS user_identities (
+    platform TEXT NOT NULL,
+    external_id TEXT NOT NULL,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (platform, external_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user ON user_identities(user_id);
+
+-- Комментарии к полям
+COMMENT ON COLUMN users.platform IS 'Платформа, на которой зарегистрирован пользователь: telegram или discord';
+COMMENT ON TABLE user_identities IS 'Учетные записи пользователей на платформах кроме Telegram, например discord:<snowflake>';
+COMMENT ON COLUMN user_identities.external_id IS 'ID пользователя на платформе';
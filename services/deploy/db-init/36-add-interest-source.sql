@@ -0,0 +1,14 @@
+-- Инициализация происхождения интересов
+-- Изменение таблицы: interests (поле source)
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ПРОИСХОЖДЕНИЕ ИНТЕРЕСОВ
+-- =============================================================================
+
+ALTER TABLE interests
+ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'catalog'
+    CHECK (source IN ('catalog', 'suggestion', 'admin'));
+
+-- Комментарии к полям
+COMMENT ON COLUMN interests.source IS 'Происхождение интереса: catalog (interests.json, управляется catalog-sync), suggestion (одобрен из предложения), admin (создан через admin API)';
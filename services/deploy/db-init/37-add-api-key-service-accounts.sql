@@ -0,0 +1,13 @@
+-- Инициализация служебных учетных записей API-ключей
+-- Изменение таблицы: api_keys (поле service_account)
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- СЛУЖЕБНЫЕ УЧЕТНЫЕ ЗАПИСИ
+-- =============================================================================
+
+ALTER TABLE api_keys
+ADD COLUMN IF NOT EXISTS service_account BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Комментарии к полям
+COMMENT ON COLUMN api_keys.service_account IS 'Ключ служебной учетной записи: допускает запросы без X-Telegram-User-ID, задается при создании';
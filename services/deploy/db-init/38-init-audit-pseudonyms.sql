@@ -0,0 +1,20 @@
+-- Инициализация псевдонимов пользователей в журнале аудита
+-- Создание таблиц: audit_pseudonyms
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ПСЕВДОНИМЫ ПОЛЬЗОВАТЕЛЕЙ В ЖУРНАЛЕ АУДИТА
+-- =============================================================================
+
+-- В audit_events пользователь записывается псевдонимом, а не Telegram ID. Связь
+-- удаляется вместе с пользователем, после чего записи аудита нельзя связать с человеком.
+CREATE TABLE IF NOT EXISTS audit_pseudonyms (
+    user_id INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    pseudonym VARCHAR(40) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Комментарии к полям
+COMMENT ON TABLE audit_pseudonyms IS 'Псевдонимы пользователей в audit_events; удаляются вместе с пользователем';
+COMMENT ON COLUMN audit_events.actor_id IS 'Псевдоним пользователя (p_...) или ID ключа admin API';
+COMMENT ON COLUMN audit_events.target_id IS 'ID объекта; для target_type = user - псевдоним пользователя';
@@ -0,0 +1,15 @@
+-- Инициализация владельцев API-ключей
+-- Изменение таблицы: api_keys (поле owner_user_id)
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- ВЛАДЕЛЬЦЫ API-КЛЮЧЕЙ
+-- =============================================================================
+
+ALTER TABLE api_keys
+ADD COLUMN IF NOT EXISTS owner_user_id INT REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_owner ON api_keys(owner_user_id);
+
+-- Комментарии к полям
+COMMENT ON COLUMN api_keys.owner_user_id IS 'Пользователь, от имени которого действует ключ без служебной учетной записи; ключ удаляется вместе с ним';
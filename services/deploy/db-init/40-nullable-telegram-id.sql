@@ -0,0 +1,21 @@
+-- Инициализация telegram_id только для пользователей Telegram
+-- Изменение таблицы: users (поле telegram_id)
+-- Дата создания: 2026-10-18
+
+-- =============================================================================
+-- TELEGRAM ID ПОЛЬЗОВАТЕЛЕЙ
+-- =============================================================================
+
+-- У пользователей других платформ telegram_id нет: отрицательные ID в Telegram - группы
+-- и каналы, и служебный ID мог совпасть с чужим чатом. Их ID на платформе - в user_identities
+ALTER TABLE users ALTER COLUMN telegram_id DROP NOT NULL;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_telegram_id_platform_check;
+ALTER TABLE users
+ADD CONSTRAINT users_telegram_id_platform_check
+    CHECK ((telegram_id IS NOT NULL) = (platform = 'telegram'));
+
+DROP SEQUENCE IF EXISTS external_user_telegram_id_seq;
+
+-- Комментарии к полям
+COMMENT ON COLUMN users.telegram_id IS 'ID пользователя в Telegram; NULL у пользователей других платформ (их ID - в user_identities)';
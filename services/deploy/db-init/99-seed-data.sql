@@ -160,3 +160,27 @@ Ahora elige el idioma que quieres aprender:', 'language_selection'),
 ON CONFLICT (key_name, language_code) DO UPDATE SET
     translation = EXCLUDED.translation,
     updated_at = NOW();
+
+-- Связи между интересами (частичные баллы совместимости)
+INSERT INTO interest_relations (interest_id, related_interest_id, relation_type)
+SELECT i1.id, i2.id, r.relation_type
+FROM (VALUES
+    ('movies_tv', 'tv_shows', 'parent'),
+    ('movies_tv', 'comedy', 'parent'),
+    ('sports', 'fitness', 'parent'),
+    ('art', 'photography', 'parent'),
+    ('art', 'design', 'parent'),
+    ('anime', 'tv_shows', 'related'),
+    ('anime', 'games', 'related'),
+    ('travel', 'outdoor', 'related'),
+    ('travel', 'languages', 'related'),
+    ('books', 'writing', 'related'),
+    ('history', 'philosophy', 'related'),
+    ('psychology', 'philosophy', 'related'),
+    ('history', 'politics', 'related'),
+    ('technology', 'science', 'related'),
+    ('fitness', 'dancing', 'related')
+) r(interest_key, related_key, relation_type)
+JOIN interests i1 ON i1.key_name = r.interest_key
+JOIN interests i2 ON i2.key_name = r.related_key
+ON CONFLICT (interest_id, related_interest_id) DO NOTHING;
@@ -0,0 +1,18 @@
+-- Миграция: Добавление таблицы целей изучения языка
+-- Дата создания: 2026-10-18
+-- Описание: Пользователь выбирает цели (путешествия, экзамены, бизнес, переезд, общение), они учитываются в подборе
+
+CREATE TABLE IF NOT EXISTS user_learning_goals (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    goal TEXT NOT NULL CHECK (goal IN (
+        'travel', 'exam_ielts', 'exam_hsk', 'exam_dele', 'business', 'relocation', 'casual'
+    )),
+    created_at TIMESTAMP DEFAULT NOW(),
+    CONSTRAINT unique_user_learning_goal UNIQUE (user_id, goal)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_learning_goals_user_id ON user_learning_goals(user_id);
+CREATE INDEX IF NOT EXISTS idx_user_learning_goals_goal ON user_learning_goals(goal);
+
+COMMENT ON TABLE user_learning_goals IS 'Цели изучения языка пользователей (мультивыбор), используются в подборе как мягкий сигнал';
@@ -0,0 +1,20 @@
+-- Миграция: Добавление очереди предложенных пользователями интересов
+-- Дата создания: 2026-10-18
+-- Описание: Пользователь предлагает интерес из редактора, модератор одобряет его через admin API
+
+CREATE TABLE IF NOT EXISTS interest_suggestions (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    suggested_text TEXT NOT NULL,
+    language_code VARCHAR(10) NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    interest_id INT REFERENCES interests(id) ON DELETE SET NULL,
+    moderator_note TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    reviewed_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_interest_suggestions_status ON interest_suggestions(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_interest_suggestions_user_id ON interest_suggestions(user_id);
+
+COMMENT ON TABLE interest_suggestions IS 'Интересы, предложенные пользователями; после одобрения становятся записями в interests';
@@ -0,0 +1,43 @@
+-- Миграция: Добавление графа связей между интересами
+-- Дата создания: 2026-10-18
+-- Описание: Похожие (related) и вложенные (parent) интересы дают частичные баллы совместимости.
+-- Граф редактируется через admin API без передеплоя.
+
+CREATE TABLE IF NOT EXISTS interest_relations (
+    id SERIAL PRIMARY KEY,
+    interest_id INT NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    related_interest_id INT NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    relation_type TEXT NOT NULL CHECK (relation_type IN ('related', 'parent')),
+    created_at TIMESTAMP DEFAULT NOW(),
+    CONSTRAINT check_interest_relation_distinct CHECK (interest_id <> related_interest_id),
+    CONSTRAINT unique_interest_relation UNIQUE (interest_id, related_interest_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_interest_relations_interest_id ON interest_relations(interest_id);
+CREATE INDEX IF NOT EXISTS idx_interest_relations_related_interest_id ON interest_relations(related_interest_id);
+
+COMMENT ON TABLE interest_relations IS 'Граф связей между интересами: частичные баллы совместимости за похожие интересы';
+
+-- Начальный набор связей
+INSERT INTO interest_relations (interest_id, related_interest_id, relation_type)
+SELECT i1.id, i2.id, r.relation_type
+FROM (VALUES
+    ('movies_tv', 'tv_shows', 'parent'),
+    ('movies_tv', 'comedy', 'parent'),
+    ('sports', 'fitness', 'parent'),
+    ('art', 'photography', 'parent'),
+    ('art', 'design', 'parent'),
+    ('anime', 'tv_shows', 'related'),
+    ('anime', 'games', 'related'),
+    ('travel', 'outdoor', 'related'),
+    ('travel', 'languages', 'related'),
+    ('books', 'writing', 'related'),
+    ('history', 'philosophy', 'related'),
+    ('psychology', 'philosophy', 'related'),
+    ('history', 'politics', 'related'),
+    ('technology', 'science', 'related'),
+    ('fitness', 'dancing', 'related')
+) r(interest_key, related_key, relation_type)
+JOIN interests i1 ON i1.key_name = r.interest_key
+JOIN interests i2 ON i2.key_name = r.related_key
+ON CONFLICT (interest_id, related_interest_id) DO NOTHING;
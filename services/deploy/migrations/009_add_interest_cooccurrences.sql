@@ -0,0 +1,18 @@
+-- Миграция: Добавление статистики совместного выбора интересов
+-- Дата создания: 2026-10-18
+-- Описание: Рекомендации «выбравшие X также выбрали Y» для редактора интересов.
+-- Таблица пересчитывается периодической задачей бота, заполнять ее не нужно.
+
+CREATE TABLE IF NOT EXISTS interest_cooccurrences (
+    interest_id INT NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    related_interest_id INT NOT NULL REFERENCES interests(id) ON DELETE CASCADE,
+    users_count INT NOT NULL,
+    confidence DOUBLE PRECISION NOT NULL,
+    computed_at TIMESTAMP DEFAULT NOW(),
+    PRIMARY KEY (interest_id, related_interest_id),
+    CONSTRAINT check_interest_cooccurrence_distinct CHECK (interest_id <> related_interest_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_interest_cooccurrences_users_count ON interest_cooccurrences(users_count DESC);
+
+COMMENT ON TABLE interest_cooccurrences IS 'Кто выбрал interest_id, тот часто выбирал и related_interest_id: рекомендации интересов';
@@ -0,0 +1,12 @@
+-- Миграция: Добавление ролей пользователей
+-- Дата создания: 2026-10-18
+-- Описание: Права в боте и admin API проверяются по роли (user, moderator, admin),
+-- а не по спискам ADMIN_CHAT_IDS/ADMIN_USERNAMES. Списки из конфигурации только назначают роль admin.
+
+ALTER TABLE users
+ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'user'
+    CHECK (role IN ('user', 'moderator', 'admin'));
+
+CREATE INDEX IF NOT EXISTS idx_users_role ON users(role);
+
+COMMENT ON COLUMN users.role IS 'Роль пользователя: user, moderator, admin; администраторы из ADMIN_CHAT_IDS/ADMIN_USERNAMES назначаются при запуске бота';
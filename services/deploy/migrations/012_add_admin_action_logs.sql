@@ -0,0 +1,19 @@
+-- Миграция: Добавление журнала действий администраторов
+-- Дата создания: 2026-10-18
+-- Описание: Действия админ-панели в Telegram (сброс профиля, смена статуса и состояния,
+-- личные сообщения пользователям) записываются в admin_action_logs.
+
+CREATE TABLE IF NOT EXISTS admin_action_logs (
+    id SERIAL PRIMARY KEY,
+    admin_id INT REFERENCES users(id) ON DELETE SET NULL,
+    action TEXT NOT NULL,
+    target_type TEXT NOT NULL,
+    target_id INT,
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_action_logs_target ON admin_action_logs(target_type, target_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_admin_action_logs_admin ON admin_action_logs(admin_id, created_at DESC);
+
+COMMENT ON TABLE admin_action_logs IS 'Действия администраторов и модераторов в админ-панели бота';
@@ -0,0 +1,24 @@
+-- Миграция: Переписка с автором отзыва
+-- Дата создания: 2026-10-18
+-- Описание: Администратор отвечает на отзыв из просмотра отзывов в боте, ответ доставляется
+-- пользователю на языке интерфейса, пользователь может ответить. Сообщения хранятся цепочкой
+-- на отзыве; последний ответ администратора по-прежнему записывается в user_feedback.admin_response.
+
+-- =============================================================================
+-- ПЕРЕПИСКА ПО ОТЗЫВАМ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS feedback_messages (
+    id SERIAL PRIMARY KEY,
+    feedback_id INTEGER NOT NULL REFERENCES user_feedback(id) ON DELETE CASCADE,
+    author_type VARCHAR(10) NOT NULL CHECK (author_type IN ('admin', 'user')),
+    author_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    message_text TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_feedback_messages_feedback ON feedback_messages(feedback_id, created_at);
+
+COMMENT ON TABLE feedback_messages IS 'Ответы администраторов на отзыв и ответы автора отзыва';
+COMMENT ON COLUMN feedback_messages.author_type IS 'admin - ответ администратора, user - ответ автора отзыва';
+COMMENT ON COLUMN feedback_messages.author_id IS 'Пользователь, написавший сообщение';
@@ -0,0 +1,36 @@
+-- Миграция: Категории, приоритеты и SLA отзывов
+-- Дата создания: 2026-10-18
+-- Описание: Отзыву назначается категория (предлагается автоматически по ключевым словам),
+-- приоритет, ответственный и срок обработки по приоритету. Бот уведомляет администраторов
+-- о необработанных отзывах с истекшим сроком.
+
+-- =============================================================================
+-- РАЗМЕТКА ОТЗЫВОВ И SLA
+-- =============================================================================
+
+ALTER TABLE user_feedback
+ADD COLUMN IF NOT EXISTS category VARCHAR(20)
+    CHECK (category IN ('bug', 'feature', 'complaint', 'praise')),
+ADD COLUMN IF NOT EXISTS category_auto BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN IF NOT EXISTS priority VARCHAR(10) NOT NULL DEFAULT 'normal'
+    CHECK (priority IN ('low', 'normal', 'high', 'urgent')),
+ADD COLUMN IF NOT EXISTS assignee_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+ADD COLUMN IF NOT EXISTS sla_due_at TIMESTAMP,
+ADD COLUMN IF NOT EXISTS sla_alerted_at TIMESTAMP;
+
+-- Существующим отзывам назначается срок обычного приоритета (72 часа)
+UPDATE user_feedback
+SET sla_due_at = created_at + INTERVAL '72 hours'
+WHERE sla_due_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_feedback_assignee ON user_feedback(assignee_id);
+CREATE INDEX IF NOT EXISTS idx_user_feedback_sla_pending ON user_feedback(sla_due_at)
+    WHERE is_processed = FALSE AND sla_alerted_at IS NULL;
+
+-- Комментарии к полям
+COMMENT ON COLUMN user_feedback.category IS 'Категория: bug, feature, complaint, praise; NULL - не определена';
+COMMENT ON COLUMN user_feedback.category_auto IS 'Категория предложена правилами по ключевым словам и не подтверждена администратором';
+COMMENT ON COLUMN user_feedback.priority IS 'Приоритет: low, normal, high, urgent; определяет срок SLA';
+COMMENT ON COLUMN user_feedback.assignee_id IS 'Администратор или модератор, ответственный за отзыв';
+COMMENT ON COLUMN user_feedback.sla_due_at IS 'Срок обработки отзыва: created_at + SLA приоритета';
+COMMENT ON COLUMN user_feedback.sla_alerted_at IS 'Когда администраторы были уведомлены о нарушении срока; сбрасывается при смене срока';
@@ -0,0 +1,28 @@
+-- Миграция: Вложения отзывов
+-- Дата создания: 2026-10-18
+-- Описание: К отзыву можно приложить фото, документы и голосовые сообщения. Бот хранит их
+-- Telegram file_id и пересылает файлы администраторам с уведомлением и в просмотре отзывов.
+
+-- =============================================================================
+-- ВЛОЖЕНИЯ ОТЗЫВОВ
+-- =============================================================================
+
+-- Сами файлы хранятся в Telegram: бот пересылает их администраторам по file_id
+CREATE TABLE IF NOT EXISTS feedback_attachments (
+    id SERIAL PRIMARY KEY,
+    feedback_id INTEGER NOT NULL REFERENCES user_feedback(id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('photo', 'document', 'voice')),
+    file_id TEXT NOT NULL,
+    file_unique_id TEXT NOT NULL,
+    file_name TEXT,
+    mime_type VARCHAR(255),
+    file_size INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_feedback_attachments_feedback ON feedback_attachments(feedback_id, id);
+
+COMMENT ON TABLE feedback_attachments IS 'Скриншоты, документы и голосовые сообщения, приложенные к отзыву';
+COMMENT ON COLUMN feedback_attachments.kind IS 'Тип вложения: photo, document, voice';
+COMMENT ON COLUMN feedback_attachments.file_id IS 'Telegram file_id для повторной отправки файла ботом';
+COMMENT ON COLUMN feedback_attachments.file_unique_id IS 'Постоянный идентификатор файла в Telegram';
@@ -0,0 +1,70 @@
+-- Миграция: NPS и опросы удовлетворенности
+-- Дата создания: 2026-10-18
+-- Описание: Опрос рассылается сегменту активных пользователей сразу или в назначенное время.
+-- Ответы собираются кнопками и хранятся по вопросам; пользователь не получает опрос чаще,
+-- чем позволяют правила частоты (проверяются по доставленным опросам).
+
+-- =============================================================================
+-- ОПРОСЫ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS surveys (
+    id SERIAL PRIMARY KEY,
+    title TEXT NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('nps', 'questionnaire')),
+    questions JSONB NOT NULL DEFAULT '[]',
+    segment JSONB NOT NULL DEFAULT '{}',
+    status TEXT NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'scheduled', 'sending', 'sent', 'cancelled')),
+    scheduled_at TIMESTAMPTZ,
+    recipients_count INT NOT NULL DEFAULT 0,
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_surveys_created ON surveys(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_surveys_scheduled ON surveys(scheduled_at) WHERE status = 'scheduled';
+
+-- =============================================================================
+-- ДОСТАВКА ОПРОСОВ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS survey_deliveries (
+    survey_id INT NOT NULL REFERENCES surveys(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'sent', 'failed', 'blocked')),
+    error TEXT,
+    attempted_at TIMESTAMPTZ,
+    PRIMARY KEY (survey_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_survey_deliveries_status ON survey_deliveries(survey_id, status);
+-- Правила частоты опросов проверяют последние доставленные опросы пользователя
+CREATE INDEX IF NOT EXISTS idx_survey_deliveries_user_sent ON survey_deliveries(user_id, attempted_at) WHERE status = 'sent';
+
+-- =============================================================================
+-- ОТВЕТЫ НА ОПРОСЫ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS survey_answers (
+    survey_id INT NOT NULL,
+    user_id INT NOT NULL,
+    question_index SMALLINT NOT NULL CHECK (question_index >= 0),
+    value SMALLINT NOT NULL,
+    answered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (survey_id, user_id, question_index),
+    FOREIGN KEY (survey_id, user_id) REFERENCES survey_deliveries(survey_id, user_id) ON DELETE CASCADE
+);
+
+-- Комментарии к полям
+COMMENT ON TABLE surveys IS 'NPS и короткие опросы для сегментов пользователей';
+COMMENT ON COLUMN surveys.kind IS 'nps - один вопрос NPS; questionnaire - до 5 вопросов';
+COMMENT ON COLUMN surveys.questions IS 'Вопросы: kind (nps, rating, choice), text и options с переводами по языкам интерфейса';
+COMMENT ON COLUMN surveys.segment IS 'Фильтры получателей, как у анонсов';
+COMMENT ON COLUMN surveys.scheduled_at IS 'Время автоматического запуска для статуса scheduled';
+COMMENT ON COLUMN surveys.recipients_count IS 'Количество получателей на момент начала рассылки';
+COMMENT ON TABLE survey_deliveries IS 'Доставка опроса каждому получателю';
+COMMENT ON TABLE survey_answers IS 'Ответы на вопросы опроса, повторный ответ заменяет предыдущий';
+COMMENT ON COLUMN survey_answers.value IS 'NPS 0-10, оценка 1-5 или номер варианта ответа с 0';
@@ -0,0 +1,27 @@
+-- Миграция: удаление аккаунта пользователем (/deleteme)
+-- Дата создания: 2026-10-18
+-- Описание: Строки пользователя удаляются каскадно, совпадения отменяются и обезличиваются,
+-- записи админ-панели о пользователе теряют ссылку на него. Для статистики остается
+-- только обезличенная запись об удалении.
+
+-- =============================================================================
+-- УДАЛЕННЫЕ АККАУНТЫ
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS account_deletions (
+    id SERIAL PRIMARY KEY,
+    deleted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    account_age_days INT NOT NULL DEFAULT 0,
+    feedback_removed INT NOT NULL DEFAULT 0,
+    matches_cancelled INT NOT NULL DEFAULT 0,
+    partners_notified INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_deletions_deleted ON account_deletions(deleted_at DESC);
+
+-- Комментарии к полям
+COMMENT ON TABLE account_deletions IS 'Обезличенные записи об удалении аккаунтов командой /deleteme: без ID, имени и Telegram ID пользователя';
+COMMENT ON COLUMN account_deletions.account_age_days IS 'Сколько дней прошло с регистрации до удаления';
+COMMENT ON COLUMN account_deletions.feedback_removed IS 'Сколько отзывов пользователя удалено вместе с аккаунтом';
+COMMENT ON COLUMN account_deletions.matches_cancelled IS 'Сколько ожидающих и текущих совпадений отменено';
+COMMENT ON COLUMN account_deletions.partners_notified IS 'Скольким текущим собеседникам отправлено уведомление';
@@ -0,0 +1,20 @@
+-- Миграция: Происхождение интересов
+-- Дата создания: 2026-10-18
+-- Описание: catalog-sync управляет только интересами из config/interests.json.
+-- Интересы, одобренные из предложений пользователей или созданные через admin API,
+-- помечаются отдельно и не удаляются синхронизацией, пока их нет в файле.
+
+ALTER TABLE interests
+ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'catalog'
+    CHECK (source IN ('catalog', 'suggestion', 'admin'));
+
+-- Интересы, уже одобренные из предложений
+UPDATE interests SET source = 'suggestion'
+WHERE source = 'catalog'
+  AND id IN (SELECT interest_id FROM interest_suggestions WHERE status = 'approved' AND interest_id IS NOT NULL);
+
+-- Интересы, ранее созданные через admin API, по БД не отличить от каталожных:
+-- если их нет в interests.json, пометьте их вручную перед запуском catalog-sync:
+--   UPDATE interests SET source = 'admin' WHERE key_name IN (...);
+
+COMMENT ON COLUMN interests.source IS 'Происхождение интереса: catalog (interests.json, управляется catalog-sync), suggestion (одобрен из предложения), admin (создан через admin API)';
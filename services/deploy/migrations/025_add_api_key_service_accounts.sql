@@ -0,0 +1,15 @@
+-- Миграция: Служебные учетные записи API-ключей
+-- Дата создания: 2026-10-18
+-- Описание: Запрос admin API без X-Telegram-User-ID выполняется от имени служебной
+-- учетной записи только для ключа с service_account = TRUE. Остальным ключам нужен
+-- заголовок: права определяются ролью пользователя и правами ключа.
+
+ALTER TABLE api_keys
+ADD COLUMN IF NOT EXISTS service_account BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- До миграции любой ключ без заголовка работал как служебный. Чтобы не сломать
+-- интеграции, существующие ключи остаются служебными; ключи, которые используются
+-- только вместе с X-Telegram-User-ID, стоит перевыпустить без флага (cmd/api-keys).
+UPDATE api_keys SET service_account = TRUE WHERE revoked_at IS NULL;
+
+COMMENT ON COLUMN api_keys.service_account IS 'Ключ служебной учетной записи: допускает запросы без X-Telegram-User-ID, задается при создании';
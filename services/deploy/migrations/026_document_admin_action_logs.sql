@@ -0,0 +1,8 @@
+-- Миграция: admin_action_logs - кэш карточки пользователя
+-- Дата создания: 2026-10-18
+-- Описание: Действия администраторов пишутся и в admin_action_logs, и в audit_events.
+-- Источник истины - append-only журнал audit_events; admin_action_logs только показывает
+-- последние действия в карточке пользователя, изменяется (анонимизация, сроки хранения)
+-- и для расследований не используется.
+
+COMMENT ON TABLE admin_action_logs IS 'Кэш последних действий администраторов для карточки пользователя в админ-панели; не журнал аудита: источник истины - audit_events';
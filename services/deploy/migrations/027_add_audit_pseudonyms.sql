@@ -0,0 +1,22 @@
+-- Миграция: Псевдонимы пользователей в журнале аудита
+-- Дата создания: 2026-10-18
+-- Описание: Журнал аудита только дополняется, поэтому удаленного пользователя в нем нельзя
+-- обезличить задним числом. Вместо Telegram ID и внутреннего ID в audit_events пишется
+-- случайный псевдоним пользователя. Связь псевдонима с пользователем хранится в
+-- audit_pseudonyms и удаляется вместе с пользователем (/deleteme, очистка по срокам):
+-- после этого записи аудита остаются, но указать на человека уже не могут.
+--
+-- Записи, сделанные до этой миграции, содержат Telegram ID и внутренние ID. Изменить их
+-- нельзя, не разорвав цепочку хешей; они хранятся как журнал безопасности и исключены
+-- из удаления по запросу пользователя.
+
+CREATE TABLE IF NOT EXISTS audit_pseudonyms (
+    user_id INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    pseudonym VARCHAR(40) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE audit_pseudonyms IS 'Псевдонимы пользователей в audit_events; удаляются вместе с пользователем';
+
+COMMENT ON COLUMN audit_events.actor_id IS 'Псевдоним пользователя (p_...) или ID ключа admin API; в записях до миграции 027 - Telegram ID';
+COMMENT ON COLUMN audit_events.target_id IS 'ID объекта; для target_type = user - псевдоним пользователя';
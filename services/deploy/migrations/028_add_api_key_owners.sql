@@ -0,0 +1,16 @@
+-- Миграция: Владельцы API-ключей
+-- Дата создания: 2026-10-18
+-- Описание: Ключ admin API без служебной учетной записи действует от имени владельца,
+-- к которому привязан при выпуске. Раньше пользователь брался из заголовка
+-- X-Telegram-User-ID, который задает клиент, и ключ мог выдать себя за любого
+-- пользователя. Теперь заголовок только сверяется с владельцем.
+
+ALTER TABLE api_keys
+ADD COLUMN IF NOT EXISTS owner_user_id INT REFERENCES users(id) ON DELETE CASCADE;
+
+-- Владельца существующих ключей восстановить нельзя: заголовок не хранился. Такие ключи
+-- получают 403, пока их не перевыпустят с владельцем (cmd/api-keys create -owner).
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_owner ON api_keys(owner_user_id);
+
+COMMENT ON COLUMN api_keys.owner_user_id IS 'Пользователь, от имени которого действует ключ без служебной учетной записи; ключ удаляется вместе с ним';
@@ -0,0 +1,19 @@
+-- Миграция: telegram_id только у пользователей Telegram
+-- Дата создания: 2026-10-18
+-- Описание: Пользователи других платформ получали служебный отрицательный telegram_id,
+-- но отрицательные ID в Telegram - это группы и каналы, и отправка по такому ID могла
+-- попасть в чужой чат. Теперь у них telegram_id нет (NULL), а адресат на платформе
+-- определяется по user_identities.
+
+ALTER TABLE users ALTER COLUMN telegram_id DROP NOT NULL;
+
+UPDATE users SET telegram_id = NULL WHERE platform <> 'telegram';
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_telegram_id_platform_check;
+ALTER TABLE users
+ADD CONSTRAINT users_telegram_id_platform_check
+    CHECK ((telegram_id IS NOT NULL) = (platform = 'telegram'));
+
+DROP SEQUENCE IF EXISTS external_user_telegram_id_seq;
+
+COMMENT ON COLUMN users.telegram_id IS 'ID пользователя в Telegram; NULL у пользователей других платформ (их ID - в user_identities)';